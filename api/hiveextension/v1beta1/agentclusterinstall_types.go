@@ -160,6 +160,12 @@ type AgentClusterInstallSpec struct {
 	// +optional
 	HoldInstallation bool `json:"holdInstallation,omitempty"`
 
+	// InstallSchedule is the window in which the installation is allowed to start. Once the RequirementsMet
+	// condition is true, installation will not begin before the start of the window, and will not begin at all
+	// if the window ends first.
+	// +optional
+	InstallSchedule *InstallSchedule `json:"installSchedule,omitempty"`
+
 	// IgnitionEndpoint stores the data of the custom ignition endpoint.
 	// +optional
 	IgnitionEndpoint *IgnitionEndpoint `json:"ignitionEndpoint,omitempty"`
@@ -188,9 +194,23 @@ type IgnitionEndpoint struct {
 	CaCertificateReference *CaCertificateReference `json:"caCertificateReference,omitempty"`
 }
 
+// InstallSchedule is the window in which the installation of the cluster is allowed to start.
+type InstallSchedule struct {
+	// StartAt is the time from which the installation is allowed to start.
+	StartAt metav1.Time `json:"startAt"`
+
+	// EndAt is the time after which the installation is no longer allowed to start. When not set, the window never ends.
+	// +optional
+	EndAt *metav1.Time `json:"endAt,omitempty"`
+}
+
 type ClusterProgressInfo struct {
 	// Estimated installation progress (in percentage)
 	TotalPercentage int64 `json:"totalPercentage"`
+	// EstimatedCompletionTime is the estimated time at which the installation completes, based on previous
+	// installations of the same OpenShift version and topology
+	// +optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
 }
 
 // AgentClusterInstallStatus defines the observed state of the AgentClusterInstall.
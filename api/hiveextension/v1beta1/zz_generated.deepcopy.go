@@ -123,6 +123,11 @@ func (in *AgentClusterInstallSpec) DeepCopyInto(out *AgentClusterInstallSpec) {
 		*out = make([]AgentMachinePool, len(*in))
 		copy(*out, *in)
 	}
+	if in.InstallSchedule != nil {
+		in, out := &in.InstallSchedule, &out.InstallSchedule
+		*out = new(InstallSchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IgnitionEndpoint != nil {
 		in, out := &in.IgnitionEndpoint, &out.IgnitionEndpoint
 		*out = new(IgnitionEndpoint)
@@ -160,7 +165,7 @@ func (in *AgentClusterInstallStatus) DeepCopyInto(out *AgentClusterInstallStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Progress = in.Progress
+	in.Progress.DeepCopyInto(&out.Progress)
 	if in.MachineNetwork != nil {
 		in, out := &in.MachineNetwork, &out.MachineNetwork
 		*out = make([]MachineNetworkEntry, len(*in))
@@ -247,6 +252,10 @@ func (in *ClusterNetworkEntry) DeepCopy() *ClusterNetworkEntry {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterProgressInfo) DeepCopyInto(out *ClusterProgressInfo) {
 	*out = *in
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProgressInfo.
@@ -319,6 +328,26 @@ func (in *IgnitionEndpoint) DeepCopy() *IgnitionEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallSchedule) DeepCopyInto(out *InstallSchedule) {
+	*out = *in
+	in.StartAt.DeepCopyInto(&out.StartAt)
+	if in.EndAt != nil {
+		in, out := &in.EndAt, &out.EndAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallSchedule.
+func (in *InstallSchedule) DeepCopy() *InstallSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineNetworkEntry) DeepCopyInto(out *MachineNetworkEntry) {
 	*out = *in
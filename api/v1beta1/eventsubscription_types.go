@@ -0,0 +1,94 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// EventSubscriptionSecretKey is the key of the signing secret in the Secret referenced by an EventSubscription
+	EventSubscriptionSecretKey = "secret"
+)
+
+// EventSubscriptionSpec defines the desired state of EventSubscription
+type EventSubscriptionSpec struct {
+	// URL is the HTTP or HTTPS endpoint that the events are delivered to, as CloudEvents.
+	URL string `json:"url"`
+
+	// SecretRef is a reference to a secret in the same namespace, whose "secret" key signs the delivered
+	// events with HMAC-SHA256 in the X-Assisted-Signature header.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+
+	// Severities limits the delivered events to these severities. All severities are delivered when empty.
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+
+	// Categories limits the delivered events to these categories. Only user events are delivered when empty.
+	// +optional
+	Categories []string `json:"categories,omitempty"`
+
+	// EventNames limits the delivered events to these names. All events are delivered when empty.
+	// +optional
+	EventNames []string `json:"eventNames,omitempty"`
+
+	// ClusterRef limits the delivered events to the events of the cluster of this ClusterDeployment.
+	// +optional
+	ClusterRef *ClusterReference `json:"clusterRef,omitempty"`
+
+	// Disabled stops the delivery of events. The events that are emitted while the subscription is disabled
+	// are not delivered.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// EventSubscriptionStatus defines the observed state of EventSubscription
+type EventSubscriptionStatus struct {
+	// SubscriptionID is the ID of the event subscription in the assisted-service, whose delivery log
+	// can be retrieved through the REST API
+	SubscriptionID string `json:"subscriptionID,omitempty"`
+
+	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// EventSubscription is the Schema for the EventSubscriptions API
+type EventSubscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventSubscriptionSpec   `json:"spec,omitempty"`
+	Status EventSubscriptionStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EventSubscriptionList contains a list of EventSubscription
+type EventSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSubscription `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EventSubscription{}, &EventSubscriptionList{})
+}
@@ -13,6 +13,9 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/openshift/assisted-service/client/audit"
+	"github.com/openshift/assisted-service/client/cluster_templates"
+	"github.com/openshift/assisted-service/client/event_subscriptions"
 	"github.com/openshift/assisted-service/client/events"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
@@ -63,6 +66,9 @@ func New(c Config) *AssistedInstall {
 
 	cli := new(AssistedInstall)
 	cli.Transport = transport
+	cli.Audit = audit.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ClusterTemplates = cluster_templates.New(transport, strfmt.Default, c.AuthInfo)
+	cli.EventSubscriptions = event_subscriptions.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
@@ -74,11 +80,14 @@ func New(c Config) *AssistedInstall {
 
 // AssistedInstall is a client for assisted install
 type AssistedInstall struct {
-	Events         *events.Client
-	Installer      *installer.Client
-	ManagedDomains *managed_domains.Client
-	Manifests      *manifests.Client
-	Operators      *operators.Client
-	Versions       *versions.Client
-	Transport      runtime.ClientTransport
+	Audit              *audit.Client
+	ClusterTemplates   *cluster_templates.Client
+	EventSubscriptions *event_subscriptions.Client
+	Events             *events.Client
+	Installer          *installer.Client
+	ManagedDomains     *managed_domains.Client
+	Manifests          *manifests.Client
+	Operators          *operators.Client
+	Versions           *versions.Client
+	Transport          runtime.ClientTransport
 }
@@ -0,0 +1,386 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2ListAuditLogParams creates a new V2ListAuditLogParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListAuditLogParams() *V2ListAuditLogParams {
+	return &V2ListAuditLogParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListAuditLogParamsWithTimeout creates a new V2ListAuditLogParams object
+// with the ability to set a timeout on a request.
+func NewV2ListAuditLogParamsWithTimeout(timeout time.Duration) *V2ListAuditLogParams {
+	return &V2ListAuditLogParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListAuditLogParamsWithContext creates a new V2ListAuditLogParams object
+// with the ability to set a context for a request.
+func NewV2ListAuditLogParamsWithContext(ctx context.Context) *V2ListAuditLogParams {
+	return &V2ListAuditLogParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListAuditLogParamsWithHTTPClient creates a new V2ListAuditLogParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListAuditLogParamsWithHTTPClient(client *http.Client) *V2ListAuditLogParams {
+	return &V2ListAuditLogParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ListAuditLogParams contains all the parameters to send to the API endpoint
+   for the v2 list audit log operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ListAuditLogParams struct {
+
+	/* Actor.
+
+	   Return the entries of the changes made by this user.
+	*/
+	Actor *string
+
+	/* Limit.
+
+	   The maximal number of entries to return.
+
+	   Format: int64
+	   Default: 100
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   The number of entries to skip before starting to return entries.
+
+	   Format: int64
+	*/
+	Offset *int64
+
+	/* OrgID.
+
+	   Return the entries of the changes made by the users of this organization.
+	*/
+	OrgID *string
+
+	/* ResourceID.
+
+	   Return the entries of the changes made to this cluster, host or infra-env.
+
+	   Format: uuid
+	*/
+	ResourceID *strfmt.UUID
+
+	/* Since.
+
+	   Return the entries of the changes made at or after this time.
+
+	   Format: date-time
+	*/
+	Since *strfmt.DateTime
+
+	/* Source.
+
+	   Return the entries of the changes made through this interface.
+	*/
+	Source *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list audit log params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListAuditLogParams) WithDefaults() *V2ListAuditLogParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list audit log params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListAuditLogParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+	)
+
+	val := V2ListAuditLogParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithTimeout(timeout time.Duration) *V2ListAuditLogParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithContext(ctx context.Context) *V2ListAuditLogParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithHTTPClient(client *http.Client) *V2ListAuditLogParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithActor adds the actor to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithActor(actor *string) *V2ListAuditLogParams {
+	o.SetActor(actor)
+	return o
+}
+
+// SetActor adds the actor to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetActor(actor *string) {
+	o.Actor = actor
+}
+
+// WithLimit adds the limit to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithLimit(limit *int64) *V2ListAuditLogParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithOffset(offset *int64) *V2ListAuditLogParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithOrgID adds the orgID to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithOrgID(orgID *string) *V2ListAuditLogParams {
+	o.SetOrgID(orgID)
+	return o
+}
+
+// SetOrgID adds the orgId to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetOrgID(orgID *string) {
+	o.OrgID = orgID
+}
+
+// WithResourceID adds the resourceID to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithResourceID(resourceID *strfmt.UUID) *V2ListAuditLogParams {
+	o.SetResourceID(resourceID)
+	return o
+}
+
+// SetResourceID adds the resourceId to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetResourceID(resourceID *strfmt.UUID) {
+	o.ResourceID = resourceID
+}
+
+// WithSince adds the since to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithSince(since *strfmt.DateTime) *V2ListAuditLogParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithSource adds the source to the v2 list audit log params
+func (o *V2ListAuditLogParams) WithSource(source *string) *V2ListAuditLogParams {
+	o.SetSource(source)
+	return o
+}
+
+// SetSource adds the source to the v2 list audit log params
+func (o *V2ListAuditLogParams) SetSource(source *string) {
+	o.Source = source
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListAuditLogParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Actor != nil {
+
+		// query param actor
+		var qrActor string
+
+		if o.Actor != nil {
+			qrActor = *o.Actor
+		}
+		qActor := qrActor
+		if qActor != "" {
+
+			if err := r.SetQueryParam("actor", qActor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.OrgID != nil {
+
+		// query param org_id
+		var qrOrgID string
+
+		if o.OrgID != nil {
+			qrOrgID = *o.OrgID
+		}
+		qOrgID := qrOrgID
+		if qOrgID != "" {
+
+			if err := r.SetQueryParam("org_id", qOrgID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ResourceID != nil {
+
+		// query param resource_id
+		var qrResourceID strfmt.UUID
+
+		if o.ResourceID != nil {
+			qrResourceID = *o.ResourceID
+		}
+		qResourceID := qrResourceID.String()
+		if qResourceID != "" {
+
+			if err := r.SetQueryParam("resource_id", qResourceID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Source != nil {
+
+		// query param source
+		var qrSource string
+
+		if o.Source != nil {
+			qrSource = *o.Source
+		}
+		qSource := qrSource
+		if qSource != "" {
+
+			if err := r.SetQueryParam("source", qSource); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
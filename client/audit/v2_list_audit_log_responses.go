@@ -0,0 +1,255 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListAuditLogReader is a Reader for the V2ListAuditLog structure.
+type V2ListAuditLogReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListAuditLogReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListAuditLogOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2ListAuditLogBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2ListAuditLogUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListAuditLogForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ListAuditLogMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListAuditLogInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListAuditLogOK creates a V2ListAuditLogOK with default headers values
+func NewV2ListAuditLogOK() *V2ListAuditLogOK {
+	return &V2ListAuditLogOK{}
+}
+
+/* V2ListAuditLogOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListAuditLogOK struct {
+	Payload models.AuditLogEntryList
+}
+
+func (o *V2ListAuditLogOK) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-log][%d] v2ListAuditLogOK  %+v", 200, o.Payload)
+}
+func (o *V2ListAuditLogOK) GetPayload() models.AuditLogEntryList {
+	return o.Payload
+}
+
+func (o *V2ListAuditLogOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditLogBadRequest creates a V2ListAuditLogBadRequest with default headers values
+func NewV2ListAuditLogBadRequest() *V2ListAuditLogBadRequest {
+	return &V2ListAuditLogBadRequest{}
+}
+
+/* V2ListAuditLogBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ListAuditLogBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2ListAuditLogBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-log][%d] v2ListAuditLogBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2ListAuditLogBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListAuditLogBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditLogUnauthorized creates a V2ListAuditLogUnauthorized with default headers values
+func NewV2ListAuditLogUnauthorized() *V2ListAuditLogUnauthorized {
+	return &V2ListAuditLogUnauthorized{}
+}
+
+/* V2ListAuditLogUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListAuditLogUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListAuditLogUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-log][%d] v2ListAuditLogUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListAuditLogUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListAuditLogUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditLogForbidden creates a V2ListAuditLogForbidden with default headers values
+func NewV2ListAuditLogForbidden() *V2ListAuditLogForbidden {
+	return &V2ListAuditLogForbidden{}
+}
+
+/* V2ListAuditLogForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListAuditLogForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListAuditLogForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-log][%d] v2ListAuditLogForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListAuditLogForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListAuditLogForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditLogMethodNotAllowed creates a V2ListAuditLogMethodNotAllowed with default headers values
+func NewV2ListAuditLogMethodNotAllowed() *V2ListAuditLogMethodNotAllowed {
+	return &V2ListAuditLogMethodNotAllowed{}
+}
+
+/* V2ListAuditLogMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ListAuditLogMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2ListAuditLogMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-log][%d] v2ListAuditLogMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2ListAuditLogMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListAuditLogMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListAuditLogInternalServerError creates a V2ListAuditLogInternalServerError with default headers values
+func NewV2ListAuditLogInternalServerError() *V2ListAuditLogInternalServerError {
+	return &V2ListAuditLogInternalServerError{}
+}
+
+/* V2ListAuditLogInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListAuditLogInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListAuditLogInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/audit-log][%d] v2ListAuditLogInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListAuditLogInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListAuditLogInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
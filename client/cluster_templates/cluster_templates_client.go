@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the cluster templates client
+type API interface {
+	/*
+	   V2DeregisterClusterTemplate Deletes the cluster template. Clusters created from the template are not affected.*/
+	V2DeregisterClusterTemplate(ctx context.Context, params *V2DeregisterClusterTemplateParams) (*V2DeregisterClusterTemplateNoContent, error)
+	/*
+	   V2GetClusterTemplate Retrieves the details of the cluster template.*/
+	V2GetClusterTemplate(ctx context.Context, params *V2GetClusterTemplateParams) (*V2GetClusterTemplateOK, error)
+	/*
+	   V2ListClusterTemplates Retrieves the list of cluster templates.*/
+	V2ListClusterTemplates(ctx context.Context, params *V2ListClusterTemplatesParams) (*V2ListClusterTemplatesOK, error)
+	/*
+	   V2RegisterClusterTemplate Creates a new cluster template.*/
+	V2RegisterClusterTemplate(ctx context.Context, params *V2RegisterClusterTemplateParams) (*V2RegisterClusterTemplateCreated, error)
+	/*
+	   V2UpdateClusterTemplate Replaces the properties of the cluster template. Clusters created from the template are not affected.*/
+	V2UpdateClusterTemplate(ctx context.Context, params *V2UpdateClusterTemplateParams) (*V2UpdateClusterTemplateOK, error)
+}
+
+// New creates a new cluster templates API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for cluster templates API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DeregisterClusterTemplate Deletes the cluster template. Clusters created from the template are not affected.
+*/
+func (a *Client) V2DeregisterClusterTemplate(ctx context.Context, params *V2DeregisterClusterTemplateParams) (*V2DeregisterClusterTemplateNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeregisterClusterTemplate",
+		Method:             "DELETE",
+		PathPattern:        "/v2/cluster-templates/{template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeregisterClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeregisterClusterTemplateNoContent), nil
+
+}
+
+/*
+V2GetClusterTemplate Retrieves the details of the cluster template.
+*/
+func (a *Client) V2GetClusterTemplate(ctx context.Context, params *V2GetClusterTemplateParams) (*V2GetClusterTemplateOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterTemplate",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-templates/{template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterTemplateOK), nil
+
+}
+
+/*
+V2ListClusterTemplates Retrieves the list of cluster templates.
+*/
+func (a *Client) V2ListClusterTemplates(ctx context.Context, params *V2ListClusterTemplatesParams) (*V2ListClusterTemplatesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterTemplates",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterTemplatesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterTemplatesOK), nil
+
+}
+
+/*
+V2RegisterClusterTemplate Creates a new cluster template.
+*/
+func (a *Client) V2RegisterClusterTemplate(ctx context.Context, params *V2RegisterClusterTemplateParams) (*V2RegisterClusterTemplateCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RegisterClusterTemplate",
+		Method:             "POST",
+		PathPattern:        "/v2/cluster-templates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RegisterClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RegisterClusterTemplateCreated), nil
+
+}
+
+/*
+V2UpdateClusterTemplate Replaces the properties of the cluster template. Clusters created from the template are not affected.
+*/
+func (a *Client) V2UpdateClusterTemplate(ctx context.Context, params *V2UpdateClusterTemplateParams) (*V2UpdateClusterTemplateOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2UpdateClusterTemplate",
+		Method:             "PUT",
+		PathPattern:        "/v2/cluster-templates/{template_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateClusterTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateClusterTemplateOK), nil
+
+}
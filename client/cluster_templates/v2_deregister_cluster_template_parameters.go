@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DeregisterClusterTemplateParams creates a new V2DeregisterClusterTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DeregisterClusterTemplateParams() *V2DeregisterClusterTemplateParams {
+	return &V2DeregisterClusterTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DeregisterClusterTemplateParamsWithTimeout creates a new V2DeregisterClusterTemplateParams object
+// with the ability to set a timeout on a request.
+func NewV2DeregisterClusterTemplateParamsWithTimeout(timeout time.Duration) *V2DeregisterClusterTemplateParams {
+	return &V2DeregisterClusterTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DeregisterClusterTemplateParamsWithContext creates a new V2DeregisterClusterTemplateParams object
+// with the ability to set a context for a request.
+func NewV2DeregisterClusterTemplateParamsWithContext(ctx context.Context) *V2DeregisterClusterTemplateParams {
+	return &V2DeregisterClusterTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DeregisterClusterTemplateParamsWithHTTPClient creates a new V2DeregisterClusterTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DeregisterClusterTemplateParamsWithHTTPClient(client *http.Client) *V2DeregisterClusterTemplateParams {
+	return &V2DeregisterClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2DeregisterClusterTemplateParams contains all the parameters to send to the API endpoint
+   for the v2 deregister cluster template operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2DeregisterClusterTemplateParams struct {
+
+	/* TemplateID.
+
+	   The cluster template to be deleted.
+
+	   Format: uuid
+	*/
+	TemplateID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 deregister cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeregisterClusterTemplateParams) WithDefaults() *V2DeregisterClusterTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 deregister cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeregisterClusterTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 deregister cluster template params
+func (o *V2DeregisterClusterTemplateParams) WithTimeout(timeout time.Duration) *V2DeregisterClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 deregister cluster template params
+func (o *V2DeregisterClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 deregister cluster template params
+func (o *V2DeregisterClusterTemplateParams) WithContext(ctx context.Context) *V2DeregisterClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 deregister cluster template params
+func (o *V2DeregisterClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 deregister cluster template params
+func (o *V2DeregisterClusterTemplateParams) WithHTTPClient(client *http.Client) *V2DeregisterClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 deregister cluster template params
+func (o *V2DeregisterClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTemplateID adds the templateID to the v2 deregister cluster template params
+func (o *V2DeregisterClusterTemplateParams) WithTemplateID(templateID strfmt.UUID) *V2DeregisterClusterTemplateParams {
+	o.SetTemplateID(templateID)
+	return o
+}
+
+// SetTemplateID adds the templateId to the v2 deregister cluster template params
+func (o *V2DeregisterClusterTemplateParams) SetTemplateID(templateID strfmt.UUID) {
+	o.TemplateID = templateID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DeregisterClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param template_id
+	if err := r.SetPathParam("template_id", o.TemplateID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeregisterClusterTemplateReader is a Reader for the V2DeregisterClusterTemplate structure.
+type V2DeregisterClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DeregisterClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewV2DeregisterClusterTemplateNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DeregisterClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DeregisterClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DeregisterClusterTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2DeregisterClusterTemplateMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DeregisterClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DeregisterClusterTemplateNoContent creates a V2DeregisterClusterTemplateNoContent with default headers values
+func NewV2DeregisterClusterTemplateNoContent() *V2DeregisterClusterTemplateNoContent {
+	return &V2DeregisterClusterTemplateNoContent{}
+}
+
+/* V2DeregisterClusterTemplateNoContent describes a response with status code 204, with default header values.
+
+Success.
+*/
+type V2DeregisterClusterTemplateNoContent struct {
+}
+
+func (o *V2DeregisterClusterTemplateNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{template_id}][%d] v2DeregisterClusterTemplateNoContent ", 204)
+}
+
+func (o *V2DeregisterClusterTemplateNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewV2DeregisterClusterTemplateUnauthorized creates a V2DeregisterClusterTemplateUnauthorized with default headers values
+func NewV2DeregisterClusterTemplateUnauthorized() *V2DeregisterClusterTemplateUnauthorized {
+	return &V2DeregisterClusterTemplateUnauthorized{}
+}
+
+/* V2DeregisterClusterTemplateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DeregisterClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2DeregisterClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{template_id}][%d] v2DeregisterClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2DeregisterClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeregisterClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterClusterTemplateForbidden creates a V2DeregisterClusterTemplateForbidden with default headers values
+func NewV2DeregisterClusterTemplateForbidden() *V2DeregisterClusterTemplateForbidden {
+	return &V2DeregisterClusterTemplateForbidden{}
+}
+
+/* V2DeregisterClusterTemplateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DeregisterClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2DeregisterClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{template_id}][%d] v2DeregisterClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+func (o *V2DeregisterClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeregisterClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterClusterTemplateNotFound creates a V2DeregisterClusterTemplateNotFound with default headers values
+func NewV2DeregisterClusterTemplateNotFound() *V2DeregisterClusterTemplateNotFound {
+	return &V2DeregisterClusterTemplateNotFound{}
+}
+
+/* V2DeregisterClusterTemplateNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DeregisterClusterTemplateNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2DeregisterClusterTemplateNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{template_id}][%d] v2DeregisterClusterTemplateNotFound  %+v", 404, o.Payload)
+}
+func (o *V2DeregisterClusterTemplateNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterClusterTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterClusterTemplateMethodNotAllowed creates a V2DeregisterClusterTemplateMethodNotAllowed with default headers values
+func NewV2DeregisterClusterTemplateMethodNotAllowed() *V2DeregisterClusterTemplateMethodNotAllowed {
+	return &V2DeregisterClusterTemplateMethodNotAllowed{}
+}
+
+/* V2DeregisterClusterTemplateMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2DeregisterClusterTemplateMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2DeregisterClusterTemplateMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{template_id}][%d] v2DeregisterClusterTemplateMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2DeregisterClusterTemplateMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterClusterTemplateMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterClusterTemplateInternalServerError creates a V2DeregisterClusterTemplateInternalServerError with default headers values
+func NewV2DeregisterClusterTemplateInternalServerError() *V2DeregisterClusterTemplateInternalServerError {
+	return &V2DeregisterClusterTemplateInternalServerError{}
+}
+
+/* V2DeregisterClusterTemplateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DeregisterClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2DeregisterClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /v2/cluster-templates/{template_id}][%d] v2DeregisterClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2DeregisterClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
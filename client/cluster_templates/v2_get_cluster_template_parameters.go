@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetClusterTemplateParams creates a new V2GetClusterTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetClusterTemplateParams() *V2GetClusterTemplateParams {
+	return &V2GetClusterTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetClusterTemplateParamsWithTimeout creates a new V2GetClusterTemplateParams object
+// with the ability to set a timeout on a request.
+func NewV2GetClusterTemplateParamsWithTimeout(timeout time.Duration) *V2GetClusterTemplateParams {
+	return &V2GetClusterTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetClusterTemplateParamsWithContext creates a new V2GetClusterTemplateParams object
+// with the ability to set a context for a request.
+func NewV2GetClusterTemplateParamsWithContext(ctx context.Context) *V2GetClusterTemplateParams {
+	return &V2GetClusterTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetClusterTemplateParamsWithHTTPClient creates a new V2GetClusterTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetClusterTemplateParamsWithHTTPClient(client *http.Client) *V2GetClusterTemplateParams {
+	return &V2GetClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2GetClusterTemplateParams contains all the parameters to send to the API endpoint
+   for the v2 get cluster template operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2GetClusterTemplateParams struct {
+
+	/* TemplateID.
+
+	   The cluster template to be retrieved.
+
+	   Format: uuid
+	*/
+	TemplateID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterTemplateParams) WithDefaults() *V2GetClusterTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get cluster template params
+func (o *V2GetClusterTemplateParams) WithTimeout(timeout time.Duration) *V2GetClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get cluster template params
+func (o *V2GetClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get cluster template params
+func (o *V2GetClusterTemplateParams) WithContext(ctx context.Context) *V2GetClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get cluster template params
+func (o *V2GetClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get cluster template params
+func (o *V2GetClusterTemplateParams) WithHTTPClient(client *http.Client) *V2GetClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get cluster template params
+func (o *V2GetClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTemplateID adds the templateID to the v2 get cluster template params
+func (o *V2GetClusterTemplateParams) WithTemplateID(templateID strfmt.UUID) *V2GetClusterTemplateParams {
+	o.SetTemplateID(templateID)
+	return o
+}
+
+// SetTemplateID adds the templateId to the v2 get cluster template params
+func (o *V2GetClusterTemplateParams) SetTemplateID(templateID strfmt.UUID) {
+	o.TemplateID = templateID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param template_id
+	if err := r.SetPathParam("template_id", o.TemplateID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
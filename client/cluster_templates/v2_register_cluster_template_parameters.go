@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2RegisterClusterTemplateParams creates a new V2RegisterClusterTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2RegisterClusterTemplateParams() *V2RegisterClusterTemplateParams {
+	return &V2RegisterClusterTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2RegisterClusterTemplateParamsWithTimeout creates a new V2RegisterClusterTemplateParams object
+// with the ability to set a timeout on a request.
+func NewV2RegisterClusterTemplateParamsWithTimeout(timeout time.Duration) *V2RegisterClusterTemplateParams {
+	return &V2RegisterClusterTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2RegisterClusterTemplateParamsWithContext creates a new V2RegisterClusterTemplateParams object
+// with the ability to set a context for a request.
+func NewV2RegisterClusterTemplateParamsWithContext(ctx context.Context) *V2RegisterClusterTemplateParams {
+	return &V2RegisterClusterTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewV2RegisterClusterTemplateParamsWithHTTPClient creates a new V2RegisterClusterTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2RegisterClusterTemplateParamsWithHTTPClient(client *http.Client) *V2RegisterClusterTemplateParams {
+	return &V2RegisterClusterTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2RegisterClusterTemplateParams contains all the parameters to send to the API endpoint
+   for the v2 register cluster template operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2RegisterClusterTemplateParams struct {
+
+	/* NewClusterTemplateParams.
+
+	   The properties describing the new cluster template.
+	*/
+	NewClusterTemplateParams *models.ClusterTemplateCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 register cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RegisterClusterTemplateParams) WithDefaults() *V2RegisterClusterTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 register cluster template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RegisterClusterTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 register cluster template params
+func (o *V2RegisterClusterTemplateParams) WithTimeout(timeout time.Duration) *V2RegisterClusterTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 register cluster template params
+func (o *V2RegisterClusterTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 register cluster template params
+func (o *V2RegisterClusterTemplateParams) WithContext(ctx context.Context) *V2RegisterClusterTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 register cluster template params
+func (o *V2RegisterClusterTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 register cluster template params
+func (o *V2RegisterClusterTemplateParams) WithHTTPClient(client *http.Client) *V2RegisterClusterTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 register cluster template params
+func (o *V2RegisterClusterTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNewClusterTemplateParams adds the newClusterTemplateParams to the v2 register cluster template params
+func (o *V2RegisterClusterTemplateParams) WithNewClusterTemplateParams(newClusterTemplateParams *models.ClusterTemplateCreateParams) *V2RegisterClusterTemplateParams {
+	o.SetNewClusterTemplateParams(newClusterTemplateParams)
+	return o
+}
+
+// SetNewClusterTemplateParams adds the newClusterTemplateParams to the v2 register cluster template params
+func (o *V2RegisterClusterTemplateParams) SetNewClusterTemplateParams(newClusterTemplateParams *models.ClusterTemplateCreateParams) {
+	o.NewClusterTemplateParams = newClusterTemplateParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2RegisterClusterTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.NewClusterTemplateParams != nil {
+		if err := r.SetBodyParam(o.NewClusterTemplateParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
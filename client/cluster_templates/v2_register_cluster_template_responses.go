@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RegisterClusterTemplateReader is a Reader for the V2RegisterClusterTemplate structure.
+type V2RegisterClusterTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2RegisterClusterTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2RegisterClusterTemplateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2RegisterClusterTemplateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2RegisterClusterTemplateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2RegisterClusterTemplateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2RegisterClusterTemplateMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2RegisterClusterTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2RegisterClusterTemplateCreated creates a V2RegisterClusterTemplateCreated with default headers values
+func NewV2RegisterClusterTemplateCreated() *V2RegisterClusterTemplateCreated {
+	return &V2RegisterClusterTemplateCreated{}
+}
+
+/* V2RegisterClusterTemplateCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2RegisterClusterTemplateCreated struct {
+	Payload *models.ClusterTemplate
+}
+
+func (o *V2RegisterClusterTemplateCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2RegisterClusterTemplateCreated  %+v", 201, o.Payload)
+}
+func (o *V2RegisterClusterTemplateCreated) GetPayload() *models.ClusterTemplate {
+	return o.Payload
+}
+
+func (o *V2RegisterClusterTemplateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterClusterTemplateBadRequest creates a V2RegisterClusterTemplateBadRequest with default headers values
+func NewV2RegisterClusterTemplateBadRequest() *V2RegisterClusterTemplateBadRequest {
+	return &V2RegisterClusterTemplateBadRequest{}
+}
+
+/* V2RegisterClusterTemplateBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2RegisterClusterTemplateBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2RegisterClusterTemplateBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2RegisterClusterTemplateBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2RegisterClusterTemplateBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterClusterTemplateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterClusterTemplateUnauthorized creates a V2RegisterClusterTemplateUnauthorized with default headers values
+func NewV2RegisterClusterTemplateUnauthorized() *V2RegisterClusterTemplateUnauthorized {
+	return &V2RegisterClusterTemplateUnauthorized{}
+}
+
+/* V2RegisterClusterTemplateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2RegisterClusterTemplateUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2RegisterClusterTemplateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2RegisterClusterTemplateUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2RegisterClusterTemplateUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RegisterClusterTemplateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterClusterTemplateForbidden creates a V2RegisterClusterTemplateForbidden with default headers values
+func NewV2RegisterClusterTemplateForbidden() *V2RegisterClusterTemplateForbidden {
+	return &V2RegisterClusterTemplateForbidden{}
+}
+
+/* V2RegisterClusterTemplateForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2RegisterClusterTemplateForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2RegisterClusterTemplateForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2RegisterClusterTemplateForbidden  %+v", 403, o.Payload)
+}
+func (o *V2RegisterClusterTemplateForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RegisterClusterTemplateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterClusterTemplateMethodNotAllowed creates a V2RegisterClusterTemplateMethodNotAllowed with default headers values
+func NewV2RegisterClusterTemplateMethodNotAllowed() *V2RegisterClusterTemplateMethodNotAllowed {
+	return &V2RegisterClusterTemplateMethodNotAllowed{}
+}
+
+/* V2RegisterClusterTemplateMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2RegisterClusterTemplateMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2RegisterClusterTemplateMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2RegisterClusterTemplateMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2RegisterClusterTemplateMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterClusterTemplateMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterClusterTemplateInternalServerError creates a V2RegisterClusterTemplateInternalServerError with default headers values
+func NewV2RegisterClusterTemplateInternalServerError() *V2RegisterClusterTemplateInternalServerError {
+	return &V2RegisterClusterTemplateInternalServerError{}
+}
+
+/* V2RegisterClusterTemplateInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2RegisterClusterTemplateInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2RegisterClusterTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-templates][%d] v2RegisterClusterTemplateInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2RegisterClusterTemplateInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterClusterTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+//go:generate mockery -name API -inpkg
+
+// API is the interface of the event subscriptions client
+type API interface {
+	/*
+	   V2DeregisterEventSubscription Deletes the event subscription and its delivery log.*/
+	V2DeregisterEventSubscription(ctx context.Context, params *V2DeregisterEventSubscriptionParams) (*V2DeregisterEventSubscriptionNoContent, error)
+	/*
+	   V2GetEventSubscription Retrieves the details of the event subscription.*/
+	V2GetEventSubscription(ctx context.Context, params *V2GetEventSubscriptionParams) (*V2GetEventSubscriptionOK, error)
+	/*
+	   V2ListEventSubscriptionDeliveries Retrieves the delivery log of the event subscription, latest deliveries first.*/
+	V2ListEventSubscriptionDeliveries(ctx context.Context, params *V2ListEventSubscriptionDeliveriesParams) (*V2ListEventSubscriptionDeliveriesOK, error)
+	/*
+	   V2ListEventSubscriptions Retrieves the list of event subscriptions.*/
+	V2ListEventSubscriptions(ctx context.Context, params *V2ListEventSubscriptionsParams) (*V2ListEventSubscriptionsOK, error)
+	/*
+	   V2RegisterEventSubscription Creates a subscription that delivers events to an HTTP endpoint as CloudEvents.*/
+	V2RegisterEventSubscription(ctx context.Context, params *V2RegisterEventSubscriptionParams) (*V2RegisterEventSubscriptionCreated, error)
+	/*
+	   V2UpdateEventSubscription Replaces the properties of the event subscription. The secret of the subscription is kept when it is not set.*/
+	V2UpdateEventSubscription(ctx context.Context, params *V2UpdateEventSubscriptionParams) (*V2UpdateEventSubscriptionOK, error)
+}
+
+// New creates a new event subscriptions API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry, authInfo runtime.ClientAuthInfoWriter) *Client {
+	return &Client{
+		transport: transport,
+		formats:   formats,
+		authInfo:  authInfo,
+	}
+}
+
+/*
+Client for event subscriptions API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+/*
+V2DeregisterEventSubscription Deletes the event subscription and its delivery log.
+*/
+func (a *Client) V2DeregisterEventSubscription(ctx context.Context, params *V2DeregisterEventSubscriptionParams) (*V2DeregisterEventSubscriptionNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeregisterEventSubscription",
+		Method:             "DELETE",
+		PathPattern:        "/v2/event-subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeregisterEventSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeregisterEventSubscriptionNoContent), nil
+
+}
+
+/*
+V2GetEventSubscription Retrieves the details of the event subscription.
+*/
+func (a *Client) V2GetEventSubscription(ctx context.Context, params *V2GetEventSubscriptionParams) (*V2GetEventSubscriptionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetEventSubscription",
+		Method:             "GET",
+		PathPattern:        "/v2/event-subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetEventSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetEventSubscriptionOK), nil
+
+}
+
+/*
+V2ListEventSubscriptionDeliveries Retrieves the delivery log of the event subscription, latest deliveries first.
+*/
+func (a *Client) V2ListEventSubscriptionDeliveries(ctx context.Context, params *V2ListEventSubscriptionDeliveriesParams) (*V2ListEventSubscriptionDeliveriesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListEventSubscriptionDeliveries",
+		Method:             "GET",
+		PathPattern:        "/v2/event-subscriptions/{subscription_id}/deliveries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListEventSubscriptionDeliveriesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListEventSubscriptionDeliveriesOK), nil
+
+}
+
+/*
+V2ListEventSubscriptions Retrieves the list of event subscriptions.
+*/
+func (a *Client) V2ListEventSubscriptions(ctx context.Context, params *V2ListEventSubscriptionsParams) (*V2ListEventSubscriptionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListEventSubscriptions",
+		Method:             "GET",
+		PathPattern:        "/v2/event-subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListEventSubscriptionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListEventSubscriptionsOK), nil
+
+}
+
+/*
+V2RegisterEventSubscription Creates a subscription that delivers events to an HTTP endpoint as CloudEvents.
+*/
+func (a *Client) V2RegisterEventSubscription(ctx context.Context, params *V2RegisterEventSubscriptionParams) (*V2RegisterEventSubscriptionCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RegisterEventSubscription",
+		Method:             "POST",
+		PathPattern:        "/v2/event-subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RegisterEventSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RegisterEventSubscriptionCreated), nil
+
+}
+
+/*
+V2UpdateEventSubscription Replaces the properties of the event subscription. The secret of the subscription is kept when it is not set.
+*/
+func (a *Client) V2UpdateEventSubscription(ctx context.Context, params *V2UpdateEventSubscriptionParams) (*V2UpdateEventSubscriptionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2UpdateEventSubscription",
+		Method:             "PUT",
+		PathPattern:        "/v2/event-subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateEventSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateEventSubscriptionOK), nil
+
+}
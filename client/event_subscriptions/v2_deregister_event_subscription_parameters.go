@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DeregisterEventSubscriptionParams creates a new V2DeregisterEventSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DeregisterEventSubscriptionParams() *V2DeregisterEventSubscriptionParams {
+	return &V2DeregisterEventSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DeregisterEventSubscriptionParamsWithTimeout creates a new V2DeregisterEventSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2DeregisterEventSubscriptionParamsWithTimeout(timeout time.Duration) *V2DeregisterEventSubscriptionParams {
+	return &V2DeregisterEventSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DeregisterEventSubscriptionParamsWithContext creates a new V2DeregisterEventSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2DeregisterEventSubscriptionParamsWithContext(ctx context.Context) *V2DeregisterEventSubscriptionParams {
+	return &V2DeregisterEventSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DeregisterEventSubscriptionParamsWithHTTPClient creates a new V2DeregisterEventSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DeregisterEventSubscriptionParamsWithHTTPClient(client *http.Client) *V2DeregisterEventSubscriptionParams {
+	return &V2DeregisterEventSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2DeregisterEventSubscriptionParams contains all the parameters to send to the API endpoint
+   for the v2 deregister event subscription operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2DeregisterEventSubscriptionParams struct {
+
+	/* SubscriptionID.
+
+	   The event subscription to be deleted.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 deregister event subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeregisterEventSubscriptionParams) WithDefaults() *V2DeregisterEventSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 deregister event subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DeregisterEventSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 deregister event subscription params
+func (o *V2DeregisterEventSubscriptionParams) WithTimeout(timeout time.Duration) *V2DeregisterEventSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 deregister event subscription params
+func (o *V2DeregisterEventSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 deregister event subscription params
+func (o *V2DeregisterEventSubscriptionParams) WithContext(ctx context.Context) *V2DeregisterEventSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 deregister event subscription params
+func (o *V2DeregisterEventSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 deregister event subscription params
+func (o *V2DeregisterEventSubscriptionParams) WithHTTPClient(client *http.Client) *V2DeregisterEventSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 deregister event subscription params
+func (o *V2DeregisterEventSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 deregister event subscription params
+func (o *V2DeregisterEventSubscriptionParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2DeregisterEventSubscriptionParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 deregister event subscription params
+func (o *V2DeregisterEventSubscriptionParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DeregisterEventSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeregisterEventSubscriptionReader is a Reader for the V2DeregisterEventSubscription structure.
+type V2DeregisterEventSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DeregisterEventSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewV2DeregisterEventSubscriptionNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DeregisterEventSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DeregisterEventSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DeregisterEventSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2DeregisterEventSubscriptionMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DeregisterEventSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DeregisterEventSubscriptionNoContent creates a V2DeregisterEventSubscriptionNoContent with default headers values
+func NewV2DeregisterEventSubscriptionNoContent() *V2DeregisterEventSubscriptionNoContent {
+	return &V2DeregisterEventSubscriptionNoContent{}
+}
+
+/* V2DeregisterEventSubscriptionNoContent describes a response with status code 204, with default header values.
+
+Success.
+*/
+type V2DeregisterEventSubscriptionNoContent struct {
+}
+
+func (o *V2DeregisterEventSubscriptionNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /v2/event-subscriptions/{subscription_id}][%d] v2DeregisterEventSubscriptionNoContent ", 204)
+}
+
+func (o *V2DeregisterEventSubscriptionNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewV2DeregisterEventSubscriptionUnauthorized creates a V2DeregisterEventSubscriptionUnauthorized with default headers values
+func NewV2DeregisterEventSubscriptionUnauthorized() *V2DeregisterEventSubscriptionUnauthorized {
+	return &V2DeregisterEventSubscriptionUnauthorized{}
+}
+
+/* V2DeregisterEventSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DeregisterEventSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2DeregisterEventSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /v2/event-subscriptions/{subscription_id}][%d] v2DeregisterEventSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2DeregisterEventSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeregisterEventSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterEventSubscriptionForbidden creates a V2DeregisterEventSubscriptionForbidden with default headers values
+func NewV2DeregisterEventSubscriptionForbidden() *V2DeregisterEventSubscriptionForbidden {
+	return &V2DeregisterEventSubscriptionForbidden{}
+}
+
+/* V2DeregisterEventSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DeregisterEventSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2DeregisterEventSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /v2/event-subscriptions/{subscription_id}][%d] v2DeregisterEventSubscriptionForbidden  %+v", 403, o.Payload)
+}
+func (o *V2DeregisterEventSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DeregisterEventSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterEventSubscriptionNotFound creates a V2DeregisterEventSubscriptionNotFound with default headers values
+func NewV2DeregisterEventSubscriptionNotFound() *V2DeregisterEventSubscriptionNotFound {
+	return &V2DeregisterEventSubscriptionNotFound{}
+}
+
+/* V2DeregisterEventSubscriptionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DeregisterEventSubscriptionNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2DeregisterEventSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /v2/event-subscriptions/{subscription_id}][%d] v2DeregisterEventSubscriptionNotFound  %+v", 404, o.Payload)
+}
+func (o *V2DeregisterEventSubscriptionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterEventSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterEventSubscriptionMethodNotAllowed creates a V2DeregisterEventSubscriptionMethodNotAllowed with default headers values
+func NewV2DeregisterEventSubscriptionMethodNotAllowed() *V2DeregisterEventSubscriptionMethodNotAllowed {
+	return &V2DeregisterEventSubscriptionMethodNotAllowed{}
+}
+
+/* V2DeregisterEventSubscriptionMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2DeregisterEventSubscriptionMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2DeregisterEventSubscriptionMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[DELETE /v2/event-subscriptions/{subscription_id}][%d] v2DeregisterEventSubscriptionMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2DeregisterEventSubscriptionMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterEventSubscriptionMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DeregisterEventSubscriptionInternalServerError creates a V2DeregisterEventSubscriptionInternalServerError with default headers values
+func NewV2DeregisterEventSubscriptionInternalServerError() *V2DeregisterEventSubscriptionInternalServerError {
+	return &V2DeregisterEventSubscriptionInternalServerError{}
+}
+
+/* V2DeregisterEventSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DeregisterEventSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2DeregisterEventSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /v2/event-subscriptions/{subscription_id}][%d] v2DeregisterEventSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2DeregisterEventSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DeregisterEventSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
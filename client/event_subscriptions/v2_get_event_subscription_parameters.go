@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetEventSubscriptionParams creates a new V2GetEventSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetEventSubscriptionParams() *V2GetEventSubscriptionParams {
+	return &V2GetEventSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetEventSubscriptionParamsWithTimeout creates a new V2GetEventSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2GetEventSubscriptionParamsWithTimeout(timeout time.Duration) *V2GetEventSubscriptionParams {
+	return &V2GetEventSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetEventSubscriptionParamsWithContext creates a new V2GetEventSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2GetEventSubscriptionParamsWithContext(ctx context.Context) *V2GetEventSubscriptionParams {
+	return &V2GetEventSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetEventSubscriptionParamsWithHTTPClient creates a new V2GetEventSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetEventSubscriptionParamsWithHTTPClient(client *http.Client) *V2GetEventSubscriptionParams {
+	return &V2GetEventSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2GetEventSubscriptionParams contains all the parameters to send to the API endpoint
+   for the v2 get event subscription operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2GetEventSubscriptionParams struct {
+
+	/* SubscriptionID.
+
+	   The event subscription to be retrieved.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get event subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetEventSubscriptionParams) WithDefaults() *V2GetEventSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get event subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetEventSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get event subscription params
+func (o *V2GetEventSubscriptionParams) WithTimeout(timeout time.Duration) *V2GetEventSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get event subscription params
+func (o *V2GetEventSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get event subscription params
+func (o *V2GetEventSubscriptionParams) WithContext(ctx context.Context) *V2GetEventSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get event subscription params
+func (o *V2GetEventSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get event subscription params
+func (o *V2GetEventSubscriptionParams) WithHTTPClient(client *http.Client) *V2GetEventSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get event subscription params
+func (o *V2GetEventSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 get event subscription params
+func (o *V2GetEventSubscriptionParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2GetEventSubscriptionParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 get event subscription params
+func (o *V2GetEventSubscriptionParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetEventSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetEventSubscriptionReader is a Reader for the V2GetEventSubscription structure.
+type V2GetEventSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetEventSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetEventSubscriptionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetEventSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetEventSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetEventSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetEventSubscriptionMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetEventSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetEventSubscriptionOK creates a V2GetEventSubscriptionOK with default headers values
+func NewV2GetEventSubscriptionOK() *V2GetEventSubscriptionOK {
+	return &V2GetEventSubscriptionOK{}
+}
+
+/* V2GetEventSubscriptionOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetEventSubscriptionOK struct {
+	Payload *models.EventSubscription
+}
+
+func (o *V2GetEventSubscriptionOK) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}][%d] v2GetEventSubscriptionOK  %+v", 200, o.Payload)
+}
+func (o *V2GetEventSubscriptionOK) GetPayload() *models.EventSubscription {
+	return o.Payload
+}
+
+func (o *V2GetEventSubscriptionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.EventSubscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetEventSubscriptionUnauthorized creates a V2GetEventSubscriptionUnauthorized with default headers values
+func NewV2GetEventSubscriptionUnauthorized() *V2GetEventSubscriptionUnauthorized {
+	return &V2GetEventSubscriptionUnauthorized{}
+}
+
+/* V2GetEventSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetEventSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2GetEventSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}][%d] v2GetEventSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2GetEventSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetEventSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetEventSubscriptionForbidden creates a V2GetEventSubscriptionForbidden with default headers values
+func NewV2GetEventSubscriptionForbidden() *V2GetEventSubscriptionForbidden {
+	return &V2GetEventSubscriptionForbidden{}
+}
+
+/* V2GetEventSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetEventSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2GetEventSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}][%d] v2GetEventSubscriptionForbidden  %+v", 403, o.Payload)
+}
+func (o *V2GetEventSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetEventSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetEventSubscriptionNotFound creates a V2GetEventSubscriptionNotFound with default headers values
+func NewV2GetEventSubscriptionNotFound() *V2GetEventSubscriptionNotFound {
+	return &V2GetEventSubscriptionNotFound{}
+}
+
+/* V2GetEventSubscriptionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetEventSubscriptionNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2GetEventSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}][%d] v2GetEventSubscriptionNotFound  %+v", 404, o.Payload)
+}
+func (o *V2GetEventSubscriptionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetEventSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetEventSubscriptionMethodNotAllowed creates a V2GetEventSubscriptionMethodNotAllowed with default headers values
+func NewV2GetEventSubscriptionMethodNotAllowed() *V2GetEventSubscriptionMethodNotAllowed {
+	return &V2GetEventSubscriptionMethodNotAllowed{}
+}
+
+/* V2GetEventSubscriptionMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetEventSubscriptionMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2GetEventSubscriptionMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}][%d] v2GetEventSubscriptionMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2GetEventSubscriptionMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetEventSubscriptionMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetEventSubscriptionInternalServerError creates a V2GetEventSubscriptionInternalServerError with default headers values
+func NewV2GetEventSubscriptionInternalServerError() *V2GetEventSubscriptionInternalServerError {
+	return &V2GetEventSubscriptionInternalServerError{}
+}
+
+/* V2GetEventSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetEventSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2GetEventSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}][%d] v2GetEventSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2GetEventSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetEventSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2ListEventSubscriptionDeliveriesParams creates a new V2ListEventSubscriptionDeliveriesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListEventSubscriptionDeliveriesParams() *V2ListEventSubscriptionDeliveriesParams {
+	return &V2ListEventSubscriptionDeliveriesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListEventSubscriptionDeliveriesParamsWithTimeout creates a new V2ListEventSubscriptionDeliveriesParams object
+// with the ability to set a timeout on a request.
+func NewV2ListEventSubscriptionDeliveriesParamsWithTimeout(timeout time.Duration) *V2ListEventSubscriptionDeliveriesParams {
+	return &V2ListEventSubscriptionDeliveriesParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListEventSubscriptionDeliveriesParamsWithContext creates a new V2ListEventSubscriptionDeliveriesParams object
+// with the ability to set a context for a request.
+func NewV2ListEventSubscriptionDeliveriesParamsWithContext(ctx context.Context) *V2ListEventSubscriptionDeliveriesParams {
+	return &V2ListEventSubscriptionDeliveriesParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListEventSubscriptionDeliveriesParamsWithHTTPClient creates a new V2ListEventSubscriptionDeliveriesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListEventSubscriptionDeliveriesParamsWithHTTPClient(client *http.Client) *V2ListEventSubscriptionDeliveriesParams {
+	return &V2ListEventSubscriptionDeliveriesParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ListEventSubscriptionDeliveriesParams contains all the parameters to send to the API endpoint
+   for the v2 list event subscription deliveries operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ListEventSubscriptionDeliveriesParams struct {
+
+	/* Limit.
+
+	   The maximal number of deliveries to retrieve.
+
+	   Default: 100
+	*/
+	Limit *int64
+
+	/* Status.
+
+	   Only retrieve the deliveries with this status.
+	*/
+	Status *string
+
+	/* SubscriptionID.
+
+	   The event subscription whose deliveries should be retrieved.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list event subscription deliveries params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListEventSubscriptionDeliveriesParams) WithDefaults() *V2ListEventSubscriptionDeliveriesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list event subscription deliveries params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListEventSubscriptionDeliveriesParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+	)
+
+	val := V2ListEventSubscriptionDeliveriesParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) WithTimeout(timeout time.Duration) *V2ListEventSubscriptionDeliveriesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) WithContext(ctx context.Context) *V2ListEventSubscriptionDeliveriesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) WithHTTPClient(client *http.Client) *V2ListEventSubscriptionDeliveriesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) WithLimit(limit *int64) *V2ListEventSubscriptionDeliveriesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithStatus adds the status to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) WithStatus(status *string) *V2ListEventSubscriptionDeliveriesParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2ListEventSubscriptionDeliveriesParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 list event subscription deliveries params
+func (o *V2ListEventSubscriptionDeliveriesParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListEventSubscriptionDeliveriesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
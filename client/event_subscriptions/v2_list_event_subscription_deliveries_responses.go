@@ -0,0 +1,255 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListEventSubscriptionDeliveriesReader is a Reader for the V2ListEventSubscriptionDeliveries structure.
+type V2ListEventSubscriptionDeliveriesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListEventSubscriptionDeliveriesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListEventSubscriptionDeliveriesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListEventSubscriptionDeliveriesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListEventSubscriptionDeliveriesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListEventSubscriptionDeliveriesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ListEventSubscriptionDeliveriesMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListEventSubscriptionDeliveriesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListEventSubscriptionDeliveriesOK creates a V2ListEventSubscriptionDeliveriesOK with default headers values
+func NewV2ListEventSubscriptionDeliveriesOK() *V2ListEventSubscriptionDeliveriesOK {
+	return &V2ListEventSubscriptionDeliveriesOK{}
+}
+
+/* V2ListEventSubscriptionDeliveriesOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListEventSubscriptionDeliveriesOK struct {
+	Payload models.EventSubscriptionDeliveryList
+}
+
+func (o *V2ListEventSubscriptionDeliveriesOK) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}/deliveries][%d] v2ListEventSubscriptionDeliveriesOK  %+v", 200, o.Payload)
+}
+func (o *V2ListEventSubscriptionDeliveriesOK) GetPayload() models.EventSubscriptionDeliveryList {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionDeliveriesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionDeliveriesUnauthorized creates a V2ListEventSubscriptionDeliveriesUnauthorized with default headers values
+func NewV2ListEventSubscriptionDeliveriesUnauthorized() *V2ListEventSubscriptionDeliveriesUnauthorized {
+	return &V2ListEventSubscriptionDeliveriesUnauthorized{}
+}
+
+/* V2ListEventSubscriptionDeliveriesUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListEventSubscriptionDeliveriesUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListEventSubscriptionDeliveriesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}/deliveries][%d] v2ListEventSubscriptionDeliveriesUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListEventSubscriptionDeliveriesUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionDeliveriesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionDeliveriesForbidden creates a V2ListEventSubscriptionDeliveriesForbidden with default headers values
+func NewV2ListEventSubscriptionDeliveriesForbidden() *V2ListEventSubscriptionDeliveriesForbidden {
+	return &V2ListEventSubscriptionDeliveriesForbidden{}
+}
+
+/* V2ListEventSubscriptionDeliveriesForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListEventSubscriptionDeliveriesForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListEventSubscriptionDeliveriesForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}/deliveries][%d] v2ListEventSubscriptionDeliveriesForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListEventSubscriptionDeliveriesForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionDeliveriesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionDeliveriesNotFound creates a V2ListEventSubscriptionDeliveriesNotFound with default headers values
+func NewV2ListEventSubscriptionDeliveriesNotFound() *V2ListEventSubscriptionDeliveriesNotFound {
+	return &V2ListEventSubscriptionDeliveriesNotFound{}
+}
+
+/* V2ListEventSubscriptionDeliveriesNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListEventSubscriptionDeliveriesNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2ListEventSubscriptionDeliveriesNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}/deliveries][%d] v2ListEventSubscriptionDeliveriesNotFound  %+v", 404, o.Payload)
+}
+func (o *V2ListEventSubscriptionDeliveriesNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionDeliveriesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionDeliveriesMethodNotAllowed creates a V2ListEventSubscriptionDeliveriesMethodNotAllowed with default headers values
+func NewV2ListEventSubscriptionDeliveriesMethodNotAllowed() *V2ListEventSubscriptionDeliveriesMethodNotAllowed {
+	return &V2ListEventSubscriptionDeliveriesMethodNotAllowed{}
+}
+
+/* V2ListEventSubscriptionDeliveriesMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ListEventSubscriptionDeliveriesMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2ListEventSubscriptionDeliveriesMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}/deliveries][%d] v2ListEventSubscriptionDeliveriesMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2ListEventSubscriptionDeliveriesMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionDeliveriesMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionDeliveriesInternalServerError creates a V2ListEventSubscriptionDeliveriesInternalServerError with default headers values
+func NewV2ListEventSubscriptionDeliveriesInternalServerError() *V2ListEventSubscriptionDeliveriesInternalServerError {
+	return &V2ListEventSubscriptionDeliveriesInternalServerError{}
+}
+
+/* V2ListEventSubscriptionDeliveriesInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListEventSubscriptionDeliveriesInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListEventSubscriptionDeliveriesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions/{subscription_id}/deliveries][%d] v2ListEventSubscriptionDeliveriesInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListEventSubscriptionDeliveriesInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionDeliveriesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
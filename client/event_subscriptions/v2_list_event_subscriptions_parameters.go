@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListEventSubscriptionsParams creates a new V2ListEventSubscriptionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListEventSubscriptionsParams() *V2ListEventSubscriptionsParams {
+	return &V2ListEventSubscriptionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListEventSubscriptionsParamsWithTimeout creates a new V2ListEventSubscriptionsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListEventSubscriptionsParamsWithTimeout(timeout time.Duration) *V2ListEventSubscriptionsParams {
+	return &V2ListEventSubscriptionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListEventSubscriptionsParamsWithContext creates a new V2ListEventSubscriptionsParams object
+// with the ability to set a context for a request.
+func NewV2ListEventSubscriptionsParamsWithContext(ctx context.Context) *V2ListEventSubscriptionsParams {
+	return &V2ListEventSubscriptionsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListEventSubscriptionsParamsWithHTTPClient creates a new V2ListEventSubscriptionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListEventSubscriptionsParamsWithHTTPClient(client *http.Client) *V2ListEventSubscriptionsParams {
+	return &V2ListEventSubscriptionsParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ListEventSubscriptionsParams contains all the parameters to send to the API endpoint
+   for the v2 list event subscriptions operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ListEventSubscriptionsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list event subscriptions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListEventSubscriptionsParams) WithDefaults() *V2ListEventSubscriptionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list event subscriptions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListEventSubscriptionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list event subscriptions params
+func (o *V2ListEventSubscriptionsParams) WithTimeout(timeout time.Duration) *V2ListEventSubscriptionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list event subscriptions params
+func (o *V2ListEventSubscriptionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list event subscriptions params
+func (o *V2ListEventSubscriptionsParams) WithContext(ctx context.Context) *V2ListEventSubscriptionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list event subscriptions params
+func (o *V2ListEventSubscriptionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list event subscriptions params
+func (o *V2ListEventSubscriptionsParams) WithHTTPClient(client *http.Client) *V2ListEventSubscriptionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list event subscriptions params
+func (o *V2ListEventSubscriptionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListEventSubscriptionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListEventSubscriptionsReader is a Reader for the V2ListEventSubscriptions structure.
+type V2ListEventSubscriptionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListEventSubscriptionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListEventSubscriptionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListEventSubscriptionsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListEventSubscriptionsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ListEventSubscriptionsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListEventSubscriptionsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListEventSubscriptionsOK creates a V2ListEventSubscriptionsOK with default headers values
+func NewV2ListEventSubscriptionsOK() *V2ListEventSubscriptionsOK {
+	return &V2ListEventSubscriptionsOK{}
+}
+
+/* V2ListEventSubscriptionsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListEventSubscriptionsOK struct {
+	Payload models.EventSubscriptionList
+}
+
+func (o *V2ListEventSubscriptionsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions][%d] v2ListEventSubscriptionsOK  %+v", 200, o.Payload)
+}
+func (o *V2ListEventSubscriptionsOK) GetPayload() models.EventSubscriptionList {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionsUnauthorized creates a V2ListEventSubscriptionsUnauthorized with default headers values
+func NewV2ListEventSubscriptionsUnauthorized() *V2ListEventSubscriptionsUnauthorized {
+	return &V2ListEventSubscriptionsUnauthorized{}
+}
+
+/* V2ListEventSubscriptionsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListEventSubscriptionsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListEventSubscriptionsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions][%d] v2ListEventSubscriptionsUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListEventSubscriptionsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionsForbidden creates a V2ListEventSubscriptionsForbidden with default headers values
+func NewV2ListEventSubscriptionsForbidden() *V2ListEventSubscriptionsForbidden {
+	return &V2ListEventSubscriptionsForbidden{}
+}
+
+/* V2ListEventSubscriptionsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListEventSubscriptionsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListEventSubscriptionsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions][%d] v2ListEventSubscriptionsForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListEventSubscriptionsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionsMethodNotAllowed creates a V2ListEventSubscriptionsMethodNotAllowed with default headers values
+func NewV2ListEventSubscriptionsMethodNotAllowed() *V2ListEventSubscriptionsMethodNotAllowed {
+	return &V2ListEventSubscriptionsMethodNotAllowed{}
+}
+
+/* V2ListEventSubscriptionsMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ListEventSubscriptionsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2ListEventSubscriptionsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions][%d] v2ListEventSubscriptionsMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2ListEventSubscriptionsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListEventSubscriptionsInternalServerError creates a V2ListEventSubscriptionsInternalServerError with default headers values
+func NewV2ListEventSubscriptionsInternalServerError() *V2ListEventSubscriptionsInternalServerError {
+	return &V2ListEventSubscriptionsInternalServerError{}
+}
+
+/* V2ListEventSubscriptionsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListEventSubscriptionsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListEventSubscriptionsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/event-subscriptions][%d] v2ListEventSubscriptionsInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListEventSubscriptionsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListEventSubscriptionsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2RegisterEventSubscriptionParams creates a new V2RegisterEventSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2RegisterEventSubscriptionParams() *V2RegisterEventSubscriptionParams {
+	return &V2RegisterEventSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2RegisterEventSubscriptionParamsWithTimeout creates a new V2RegisterEventSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2RegisterEventSubscriptionParamsWithTimeout(timeout time.Duration) *V2RegisterEventSubscriptionParams {
+	return &V2RegisterEventSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2RegisterEventSubscriptionParamsWithContext creates a new V2RegisterEventSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2RegisterEventSubscriptionParamsWithContext(ctx context.Context) *V2RegisterEventSubscriptionParams {
+	return &V2RegisterEventSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2RegisterEventSubscriptionParamsWithHTTPClient creates a new V2RegisterEventSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2RegisterEventSubscriptionParamsWithHTTPClient(client *http.Client) *V2RegisterEventSubscriptionParams {
+	return &V2RegisterEventSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2RegisterEventSubscriptionParams contains all the parameters to send to the API endpoint
+   for the v2 register event subscription operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2RegisterEventSubscriptionParams struct {
+
+	/* NewEventSubscriptionParams.
+
+	   The properties describing the new event subscription.
+	*/
+	NewEventSubscriptionParams *models.EventSubscriptionCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 register event subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RegisterEventSubscriptionParams) WithDefaults() *V2RegisterEventSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 register event subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RegisterEventSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 register event subscription params
+func (o *V2RegisterEventSubscriptionParams) WithTimeout(timeout time.Duration) *V2RegisterEventSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 register event subscription params
+func (o *V2RegisterEventSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 register event subscription params
+func (o *V2RegisterEventSubscriptionParams) WithContext(ctx context.Context) *V2RegisterEventSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 register event subscription params
+func (o *V2RegisterEventSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 register event subscription params
+func (o *V2RegisterEventSubscriptionParams) WithHTTPClient(client *http.Client) *V2RegisterEventSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 register event subscription params
+func (o *V2RegisterEventSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNewEventSubscriptionParams adds the newEventSubscriptionParams to the v2 register event subscription params
+func (o *V2RegisterEventSubscriptionParams) WithNewEventSubscriptionParams(newEventSubscriptionParams *models.EventSubscriptionCreateParams) *V2RegisterEventSubscriptionParams {
+	o.SetNewEventSubscriptionParams(newEventSubscriptionParams)
+	return o
+}
+
+// SetNewEventSubscriptionParams adds the newEventSubscriptionParams to the v2 register event subscription params
+func (o *V2RegisterEventSubscriptionParams) SetNewEventSubscriptionParams(newEventSubscriptionParams *models.EventSubscriptionCreateParams) {
+	o.NewEventSubscriptionParams = newEventSubscriptionParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2RegisterEventSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.NewEventSubscriptionParams != nil {
+		if err := r.SetBodyParam(o.NewEventSubscriptionParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
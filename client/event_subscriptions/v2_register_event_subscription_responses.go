@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RegisterEventSubscriptionReader is a Reader for the V2RegisterEventSubscription structure.
+type V2RegisterEventSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2RegisterEventSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2RegisterEventSubscriptionCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2RegisterEventSubscriptionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2RegisterEventSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2RegisterEventSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2RegisterEventSubscriptionMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2RegisterEventSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2RegisterEventSubscriptionCreated creates a V2RegisterEventSubscriptionCreated with default headers values
+func NewV2RegisterEventSubscriptionCreated() *V2RegisterEventSubscriptionCreated {
+	return &V2RegisterEventSubscriptionCreated{}
+}
+
+/* V2RegisterEventSubscriptionCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2RegisterEventSubscriptionCreated struct {
+	Payload *models.EventSubscription
+}
+
+func (o *V2RegisterEventSubscriptionCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/event-subscriptions][%d] v2RegisterEventSubscriptionCreated  %+v", 201, o.Payload)
+}
+func (o *V2RegisterEventSubscriptionCreated) GetPayload() *models.EventSubscription {
+	return o.Payload
+}
+
+func (o *V2RegisterEventSubscriptionCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.EventSubscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterEventSubscriptionBadRequest creates a V2RegisterEventSubscriptionBadRequest with default headers values
+func NewV2RegisterEventSubscriptionBadRequest() *V2RegisterEventSubscriptionBadRequest {
+	return &V2RegisterEventSubscriptionBadRequest{}
+}
+
+/* V2RegisterEventSubscriptionBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2RegisterEventSubscriptionBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2RegisterEventSubscriptionBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/event-subscriptions][%d] v2RegisterEventSubscriptionBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2RegisterEventSubscriptionBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterEventSubscriptionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterEventSubscriptionUnauthorized creates a V2RegisterEventSubscriptionUnauthorized with default headers values
+func NewV2RegisterEventSubscriptionUnauthorized() *V2RegisterEventSubscriptionUnauthorized {
+	return &V2RegisterEventSubscriptionUnauthorized{}
+}
+
+/* V2RegisterEventSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2RegisterEventSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2RegisterEventSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/event-subscriptions][%d] v2RegisterEventSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2RegisterEventSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RegisterEventSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterEventSubscriptionForbidden creates a V2RegisterEventSubscriptionForbidden with default headers values
+func NewV2RegisterEventSubscriptionForbidden() *V2RegisterEventSubscriptionForbidden {
+	return &V2RegisterEventSubscriptionForbidden{}
+}
+
+/* V2RegisterEventSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2RegisterEventSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2RegisterEventSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/event-subscriptions][%d] v2RegisterEventSubscriptionForbidden  %+v", 403, o.Payload)
+}
+func (o *V2RegisterEventSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RegisterEventSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterEventSubscriptionMethodNotAllowed creates a V2RegisterEventSubscriptionMethodNotAllowed with default headers values
+func NewV2RegisterEventSubscriptionMethodNotAllowed() *V2RegisterEventSubscriptionMethodNotAllowed {
+	return &V2RegisterEventSubscriptionMethodNotAllowed{}
+}
+
+/* V2RegisterEventSubscriptionMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2RegisterEventSubscriptionMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2RegisterEventSubscriptionMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/event-subscriptions][%d] v2RegisterEventSubscriptionMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2RegisterEventSubscriptionMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterEventSubscriptionMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RegisterEventSubscriptionInternalServerError creates a V2RegisterEventSubscriptionInternalServerError with default headers values
+func NewV2RegisterEventSubscriptionInternalServerError() *V2RegisterEventSubscriptionInternalServerError {
+	return &V2RegisterEventSubscriptionInternalServerError{}
+}
+
+/* V2RegisterEventSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2RegisterEventSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2RegisterEventSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/event-subscriptions][%d] v2RegisterEventSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2RegisterEventSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RegisterEventSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
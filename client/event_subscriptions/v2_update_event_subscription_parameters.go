@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateEventSubscriptionParams creates a new V2UpdateEventSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2UpdateEventSubscriptionParams() *V2UpdateEventSubscriptionParams {
+	return &V2UpdateEventSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2UpdateEventSubscriptionParamsWithTimeout creates a new V2UpdateEventSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewV2UpdateEventSubscriptionParamsWithTimeout(timeout time.Duration) *V2UpdateEventSubscriptionParams {
+	return &V2UpdateEventSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2UpdateEventSubscriptionParamsWithContext creates a new V2UpdateEventSubscriptionParams object
+// with the ability to set a context for a request.
+func NewV2UpdateEventSubscriptionParamsWithContext(ctx context.Context) *V2UpdateEventSubscriptionParams {
+	return &V2UpdateEventSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewV2UpdateEventSubscriptionParamsWithHTTPClient creates a new V2UpdateEventSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2UpdateEventSubscriptionParamsWithHTTPClient(client *http.Client) *V2UpdateEventSubscriptionParams {
+	return &V2UpdateEventSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2UpdateEventSubscriptionParams contains all the parameters to send to the API endpoint
+   for the v2 update event subscription operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2UpdateEventSubscriptionParams struct {
+
+	/* EventSubscriptionParams.
+
+	   The new properties of the event subscription.
+	*/
+	EventSubscriptionParams *models.EventSubscriptionCreateParams
+
+	/* SubscriptionID.
+
+	   The event subscription to be updated.
+
+	   Format: uuid
+	*/
+	SubscriptionID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 update event subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateEventSubscriptionParams) WithDefaults() *V2UpdateEventSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 update event subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateEventSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) WithTimeout(timeout time.Duration) *V2UpdateEventSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) WithContext(ctx context.Context) *V2UpdateEventSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) WithHTTPClient(client *http.Client) *V2UpdateEventSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithEventSubscriptionParams adds the eventSubscriptionParams to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) WithEventSubscriptionParams(eventSubscriptionParams *models.EventSubscriptionCreateParams) *V2UpdateEventSubscriptionParams {
+	o.SetEventSubscriptionParams(eventSubscriptionParams)
+	return o
+}
+
+// SetEventSubscriptionParams adds the eventSubscriptionParams to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) SetEventSubscriptionParams(eventSubscriptionParams *models.EventSubscriptionCreateParams) {
+	o.EventSubscriptionParams = eventSubscriptionParams
+}
+
+// WithSubscriptionID adds the subscriptionID to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) WithSubscriptionID(subscriptionID strfmt.UUID) *V2UpdateEventSubscriptionParams {
+	o.SetSubscriptionID(subscriptionID)
+	return o
+}
+
+// SetSubscriptionID adds the subscriptionId to the v2 update event subscription params
+func (o *V2UpdateEventSubscriptionParams) SetSubscriptionID(subscriptionID strfmt.UUID) {
+	o.SubscriptionID = subscriptionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2UpdateEventSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.EventSubscriptionParams != nil {
+		if err := r.SetBodyParam(o.EventSubscriptionParams); err != nil {
+			return err
+		}
+	}
+
+	// path param subscription_id
+	if err := r.SetPathParam("subscription_id", o.SubscriptionID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateEventSubscriptionReader is a Reader for the V2UpdateEventSubscription structure.
+type V2UpdateEventSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2UpdateEventSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2UpdateEventSubscriptionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2UpdateEventSubscriptionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2UpdateEventSubscriptionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2UpdateEventSubscriptionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2UpdateEventSubscriptionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2UpdateEventSubscriptionMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2UpdateEventSubscriptionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2UpdateEventSubscriptionOK creates a V2UpdateEventSubscriptionOK with default headers values
+func NewV2UpdateEventSubscriptionOK() *V2UpdateEventSubscriptionOK {
+	return &V2UpdateEventSubscriptionOK{}
+}
+
+/* V2UpdateEventSubscriptionOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2UpdateEventSubscriptionOK struct {
+	Payload *models.EventSubscription
+}
+
+func (o *V2UpdateEventSubscriptionOK) Error() string {
+	return fmt.Sprintf("[PUT /v2/event-subscriptions/{subscription_id}][%d] v2UpdateEventSubscriptionOK  %+v", 200, o.Payload)
+}
+func (o *V2UpdateEventSubscriptionOK) GetPayload() *models.EventSubscription {
+	return o.Payload
+}
+
+func (o *V2UpdateEventSubscriptionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.EventSubscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateEventSubscriptionBadRequest creates a V2UpdateEventSubscriptionBadRequest with default headers values
+func NewV2UpdateEventSubscriptionBadRequest() *V2UpdateEventSubscriptionBadRequest {
+	return &V2UpdateEventSubscriptionBadRequest{}
+}
+
+/* V2UpdateEventSubscriptionBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2UpdateEventSubscriptionBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2UpdateEventSubscriptionBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /v2/event-subscriptions/{subscription_id}][%d] v2UpdateEventSubscriptionBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2UpdateEventSubscriptionBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateEventSubscriptionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateEventSubscriptionUnauthorized creates a V2UpdateEventSubscriptionUnauthorized with default headers values
+func NewV2UpdateEventSubscriptionUnauthorized() *V2UpdateEventSubscriptionUnauthorized {
+	return &V2UpdateEventSubscriptionUnauthorized{}
+}
+
+/* V2UpdateEventSubscriptionUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2UpdateEventSubscriptionUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2UpdateEventSubscriptionUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /v2/event-subscriptions/{subscription_id}][%d] v2UpdateEventSubscriptionUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2UpdateEventSubscriptionUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateEventSubscriptionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateEventSubscriptionForbidden creates a V2UpdateEventSubscriptionForbidden with default headers values
+func NewV2UpdateEventSubscriptionForbidden() *V2UpdateEventSubscriptionForbidden {
+	return &V2UpdateEventSubscriptionForbidden{}
+}
+
+/* V2UpdateEventSubscriptionForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2UpdateEventSubscriptionForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2UpdateEventSubscriptionForbidden) Error() string {
+	return fmt.Sprintf("[PUT /v2/event-subscriptions/{subscription_id}][%d] v2UpdateEventSubscriptionForbidden  %+v", 403, o.Payload)
+}
+func (o *V2UpdateEventSubscriptionForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateEventSubscriptionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateEventSubscriptionNotFound creates a V2UpdateEventSubscriptionNotFound with default headers values
+func NewV2UpdateEventSubscriptionNotFound() *V2UpdateEventSubscriptionNotFound {
+	return &V2UpdateEventSubscriptionNotFound{}
+}
+
+/* V2UpdateEventSubscriptionNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2UpdateEventSubscriptionNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2UpdateEventSubscriptionNotFound) Error() string {
+	return fmt.Sprintf("[PUT /v2/event-subscriptions/{subscription_id}][%d] v2UpdateEventSubscriptionNotFound  %+v", 404, o.Payload)
+}
+func (o *V2UpdateEventSubscriptionNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateEventSubscriptionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateEventSubscriptionMethodNotAllowed creates a V2UpdateEventSubscriptionMethodNotAllowed with default headers values
+func NewV2UpdateEventSubscriptionMethodNotAllowed() *V2UpdateEventSubscriptionMethodNotAllowed {
+	return &V2UpdateEventSubscriptionMethodNotAllowed{}
+}
+
+/* V2UpdateEventSubscriptionMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2UpdateEventSubscriptionMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2UpdateEventSubscriptionMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[PUT /v2/event-subscriptions/{subscription_id}][%d] v2UpdateEventSubscriptionMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2UpdateEventSubscriptionMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateEventSubscriptionMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateEventSubscriptionInternalServerError creates a V2UpdateEventSubscriptionInternalServerError with default headers values
+func NewV2UpdateEventSubscriptionInternalServerError() *V2UpdateEventSubscriptionInternalServerError {
+	return &V2UpdateEventSubscriptionInternalServerError{}
+}
+
+/* V2UpdateEventSubscriptionInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2UpdateEventSubscriptionInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2UpdateEventSubscriptionInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /v2/event-subscriptions/{subscription_id}][%d] v2UpdateEventSubscriptionInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2UpdateEventSubscriptionInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateEventSubscriptionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ package events
 
 import (
 	"context"
+	"io"
 
 	"github.com/go-openapi/runtime"
 
@@ -20,6 +21,12 @@ type API interface {
 	/*
 	   V2ListEvents Lists events for a cluster.*/
 	V2ListEvents(ctx context.Context, params *V2ListEventsParams) (*V2ListEventsOK, error)
+	/*
+	   V2StreamEvents Streams the events of a cluster, host or infra-env as server-sent events while they are saved.
+	   Each message holds an event in JSON format and its ID, which can be sent back in the Last-Event-ID header
+	   (or the last_event_id parameter) to resume the stream after the last received event.
+	*/
+	V2StreamEvents(ctx context.Context, params *V2StreamEventsParams, writer io.Writer) (*V2StreamEventsOK, error)
 }
 
 // New creates a new events API client.
@@ -64,3 +71,31 @@ func (a *Client) V2ListEvents(ctx context.Context, params *V2ListEventsParams) (
 	return result.(*V2ListEventsOK), nil
 
 }
+
+/*
+V2StreamEvents Streams the events of a cluster, host or infra-env as server-sent events while they are saved.
+Each message holds an event in JSON format and its ID, which can be sent back in the Last-Event-ID header
+(or the last_event_id parameter) to resume the stream after the last received event.
+
+*/
+func (a *Client) V2StreamEvents(ctx context.Context, params *V2StreamEventsParams, writer io.Writer) (*V2StreamEventsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2StreamEvents",
+		Method:             "GET",
+		PathPattern:        "/v2/events/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2StreamEventsReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2StreamEventsOK), nil
+
+}
@@ -60,6 +60,12 @@ func NewV2ListEventsParamsWithHTTPClient(client *http.Client) *V2ListEventsParam
 */
 type V2ListEventsParams struct {
 
+	/* Archived.
+
+	   Return the events that were pruned from the database and archived, instead of the current events. Requires either cluster_id or infra_env_id.
+	*/
+	Archived *bool
+
 	/* Categories.
 
 	   A comma-separated list of event categories.
@@ -74,6 +80,14 @@ type V2ListEventsParams struct {
 	*/
 	ClusterID *strfmt.UUID
 
+	/* EndTime.
+
+	   Only return the events that occurred before this time.
+
+	   Format: date-time
+	*/
+	EndTime *strfmt.DateTime
+
 	/* HostID.
 
 	   A host in the specified cluster to return events for.
@@ -90,6 +104,56 @@ type V2ListEventsParams struct {
 	*/
 	InfraEnvID *strfmt.UUID
 
+	/* Limit.
+
+	   The maximal number of events to return. All the events are returned when not set.
+
+	   Format: int64
+	*/
+	Limit *int64
+
+	/* Message.
+
+	   Only return the events whose message contains this text, ignoring case.
+	*/
+	Message *string
+
+	/* Names.
+
+	   A comma-separated list of event names. Events of all names are returned when not set.
+	*/
+	Names []string
+
+	/* Offset.
+
+	   The number of events to skip before starting to return events.
+
+	   Format: int64
+	*/
+	Offset *int64
+
+	/* Order.
+
+	   Order the events by their time, from the oldest (ascending) or from the latest (descending).
+
+	   Default: "ascending"
+	*/
+	Order *string
+
+	/* Severities.
+
+	   A comma-separated list of event severities. Events of all severities are returned when not set.
+	*/
+	Severities []string
+
+	/* StartTime.
+
+	   Only return the events that occurred at or after this time.
+
+	   Format: date-time
+	*/
+	StartTime *strfmt.DateTime
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -107,7 +171,21 @@ func (o *V2ListEventsParams) WithDefaults() *V2ListEventsParams {
 //
 // All values with no default are reset to their zero value.
 func (o *V2ListEventsParams) SetDefaults() {
-	// no default values defined for this parameter
+	var (
+		archivedDefault = bool(false)
+
+		orderDefault = string("ascending")
+	)
+
+	val := V2ListEventsParams{
+		Archived: &archivedDefault,
+		Order:    &orderDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
 }
 
 // WithTimeout adds the timeout to the v2 list events params
@@ -143,6 +221,17 @@ func (o *V2ListEventsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithArchived adds the archived to the v2 list events params
+func (o *V2ListEventsParams) WithArchived(archived *bool) *V2ListEventsParams {
+	o.SetArchived(archived)
+	return o
+}
+
+// SetArchived adds the archived to the v2 list events params
+func (o *V2ListEventsParams) SetArchived(archived *bool) {
+	o.Archived = archived
+}
+
 // WithCategories adds the categories to the v2 list events params
 func (o *V2ListEventsParams) WithCategories(categories []string) *V2ListEventsParams {
 	o.SetCategories(categories)
@@ -165,6 +254,17 @@ func (o *V2ListEventsParams) SetClusterID(clusterID *strfmt.UUID) {
 	o.ClusterID = clusterID
 }
 
+// WithEndTime adds the endTime to the v2 list events params
+func (o *V2ListEventsParams) WithEndTime(endTime *strfmt.DateTime) *V2ListEventsParams {
+	o.SetEndTime(endTime)
+	return o
+}
+
+// SetEndTime adds the endTime to the v2 list events params
+func (o *V2ListEventsParams) SetEndTime(endTime *strfmt.DateTime) {
+	o.EndTime = endTime
+}
+
 // WithHostID adds the hostID to the v2 list events params
 func (o *V2ListEventsParams) WithHostID(hostID *strfmt.UUID) *V2ListEventsParams {
 	o.SetHostID(hostID)
@@ -187,6 +287,83 @@ func (o *V2ListEventsParams) SetInfraEnvID(infraEnvID *strfmt.UUID) {
 	o.InfraEnvID = infraEnvID
 }
 
+// WithLimit adds the limit to the v2 list events params
+func (o *V2ListEventsParams) WithLimit(limit *int64) *V2ListEventsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list events params
+func (o *V2ListEventsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMessage adds the message to the v2 list events params
+func (o *V2ListEventsParams) WithMessage(message *string) *V2ListEventsParams {
+	o.SetMessage(message)
+	return o
+}
+
+// SetMessage adds the message to the v2 list events params
+func (o *V2ListEventsParams) SetMessage(message *string) {
+	o.Message = message
+}
+
+// WithNames adds the names to the v2 list events params
+func (o *V2ListEventsParams) WithNames(names []string) *V2ListEventsParams {
+	o.SetNames(names)
+	return o
+}
+
+// SetNames adds the names to the v2 list events params
+func (o *V2ListEventsParams) SetNames(names []string) {
+	o.Names = names
+}
+
+// WithOffset adds the offset to the v2 list events params
+func (o *V2ListEventsParams) WithOffset(offset *int64) *V2ListEventsParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the v2 list events params
+func (o *V2ListEventsParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithOrder adds the order to the v2 list events params
+func (o *V2ListEventsParams) WithOrder(order *string) *V2ListEventsParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the v2 list events params
+func (o *V2ListEventsParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithSeverities adds the severities to the v2 list events params
+func (o *V2ListEventsParams) WithSeverities(severities []string) *V2ListEventsParams {
+	o.SetSeverities(severities)
+	return o
+}
+
+// SetSeverities adds the severities to the v2 list events params
+func (o *V2ListEventsParams) SetSeverities(severities []string) {
+	o.Severities = severities
+}
+
+// WithStartTime adds the startTime to the v2 list events params
+func (o *V2ListEventsParams) WithStartTime(startTime *strfmt.DateTime) *V2ListEventsParams {
+	o.SetStartTime(startTime)
+	return o
+}
+
+// SetStartTime adds the startTime to the v2 list events params
+func (o *V2ListEventsParams) SetStartTime(startTime *strfmt.DateTime) {
+	o.StartTime = startTime
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *V2ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -195,6 +372,23 @@ func (o *V2ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.Archived != nil {
+
+		// query param archived
+		var qrArchived bool
+
+		if o.Archived != nil {
+			qrArchived = *o.Archived
+		}
+		qArchived := swag.FormatBool(qrArchived)
+		if qArchived != "" {
+
+			if err := r.SetQueryParam("archived", qArchived); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Categories != nil {
 
 		// binding items for categories
@@ -223,6 +417,23 @@ func (o *V2ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		}
 	}
 
+	if o.EndTime != nil {
+
+		// query param end_time
+		var qrEndTime strfmt.DateTime
+
+		if o.EndTime != nil {
+			qrEndTime = *o.EndTime
+		}
+		qEndTime := qrEndTime.String()
+		if qEndTime != "" {
+
+			if err := r.SetQueryParam("end_time", qEndTime); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.HostID != nil {
 
 		// query param host_id
@@ -257,6 +468,113 @@ func (o *V2ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		}
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Message != nil {
+
+		// query param message
+		var qrMessage string
+
+		if o.Message != nil {
+			qrMessage = *o.Message
+		}
+		qMessage := qrMessage
+		if qMessage != "" {
+
+			if err := r.SetQueryParam("message", qMessage); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Names != nil {
+
+		// binding items for names
+		joinedNames := o.bindParamNames(reg)
+
+		// query array param names
+		if err := r.SetQueryParam("names", joinedNames...); err != nil {
+			return err
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Severities != nil {
+
+		// binding items for severities
+		joinedSeverities := o.bindParamSeverities(reg)
+
+		// query array param severities
+		if err := r.SetQueryParam("severities", joinedSeverities...); err != nil {
+			return err
+		}
+	}
+
+	if o.StartTime != nil {
+
+		// query param start_time
+		var qrStartTime strfmt.DateTime
+
+		if o.StartTime != nil {
+			qrStartTime = *o.StartTime
+		}
+		qStartTime := qrStartTime.String()
+		if qStartTime != "" {
+
+			if err := r.SetQueryParam("start_time", qStartTime); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -279,3 +597,37 @@ func (o *V2ListEventsParams) bindParamCategories(formats strfmt.Registry) []stri
 
 	return categoriesIS
 }
+
+// bindParamV2ListEvents binds the parameter names
+func (o *V2ListEventsParams) bindParamNames(formats strfmt.Registry) []string {
+	namesIR := o.Names
+
+	var namesIC []string
+	for _, namesIIR := range namesIR { // explode []string
+
+		namesIIV := namesIIR // string as string
+		namesIC = append(namesIC, namesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	namesIS := swag.JoinByFormat(namesIC, "")
+
+	return namesIS
+}
+
+// bindParamV2ListEvents binds the parameter severities
+func (o *V2ListEventsParams) bindParamSeverities(formats strfmt.Registry) []string {
+	severitiesIR := o.Severities
+
+	var severitiesIC []string
+	for _, severitiesIIR := range severitiesIR { // explode []string
+
+		severitiesIIV := severitiesIIR // string as string
+		severitiesIC = append(severitiesIC, severitiesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	severitiesIS := swag.JoinByFormat(severitiesIC, "")
+
+	return severitiesIS
+}
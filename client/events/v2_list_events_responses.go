@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -29,6 +31,12 @@ func (o *V2ListEventsReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewV2ListEventsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 401:
 		result := NewV2ListEventsUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -74,6 +82,13 @@ func NewV2ListEventsOK() *V2ListEventsOK {
 Success.
 */
 type V2ListEventsOK struct {
+
+	/* The number of events that match the filters, regardless of the limit and offset.
+
+	   Format: int64
+	*/
+	EventCount int64
+
 	Payload models.EventList
 }
 
@@ -86,6 +101,17 @@ func (o *V2ListEventsOK) GetPayload() models.EventList {
 
 func (o *V2ListEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header Event-Count
+	hdrEventCount := response.GetHeader("Event-Count")
+
+	if hdrEventCount != "" {
+		valeventCount, err := swag.ConvertInt64(hdrEventCount)
+		if err != nil {
+			return errors.InvalidType("Event-Count", "header", "int64", hdrEventCount)
+		}
+		o.EventCount = valeventCount
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -94,6 +120,38 @@ func (o *V2ListEventsOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewV2ListEventsBadRequest creates a V2ListEventsBadRequest with default headers values
+func NewV2ListEventsBadRequest() *V2ListEventsBadRequest {
+	return &V2ListEventsBadRequest{}
+}
+
+/* V2ListEventsBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ListEventsBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2ListEventsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/events][%d] v2ListEventsBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2ListEventsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListEventsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewV2ListEventsUnauthorized creates a V2ListEventsUnauthorized with default headers values
 func NewV2ListEventsUnauthorized() *V2ListEventsUnauthorized {
 	return &V2ListEventsUnauthorized{}
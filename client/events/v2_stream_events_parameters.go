@@ -0,0 +1,434 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2StreamEventsParams creates a new V2StreamEventsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2StreamEventsParams() *V2StreamEventsParams {
+	return &V2StreamEventsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2StreamEventsParamsWithTimeout creates a new V2StreamEventsParams object
+// with the ability to set a timeout on a request.
+func NewV2StreamEventsParamsWithTimeout(timeout time.Duration) *V2StreamEventsParams {
+	return &V2StreamEventsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2StreamEventsParamsWithContext creates a new V2StreamEventsParams object
+// with the ability to set a context for a request.
+func NewV2StreamEventsParamsWithContext(ctx context.Context) *V2StreamEventsParams {
+	return &V2StreamEventsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2StreamEventsParamsWithHTTPClient creates a new V2StreamEventsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2StreamEventsParamsWithHTTPClient(client *http.Client) *V2StreamEventsParams {
+	return &V2StreamEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2StreamEventsParams contains all the parameters to send to the API endpoint
+   for the v2 stream events operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2StreamEventsParams struct {
+
+	/* LastEventID.
+
+	   The ID of the last event received before the stream was interrupted, as sent by EventSource clients when they reconnect.
+
+	   Format: int64
+	*/
+	LastEventIDHeader *int64
+
+	/* Categories.
+
+	   A comma-separated list of event categories.
+	*/
+	Categories []string
+
+	/* ClusterID.
+
+	   The cluster to stream events for.
+
+	   Format: uuid
+	*/
+	ClusterID *strfmt.UUID
+
+	/* HostID.
+
+	   A host in the specified cluster to stream events for.
+
+	   Format: uuid
+	*/
+	HostID *strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env to stream events for.
+
+	   Format: uuid
+	*/
+	InfraEnvID *strfmt.UUID
+
+	/* LastEventID.
+
+	   Resume the stream after the event with this ID. Only new events are streamed when neither this parameter nor the Last-Event-ID header are set.
+
+	   Format: int64
+	*/
+	LastEventID *int64
+
+	/* Names.
+
+	   A comma-separated list of event names. Events of all names are streamed when not set.
+	*/
+	Names []string
+
+	/* Severities.
+
+	   A comma-separated list of event severities. Events of all severities are streamed when not set.
+	*/
+	Severities []string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 stream events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2StreamEventsParams) WithDefaults() *V2StreamEventsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 stream events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2StreamEventsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 stream events params
+func (o *V2StreamEventsParams) WithTimeout(timeout time.Duration) *V2StreamEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 stream events params
+func (o *V2StreamEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 stream events params
+func (o *V2StreamEventsParams) WithContext(ctx context.Context) *V2StreamEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 stream events params
+func (o *V2StreamEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 stream events params
+func (o *V2StreamEventsParams) WithHTTPClient(client *http.Client) *V2StreamEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 stream events params
+func (o *V2StreamEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLastEventIDHeader adds the lastEventID to the v2 stream events params
+func (o *V2StreamEventsParams) WithLastEventIDHeader(lastEventID *int64) *V2StreamEventsParams {
+	o.SetLastEventIDHeader(lastEventID)
+	return o
+}
+
+// SetLastEventIDHeader adds the lastEventId to the v2 stream events params
+func (o *V2StreamEventsParams) SetLastEventIDHeader(lastEventID *int64) {
+	o.LastEventIDHeader = lastEventID
+}
+
+// WithCategories adds the categories to the v2 stream events params
+func (o *V2StreamEventsParams) WithCategories(categories []string) *V2StreamEventsParams {
+	o.SetCategories(categories)
+	return o
+}
+
+// SetCategories adds the categories to the v2 stream events params
+func (o *V2StreamEventsParams) SetCategories(categories []string) {
+	o.Categories = categories
+}
+
+// WithClusterID adds the clusterID to the v2 stream events params
+func (o *V2StreamEventsParams) WithClusterID(clusterID *strfmt.UUID) *V2StreamEventsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 stream events params
+func (o *V2StreamEventsParams) SetClusterID(clusterID *strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithHostID adds the hostID to the v2 stream events params
+func (o *V2StreamEventsParams) WithHostID(hostID *strfmt.UUID) *V2StreamEventsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 stream events params
+func (o *V2StreamEventsParams) SetHostID(hostID *strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 stream events params
+func (o *V2StreamEventsParams) WithInfraEnvID(infraEnvID *strfmt.UUID) *V2StreamEventsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 stream events params
+func (o *V2StreamEventsParams) SetInfraEnvID(infraEnvID *strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WithLastEventID adds the lastEventID to the v2 stream events params
+func (o *V2StreamEventsParams) WithLastEventID(lastEventID *int64) *V2StreamEventsParams {
+	o.SetLastEventID(lastEventID)
+	return o
+}
+
+// SetLastEventID adds the lastEventId to the v2 stream events params
+func (o *V2StreamEventsParams) SetLastEventID(lastEventID *int64) {
+	o.LastEventID = lastEventID
+}
+
+// WithNames adds the names to the v2 stream events params
+func (o *V2StreamEventsParams) WithNames(names []string) *V2StreamEventsParams {
+	o.SetNames(names)
+	return o
+}
+
+// SetNames adds the names to the v2 stream events params
+func (o *V2StreamEventsParams) SetNames(names []string) {
+	o.Names = names
+}
+
+// WithSeverities adds the severities to the v2 stream events params
+func (o *V2StreamEventsParams) WithSeverities(severities []string) *V2StreamEventsParams {
+	o.SetSeverities(severities)
+	return o
+}
+
+// SetSeverities adds the severities to the v2 stream events params
+func (o *V2StreamEventsParams) SetSeverities(severities []string) {
+	o.Severities = severities
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2StreamEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LastEventIDHeader != nil {
+
+		// header param Last-Event-ID
+		if err := r.SetHeaderParam("Last-Event-ID", swag.FormatInt64(*o.LastEventIDHeader)); err != nil {
+			return err
+		}
+	}
+
+	if o.Categories != nil {
+
+		// binding items for categories
+		joinedCategories := o.bindParamCategories(reg)
+
+		// query array param categories
+		if err := r.SetQueryParam("categories", joinedCategories...); err != nil {
+			return err
+		}
+	}
+
+	if o.ClusterID != nil {
+
+		// query param cluster_id
+		var qrClusterID strfmt.UUID
+
+		if o.ClusterID != nil {
+			qrClusterID = *o.ClusterID
+		}
+		qClusterID := qrClusterID.String()
+		if qClusterID != "" {
+
+			if err := r.SetQueryParam("cluster_id", qClusterID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.HostID != nil {
+
+		// query param host_id
+		var qrHostID strfmt.UUID
+
+		if o.HostID != nil {
+			qrHostID = *o.HostID
+		}
+		qHostID := qrHostID.String()
+		if qHostID != "" {
+
+			if err := r.SetQueryParam("host_id", qHostID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.InfraEnvID != nil {
+
+		// query param infra_env_id
+		var qrInfraEnvID strfmt.UUID
+
+		if o.InfraEnvID != nil {
+			qrInfraEnvID = *o.InfraEnvID
+		}
+		qInfraEnvID := qrInfraEnvID.String()
+		if qInfraEnvID != "" {
+
+			if err := r.SetQueryParam("infra_env_id", qInfraEnvID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.LastEventID != nil {
+
+		// query param last_event_id
+		var qrLastEventID int64
+
+		if o.LastEventID != nil {
+			qrLastEventID = *o.LastEventID
+		}
+		qLastEventID := swag.FormatInt64(qrLastEventID)
+		if qLastEventID != "" {
+
+			if err := r.SetQueryParam("last_event_id", qLastEventID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Names != nil {
+
+		// binding items for names
+		joinedNames := o.bindParamNames(reg)
+
+		// query array param names
+		if err := r.SetQueryParam("names", joinedNames...); err != nil {
+			return err
+		}
+	}
+
+	if o.Severities != nil {
+
+		// binding items for severities
+		joinedSeverities := o.bindParamSeverities(reg)
+
+		// query array param severities
+		if err := r.SetQueryParam("severities", joinedSeverities...); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamV2StreamEvents binds the parameter categories
+func (o *V2StreamEventsParams) bindParamCategories(formats strfmt.Registry) []string {
+	categoriesIR := o.Categories
+
+	var categoriesIC []string
+	for _, categoriesIIR := range categoriesIR { // explode []string
+
+		categoriesIIV := categoriesIIR // string as string
+		categoriesIC = append(categoriesIC, categoriesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	categoriesIS := swag.JoinByFormat(categoriesIC, "")
+
+	return categoriesIS
+}
+
+// bindParamV2StreamEvents binds the parameter names
+func (o *V2StreamEventsParams) bindParamNames(formats strfmt.Registry) []string {
+	namesIR := o.Names
+
+	var namesIC []string
+	for _, namesIIR := range namesIR { // explode []string
+
+		namesIIV := namesIIR // string as string
+		namesIC = append(namesIC, namesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	namesIS := swag.JoinByFormat(namesIC, "")
+
+	return namesIS
+}
+
+// bindParamV2StreamEvents binds the parameter severities
+func (o *V2StreamEventsParams) bindParamSeverities(formats strfmt.Registry) []string {
+	severitiesIR := o.Severities
+
+	var severitiesIC []string
+	for _, severitiesIIR := range severitiesIR { // explode []string
+
+		severitiesIIV := severitiesIIR // string as string
+		severitiesIC = append(severitiesIC, severitiesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	severitiesIS := swag.JoinByFormat(severitiesIC, "")
+
+	return severitiesIS
+}
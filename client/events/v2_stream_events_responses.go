@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2StreamEventsReader is a Reader for the V2StreamEvents structure.
+type V2StreamEventsReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2StreamEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2StreamEventsOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2StreamEventsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2StreamEventsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2StreamEventsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2StreamEventsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2StreamEventsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2StreamEventsOK creates a V2StreamEventsOK with default headers values
+func NewV2StreamEventsOK(writer io.Writer) *V2StreamEventsOK {
+	return &V2StreamEventsOK{
+
+		Payload: writer,
+	}
+}
+
+/* V2StreamEventsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2StreamEventsOK struct {
+	Payload io.Writer
+}
+
+func (o *V2StreamEventsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/events/stream][%d] v2StreamEventsOK  %+v", 200, o.Payload)
+}
+func (o *V2StreamEventsOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *V2StreamEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2StreamEventsBadRequest creates a V2StreamEventsBadRequest with default headers values
+func NewV2StreamEventsBadRequest() *V2StreamEventsBadRequest {
+	return &V2StreamEventsBadRequest{}
+}
+
+/* V2StreamEventsBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2StreamEventsBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2StreamEventsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/events/stream][%d] v2StreamEventsBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2StreamEventsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2StreamEventsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2StreamEventsUnauthorized creates a V2StreamEventsUnauthorized with default headers values
+func NewV2StreamEventsUnauthorized() *V2StreamEventsUnauthorized {
+	return &V2StreamEventsUnauthorized{}
+}
+
+/* V2StreamEventsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2StreamEventsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2StreamEventsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/events/stream][%d] v2StreamEventsUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2StreamEventsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2StreamEventsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2StreamEventsForbidden creates a V2StreamEventsForbidden with default headers values
+func NewV2StreamEventsForbidden() *V2StreamEventsForbidden {
+	return &V2StreamEventsForbidden{}
+}
+
+/* V2StreamEventsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2StreamEventsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2StreamEventsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/events/stream][%d] v2StreamEventsForbidden  %+v", 403, o.Payload)
+}
+func (o *V2StreamEventsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2StreamEventsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2StreamEventsMethodNotAllowed creates a V2StreamEventsMethodNotAllowed with default headers values
+func NewV2StreamEventsMethodNotAllowed() *V2StreamEventsMethodNotAllowed {
+	return &V2StreamEventsMethodNotAllowed{}
+}
+
+/* V2StreamEventsMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2StreamEventsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2StreamEventsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/events/stream][%d] v2StreamEventsMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2StreamEventsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2StreamEventsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2StreamEventsInternalServerError creates a V2StreamEventsInternalServerError with default headers values
+func NewV2StreamEventsInternalServerError() *V2StreamEventsInternalServerError {
+	return &V2StreamEventsInternalServerError{}
+}
+
+/* V2StreamEventsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2StreamEventsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2StreamEventsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/events/stream][%d] v2StreamEventsInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2StreamEventsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2StreamEventsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
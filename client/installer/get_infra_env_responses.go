@@ -86,6 +86,11 @@ func NewGetInfraEnvOK() *GetInfraEnvOK {
 Success.
 */
 type GetInfraEnvOK struct {
+
+	/* The resource version of the infra-env, to be sent in the If-Match header of its updates.
+	 */
+	ETag string
+
 	Payload *models.InfraEnv
 }
 
@@ -98,6 +103,13 @@ func (o *GetInfraEnvOK) GetPayload() *models.InfraEnv {
 
 func (o *GetInfraEnvOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.InfraEnv)
 
 	// response payload
@@ -91,9 +91,15 @@ type API interface {
 	/*
 	   V2UploadLogs Agent API to upload logs.*/
 	V2UploadLogs(ctx context.Context, params *V2UploadLogsParams) (*V2UploadLogsNoContent, error)
+	/*
+	   V2CancelInstallSchedule Cancels the scheduled installation of the cluster.*/
+	V2CancelInstallSchedule(ctx context.Context, params *V2CancelInstallScheduleParams) (*V2CancelInstallScheduleAccepted, error)
 	/*
 	   V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.*/
 	V2CompleteInstallation(ctx context.Context, params *V2CompleteInstallationParams) (*V2CompleteInstallationAccepted, error)
+	/*
+	   V2CreateHostDiagnostic Queues a diagnostic action from the service allowlist to be run on the host by its agent.*/
+	V2CreateHostDiagnostic(ctx context.Context, params *V2CreateHostDiagnosticParams) (*V2CreateHostDiagnosticCreated, error)
 	/*
 	   V2DeregisterCluster Deletes an OpenShift cluster definition.*/
 	V2DeregisterCluster(ctx context.Context, params *V2DeregisterClusterParams) (*V2DeregisterClusterNoContent, error)
@@ -106,15 +112,32 @@ type API interface {
 	/*
 	   V2DownloadInfraEnvFiles Downloads the customized ignition file for this host*/
 	V2DownloadInfraEnvFiles(ctx context.Context, params *V2DownloadInfraEnvFilesParams, writer io.Writer) (*V2DownloadInfraEnvFilesOK, error)
+	/*
+	   V2DryRunInstallation Generates the install-config, the operator manifests and the ignition files of the cluster as the installation
+	   would, in a scratch working directory, and returns the generated artifacts together with the errors of every
+	   generation stage. The cluster, its hosts and its stored files are not modified.
+	*/
+	V2DryRunInstallation(ctx context.Context, params *V2DryRunInstallationParams) (*V2DryRunInstallationOK, error)
 	/*
 	   V2GetCluster Retrieves the details of the OpenShift cluster.*/
 	V2GetCluster(ctx context.Context, params *V2GetClusterParams) (*V2GetClusterOK, error)
 	/*
 	   V2GetClusterInstallConfig Get the cluster's install config YAML.*/
 	V2GetClusterInstallConfig(ctx context.Context, params *V2GetClusterInstallConfigParams) (*V2GetClusterInstallConfigOK, error)
+	/*
+	   V2GetClusterInstallConfigDiff Get a unified diff between the install config YAML rendered with two versions of the install config overrides.
+	*/
+	V2GetClusterInstallConfigDiff(ctx context.Context, params *V2GetClusterInstallConfigDiffParams) (*V2GetClusterInstallConfigDiffOK, error)
+	/*
+	   V2GetClusterInstallationTimeline Get the timeline of the cluster installation, built from the installation stages that each host went through.
+	*/
+	V2GetClusterInstallationTimeline(ctx context.Context, params *V2GetClusterInstallationTimelineParams) (*V2GetClusterInstallationTimelineOK, error)
 	/*
 	   V2GetHost Retrieves the details of the OpenShift host.*/
 	V2GetHost(ctx context.Context, params *V2GetHostParams) (*V2GetHostOK, error)
+	/*
+	   V2GetHostDiagnostic Retrieves a diagnostic action of the host, including its output once the agent uploaded it.*/
+	V2GetHostDiagnostic(ctx context.Context, params *V2GetHostDiagnosticParams) (*V2GetHostDiagnosticOK, error)
 	/*
 	   V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error*/
 	V2GetHostIgnition(ctx context.Context, params *V2GetHostIgnitionParams) (*V2GetHostIgnitionOK, error)
@@ -128,17 +151,40 @@ type API interface {
 	   V2ImportCluster Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster*/
 	V2ImportCluster(ctx context.Context, params *V2ImportClusterParams) (*V2ImportClusterCreated, error)
 	/*
-	   V2InstallCluster Installs the OpenShift cluster.*/
+	   V2InstallCluster Installs the OpenShift cluster. When an install schedule is given and its start time is in the future,
+	   the installation is scheduled instead and starts automatically once the cluster is ready within the schedule's window.
+	*/
 	V2InstallCluster(ctx context.Context, params *V2InstallClusterParams) (*V2InstallClusterAccepted, error)
 	/*
 	   V2InstallHost install specific host for day2 cluster.*/
 	V2InstallHost(ctx context.Context, params *V2InstallHostParams) (*V2InstallHostAccepted, error)
+	/*
+	   V2ListClusterInstallConfigHistory Lists the versions of the install config overrides of the cluster, oldest first.*/
+	V2ListClusterInstallConfigHistory(ctx context.Context, params *V2ListClusterInstallConfigHistoryParams) (*V2ListClusterInstallConfigHistoryOK, error)
+	/*
+	   V2ListClusterStateTransitions Lists the status transitions of the cluster, oldest first.*/
+	V2ListClusterStateTransitions(ctx context.Context, params *V2ListClusterStateTransitionsParams) (*V2ListClusterStateTransitionsOK, error)
 	/*
 	   V2ListClusters Retrieves the list of OpenShift clusters.*/
 	V2ListClusters(ctx context.Context, params *V2ListClustersParams) (*V2ListClustersOK, error)
+	/*
+	   V2ListDeletedClusters Lists the deregistered clusters that can still be restored, before they are permanently deleted.
+	*/
+	V2ListDeletedClusters(ctx context.Context, params *V2ListDeletedClustersParams) (*V2ListDeletedClustersOK, error)
 	/*
 	   V2ListFeatureSupportLevels Retrieves the support levels for features for each OpenShift version.*/
 	V2ListFeatureSupportLevels(ctx context.Context, params *V2ListFeatureSupportLevelsParams) (*V2ListFeatureSupportLevelsOK, error)
+	/*
+	   V2ListHostDiagnostics Lists the diagnostic actions that were requested for the host.*/
+	V2ListHostDiagnostics(ctx context.Context, params *V2ListHostDiagnosticsParams) (*V2ListHostDiagnosticsOK, error)
+	/*
+	   V2ListHostStateTransitions Lists the status transitions of the host, oldest first.*/
+	V2ListHostStateTransitions(ctx context.Context, params *V2ListHostStateTransitionsParams) (*V2ListHostStateTransitionsOK, error)
+	/*
+	   V2ListHostValidationStats Lists the statistics of the validations of the host: how long they took to succeed after the host was
+	   discovered, and how often they changed between success and failure.
+	*/
+	V2ListHostValidationStats(ctx context.Context, params *V2ListHostValidationStatsParams) (*V2ListHostValidationStatsOK, error)
 	/*
 	   V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.*/
 	V2ListHosts(ctx context.Context, params *V2ListHostsParams) (*V2ListHostsOK, error)
@@ -151,6 +197,13 @@ type API interface {
 	/*
 	   V2RegisterHost Registers a new OpenShift agent.*/
 	V2RegisterHost(ctx context.Context, params *V2RegisterHostParams) (*V2RegisterHostCreated, error)
+	/*
+	   V2ReinstallFailedHosts Resets only the failed or cancelled workers of an installing or installed cluster, without touching
+	   its other hosts. The hosts become day-2 hosts of the cluster: once they are rebooted into the discovery
+	   image and register again, they are installed with v2InstallHost and join the cluster through the
+	   add-hosts flow, using a freshly generated day-2 ignition.
+	*/
+	V2ReinstallFailedHosts(ctx context.Context, params *V2ReinstallFailedHostsParams) (*V2ReinstallFailedHostsAccepted, error)
 	/*
 	   V2ResetCluster Resets a failed installation.*/
 	V2ResetCluster(ctx context.Context, params *V2ResetClusterParams) (*V2ResetClusterAccepted, error)
@@ -162,6 +215,11 @@ type API interface {
 
 	   Reset failed host validation. It may be performed on any host validation with persistent validation result.*/
 	V2ResetHostValidation(ctx context.Context, params *V2ResetHostValidationParams) (*V2ResetHostValidationOK, error)
+	/*
+	   V2RestoreDeletedCluster Restores a deregistered cluster, together with the hosts that were deregistered with it, before it is
+	   permanently deleted.
+	*/
+	V2RestoreDeletedCluster(ctx context.Context, params *V2RestoreDeletedClusterParams) (*V2RestoreDeletedClusterCreated, error)
 	/*
 	   V2UpdateClusterInstallConfig Override values in the install config.*/
 	V2UpdateClusterInstallConfig(ctx context.Context, params *V2UpdateClusterInstallConfigParams) (*V2UpdateClusterInstallConfigCreated, error)
@@ -813,6 +871,31 @@ func (a *Client) V2UploadLogs(ctx context.Context, params *V2UploadLogsParams) (
 
 }
 
+/*
+V2CancelInstallSchedule Cancels the scheduled installation of the cluster.
+*/
+func (a *Client) V2CancelInstallSchedule(ctx context.Context, params *V2CancelInstallScheduleParams) (*V2CancelInstallScheduleAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CancelInstallSchedule",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/actions/cancel-install-schedule",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CancelInstallScheduleReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CancelInstallScheduleAccepted), nil
+
+}
+
 /*
 V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.
 */
@@ -838,6 +921,31 @@ func (a *Client) V2CompleteInstallation(ctx context.Context, params *V2CompleteI
 
 }
 
+/*
+V2CreateHostDiagnostic Queues a diagnostic action from the service allowlist to be run on the host by its agent.
+*/
+func (a *Client) V2CreateHostDiagnostic(ctx context.Context, params *V2CreateHostDiagnosticParams) (*V2CreateHostDiagnosticCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CreateHostDiagnostic",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateHostDiagnosticReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateHostDiagnosticCreated), nil
+
+}
+
 /*
 V2DeregisterCluster Deletes an OpenShift cluster definition.
 */
@@ -938,6 +1046,34 @@ func (a *Client) V2DownloadInfraEnvFiles(ctx context.Context, params *V2Download
 
 }
 
+/*
+V2DryRunInstallation Generates the install-config, the operator manifests and the ignition files of the cluster as the installation
+would, in a scratch working directory, and returns the generated artifacts together with the errors of every
+generation stage. The cluster, its hosts and its stored files are not modified.
+
+*/
+func (a *Client) V2DryRunInstallation(ctx context.Context, params *V2DryRunInstallationParams) (*V2DryRunInstallationOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DryRunInstallation",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/actions/dry-run",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DryRunInstallationReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DryRunInstallationOK), nil
+
+}
+
 /*
 V2GetCluster Retrieves the details of the OpenShift cluster.
 */
@@ -988,6 +1124,58 @@ func (a *Client) V2GetClusterInstallConfig(ctx context.Context, params *V2GetClu
 
 }
 
+/*
+V2GetClusterInstallConfigDiff Get a unified diff between the install config YAML rendered with two versions of the install config overrides.
+
+*/
+func (a *Client) V2GetClusterInstallConfigDiff(ctx context.Context, params *V2GetClusterInstallConfigDiffParams) (*V2GetClusterInstallConfigDiffOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterInstallConfigDiff",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/install-config/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterInstallConfigDiffReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterInstallConfigDiffOK), nil
+
+}
+
+/*
+V2GetClusterInstallationTimeline Get the timeline of the cluster installation, built from the installation stages that each host went through.
+
+*/
+func (a *Client) V2GetClusterInstallationTimeline(ctx context.Context, params *V2GetClusterInstallationTimelineParams) (*V2GetClusterInstallationTimelineOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterInstallationTimeline",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/installation-timeline",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterInstallationTimelineReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterInstallationTimelineOK), nil
+
+}
+
 /*
 V2GetHost Retrieves the details of the OpenShift host.
 */
@@ -1013,6 +1201,31 @@ func (a *Client) V2GetHost(ctx context.Context, params *V2GetHostParams) (*V2Get
 
 }
 
+/*
+V2GetHostDiagnostic Retrieves a diagnostic action of the host, including its output once the agent uploaded it.
+*/
+func (a *Client) V2GetHostDiagnostic(ctx context.Context, params *V2GetHostDiagnosticParams) (*V2GetHostDiagnosticOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetHostDiagnostic",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetHostDiagnosticReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetHostDiagnosticOK), nil
+
+}
+
 /*
 V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error
 */
@@ -1114,7 +1327,9 @@ func (a *Client) V2ImportCluster(ctx context.Context, params *V2ImportClusterPar
 }
 
 /*
-V2InstallCluster Installs the OpenShift cluster.
+V2InstallCluster Installs the OpenShift cluster. When an install schedule is given and its start time is in the future,
+the installation is scheduled instead and starts automatically once the cluster is ready within the schedule's window.
+
 */
 func (a *Client) V2InstallCluster(ctx context.Context, params *V2InstallClusterParams) (*V2InstallClusterAccepted, error) {
 
@@ -1163,6 +1378,56 @@ func (a *Client) V2InstallHost(ctx context.Context, params *V2InstallHostParams)
 
 }
 
+/*
+V2ListClusterInstallConfigHistory Lists the versions of the install config overrides of the cluster, oldest first.
+*/
+func (a *Client) V2ListClusterInstallConfigHistory(ctx context.Context, params *V2ListClusterInstallConfigHistoryParams) (*V2ListClusterInstallConfigHistoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterInstallConfigHistory",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/install-config/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterInstallConfigHistoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterInstallConfigHistoryOK), nil
+
+}
+
+/*
+V2ListClusterStateTransitions Lists the status transitions of the cluster, oldest first.
+*/
+func (a *Client) V2ListClusterStateTransitions(ctx context.Context, params *V2ListClusterStateTransitionsParams) (*V2ListClusterStateTransitionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterStateTransitions",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/state-transitions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterStateTransitionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterStateTransitionsOK), nil
+
+}
+
 /*
 V2ListClusters Retrieves the list of OpenShift clusters.
 */
@@ -1188,6 +1453,32 @@ func (a *Client) V2ListClusters(ctx context.Context, params *V2ListClustersParam
 
 }
 
+/*
+V2ListDeletedClusters Lists the deregistered clusters that can still be restored, before they are permanently deleted.
+
+*/
+func (a *Client) V2ListDeletedClusters(ctx context.Context, params *V2ListDeletedClustersParams) (*V2ListDeletedClustersOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListDeletedClusters",
+		Method:             "GET",
+		PathPattern:        "/v2/deleted-clusters",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListDeletedClustersReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListDeletedClustersOK), nil
+
+}
+
 /*
 V2ListFeatureSupportLevels Retrieves the support levels for features for each OpenShift version.
 */
@@ -1213,6 +1504,83 @@ func (a *Client) V2ListFeatureSupportLevels(ctx context.Context, params *V2ListF
 
 }
 
+/*
+V2ListHostDiagnostics Lists the diagnostic actions that were requested for the host.
+*/
+func (a *Client) V2ListHostDiagnostics(ctx context.Context, params *V2ListHostDiagnosticsParams) (*V2ListHostDiagnosticsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListHostDiagnostics",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostDiagnosticsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostDiagnosticsOK), nil
+
+}
+
+/*
+V2ListHostStateTransitions Lists the status transitions of the host, oldest first.
+*/
+func (a *Client) V2ListHostStateTransitions(ctx context.Context, params *V2ListHostStateTransitionsParams) (*V2ListHostStateTransitionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListHostStateTransitions",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostStateTransitionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostStateTransitionsOK), nil
+
+}
+
+/*
+V2ListHostValidationStats Lists the statistics of the validations of the host: how long they took to succeed after the host was
+discovered, and how often they changed between success and failure.
+
+*/
+func (a *Client) V2ListHostValidationStats(ctx context.Context, params *V2ListHostValidationStatsParams) (*V2ListHostValidationStatsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListHostValidationStats",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostValidationStatsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostValidationStatsOK), nil
+
+}
+
 /*
 V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.
 */
@@ -1313,6 +1681,35 @@ func (a *Client) V2RegisterHost(ctx context.Context, params *V2RegisterHostParam
 
 }
 
+/*
+V2ReinstallFailedHosts Resets only the failed or cancelled workers of an installing or installed cluster, without touching
+its other hosts. The hosts become day-2 hosts of the cluster: once they are rebooted into the discovery
+image and register again, they are installed with v2InstallHost and join the cluster through the
+add-hosts flow, using a freshly generated day-2 ignition.
+
+*/
+func (a *Client) V2ReinstallFailedHosts(ctx context.Context, params *V2ReinstallFailedHostsParams) (*V2ReinstallFailedHostsAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ReinstallFailedHosts",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/actions/reinstall-failed-hosts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ReinstallFailedHostsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ReinstallFailedHostsAccepted), nil
+
+}
+
 /*
 V2ResetCluster Resets a failed installation.
 */
@@ -1390,6 +1787,33 @@ func (a *Client) V2ResetHostValidation(ctx context.Context, params *V2ResetHostV
 
 }
 
+/*
+V2RestoreDeletedCluster Restores a deregistered cluster, together with the hosts that were deregistered with it, before it is
+permanently deleted.
+
+*/
+func (a *Client) V2RestoreDeletedCluster(ctx context.Context, params *V2RestoreDeletedClusterParams) (*V2RestoreDeletedClusterCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RestoreDeletedCluster",
+		Method:             "POST",
+		PathPattern:        "/v2/deleted-clusters/{cluster_id}/actions/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RestoreDeletedClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RestoreDeletedClusterCreated), nil
+
+}
+
 /*
 V2UpdateClusterInstallConfig Override values in the install config.
 */
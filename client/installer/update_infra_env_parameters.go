@@ -61,6 +61,12 @@ func NewUpdateInfraEnvParamsWithHTTPClient(client *http.Client) *UpdateInfraEnvP
 */
 type UpdateInfraEnvParams struct {
 
+	/* IfMatch.
+
+	   Update the infra-env only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.
+	*/
+	IfMatch *string
+
 	/* InfraEnvUpdateParams.
 
 	   The properties to update.
@@ -128,6 +134,17 @@ func (o *UpdateInfraEnvParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the update infra env params
+func (o *UpdateInfraEnvParams) WithIfMatch(ifMatch *string) *UpdateInfraEnvParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the update infra env params
+func (o *UpdateInfraEnvParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithInfraEnvUpdateParams adds the infraEnvUpdateParams to the update infra env params
 func (o *UpdateInfraEnvParams) WithInfraEnvUpdateParams(infraEnvUpdateParams *models.InfraEnvUpdateParams) *UpdateInfraEnvParams {
 	o.SetInfraEnvUpdateParams(infraEnvUpdateParams)
@@ -157,6 +174,14 @@ func (o *UpdateInfraEnvParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		return err
 	}
 	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
 	if o.InfraEnvUpdateParams != nil {
 		if err := r.SetBodyParam(o.InfraEnvUpdateParams); err != nil {
 			return err
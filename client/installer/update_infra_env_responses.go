@@ -65,6 +65,12 @@ func (o *UpdateInfraEnvReader) ReadResponse(response runtime.ClientResponse, con
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewUpdateInfraEnvPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewUpdateInfraEnvInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -92,6 +98,11 @@ func NewUpdateInfraEnvCreated() *UpdateInfraEnvCreated {
 Success.
 */
 type UpdateInfraEnvCreated struct {
+
+	/* The resource version of the infra-env, to be sent in the If-Match header of its updates.
+	 */
+	ETag string
+
 	Payload *models.InfraEnv
 }
 
@@ -104,6 +115,13 @@ func (o *UpdateInfraEnvCreated) GetPayload() *models.InfraEnv {
 
 func (o *UpdateInfraEnvCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.InfraEnv)
 
 	// response payload
@@ -306,6 +324,38 @@ func (o *UpdateInfraEnvConflict) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewUpdateInfraEnvPreconditionFailed creates a UpdateInfraEnvPreconditionFailed with default headers values
+func NewUpdateInfraEnvPreconditionFailed() *UpdateInfraEnvPreconditionFailed {
+	return &UpdateInfraEnvPreconditionFailed{}
+}
+
+/* UpdateInfraEnvPreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed.
+*/
+type UpdateInfraEnvPreconditionFailed struct {
+	Payload *models.Error
+}
+
+func (o *UpdateInfraEnvPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}][%d] updateInfraEnvPreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *UpdateInfraEnvPreconditionFailed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateInfraEnvPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpdateInfraEnvInternalServerError creates a UpdateInfraEnvInternalServerError with default headers values
 func NewUpdateInfraEnvInternalServerError() *UpdateInfraEnvInternalServerError {
 	return &UpdateInfraEnvInternalServerError{}
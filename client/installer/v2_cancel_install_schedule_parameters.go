@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2CancelInstallScheduleParams creates a new V2CancelInstallScheduleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2CancelInstallScheduleParams() *V2CancelInstallScheduleParams {
+	return &V2CancelInstallScheduleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2CancelInstallScheduleParamsWithTimeout creates a new V2CancelInstallScheduleParams object
+// with the ability to set a timeout on a request.
+func NewV2CancelInstallScheduleParamsWithTimeout(timeout time.Duration) *V2CancelInstallScheduleParams {
+	return &V2CancelInstallScheduleParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2CancelInstallScheduleParamsWithContext creates a new V2CancelInstallScheduleParams object
+// with the ability to set a context for a request.
+func NewV2CancelInstallScheduleParamsWithContext(ctx context.Context) *V2CancelInstallScheduleParams {
+	return &V2CancelInstallScheduleParams{
+		Context: ctx,
+	}
+}
+
+// NewV2CancelInstallScheduleParamsWithHTTPClient creates a new V2CancelInstallScheduleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2CancelInstallScheduleParamsWithHTTPClient(client *http.Client) *V2CancelInstallScheduleParams {
+	return &V2CancelInstallScheduleParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2CancelInstallScheduleParams contains all the parameters to send to the API endpoint
+   for the v2 cancel install schedule operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2CancelInstallScheduleParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose scheduled installation is to be canceled.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 cancel install schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CancelInstallScheduleParams) WithDefaults() *V2CancelInstallScheduleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 cancel install schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CancelInstallScheduleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 cancel install schedule params
+func (o *V2CancelInstallScheduleParams) WithTimeout(timeout time.Duration) *V2CancelInstallScheduleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 cancel install schedule params
+func (o *V2CancelInstallScheduleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 cancel install schedule params
+func (o *V2CancelInstallScheduleParams) WithContext(ctx context.Context) *V2CancelInstallScheduleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 cancel install schedule params
+func (o *V2CancelInstallScheduleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 cancel install schedule params
+func (o *V2CancelInstallScheduleParams) WithHTTPClient(client *http.Client) *V2CancelInstallScheduleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 cancel install schedule params
+func (o *V2CancelInstallScheduleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 cancel install schedule params
+func (o *V2CancelInstallScheduleParams) WithClusterID(clusterID strfmt.UUID) *V2CancelInstallScheduleParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 cancel install schedule params
+func (o *V2CancelInstallScheduleParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2CancelInstallScheduleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CancelInstallScheduleReader is a Reader for the V2CancelInstallSchedule structure.
+type V2CancelInstallScheduleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2CancelInstallScheduleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2CancelInstallScheduleAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2CancelInstallScheduleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2CancelInstallScheduleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2CancelInstallScheduleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2CancelInstallScheduleMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2CancelInstallScheduleConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2CancelInstallScheduleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2CancelInstallScheduleAccepted creates a V2CancelInstallScheduleAccepted with default headers values
+func NewV2CancelInstallScheduleAccepted() *V2CancelInstallScheduleAccepted {
+	return &V2CancelInstallScheduleAccepted{}
+}
+
+/* V2CancelInstallScheduleAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2CancelInstallScheduleAccepted struct {
+	Payload *models.Cluster
+}
+
+func (o *V2CancelInstallScheduleAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/cancel-install-schedule][%d] v2CancelInstallScheduleAccepted  %+v", 202, o.Payload)
+}
+func (o *V2CancelInstallScheduleAccepted) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *V2CancelInstallScheduleAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelInstallScheduleUnauthorized creates a V2CancelInstallScheduleUnauthorized with default headers values
+func NewV2CancelInstallScheduleUnauthorized() *V2CancelInstallScheduleUnauthorized {
+	return &V2CancelInstallScheduleUnauthorized{}
+}
+
+/* V2CancelInstallScheduleUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2CancelInstallScheduleUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2CancelInstallScheduleUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/cancel-install-schedule][%d] v2CancelInstallScheduleUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2CancelInstallScheduleUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CancelInstallScheduleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelInstallScheduleForbidden creates a V2CancelInstallScheduleForbidden with default headers values
+func NewV2CancelInstallScheduleForbidden() *V2CancelInstallScheduleForbidden {
+	return &V2CancelInstallScheduleForbidden{}
+}
+
+/* V2CancelInstallScheduleForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2CancelInstallScheduleForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2CancelInstallScheduleForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/cancel-install-schedule][%d] v2CancelInstallScheduleForbidden  %+v", 403, o.Payload)
+}
+func (o *V2CancelInstallScheduleForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CancelInstallScheduleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelInstallScheduleNotFound creates a V2CancelInstallScheduleNotFound with default headers values
+func NewV2CancelInstallScheduleNotFound() *V2CancelInstallScheduleNotFound {
+	return &V2CancelInstallScheduleNotFound{}
+}
+
+/* V2CancelInstallScheduleNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2CancelInstallScheduleNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2CancelInstallScheduleNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/cancel-install-schedule][%d] v2CancelInstallScheduleNotFound  %+v", 404, o.Payload)
+}
+func (o *V2CancelInstallScheduleNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CancelInstallScheduleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelInstallScheduleMethodNotAllowed creates a V2CancelInstallScheduleMethodNotAllowed with default headers values
+func NewV2CancelInstallScheduleMethodNotAllowed() *V2CancelInstallScheduleMethodNotAllowed {
+	return &V2CancelInstallScheduleMethodNotAllowed{}
+}
+
+/* V2CancelInstallScheduleMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2CancelInstallScheduleMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2CancelInstallScheduleMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/cancel-install-schedule][%d] v2CancelInstallScheduleMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2CancelInstallScheduleMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CancelInstallScheduleMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelInstallScheduleConflict creates a V2CancelInstallScheduleConflict with default headers values
+func NewV2CancelInstallScheduleConflict() *V2CancelInstallScheduleConflict {
+	return &V2CancelInstallScheduleConflict{}
+}
+
+/* V2CancelInstallScheduleConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2CancelInstallScheduleConflict struct {
+	Payload *models.Error
+}
+
+func (o *V2CancelInstallScheduleConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/cancel-install-schedule][%d] v2CancelInstallScheduleConflict  %+v", 409, o.Payload)
+}
+func (o *V2CancelInstallScheduleConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CancelInstallScheduleConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelInstallScheduleInternalServerError creates a V2CancelInstallScheduleInternalServerError with default headers values
+func NewV2CancelInstallScheduleInternalServerError() *V2CancelInstallScheduleInternalServerError {
+	return &V2CancelInstallScheduleInternalServerError{}
+}
+
+/* V2CancelInstallScheduleInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2CancelInstallScheduleInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2CancelInstallScheduleInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/cancel-install-schedule][%d] v2CancelInstallScheduleInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2CancelInstallScheduleInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CancelInstallScheduleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
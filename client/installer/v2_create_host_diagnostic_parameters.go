@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2CreateHostDiagnosticParams creates a new V2CreateHostDiagnosticParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2CreateHostDiagnosticParams() *V2CreateHostDiagnosticParams {
+	return &V2CreateHostDiagnosticParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2CreateHostDiagnosticParamsWithTimeout creates a new V2CreateHostDiagnosticParams object
+// with the ability to set a timeout on a request.
+func NewV2CreateHostDiagnosticParamsWithTimeout(timeout time.Duration) *V2CreateHostDiagnosticParams {
+	return &V2CreateHostDiagnosticParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2CreateHostDiagnosticParamsWithContext creates a new V2CreateHostDiagnosticParams object
+// with the ability to set a context for a request.
+func NewV2CreateHostDiagnosticParamsWithContext(ctx context.Context) *V2CreateHostDiagnosticParams {
+	return &V2CreateHostDiagnosticParams{
+		Context: ctx,
+	}
+}
+
+// NewV2CreateHostDiagnosticParamsWithHTTPClient creates a new V2CreateHostDiagnosticParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2CreateHostDiagnosticParamsWithHTTPClient(client *http.Client) *V2CreateHostDiagnosticParams {
+	return &V2CreateHostDiagnosticParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2CreateHostDiagnosticParams contains all the parameters to send to the API endpoint
+   for the v2 create host diagnostic operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2CreateHostDiagnosticParams struct {
+
+	/* HostDiagnosticCreateParams.
+
+	   The diagnostic action to be run.
+	*/
+	HostDiagnosticCreateParams *models.HostDiagnosticCreateParams
+
+	/* HostID.
+
+	   The host that the diagnostic action should run on.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host that the diagnostic action should run on.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 create host diagnostic params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CreateHostDiagnosticParams) WithDefaults() *V2CreateHostDiagnosticParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 create host diagnostic params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CreateHostDiagnosticParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) WithTimeout(timeout time.Duration) *V2CreateHostDiagnosticParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) WithContext(ctx context.Context) *V2CreateHostDiagnosticParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) WithHTTPClient(client *http.Client) *V2CreateHostDiagnosticParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostDiagnosticCreateParams adds the hostDiagnosticCreateParams to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) WithHostDiagnosticCreateParams(hostDiagnosticCreateParams *models.HostDiagnosticCreateParams) *V2CreateHostDiagnosticParams {
+	o.SetHostDiagnosticCreateParams(hostDiagnosticCreateParams)
+	return o
+}
+
+// SetHostDiagnosticCreateParams adds the hostDiagnosticCreateParams to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) SetHostDiagnosticCreateParams(hostDiagnosticCreateParams *models.HostDiagnosticCreateParams) {
+	o.HostDiagnosticCreateParams = hostDiagnosticCreateParams
+}
+
+// WithHostID adds the hostID to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) WithHostID(hostID strfmt.UUID) *V2CreateHostDiagnosticParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2CreateHostDiagnosticParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 create host diagnostic params
+func (o *V2CreateHostDiagnosticParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2CreateHostDiagnosticParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.HostDiagnosticCreateParams != nil {
+		if err := r.SetBodyParam(o.HostDiagnosticCreateParams); err != nil {
+			return err
+		}
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
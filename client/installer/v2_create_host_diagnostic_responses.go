@@ -0,0 +1,295 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CreateHostDiagnosticReader is a Reader for the V2CreateHostDiagnostic structure.
+type V2CreateHostDiagnosticReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2CreateHostDiagnosticReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2CreateHostDiagnosticCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2CreateHostDiagnosticBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2CreateHostDiagnosticUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2CreateHostDiagnosticForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2CreateHostDiagnosticNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2CreateHostDiagnosticConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2CreateHostDiagnosticInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2CreateHostDiagnosticCreated creates a V2CreateHostDiagnosticCreated with default headers values
+func NewV2CreateHostDiagnosticCreated() *V2CreateHostDiagnosticCreated {
+	return &V2CreateHostDiagnosticCreated{}
+}
+
+/* V2CreateHostDiagnosticCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2CreateHostDiagnosticCreated struct {
+	Payload *models.HostDiagnostic
+}
+
+func (o *V2CreateHostDiagnosticCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2CreateHostDiagnosticCreated  %+v", 201, o.Payload)
+}
+func (o *V2CreateHostDiagnosticCreated) GetPayload() *models.HostDiagnostic {
+	return o.Payload
+}
+
+func (o *V2CreateHostDiagnosticCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HostDiagnostic)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateHostDiagnosticBadRequest creates a V2CreateHostDiagnosticBadRequest with default headers values
+func NewV2CreateHostDiagnosticBadRequest() *V2CreateHostDiagnosticBadRequest {
+	return &V2CreateHostDiagnosticBadRequest{}
+}
+
+/* V2CreateHostDiagnosticBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2CreateHostDiagnosticBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2CreateHostDiagnosticBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2CreateHostDiagnosticBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2CreateHostDiagnosticBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateHostDiagnosticBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateHostDiagnosticUnauthorized creates a V2CreateHostDiagnosticUnauthorized with default headers values
+func NewV2CreateHostDiagnosticUnauthorized() *V2CreateHostDiagnosticUnauthorized {
+	return &V2CreateHostDiagnosticUnauthorized{}
+}
+
+/* V2CreateHostDiagnosticUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2CreateHostDiagnosticUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2CreateHostDiagnosticUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2CreateHostDiagnosticUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2CreateHostDiagnosticUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CreateHostDiagnosticUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateHostDiagnosticForbidden creates a V2CreateHostDiagnosticForbidden with default headers values
+func NewV2CreateHostDiagnosticForbidden() *V2CreateHostDiagnosticForbidden {
+	return &V2CreateHostDiagnosticForbidden{}
+}
+
+/* V2CreateHostDiagnosticForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2CreateHostDiagnosticForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2CreateHostDiagnosticForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2CreateHostDiagnosticForbidden  %+v", 403, o.Payload)
+}
+func (o *V2CreateHostDiagnosticForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CreateHostDiagnosticForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateHostDiagnosticNotFound creates a V2CreateHostDiagnosticNotFound with default headers values
+func NewV2CreateHostDiagnosticNotFound() *V2CreateHostDiagnosticNotFound {
+	return &V2CreateHostDiagnosticNotFound{}
+}
+
+/* V2CreateHostDiagnosticNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2CreateHostDiagnosticNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2CreateHostDiagnosticNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2CreateHostDiagnosticNotFound  %+v", 404, o.Payload)
+}
+func (o *V2CreateHostDiagnosticNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateHostDiagnosticNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateHostDiagnosticConflict creates a V2CreateHostDiagnosticConflict with default headers values
+func NewV2CreateHostDiagnosticConflict() *V2CreateHostDiagnosticConflict {
+	return &V2CreateHostDiagnosticConflict{}
+}
+
+/* V2CreateHostDiagnosticConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2CreateHostDiagnosticConflict struct {
+	Payload *models.Error
+}
+
+func (o *V2CreateHostDiagnosticConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2CreateHostDiagnosticConflict  %+v", 409, o.Payload)
+}
+func (o *V2CreateHostDiagnosticConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateHostDiagnosticConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateHostDiagnosticInternalServerError creates a V2CreateHostDiagnosticInternalServerError with default headers values
+func NewV2CreateHostDiagnosticInternalServerError() *V2CreateHostDiagnosticInternalServerError {
+	return &V2CreateHostDiagnosticInternalServerError{}
+}
+
+/* V2CreateHostDiagnosticInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2CreateHostDiagnosticInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2CreateHostDiagnosticInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2CreateHostDiagnosticInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2CreateHostDiagnosticInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateHostDiagnosticInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
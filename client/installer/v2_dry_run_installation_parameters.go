@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DryRunInstallationParams creates a new V2DryRunInstallationParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DryRunInstallationParams() *V2DryRunInstallationParams {
+	return &V2DryRunInstallationParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DryRunInstallationParamsWithTimeout creates a new V2DryRunInstallationParams object
+// with the ability to set a timeout on a request.
+func NewV2DryRunInstallationParamsWithTimeout(timeout time.Duration) *V2DryRunInstallationParams {
+	return &V2DryRunInstallationParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DryRunInstallationParamsWithContext creates a new V2DryRunInstallationParams object
+// with the ability to set a context for a request.
+func NewV2DryRunInstallationParamsWithContext(ctx context.Context) *V2DryRunInstallationParams {
+	return &V2DryRunInstallationParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DryRunInstallationParamsWithHTTPClient creates a new V2DryRunInstallationParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DryRunInstallationParamsWithHTTPClient(client *http.Client) *V2DryRunInstallationParams {
+	return &V2DryRunInstallationParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2DryRunInstallationParams contains all the parameters to send to the API endpoint
+   for the v2 dry run installation operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2DryRunInstallationParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose installation is to be tried.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 dry run installation params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DryRunInstallationParams) WithDefaults() *V2DryRunInstallationParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 dry run installation params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DryRunInstallationParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 dry run installation params
+func (o *V2DryRunInstallationParams) WithTimeout(timeout time.Duration) *V2DryRunInstallationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 dry run installation params
+func (o *V2DryRunInstallationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 dry run installation params
+func (o *V2DryRunInstallationParams) WithContext(ctx context.Context) *V2DryRunInstallationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 dry run installation params
+func (o *V2DryRunInstallationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 dry run installation params
+func (o *V2DryRunInstallationParams) WithHTTPClient(client *http.Client) *V2DryRunInstallationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 dry run installation params
+func (o *V2DryRunInstallationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 dry run installation params
+func (o *V2DryRunInstallationParams) WithClusterID(clusterID strfmt.UUID) *V2DryRunInstallationParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 dry run installation params
+func (o *V2DryRunInstallationParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DryRunInstallationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
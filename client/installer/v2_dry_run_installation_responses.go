@@ -0,0 +1,295 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DryRunInstallationReader is a Reader for the V2DryRunInstallation structure.
+type V2DryRunInstallationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DryRunInstallationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2DryRunInstallationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DryRunInstallationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DryRunInstallationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DryRunInstallationNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2DryRunInstallationMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2DryRunInstallationConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DryRunInstallationInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DryRunInstallationOK creates a V2DryRunInstallationOK with default headers values
+func NewV2DryRunInstallationOK() *V2DryRunInstallationOK {
+	return &V2DryRunInstallationOK{}
+}
+
+/* V2DryRunInstallationOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2DryRunInstallationOK struct {
+	Payload *models.DryRunResult
+}
+
+func (o *V2DryRunInstallationOK) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallationOK  %+v", 200, o.Payload)
+}
+func (o *V2DryRunInstallationOK) GetPayload() *models.DryRunResult {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DryRunResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallationUnauthorized creates a V2DryRunInstallationUnauthorized with default headers values
+func NewV2DryRunInstallationUnauthorized() *V2DryRunInstallationUnauthorized {
+	return &V2DryRunInstallationUnauthorized{}
+}
+
+/* V2DryRunInstallationUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DryRunInstallationUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2DryRunInstallationUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallationUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2DryRunInstallationUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallationForbidden creates a V2DryRunInstallationForbidden with default headers values
+func NewV2DryRunInstallationForbidden() *V2DryRunInstallationForbidden {
+	return &V2DryRunInstallationForbidden{}
+}
+
+/* V2DryRunInstallationForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DryRunInstallationForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2DryRunInstallationForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallationForbidden  %+v", 403, o.Payload)
+}
+func (o *V2DryRunInstallationForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallationNotFound creates a V2DryRunInstallationNotFound with default headers values
+func NewV2DryRunInstallationNotFound() *V2DryRunInstallationNotFound {
+	return &V2DryRunInstallationNotFound{}
+}
+
+/* V2DryRunInstallationNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DryRunInstallationNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2DryRunInstallationNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallationNotFound  %+v", 404, o.Payload)
+}
+func (o *V2DryRunInstallationNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallationNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallationMethodNotAllowed creates a V2DryRunInstallationMethodNotAllowed with default headers values
+func NewV2DryRunInstallationMethodNotAllowed() *V2DryRunInstallationMethodNotAllowed {
+	return &V2DryRunInstallationMethodNotAllowed{}
+}
+
+/* V2DryRunInstallationMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2DryRunInstallationMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2DryRunInstallationMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallationMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2DryRunInstallationMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallationMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallationConflict creates a V2DryRunInstallationConflict with default headers values
+func NewV2DryRunInstallationConflict() *V2DryRunInstallationConflict {
+	return &V2DryRunInstallationConflict{}
+}
+
+/* V2DryRunInstallationConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2DryRunInstallationConflict struct {
+	Payload *models.Error
+}
+
+func (o *V2DryRunInstallationConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallationConflict  %+v", 409, o.Payload)
+}
+func (o *V2DryRunInstallationConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallationConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DryRunInstallationInternalServerError creates a V2DryRunInstallationInternalServerError with default headers values
+func NewV2DryRunInstallationInternalServerError() *V2DryRunInstallationInternalServerError {
+	return &V2DryRunInstallationInternalServerError{}
+}
+
+/* V2DryRunInstallationInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DryRunInstallationInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2DryRunInstallationInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/dry-run][%d] v2DryRunInstallationInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2DryRunInstallationInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DryRunInstallationInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2GetClusterInstallConfigDiffParams creates a new V2GetClusterInstallConfigDiffParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetClusterInstallConfigDiffParams() *V2GetClusterInstallConfigDiffParams {
+	return &V2GetClusterInstallConfigDiffParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetClusterInstallConfigDiffParamsWithTimeout creates a new V2GetClusterInstallConfigDiffParams object
+// with the ability to set a timeout on a request.
+func NewV2GetClusterInstallConfigDiffParamsWithTimeout(timeout time.Duration) *V2GetClusterInstallConfigDiffParams {
+	return &V2GetClusterInstallConfigDiffParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetClusterInstallConfigDiffParamsWithContext creates a new V2GetClusterInstallConfigDiffParams object
+// with the ability to set a context for a request.
+func NewV2GetClusterInstallConfigDiffParamsWithContext(ctx context.Context) *V2GetClusterInstallConfigDiffParams {
+	return &V2GetClusterInstallConfigDiffParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetClusterInstallConfigDiffParamsWithHTTPClient creates a new V2GetClusterInstallConfigDiffParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetClusterInstallConfigDiffParamsWithHTTPClient(client *http.Client) *V2GetClusterInstallConfigDiffParams {
+	return &V2GetClusterInstallConfigDiffParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2GetClusterInstallConfigDiffParams contains all the parameters to send to the API endpoint
+   for the v2 get cluster install config diff operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2GetClusterInstallConfigDiffParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose install config is being compared.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* From.
+
+	   The version of the install config overrides to compare from. Version 0 is the install config without overrides.
+	*/
+	From *int64
+
+	/* To.
+
+	   The version of the install config overrides to compare to. Defaults to the current overrides.
+	*/
+	To *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get cluster install config diff params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterInstallConfigDiffParams) WithDefaults() *V2GetClusterInstallConfigDiffParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get cluster install config diff params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterInstallConfigDiffParams) SetDefaults() {
+	var (
+		fromDefault = int64(0)
+	)
+
+	val := V2GetClusterInstallConfigDiffParams{
+		From: &fromDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) WithTimeout(timeout time.Duration) *V2GetClusterInstallConfigDiffParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) WithContext(ctx context.Context) *V2GetClusterInstallConfigDiffParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) WithHTTPClient(client *http.Client) *V2GetClusterInstallConfigDiffParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) WithClusterID(clusterID strfmt.UUID) *V2GetClusterInstallConfigDiffParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithFrom adds the from to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) WithFrom(from *int64) *V2GetClusterInstallConfigDiffParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) SetFrom(from *int64) {
+	o.From = from
+}
+
+// WithTo adds the to to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) WithTo(to *int64) *V2GetClusterInstallConfigDiffParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the v2 get cluster install config diff params
+func (o *V2GetClusterInstallConfigDiffParams) SetTo(to *int64) {
+	o.To = to
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetClusterInstallConfigDiffParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.From != nil {
+
+		// query param from
+		var qrFrom int64
+
+		if o.From != nil {
+			qrFrom = *o.From
+		}
+		qFrom := swag.FormatInt64(qrFrom)
+		if qFrom != "" {
+
+			if err := r.SetQueryParam("from", qFrom); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.To != nil {
+
+		// query param to
+		var qrTo int64
+
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := swag.FormatInt64(qrTo)
+		if qTo != "" {
+
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterInstallConfigDiffReader is a Reader for the V2GetClusterInstallConfigDiff structure.
+type V2GetClusterInstallConfigDiffReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetClusterInstallConfigDiffReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetClusterInstallConfigDiffOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetClusterInstallConfigDiffUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetClusterInstallConfigDiffForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetClusterInstallConfigDiffNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetClusterInstallConfigDiffMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetClusterInstallConfigDiffInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetClusterInstallConfigDiffOK creates a V2GetClusterInstallConfigDiffOK with default headers values
+func NewV2GetClusterInstallConfigDiffOK() *V2GetClusterInstallConfigDiffOK {
+	return &V2GetClusterInstallConfigDiffOK{}
+}
+
+/* V2GetClusterInstallConfigDiffOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetClusterInstallConfigDiffOK struct {
+	Payload string
+}
+
+func (o *V2GetClusterInstallConfigDiffOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/diff][%d] v2GetClusterInstallConfigDiffOK  %+v", 200, o.Payload)
+}
+func (o *V2GetClusterInstallConfigDiffOK) GetPayload() string {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallConfigDiffOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallConfigDiffUnauthorized creates a V2GetClusterInstallConfigDiffUnauthorized with default headers values
+func NewV2GetClusterInstallConfigDiffUnauthorized() *V2GetClusterInstallConfigDiffUnauthorized {
+	return &V2GetClusterInstallConfigDiffUnauthorized{}
+}
+
+/* V2GetClusterInstallConfigDiffUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetClusterInstallConfigDiffUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2GetClusterInstallConfigDiffUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/diff][%d] v2GetClusterInstallConfigDiffUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2GetClusterInstallConfigDiffUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallConfigDiffUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallConfigDiffForbidden creates a V2GetClusterInstallConfigDiffForbidden with default headers values
+func NewV2GetClusterInstallConfigDiffForbidden() *V2GetClusterInstallConfigDiffForbidden {
+	return &V2GetClusterInstallConfigDiffForbidden{}
+}
+
+/* V2GetClusterInstallConfigDiffForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetClusterInstallConfigDiffForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2GetClusterInstallConfigDiffForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/diff][%d] v2GetClusterInstallConfigDiffForbidden  %+v", 403, o.Payload)
+}
+func (o *V2GetClusterInstallConfigDiffForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallConfigDiffForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallConfigDiffNotFound creates a V2GetClusterInstallConfigDiffNotFound with default headers values
+func NewV2GetClusterInstallConfigDiffNotFound() *V2GetClusterInstallConfigDiffNotFound {
+	return &V2GetClusterInstallConfigDiffNotFound{}
+}
+
+/* V2GetClusterInstallConfigDiffNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetClusterInstallConfigDiffNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2GetClusterInstallConfigDiffNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/diff][%d] v2GetClusterInstallConfigDiffNotFound  %+v", 404, o.Payload)
+}
+func (o *V2GetClusterInstallConfigDiffNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallConfigDiffNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallConfigDiffMethodNotAllowed creates a V2GetClusterInstallConfigDiffMethodNotAllowed with default headers values
+func NewV2GetClusterInstallConfigDiffMethodNotAllowed() *V2GetClusterInstallConfigDiffMethodNotAllowed {
+	return &V2GetClusterInstallConfigDiffMethodNotAllowed{}
+}
+
+/* V2GetClusterInstallConfigDiffMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetClusterInstallConfigDiffMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2GetClusterInstallConfigDiffMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/diff][%d] v2GetClusterInstallConfigDiffMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2GetClusterInstallConfigDiffMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallConfigDiffMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallConfigDiffInternalServerError creates a V2GetClusterInstallConfigDiffInternalServerError with default headers values
+func NewV2GetClusterInstallConfigDiffInternalServerError() *V2GetClusterInstallConfigDiffInternalServerError {
+	return &V2GetClusterInstallConfigDiffInternalServerError{}
+}
+
+/* V2GetClusterInstallConfigDiffInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetClusterInstallConfigDiffInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2GetClusterInstallConfigDiffInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/diff][%d] v2GetClusterInstallConfigDiffInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2GetClusterInstallConfigDiffInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallConfigDiffInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
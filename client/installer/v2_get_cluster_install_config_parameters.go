@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewV2GetClusterInstallConfigParams creates a new V2GetClusterInstallConfigParams object,
@@ -67,6 +68,14 @@ type V2GetClusterInstallConfigParams struct {
 	*/
 	ClusterID strfmt.UUID
 
+	/* Version.
+
+	     Render the install config with the overrides of this version of the install config overrides history
+	instead of the current ones. Version 0 renders the install config without overrides.
+
+	*/
+	Version *int64
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -131,6 +140,17 @@ func (o *V2GetClusterInstallConfigParams) SetClusterID(clusterID strfmt.UUID) {
 	o.ClusterID = clusterID
 }
 
+// WithVersion adds the version to the v2 get cluster install config params
+func (o *V2GetClusterInstallConfigParams) WithVersion(version *int64) *V2GetClusterInstallConfigParams {
+	o.SetVersion(version)
+	return o
+}
+
+// SetVersion adds the version to the v2 get cluster install config params
+func (o *V2GetClusterInstallConfigParams) SetVersion(version *int64) {
+	o.Version = version
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *V2GetClusterInstallConfigParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -144,6 +164,23 @@ func (o *V2GetClusterInstallConfigParams) WriteToRequest(r runtime.ClientRequest
 		return err
 	}
 
+	if o.Version != nil {
+
+		// query param version
+		var qrVersion int64
+
+		if o.Version != nil {
+			qrVersion = *o.Version
+		}
+		qVersion := swag.FormatInt64(qrVersion)
+		if qVersion != "" {
+
+			if err := r.SetQueryParam("version", qVersion); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetClusterInstallationTimelineParams creates a new V2GetClusterInstallationTimelineParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetClusterInstallationTimelineParams() *V2GetClusterInstallationTimelineParams {
+	return &V2GetClusterInstallationTimelineParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetClusterInstallationTimelineParamsWithTimeout creates a new V2GetClusterInstallationTimelineParams object
+// with the ability to set a timeout on a request.
+func NewV2GetClusterInstallationTimelineParamsWithTimeout(timeout time.Duration) *V2GetClusterInstallationTimelineParams {
+	return &V2GetClusterInstallationTimelineParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetClusterInstallationTimelineParamsWithContext creates a new V2GetClusterInstallationTimelineParams object
+// with the ability to set a context for a request.
+func NewV2GetClusterInstallationTimelineParamsWithContext(ctx context.Context) *V2GetClusterInstallationTimelineParams {
+	return &V2GetClusterInstallationTimelineParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetClusterInstallationTimelineParamsWithHTTPClient creates a new V2GetClusterInstallationTimelineParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetClusterInstallationTimelineParamsWithHTTPClient(client *http.Client) *V2GetClusterInstallationTimelineParams {
+	return &V2GetClusterInstallationTimelineParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2GetClusterInstallationTimelineParams contains all the parameters to send to the API endpoint
+   for the v2 get cluster installation timeline operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2GetClusterInstallationTimelineParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose installation timeline is being retrieved.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get cluster installation timeline params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterInstallationTimelineParams) WithDefaults() *V2GetClusterInstallationTimelineParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get cluster installation timeline params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterInstallationTimelineParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get cluster installation timeline params
+func (o *V2GetClusterInstallationTimelineParams) WithTimeout(timeout time.Duration) *V2GetClusterInstallationTimelineParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get cluster installation timeline params
+func (o *V2GetClusterInstallationTimelineParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get cluster installation timeline params
+func (o *V2GetClusterInstallationTimelineParams) WithContext(ctx context.Context) *V2GetClusterInstallationTimelineParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get cluster installation timeline params
+func (o *V2GetClusterInstallationTimelineParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get cluster installation timeline params
+func (o *V2GetClusterInstallationTimelineParams) WithHTTPClient(client *http.Client) *V2GetClusterInstallationTimelineParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get cluster installation timeline params
+func (o *V2GetClusterInstallationTimelineParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 get cluster installation timeline params
+func (o *V2GetClusterInstallationTimelineParams) WithClusterID(clusterID strfmt.UUID) *V2GetClusterInstallationTimelineParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get cluster installation timeline params
+func (o *V2GetClusterInstallationTimelineParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetClusterInstallationTimelineParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterInstallationTimelineReader is a Reader for the V2GetClusterInstallationTimeline structure.
+type V2GetClusterInstallationTimelineReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetClusterInstallationTimelineReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetClusterInstallationTimelineOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetClusterInstallationTimelineUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetClusterInstallationTimelineForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetClusterInstallationTimelineNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetClusterInstallationTimelineMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetClusterInstallationTimelineInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetClusterInstallationTimelineOK creates a V2GetClusterInstallationTimelineOK with default headers values
+func NewV2GetClusterInstallationTimelineOK() *V2GetClusterInstallationTimelineOK {
+	return &V2GetClusterInstallationTimelineOK{}
+}
+
+/* V2GetClusterInstallationTimelineOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetClusterInstallationTimelineOK struct {
+	Payload *models.InstallationTimeline
+}
+
+func (o *V2GetClusterInstallationTimelineOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetClusterInstallationTimelineOK  %+v", 200, o.Payload)
+}
+func (o *V2GetClusterInstallationTimelineOK) GetPayload() *models.InstallationTimeline {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallationTimelineOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InstallationTimeline)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallationTimelineUnauthorized creates a V2GetClusterInstallationTimelineUnauthorized with default headers values
+func NewV2GetClusterInstallationTimelineUnauthorized() *V2GetClusterInstallationTimelineUnauthorized {
+	return &V2GetClusterInstallationTimelineUnauthorized{}
+}
+
+/* V2GetClusterInstallationTimelineUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetClusterInstallationTimelineUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2GetClusterInstallationTimelineUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetClusterInstallationTimelineUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2GetClusterInstallationTimelineUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallationTimelineUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallationTimelineForbidden creates a V2GetClusterInstallationTimelineForbidden with default headers values
+func NewV2GetClusterInstallationTimelineForbidden() *V2GetClusterInstallationTimelineForbidden {
+	return &V2GetClusterInstallationTimelineForbidden{}
+}
+
+/* V2GetClusterInstallationTimelineForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetClusterInstallationTimelineForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2GetClusterInstallationTimelineForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetClusterInstallationTimelineForbidden  %+v", 403, o.Payload)
+}
+func (o *V2GetClusterInstallationTimelineForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallationTimelineForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallationTimelineNotFound creates a V2GetClusterInstallationTimelineNotFound with default headers values
+func NewV2GetClusterInstallationTimelineNotFound() *V2GetClusterInstallationTimelineNotFound {
+	return &V2GetClusterInstallationTimelineNotFound{}
+}
+
+/* V2GetClusterInstallationTimelineNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetClusterInstallationTimelineNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2GetClusterInstallationTimelineNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetClusterInstallationTimelineNotFound  %+v", 404, o.Payload)
+}
+func (o *V2GetClusterInstallationTimelineNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallationTimelineNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallationTimelineMethodNotAllowed creates a V2GetClusterInstallationTimelineMethodNotAllowed with default headers values
+func NewV2GetClusterInstallationTimelineMethodNotAllowed() *V2GetClusterInstallationTimelineMethodNotAllowed {
+	return &V2GetClusterInstallationTimelineMethodNotAllowed{}
+}
+
+/* V2GetClusterInstallationTimelineMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetClusterInstallationTimelineMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2GetClusterInstallationTimelineMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetClusterInstallationTimelineMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2GetClusterInstallationTimelineMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallationTimelineMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterInstallationTimelineInternalServerError creates a V2GetClusterInstallationTimelineInternalServerError with default headers values
+func NewV2GetClusterInstallationTimelineInternalServerError() *V2GetClusterInstallationTimelineInternalServerError {
+	return &V2GetClusterInstallationTimelineInternalServerError{}
+}
+
+/* V2GetClusterInstallationTimelineInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetClusterInstallationTimelineInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2GetClusterInstallationTimelineInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/installation-timeline][%d] v2GetClusterInstallationTimelineInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2GetClusterInstallationTimelineInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterInstallationTimelineInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
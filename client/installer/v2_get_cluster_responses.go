@@ -80,6 +80,11 @@ func NewV2GetClusterOK() *V2GetClusterOK {
 Success.
 */
 type V2GetClusterOK struct {
+
+	/* The resource version of the cluster, to be sent in the If-Match header of its updates.
+	 */
+	ETag string
+
 	Payload *models.Cluster
 }
 
@@ -92,6 +97,13 @@ func (o *V2GetClusterOK) GetPayload() *models.Cluster {
 
 func (o *V2GetClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Cluster)
 
 	// response payload
@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetHostDiagnosticParams creates a new V2GetHostDiagnosticParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetHostDiagnosticParams() *V2GetHostDiagnosticParams {
+	return &V2GetHostDiagnosticParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetHostDiagnosticParamsWithTimeout creates a new V2GetHostDiagnosticParams object
+// with the ability to set a timeout on a request.
+func NewV2GetHostDiagnosticParamsWithTimeout(timeout time.Duration) *V2GetHostDiagnosticParams {
+	return &V2GetHostDiagnosticParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetHostDiagnosticParamsWithContext creates a new V2GetHostDiagnosticParams object
+// with the ability to set a context for a request.
+func NewV2GetHostDiagnosticParamsWithContext(ctx context.Context) *V2GetHostDiagnosticParams {
+	return &V2GetHostDiagnosticParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetHostDiagnosticParamsWithHTTPClient creates a new V2GetHostDiagnosticParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetHostDiagnosticParamsWithHTTPClient(client *http.Client) *V2GetHostDiagnosticParams {
+	return &V2GetHostDiagnosticParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2GetHostDiagnosticParams contains all the parameters to send to the API endpoint
+   for the v2 get host diagnostic operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2GetHostDiagnosticParams struct {
+
+	/* DiagnosticID.
+
+	   The diagnostic that should be retrieved.
+
+	   Format: uuid
+	*/
+	DiagnosticID strfmt.UUID
+
+	/* HostID.
+
+	   The host whose diagnostic should be retrieved.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host whose diagnostic should be retrieved.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get host diagnostic params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetHostDiagnosticParams) WithDefaults() *V2GetHostDiagnosticParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get host diagnostic params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetHostDiagnosticParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) WithTimeout(timeout time.Duration) *V2GetHostDiagnosticParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) WithContext(ctx context.Context) *V2GetHostDiagnosticParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) WithHTTPClient(client *http.Client) *V2GetHostDiagnosticParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDiagnosticID adds the diagnosticID to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) WithDiagnosticID(diagnosticID strfmt.UUID) *V2GetHostDiagnosticParams {
+	o.SetDiagnosticID(diagnosticID)
+	return o
+}
+
+// SetDiagnosticID adds the diagnosticId to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) SetDiagnosticID(diagnosticID strfmt.UUID) {
+	o.DiagnosticID = diagnosticID
+}
+
+// WithHostID adds the hostID to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) WithHostID(hostID strfmt.UUID) *V2GetHostDiagnosticParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2GetHostDiagnosticParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 get host diagnostic params
+func (o *V2GetHostDiagnosticParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetHostDiagnosticParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param diagnostic_id
+	if err := r.SetPathParam("diagnostic_id", o.DiagnosticID.String()); err != nil {
+		return err
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
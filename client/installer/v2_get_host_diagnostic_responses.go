@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetHostDiagnosticReader is a Reader for the V2GetHostDiagnostic structure.
+type V2GetHostDiagnosticReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetHostDiagnosticReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetHostDiagnosticOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetHostDiagnosticUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetHostDiagnosticForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetHostDiagnosticNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetHostDiagnosticInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetHostDiagnosticOK creates a V2GetHostDiagnosticOK with default headers values
+func NewV2GetHostDiagnosticOK() *V2GetHostDiagnosticOK {
+	return &V2GetHostDiagnosticOK{}
+}
+
+/* V2GetHostDiagnosticOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetHostDiagnosticOK struct {
+	Payload *models.HostDiagnostic
+}
+
+func (o *V2GetHostDiagnosticOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}][%d] v2GetHostDiagnosticOK  %+v", 200, o.Payload)
+}
+func (o *V2GetHostDiagnosticOK) GetPayload() *models.HostDiagnostic {
+	return o.Payload
+}
+
+func (o *V2GetHostDiagnosticOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HostDiagnostic)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostDiagnosticUnauthorized creates a V2GetHostDiagnosticUnauthorized with default headers values
+func NewV2GetHostDiagnosticUnauthorized() *V2GetHostDiagnosticUnauthorized {
+	return &V2GetHostDiagnosticUnauthorized{}
+}
+
+/* V2GetHostDiagnosticUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetHostDiagnosticUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2GetHostDiagnosticUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}][%d] v2GetHostDiagnosticUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2GetHostDiagnosticUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetHostDiagnosticUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostDiagnosticForbidden creates a V2GetHostDiagnosticForbidden with default headers values
+func NewV2GetHostDiagnosticForbidden() *V2GetHostDiagnosticForbidden {
+	return &V2GetHostDiagnosticForbidden{}
+}
+
+/* V2GetHostDiagnosticForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetHostDiagnosticForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2GetHostDiagnosticForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}][%d] v2GetHostDiagnosticForbidden  %+v", 403, o.Payload)
+}
+func (o *V2GetHostDiagnosticForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetHostDiagnosticForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostDiagnosticNotFound creates a V2GetHostDiagnosticNotFound with default headers values
+func NewV2GetHostDiagnosticNotFound() *V2GetHostDiagnosticNotFound {
+	return &V2GetHostDiagnosticNotFound{}
+}
+
+/* V2GetHostDiagnosticNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetHostDiagnosticNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2GetHostDiagnosticNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}][%d] v2GetHostDiagnosticNotFound  %+v", 404, o.Payload)
+}
+func (o *V2GetHostDiagnosticNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetHostDiagnosticNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetHostDiagnosticInternalServerError creates a V2GetHostDiagnosticInternalServerError with default headers values
+func NewV2GetHostDiagnosticInternalServerError() *V2GetHostDiagnosticInternalServerError {
+	return &V2GetHostDiagnosticInternalServerError{}
+}
+
+/* V2GetHostDiagnosticInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetHostDiagnosticInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2GetHostDiagnosticInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}][%d] v2GetHostDiagnosticInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2GetHostDiagnosticInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetHostDiagnosticInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
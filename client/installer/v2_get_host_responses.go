@@ -80,6 +80,11 @@ func NewV2GetHostOK() *V2GetHostOK {
 Success.
 */
 type V2GetHostOK struct {
+
+	/* The resource version of the host, to be sent in the If-Match header of its updates.
+	 */
+	ETag string
+
 	Payload *models.Host
 }
 
@@ -92,6 +97,13 @@ func (o *V2GetHostOK) GetPayload() *models.Host {
 
 func (o *V2GetHostOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Host)
 
 	// response payload
@@ -88,6 +88,17 @@ type V2GetNextStepsParams struct {
 	*/
 	Timestamp *int64
 
+	/* WaitTimeout.
+
+	     Long-poll mode. The number of seconds the service may hold the request open, waiting for the state
+	of the host to change or for a new command to be queued for it, before it returns the next operations.
+	When omitted or 0 the next operations are returned immediately. The request is not held
+	when the host changed since it last checked in, nor when the service is unable to
+	watch the host for changes.
+
+	*/
+	WaitTimeout *int64
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -185,6 +196,17 @@ func (o *V2GetNextStepsParams) SetTimestamp(timestamp *int64) {
 	o.Timestamp = timestamp
 }
 
+// WithWaitTimeout adds the waitTimeout to the v2 get next steps params
+func (o *V2GetNextStepsParams) WithWaitTimeout(waitTimeout *int64) *V2GetNextStepsParams {
+	o.SetWaitTimeout(waitTimeout)
+	return o
+}
+
+// SetWaitTimeout adds the waitTimeout to the v2 get next steps params
+func (o *V2GetNextStepsParams) SetWaitTimeout(waitTimeout *int64) {
+	o.WaitTimeout = waitTimeout
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *V2GetNextStepsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -228,6 +250,23 @@ func (o *V2GetNextStepsParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.WaitTimeout != nil {
+
+		// query param wait_timeout
+		var qrWaitTimeout int64
+
+		if o.WaitTimeout != nil {
+			qrWaitTimeout = *o.WaitTimeout
+		}
+		qWaitTimeout := swag.FormatInt64(qrWaitTimeout)
+		if qWaitTimeout != "" {
+
+			if err := r.SetQueryParam("wait_timeout", qWaitTimeout); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
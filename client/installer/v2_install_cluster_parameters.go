@@ -14,6 +14,8 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
 )
 
 // NewV2InstallClusterParams creates a new V2InstallClusterParams object,
@@ -67,6 +69,12 @@ type V2InstallClusterParams struct {
 	*/
 	ClusterID strfmt.UUID
 
+	/* InstallSchedule.
+
+	   The window in which the installation is allowed to start.
+	*/
+	InstallSchedule *models.InstallSchedule
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -131,6 +139,17 @@ func (o *V2InstallClusterParams) SetClusterID(clusterID strfmt.UUID) {
 	o.ClusterID = clusterID
 }
 
+// WithInstallSchedule adds the installSchedule to the v2 install cluster params
+func (o *V2InstallClusterParams) WithInstallSchedule(installSchedule *models.InstallSchedule) *V2InstallClusterParams {
+	o.SetInstallSchedule(installSchedule)
+	return o
+}
+
+// SetInstallSchedule adds the installSchedule to the v2 install cluster params
+func (o *V2InstallClusterParams) SetInstallSchedule(installSchedule *models.InstallSchedule) {
+	o.InstallSchedule = installSchedule
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *V2InstallClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -143,6 +162,11 @@ func (o *V2InstallClusterParams) WriteToRequest(r runtime.ClientRequest, reg str
 	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
 		return err
 	}
+	if o.InstallSchedule != nil {
+		if err := r.SetBodyParam(o.InstallSchedule); err != nil {
+			return err
+		}
+	}
 
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListClusterInstallConfigHistoryParams creates a new V2ListClusterInstallConfigHistoryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListClusterInstallConfigHistoryParams() *V2ListClusterInstallConfigHistoryParams {
+	return &V2ListClusterInstallConfigHistoryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListClusterInstallConfigHistoryParamsWithTimeout creates a new V2ListClusterInstallConfigHistoryParams object
+// with the ability to set a timeout on a request.
+func NewV2ListClusterInstallConfigHistoryParamsWithTimeout(timeout time.Duration) *V2ListClusterInstallConfigHistoryParams {
+	return &V2ListClusterInstallConfigHistoryParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListClusterInstallConfigHistoryParamsWithContext creates a new V2ListClusterInstallConfigHistoryParams object
+// with the ability to set a context for a request.
+func NewV2ListClusterInstallConfigHistoryParamsWithContext(ctx context.Context) *V2ListClusterInstallConfigHistoryParams {
+	return &V2ListClusterInstallConfigHistoryParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListClusterInstallConfigHistoryParamsWithHTTPClient creates a new V2ListClusterInstallConfigHistoryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListClusterInstallConfigHistoryParamsWithHTTPClient(client *http.Client) *V2ListClusterInstallConfigHistoryParams {
+	return &V2ListClusterInstallConfigHistoryParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ListClusterInstallConfigHistoryParams contains all the parameters to send to the API endpoint
+   for the v2 list cluster install config history operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ListClusterInstallConfigHistoryParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose install config overrides history is being retrieved.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list cluster install config history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterInstallConfigHistoryParams) WithDefaults() *V2ListClusterInstallConfigHistoryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list cluster install config history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterInstallConfigHistoryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list cluster install config history params
+func (o *V2ListClusterInstallConfigHistoryParams) WithTimeout(timeout time.Duration) *V2ListClusterInstallConfigHistoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list cluster install config history params
+func (o *V2ListClusterInstallConfigHistoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list cluster install config history params
+func (o *V2ListClusterInstallConfigHistoryParams) WithContext(ctx context.Context) *V2ListClusterInstallConfigHistoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list cluster install config history params
+func (o *V2ListClusterInstallConfigHistoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list cluster install config history params
+func (o *V2ListClusterInstallConfigHistoryParams) WithHTTPClient(client *http.Client) *V2ListClusterInstallConfigHistoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list cluster install config history params
+func (o *V2ListClusterInstallConfigHistoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 list cluster install config history params
+func (o *V2ListClusterInstallConfigHistoryParams) WithClusterID(clusterID strfmt.UUID) *V2ListClusterInstallConfigHistoryParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 list cluster install config history params
+func (o *V2ListClusterInstallConfigHistoryParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListClusterInstallConfigHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterInstallConfigHistoryReader is a Reader for the V2ListClusterInstallConfigHistory structure.
+type V2ListClusterInstallConfigHistoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListClusterInstallConfigHistoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListClusterInstallConfigHistoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListClusterInstallConfigHistoryUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListClusterInstallConfigHistoryForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListClusterInstallConfigHistoryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ListClusterInstallConfigHistoryMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListClusterInstallConfigHistoryInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListClusterInstallConfigHistoryOK creates a V2ListClusterInstallConfigHistoryOK with default headers values
+func NewV2ListClusterInstallConfigHistoryOK() *V2ListClusterInstallConfigHistoryOK {
+	return &V2ListClusterInstallConfigHistoryOK{}
+}
+
+/* V2ListClusterInstallConfigHistoryOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListClusterInstallConfigHistoryOK struct {
+	Payload models.InstallConfigOverrideVersionList
+}
+
+func (o *V2ListClusterInstallConfigHistoryOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/history][%d] v2ListClusterInstallConfigHistoryOK  %+v", 200, o.Payload)
+}
+func (o *V2ListClusterInstallConfigHistoryOK) GetPayload() models.InstallConfigOverrideVersionList {
+	return o.Payload
+}
+
+func (o *V2ListClusterInstallConfigHistoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterInstallConfigHistoryUnauthorized creates a V2ListClusterInstallConfigHistoryUnauthorized with default headers values
+func NewV2ListClusterInstallConfigHistoryUnauthorized() *V2ListClusterInstallConfigHistoryUnauthorized {
+	return &V2ListClusterInstallConfigHistoryUnauthorized{}
+}
+
+/* V2ListClusterInstallConfigHistoryUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListClusterInstallConfigHistoryUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListClusterInstallConfigHistoryUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/history][%d] v2ListClusterInstallConfigHistoryUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListClusterInstallConfigHistoryUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterInstallConfigHistoryUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterInstallConfigHistoryForbidden creates a V2ListClusterInstallConfigHistoryForbidden with default headers values
+func NewV2ListClusterInstallConfigHistoryForbidden() *V2ListClusterInstallConfigHistoryForbidden {
+	return &V2ListClusterInstallConfigHistoryForbidden{}
+}
+
+/* V2ListClusterInstallConfigHistoryForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListClusterInstallConfigHistoryForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListClusterInstallConfigHistoryForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/history][%d] v2ListClusterInstallConfigHistoryForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListClusterInstallConfigHistoryForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterInstallConfigHistoryForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterInstallConfigHistoryNotFound creates a V2ListClusterInstallConfigHistoryNotFound with default headers values
+func NewV2ListClusterInstallConfigHistoryNotFound() *V2ListClusterInstallConfigHistoryNotFound {
+	return &V2ListClusterInstallConfigHistoryNotFound{}
+}
+
+/* V2ListClusterInstallConfigHistoryNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListClusterInstallConfigHistoryNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2ListClusterInstallConfigHistoryNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/history][%d] v2ListClusterInstallConfigHistoryNotFound  %+v", 404, o.Payload)
+}
+func (o *V2ListClusterInstallConfigHistoryNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterInstallConfigHistoryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterInstallConfigHistoryMethodNotAllowed creates a V2ListClusterInstallConfigHistoryMethodNotAllowed with default headers values
+func NewV2ListClusterInstallConfigHistoryMethodNotAllowed() *V2ListClusterInstallConfigHistoryMethodNotAllowed {
+	return &V2ListClusterInstallConfigHistoryMethodNotAllowed{}
+}
+
+/* V2ListClusterInstallConfigHistoryMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ListClusterInstallConfigHistoryMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2ListClusterInstallConfigHistoryMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/history][%d] v2ListClusterInstallConfigHistoryMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2ListClusterInstallConfigHistoryMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterInstallConfigHistoryMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterInstallConfigHistoryInternalServerError creates a V2ListClusterInstallConfigHistoryInternalServerError with default headers values
+func NewV2ListClusterInstallConfigHistoryInternalServerError() *V2ListClusterInstallConfigHistoryInternalServerError {
+	return &V2ListClusterInstallConfigHistoryInternalServerError{}
+}
+
+/* V2ListClusterInstallConfigHistoryInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListClusterInstallConfigHistoryInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListClusterInstallConfigHistoryInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/install-config/history][%d] v2ListClusterInstallConfigHistoryInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListClusterInstallConfigHistoryInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterInstallConfigHistoryInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListClusterStateTransitionsParams creates a new V2ListClusterStateTransitionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListClusterStateTransitionsParams() *V2ListClusterStateTransitionsParams {
+	return &V2ListClusterStateTransitionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListClusterStateTransitionsParamsWithTimeout creates a new V2ListClusterStateTransitionsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListClusterStateTransitionsParamsWithTimeout(timeout time.Duration) *V2ListClusterStateTransitionsParams {
+	return &V2ListClusterStateTransitionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListClusterStateTransitionsParamsWithContext creates a new V2ListClusterStateTransitionsParams object
+// with the ability to set a context for a request.
+func NewV2ListClusterStateTransitionsParamsWithContext(ctx context.Context) *V2ListClusterStateTransitionsParams {
+	return &V2ListClusterStateTransitionsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListClusterStateTransitionsParamsWithHTTPClient creates a new V2ListClusterStateTransitionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListClusterStateTransitionsParamsWithHTTPClient(client *http.Client) *V2ListClusterStateTransitionsParams {
+	return &V2ListClusterStateTransitionsParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ListClusterStateTransitionsParams contains all the parameters to send to the API endpoint
+   for the v2 list cluster state transitions operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ListClusterStateTransitionsParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose status transitions should be listed.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list cluster state transitions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterStateTransitionsParams) WithDefaults() *V2ListClusterStateTransitionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list cluster state transitions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterStateTransitionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list cluster state transitions params
+func (o *V2ListClusterStateTransitionsParams) WithTimeout(timeout time.Duration) *V2ListClusterStateTransitionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list cluster state transitions params
+func (o *V2ListClusterStateTransitionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list cluster state transitions params
+func (o *V2ListClusterStateTransitionsParams) WithContext(ctx context.Context) *V2ListClusterStateTransitionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list cluster state transitions params
+func (o *V2ListClusterStateTransitionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list cluster state transitions params
+func (o *V2ListClusterStateTransitionsParams) WithHTTPClient(client *http.Client) *V2ListClusterStateTransitionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list cluster state transitions params
+func (o *V2ListClusterStateTransitionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 list cluster state transitions params
+func (o *V2ListClusterStateTransitionsParams) WithClusterID(clusterID strfmt.UUID) *V2ListClusterStateTransitionsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 list cluster state transitions params
+func (o *V2ListClusterStateTransitionsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListClusterStateTransitionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterStateTransitionsReader is a Reader for the V2ListClusterStateTransitions structure.
+type V2ListClusterStateTransitionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListClusterStateTransitionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListClusterStateTransitionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListClusterStateTransitionsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListClusterStateTransitionsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListClusterStateTransitionsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ListClusterStateTransitionsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListClusterStateTransitionsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListClusterStateTransitionsOK creates a V2ListClusterStateTransitionsOK with default headers values
+func NewV2ListClusterStateTransitionsOK() *V2ListClusterStateTransitionsOK {
+	return &V2ListClusterStateTransitionsOK{}
+}
+
+/* V2ListClusterStateTransitionsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListClusterStateTransitionsOK struct {
+	Payload models.StateTransitionList
+}
+
+func (o *V2ListClusterStateTransitionsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/state-transitions][%d] v2ListClusterStateTransitionsOK  %+v", 200, o.Payload)
+}
+func (o *V2ListClusterStateTransitionsOK) GetPayload() models.StateTransitionList {
+	return o.Payload
+}
+
+func (o *V2ListClusterStateTransitionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterStateTransitionsUnauthorized creates a V2ListClusterStateTransitionsUnauthorized with default headers values
+func NewV2ListClusterStateTransitionsUnauthorized() *V2ListClusterStateTransitionsUnauthorized {
+	return &V2ListClusterStateTransitionsUnauthorized{}
+}
+
+/* V2ListClusterStateTransitionsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListClusterStateTransitionsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListClusterStateTransitionsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/state-transitions][%d] v2ListClusterStateTransitionsUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListClusterStateTransitionsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterStateTransitionsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterStateTransitionsForbidden creates a V2ListClusterStateTransitionsForbidden with default headers values
+func NewV2ListClusterStateTransitionsForbidden() *V2ListClusterStateTransitionsForbidden {
+	return &V2ListClusterStateTransitionsForbidden{}
+}
+
+/* V2ListClusterStateTransitionsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListClusterStateTransitionsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListClusterStateTransitionsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/state-transitions][%d] v2ListClusterStateTransitionsForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListClusterStateTransitionsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterStateTransitionsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterStateTransitionsNotFound creates a V2ListClusterStateTransitionsNotFound with default headers values
+func NewV2ListClusterStateTransitionsNotFound() *V2ListClusterStateTransitionsNotFound {
+	return &V2ListClusterStateTransitionsNotFound{}
+}
+
+/* V2ListClusterStateTransitionsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListClusterStateTransitionsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2ListClusterStateTransitionsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/state-transitions][%d] v2ListClusterStateTransitionsNotFound  %+v", 404, o.Payload)
+}
+func (o *V2ListClusterStateTransitionsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterStateTransitionsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterStateTransitionsMethodNotAllowed creates a V2ListClusterStateTransitionsMethodNotAllowed with default headers values
+func NewV2ListClusterStateTransitionsMethodNotAllowed() *V2ListClusterStateTransitionsMethodNotAllowed {
+	return &V2ListClusterStateTransitionsMethodNotAllowed{}
+}
+
+/* V2ListClusterStateTransitionsMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ListClusterStateTransitionsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2ListClusterStateTransitionsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/state-transitions][%d] v2ListClusterStateTransitionsMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2ListClusterStateTransitionsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterStateTransitionsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterStateTransitionsInternalServerError creates a V2ListClusterStateTransitionsInternalServerError with default headers values
+func NewV2ListClusterStateTransitionsInternalServerError() *V2ListClusterStateTransitionsInternalServerError {
+	return &V2ListClusterStateTransitionsInternalServerError{}
+}
+
+/* V2ListClusterStateTransitionsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListClusterStateTransitionsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListClusterStateTransitionsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/state-transitions][%d] v2ListClusterStateTransitionsInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListClusterStateTransitionsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterStateTransitionsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
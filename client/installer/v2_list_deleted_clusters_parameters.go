@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListDeletedClustersParams creates a new V2ListDeletedClustersParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListDeletedClustersParams() *V2ListDeletedClustersParams {
+	return &V2ListDeletedClustersParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListDeletedClustersParamsWithTimeout creates a new V2ListDeletedClustersParams object
+// with the ability to set a timeout on a request.
+func NewV2ListDeletedClustersParamsWithTimeout(timeout time.Duration) *V2ListDeletedClustersParams {
+	return &V2ListDeletedClustersParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListDeletedClustersParamsWithContext creates a new V2ListDeletedClustersParams object
+// with the ability to set a context for a request.
+func NewV2ListDeletedClustersParamsWithContext(ctx context.Context) *V2ListDeletedClustersParams {
+	return &V2ListDeletedClustersParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListDeletedClustersParamsWithHTTPClient creates a new V2ListDeletedClustersParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListDeletedClustersParamsWithHTTPClient(client *http.Client) *V2ListDeletedClustersParams {
+	return &V2ListDeletedClustersParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ListDeletedClustersParams contains all the parameters to send to the API endpoint
+   for the v2 list deleted clusters operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ListDeletedClustersParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list deleted clusters params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListDeletedClustersParams) WithDefaults() *V2ListDeletedClustersParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list deleted clusters params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListDeletedClustersParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list deleted clusters params
+func (o *V2ListDeletedClustersParams) WithTimeout(timeout time.Duration) *V2ListDeletedClustersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list deleted clusters params
+func (o *V2ListDeletedClustersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list deleted clusters params
+func (o *V2ListDeletedClustersParams) WithContext(ctx context.Context) *V2ListDeletedClustersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list deleted clusters params
+func (o *V2ListDeletedClustersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list deleted clusters params
+func (o *V2ListDeletedClustersParams) WithHTTPClient(client *http.Client) *V2ListDeletedClustersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list deleted clusters params
+func (o *V2ListDeletedClustersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListDeletedClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
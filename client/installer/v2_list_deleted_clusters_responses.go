@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListDeletedClustersReader is a Reader for the V2ListDeletedClusters structure.
+type V2ListDeletedClustersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListDeletedClustersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListDeletedClustersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListDeletedClustersUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListDeletedClustersForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ListDeletedClustersMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListDeletedClustersInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListDeletedClustersOK creates a V2ListDeletedClustersOK with default headers values
+func NewV2ListDeletedClustersOK() *V2ListDeletedClustersOK {
+	return &V2ListDeletedClustersOK{}
+}
+
+/* V2ListDeletedClustersOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListDeletedClustersOK struct {
+	Payload models.DeletedClusterList
+}
+
+func (o *V2ListDeletedClustersOK) Error() string {
+	return fmt.Sprintf("[GET /v2/deleted-clusters][%d] v2ListDeletedClustersOK  %+v", 200, o.Payload)
+}
+func (o *V2ListDeletedClustersOK) GetPayload() models.DeletedClusterList {
+	return o.Payload
+}
+
+func (o *V2ListDeletedClustersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListDeletedClustersUnauthorized creates a V2ListDeletedClustersUnauthorized with default headers values
+func NewV2ListDeletedClustersUnauthorized() *V2ListDeletedClustersUnauthorized {
+	return &V2ListDeletedClustersUnauthorized{}
+}
+
+/* V2ListDeletedClustersUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListDeletedClustersUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListDeletedClustersUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/deleted-clusters][%d] v2ListDeletedClustersUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListDeletedClustersUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListDeletedClustersUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListDeletedClustersForbidden creates a V2ListDeletedClustersForbidden with default headers values
+func NewV2ListDeletedClustersForbidden() *V2ListDeletedClustersForbidden {
+	return &V2ListDeletedClustersForbidden{}
+}
+
+/* V2ListDeletedClustersForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListDeletedClustersForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListDeletedClustersForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/deleted-clusters][%d] v2ListDeletedClustersForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListDeletedClustersForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListDeletedClustersForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListDeletedClustersMethodNotAllowed creates a V2ListDeletedClustersMethodNotAllowed with default headers values
+func NewV2ListDeletedClustersMethodNotAllowed() *V2ListDeletedClustersMethodNotAllowed {
+	return &V2ListDeletedClustersMethodNotAllowed{}
+}
+
+/* V2ListDeletedClustersMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ListDeletedClustersMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2ListDeletedClustersMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/deleted-clusters][%d] v2ListDeletedClustersMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2ListDeletedClustersMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListDeletedClustersMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListDeletedClustersInternalServerError creates a V2ListDeletedClustersInternalServerError with default headers values
+func NewV2ListDeletedClustersInternalServerError() *V2ListDeletedClustersInternalServerError {
+	return &V2ListDeletedClustersInternalServerError{}
+}
+
+/* V2ListDeletedClustersInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListDeletedClustersInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListDeletedClustersInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/deleted-clusters][%d] v2ListDeletedClustersInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListDeletedClustersInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListDeletedClustersInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListHostDiagnosticsParams creates a new V2ListHostDiagnosticsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListHostDiagnosticsParams() *V2ListHostDiagnosticsParams {
+	return &V2ListHostDiagnosticsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListHostDiagnosticsParamsWithTimeout creates a new V2ListHostDiagnosticsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListHostDiagnosticsParamsWithTimeout(timeout time.Duration) *V2ListHostDiagnosticsParams {
+	return &V2ListHostDiagnosticsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListHostDiagnosticsParamsWithContext creates a new V2ListHostDiagnosticsParams object
+// with the ability to set a context for a request.
+func NewV2ListHostDiagnosticsParamsWithContext(ctx context.Context) *V2ListHostDiagnosticsParams {
+	return &V2ListHostDiagnosticsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListHostDiagnosticsParamsWithHTTPClient creates a new V2ListHostDiagnosticsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListHostDiagnosticsParamsWithHTTPClient(client *http.Client) *V2ListHostDiagnosticsParams {
+	return &V2ListHostDiagnosticsParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ListHostDiagnosticsParams contains all the parameters to send to the API endpoint
+   for the v2 list host diagnostics operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ListHostDiagnosticsParams struct {
+
+	/* HostID.
+
+	   The host whose diagnostics should be listed.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host whose diagnostics should be listed.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list host diagnostics params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostDiagnosticsParams) WithDefaults() *V2ListHostDiagnosticsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list host diagnostics params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostDiagnosticsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) WithTimeout(timeout time.Duration) *V2ListHostDiagnosticsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) WithContext(ctx context.Context) *V2ListHostDiagnosticsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) WithHTTPClient(client *http.Client) *V2ListHostDiagnosticsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostID adds the hostID to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) WithHostID(hostID strfmt.UUID) *V2ListHostDiagnosticsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2ListHostDiagnosticsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 list host diagnostics params
+func (o *V2ListHostDiagnosticsParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListHostDiagnosticsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
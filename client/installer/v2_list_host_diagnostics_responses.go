@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostDiagnosticsReader is a Reader for the V2ListHostDiagnostics structure.
+type V2ListHostDiagnosticsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListHostDiagnosticsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListHostDiagnosticsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListHostDiagnosticsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListHostDiagnosticsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListHostDiagnosticsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListHostDiagnosticsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListHostDiagnosticsOK creates a V2ListHostDiagnosticsOK with default headers values
+func NewV2ListHostDiagnosticsOK() *V2ListHostDiagnosticsOK {
+	return &V2ListHostDiagnosticsOK{}
+}
+
+/* V2ListHostDiagnosticsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListHostDiagnosticsOK struct {
+	Payload models.HostDiagnosticList
+}
+
+func (o *V2ListHostDiagnosticsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2ListHostDiagnosticsOK  %+v", 200, o.Payload)
+}
+func (o *V2ListHostDiagnosticsOK) GetPayload() models.HostDiagnosticList {
+	return o.Payload
+}
+
+func (o *V2ListHostDiagnosticsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostDiagnosticsUnauthorized creates a V2ListHostDiagnosticsUnauthorized with default headers values
+func NewV2ListHostDiagnosticsUnauthorized() *V2ListHostDiagnosticsUnauthorized {
+	return &V2ListHostDiagnosticsUnauthorized{}
+}
+
+/* V2ListHostDiagnosticsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListHostDiagnosticsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListHostDiagnosticsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2ListHostDiagnosticsUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListHostDiagnosticsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostDiagnosticsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostDiagnosticsForbidden creates a V2ListHostDiagnosticsForbidden with default headers values
+func NewV2ListHostDiagnosticsForbidden() *V2ListHostDiagnosticsForbidden {
+	return &V2ListHostDiagnosticsForbidden{}
+}
+
+/* V2ListHostDiagnosticsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListHostDiagnosticsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListHostDiagnosticsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2ListHostDiagnosticsForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListHostDiagnosticsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostDiagnosticsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostDiagnosticsNotFound creates a V2ListHostDiagnosticsNotFound with default headers values
+func NewV2ListHostDiagnosticsNotFound() *V2ListHostDiagnosticsNotFound {
+	return &V2ListHostDiagnosticsNotFound{}
+}
+
+/* V2ListHostDiagnosticsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListHostDiagnosticsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2ListHostDiagnosticsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2ListHostDiagnosticsNotFound  %+v", 404, o.Payload)
+}
+func (o *V2ListHostDiagnosticsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostDiagnosticsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostDiagnosticsInternalServerError creates a V2ListHostDiagnosticsInternalServerError with default headers values
+func NewV2ListHostDiagnosticsInternalServerError() *V2ListHostDiagnosticsInternalServerError {
+	return &V2ListHostDiagnosticsInternalServerError{}
+}
+
+/* V2ListHostDiagnosticsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListHostDiagnosticsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListHostDiagnosticsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics][%d] v2ListHostDiagnosticsInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListHostDiagnosticsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostDiagnosticsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListHostStateTransitionsParams creates a new V2ListHostStateTransitionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListHostStateTransitionsParams() *V2ListHostStateTransitionsParams {
+	return &V2ListHostStateTransitionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListHostStateTransitionsParamsWithTimeout creates a new V2ListHostStateTransitionsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListHostStateTransitionsParamsWithTimeout(timeout time.Duration) *V2ListHostStateTransitionsParams {
+	return &V2ListHostStateTransitionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListHostStateTransitionsParamsWithContext creates a new V2ListHostStateTransitionsParams object
+// with the ability to set a context for a request.
+func NewV2ListHostStateTransitionsParamsWithContext(ctx context.Context) *V2ListHostStateTransitionsParams {
+	return &V2ListHostStateTransitionsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListHostStateTransitionsParamsWithHTTPClient creates a new V2ListHostStateTransitionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListHostStateTransitionsParamsWithHTTPClient(client *http.Client) *V2ListHostStateTransitionsParams {
+	return &V2ListHostStateTransitionsParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ListHostStateTransitionsParams contains all the parameters to send to the API endpoint
+   for the v2 list host state transitions operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ListHostStateTransitionsParams struct {
+
+	/* HostID.
+
+	   The host whose status transitions should be listed.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host whose status transitions should be listed.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list host state transitions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostStateTransitionsParams) WithDefaults() *V2ListHostStateTransitionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list host state transitions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostStateTransitionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) WithTimeout(timeout time.Duration) *V2ListHostStateTransitionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) WithContext(ctx context.Context) *V2ListHostStateTransitionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) WithHTTPClient(client *http.Client) *V2ListHostStateTransitionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostID adds the hostID to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) WithHostID(hostID strfmt.UUID) *V2ListHostStateTransitionsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2ListHostStateTransitionsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 list host state transitions params
+func (o *V2ListHostStateTransitionsParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListHostStateTransitionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
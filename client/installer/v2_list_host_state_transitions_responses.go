@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostStateTransitionsReader is a Reader for the V2ListHostStateTransitions structure.
+type V2ListHostStateTransitionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListHostStateTransitionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListHostStateTransitionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListHostStateTransitionsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListHostStateTransitionsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListHostStateTransitionsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListHostStateTransitionsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListHostStateTransitionsOK creates a V2ListHostStateTransitionsOK with default headers values
+func NewV2ListHostStateTransitionsOK() *V2ListHostStateTransitionsOK {
+	return &V2ListHostStateTransitionsOK{}
+}
+
+/* V2ListHostStateTransitionsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListHostStateTransitionsOK struct {
+	Payload models.StateTransitionList
+}
+
+func (o *V2ListHostStateTransitionsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions][%d] v2ListHostStateTransitionsOK  %+v", 200, o.Payload)
+}
+func (o *V2ListHostStateTransitionsOK) GetPayload() models.StateTransitionList {
+	return o.Payload
+}
+
+func (o *V2ListHostStateTransitionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostStateTransitionsUnauthorized creates a V2ListHostStateTransitionsUnauthorized with default headers values
+func NewV2ListHostStateTransitionsUnauthorized() *V2ListHostStateTransitionsUnauthorized {
+	return &V2ListHostStateTransitionsUnauthorized{}
+}
+
+/* V2ListHostStateTransitionsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListHostStateTransitionsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListHostStateTransitionsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions][%d] v2ListHostStateTransitionsUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListHostStateTransitionsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostStateTransitionsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostStateTransitionsForbidden creates a V2ListHostStateTransitionsForbidden with default headers values
+func NewV2ListHostStateTransitionsForbidden() *V2ListHostStateTransitionsForbidden {
+	return &V2ListHostStateTransitionsForbidden{}
+}
+
+/* V2ListHostStateTransitionsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListHostStateTransitionsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListHostStateTransitionsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions][%d] v2ListHostStateTransitionsForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListHostStateTransitionsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostStateTransitionsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostStateTransitionsNotFound creates a V2ListHostStateTransitionsNotFound with default headers values
+func NewV2ListHostStateTransitionsNotFound() *V2ListHostStateTransitionsNotFound {
+	return &V2ListHostStateTransitionsNotFound{}
+}
+
+/* V2ListHostStateTransitionsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListHostStateTransitionsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2ListHostStateTransitionsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions][%d] v2ListHostStateTransitionsNotFound  %+v", 404, o.Payload)
+}
+func (o *V2ListHostStateTransitionsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostStateTransitionsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostStateTransitionsInternalServerError creates a V2ListHostStateTransitionsInternalServerError with default headers values
+func NewV2ListHostStateTransitionsInternalServerError() *V2ListHostStateTransitionsInternalServerError {
+	return &V2ListHostStateTransitionsInternalServerError{}
+}
+
+/* V2ListHostStateTransitionsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListHostStateTransitionsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListHostStateTransitionsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions][%d] v2ListHostStateTransitionsInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListHostStateTransitionsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostStateTransitionsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
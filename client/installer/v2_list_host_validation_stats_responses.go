@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostValidationStatsReader is a Reader for the V2ListHostValidationStats structure.
+type V2ListHostValidationStatsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListHostValidationStatsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListHostValidationStatsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListHostValidationStatsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListHostValidationStatsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListHostValidationStatsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListHostValidationStatsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListHostValidationStatsOK creates a V2ListHostValidationStatsOK with default headers values
+func NewV2ListHostValidationStatsOK() *V2ListHostValidationStatsOK {
+	return &V2ListHostValidationStatsOK{}
+}
+
+/* V2ListHostValidationStatsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListHostValidationStatsOK struct {
+	Payload models.HostValidationStatsList
+}
+
+func (o *V2ListHostValidationStatsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats][%d] v2ListHostValidationStatsOK  %+v", 200, o.Payload)
+}
+func (o *V2ListHostValidationStatsOK) GetPayload() models.HostValidationStatsList {
+	return o.Payload
+}
+
+func (o *V2ListHostValidationStatsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostValidationStatsUnauthorized creates a V2ListHostValidationStatsUnauthorized with default headers values
+func NewV2ListHostValidationStatsUnauthorized() *V2ListHostValidationStatsUnauthorized {
+	return &V2ListHostValidationStatsUnauthorized{}
+}
+
+/* V2ListHostValidationStatsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListHostValidationStatsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListHostValidationStatsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats][%d] v2ListHostValidationStatsUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ListHostValidationStatsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostValidationStatsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostValidationStatsForbidden creates a V2ListHostValidationStatsForbidden with default headers values
+func NewV2ListHostValidationStatsForbidden() *V2ListHostValidationStatsForbidden {
+	return &V2ListHostValidationStatsForbidden{}
+}
+
+/* V2ListHostValidationStatsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListHostValidationStatsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ListHostValidationStatsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats][%d] v2ListHostValidationStatsForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ListHostValidationStatsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostValidationStatsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostValidationStatsNotFound creates a V2ListHostValidationStatsNotFound with default headers values
+func NewV2ListHostValidationStatsNotFound() *V2ListHostValidationStatsNotFound {
+	return &V2ListHostValidationStatsNotFound{}
+}
+
+/* V2ListHostValidationStatsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListHostValidationStatsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2ListHostValidationStatsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats][%d] v2ListHostValidationStatsNotFound  %+v", 404, o.Payload)
+}
+func (o *V2ListHostValidationStatsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostValidationStatsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostValidationStatsInternalServerError creates a V2ListHostValidationStatsInternalServerError with default headers values
+func NewV2ListHostValidationStatsInternalServerError() *V2ListHostValidationStatsInternalServerError {
+	return &V2ListHostValidationStatsInternalServerError{}
+}
+
+/* V2ListHostValidationStatsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListHostValidationStatsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ListHostValidationStatsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats][%d] v2ListHostValidationStatsInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ListHostValidationStatsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostValidationStatsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
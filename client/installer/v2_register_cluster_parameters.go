@@ -61,6 +61,17 @@ func NewV2RegisterClusterParamsWithHTTPClient(client *http.Client) *V2RegisterCl
 */
 type V2RegisterClusterParams struct {
 
+	/* FromTemplate.
+
+	     A cluster template to create the cluster from. The properties describing the new cluster take precedence
+	over the ones of the template, and the manifests and install config overrides of the template are added
+	to the cluster.
+
+
+	     Format: uuid
+	*/
+	FromTemplate *strfmt.UUID
+
 	/* NewClusterParams.
 
 	   The properties describing the new cluster.
@@ -120,6 +131,17 @@ func (o *V2RegisterClusterParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithFromTemplate adds the fromTemplate to the v2 register cluster params
+func (o *V2RegisterClusterParams) WithFromTemplate(fromTemplate *strfmt.UUID) *V2RegisterClusterParams {
+	o.SetFromTemplate(fromTemplate)
+	return o
+}
+
+// SetFromTemplate adds the fromTemplate to the v2 register cluster params
+func (o *V2RegisterClusterParams) SetFromTemplate(fromTemplate *strfmt.UUID) {
+	o.FromTemplate = fromTemplate
+}
+
 // WithNewClusterParams adds the newClusterParams to the v2 register cluster params
 func (o *V2RegisterClusterParams) WithNewClusterParams(newClusterParams *models.ClusterCreateParams) *V2RegisterClusterParams {
 	o.SetNewClusterParams(newClusterParams)
@@ -138,6 +160,23 @@ func (o *V2RegisterClusterParams) WriteToRequest(r runtime.ClientRequest, reg st
 		return err
 	}
 	var res []error
+
+	if o.FromTemplate != nil {
+
+		// query param from_template
+		var qrFromTemplate strfmt.UUID
+
+		if o.FromTemplate != nil {
+			qrFromTemplate = *o.FromTemplate
+		}
+		qFromTemplate := qrFromTemplate.String()
+		if qFromTemplate != "" {
+
+			if err := r.SetQueryParam("from_template", qFromTemplate); err != nil {
+				return err
+			}
+		}
+	}
 	if o.NewClusterParams != nil {
 		if err := r.SetBodyParam(o.NewClusterParams); err != nil {
 			return err
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ReinstallFailedHostsParams creates a new V2ReinstallFailedHostsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ReinstallFailedHostsParams() *V2ReinstallFailedHostsParams {
+	return &V2ReinstallFailedHostsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ReinstallFailedHostsParamsWithTimeout creates a new V2ReinstallFailedHostsParams object
+// with the ability to set a timeout on a request.
+func NewV2ReinstallFailedHostsParamsWithTimeout(timeout time.Duration) *V2ReinstallFailedHostsParams {
+	return &V2ReinstallFailedHostsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ReinstallFailedHostsParamsWithContext creates a new V2ReinstallFailedHostsParams object
+// with the ability to set a context for a request.
+func NewV2ReinstallFailedHostsParamsWithContext(ctx context.Context) *V2ReinstallFailedHostsParams {
+	return &V2ReinstallFailedHostsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ReinstallFailedHostsParamsWithHTTPClient creates a new V2ReinstallFailedHostsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ReinstallFailedHostsParamsWithHTTPClient(client *http.Client) *V2ReinstallFailedHostsParams {
+	return &V2ReinstallFailedHostsParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2ReinstallFailedHostsParams contains all the parameters to send to the API endpoint
+   for the v2 reinstall failed hosts operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2ReinstallFailedHostsParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose failed hosts are to be re-installed.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 reinstall failed hosts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ReinstallFailedHostsParams) WithDefaults() *V2ReinstallFailedHostsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 reinstall failed hosts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ReinstallFailedHostsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 reinstall failed hosts params
+func (o *V2ReinstallFailedHostsParams) WithTimeout(timeout time.Duration) *V2ReinstallFailedHostsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 reinstall failed hosts params
+func (o *V2ReinstallFailedHostsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 reinstall failed hosts params
+func (o *V2ReinstallFailedHostsParams) WithContext(ctx context.Context) *V2ReinstallFailedHostsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 reinstall failed hosts params
+func (o *V2ReinstallFailedHostsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 reinstall failed hosts params
+func (o *V2ReinstallFailedHostsParams) WithHTTPClient(client *http.Client) *V2ReinstallFailedHostsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 reinstall failed hosts params
+func (o *V2ReinstallFailedHostsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 reinstall failed hosts params
+func (o *V2ReinstallFailedHostsParams) WithClusterID(clusterID strfmt.UUID) *V2ReinstallFailedHostsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 reinstall failed hosts params
+func (o *V2ReinstallFailedHostsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ReinstallFailedHostsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ReinstallFailedHostsReader is a Reader for the V2ReinstallFailedHosts structure.
+type V2ReinstallFailedHostsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ReinstallFailedHostsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2ReinstallFailedHostsAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ReinstallFailedHostsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ReinstallFailedHostsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ReinstallFailedHostsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ReinstallFailedHostsMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2ReinstallFailedHostsConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ReinstallFailedHostsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ReinstallFailedHostsAccepted creates a V2ReinstallFailedHostsAccepted with default headers values
+func NewV2ReinstallFailedHostsAccepted() *V2ReinstallFailedHostsAccepted {
+	return &V2ReinstallFailedHostsAccepted{}
+}
+
+/* V2ReinstallFailedHostsAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2ReinstallFailedHostsAccepted struct {
+	Payload *models.Cluster
+}
+
+func (o *V2ReinstallFailedHostsAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/reinstall-failed-hosts][%d] v2ReinstallFailedHostsAccepted  %+v", 202, o.Payload)
+}
+func (o *V2ReinstallFailedHostsAccepted) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *V2ReinstallFailedHostsAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ReinstallFailedHostsUnauthorized creates a V2ReinstallFailedHostsUnauthorized with default headers values
+func NewV2ReinstallFailedHostsUnauthorized() *V2ReinstallFailedHostsUnauthorized {
+	return &V2ReinstallFailedHostsUnauthorized{}
+}
+
+/* V2ReinstallFailedHostsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ReinstallFailedHostsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ReinstallFailedHostsUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/reinstall-failed-hosts][%d] v2ReinstallFailedHostsUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2ReinstallFailedHostsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ReinstallFailedHostsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ReinstallFailedHostsForbidden creates a V2ReinstallFailedHostsForbidden with default headers values
+func NewV2ReinstallFailedHostsForbidden() *V2ReinstallFailedHostsForbidden {
+	return &V2ReinstallFailedHostsForbidden{}
+}
+
+/* V2ReinstallFailedHostsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ReinstallFailedHostsForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2ReinstallFailedHostsForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/reinstall-failed-hosts][%d] v2ReinstallFailedHostsForbidden  %+v", 403, o.Payload)
+}
+func (o *V2ReinstallFailedHostsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ReinstallFailedHostsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ReinstallFailedHostsNotFound creates a V2ReinstallFailedHostsNotFound with default headers values
+func NewV2ReinstallFailedHostsNotFound() *V2ReinstallFailedHostsNotFound {
+	return &V2ReinstallFailedHostsNotFound{}
+}
+
+/* V2ReinstallFailedHostsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ReinstallFailedHostsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2ReinstallFailedHostsNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/reinstall-failed-hosts][%d] v2ReinstallFailedHostsNotFound  %+v", 404, o.Payload)
+}
+func (o *V2ReinstallFailedHostsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ReinstallFailedHostsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ReinstallFailedHostsMethodNotAllowed creates a V2ReinstallFailedHostsMethodNotAllowed with default headers values
+func NewV2ReinstallFailedHostsMethodNotAllowed() *V2ReinstallFailedHostsMethodNotAllowed {
+	return &V2ReinstallFailedHostsMethodNotAllowed{}
+}
+
+/* V2ReinstallFailedHostsMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ReinstallFailedHostsMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2ReinstallFailedHostsMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/reinstall-failed-hosts][%d] v2ReinstallFailedHostsMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2ReinstallFailedHostsMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ReinstallFailedHostsMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ReinstallFailedHostsConflict creates a V2ReinstallFailedHostsConflict with default headers values
+func NewV2ReinstallFailedHostsConflict() *V2ReinstallFailedHostsConflict {
+	return &V2ReinstallFailedHostsConflict{}
+}
+
+/* V2ReinstallFailedHostsConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2ReinstallFailedHostsConflict struct {
+	Payload *models.Error
+}
+
+func (o *V2ReinstallFailedHostsConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/reinstall-failed-hosts][%d] v2ReinstallFailedHostsConflict  %+v", 409, o.Payload)
+}
+func (o *V2ReinstallFailedHostsConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ReinstallFailedHostsConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ReinstallFailedHostsInternalServerError creates a V2ReinstallFailedHostsInternalServerError with default headers values
+func NewV2ReinstallFailedHostsInternalServerError() *V2ReinstallFailedHostsInternalServerError {
+	return &V2ReinstallFailedHostsInternalServerError{}
+}
+
+/* V2ReinstallFailedHostsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ReinstallFailedHostsInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2ReinstallFailedHostsInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/actions/reinstall-failed-hosts][%d] v2ReinstallFailedHostsInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2ReinstallFailedHostsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ReinstallFailedHostsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2RestoreDeletedClusterParams creates a new V2RestoreDeletedClusterParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2RestoreDeletedClusterParams() *V2RestoreDeletedClusterParams {
+	return &V2RestoreDeletedClusterParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2RestoreDeletedClusterParamsWithTimeout creates a new V2RestoreDeletedClusterParams object
+// with the ability to set a timeout on a request.
+func NewV2RestoreDeletedClusterParamsWithTimeout(timeout time.Duration) *V2RestoreDeletedClusterParams {
+	return &V2RestoreDeletedClusterParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2RestoreDeletedClusterParamsWithContext creates a new V2RestoreDeletedClusterParams object
+// with the ability to set a context for a request.
+func NewV2RestoreDeletedClusterParamsWithContext(ctx context.Context) *V2RestoreDeletedClusterParams {
+	return &V2RestoreDeletedClusterParams{
+		Context: ctx,
+	}
+}
+
+// NewV2RestoreDeletedClusterParamsWithHTTPClient creates a new V2RestoreDeletedClusterParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2RestoreDeletedClusterParamsWithHTTPClient(client *http.Client) *V2RestoreDeletedClusterParams {
+	return &V2RestoreDeletedClusterParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2RestoreDeletedClusterParams contains all the parameters to send to the API endpoint
+   for the v2 restore deleted cluster operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2RestoreDeletedClusterParams struct {
+
+	/* ClusterID.
+
+	   The deregistered cluster to be restored.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 restore deleted cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RestoreDeletedClusterParams) WithDefaults() *V2RestoreDeletedClusterParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 restore deleted cluster params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2RestoreDeletedClusterParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 restore deleted cluster params
+func (o *V2RestoreDeletedClusterParams) WithTimeout(timeout time.Duration) *V2RestoreDeletedClusterParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 restore deleted cluster params
+func (o *V2RestoreDeletedClusterParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 restore deleted cluster params
+func (o *V2RestoreDeletedClusterParams) WithContext(ctx context.Context) *V2RestoreDeletedClusterParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 restore deleted cluster params
+func (o *V2RestoreDeletedClusterParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 restore deleted cluster params
+func (o *V2RestoreDeletedClusterParams) WithHTTPClient(client *http.Client) *V2RestoreDeletedClusterParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 restore deleted cluster params
+func (o *V2RestoreDeletedClusterParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 restore deleted cluster params
+func (o *V2RestoreDeletedClusterParams) WithClusterID(clusterID strfmt.UUID) *V2RestoreDeletedClusterParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 restore deleted cluster params
+func (o *V2RestoreDeletedClusterParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2RestoreDeletedClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
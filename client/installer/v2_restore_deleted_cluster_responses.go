@@ -0,0 +1,333 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RestoreDeletedClusterReader is a Reader for the V2RestoreDeletedCluster structure.
+type V2RestoreDeletedClusterReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2RestoreDeletedClusterReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2RestoreDeletedClusterCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2RestoreDeletedClusterBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2RestoreDeletedClusterUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2RestoreDeletedClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2RestoreDeletedClusterNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2RestoreDeletedClusterMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2RestoreDeletedClusterConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2RestoreDeletedClusterInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2RestoreDeletedClusterCreated creates a V2RestoreDeletedClusterCreated with default headers values
+func NewV2RestoreDeletedClusterCreated() *V2RestoreDeletedClusterCreated {
+	return &V2RestoreDeletedClusterCreated{}
+}
+
+/* V2RestoreDeletedClusterCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2RestoreDeletedClusterCreated struct {
+	Payload *models.Cluster
+}
+
+func (o *V2RestoreDeletedClusterCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/deleted-clusters/{cluster_id}/actions/restore][%d] v2RestoreDeletedClusterCreated  %+v", 201, o.Payload)
+}
+func (o *V2RestoreDeletedClusterCreated) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *V2RestoreDeletedClusterCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RestoreDeletedClusterBadRequest creates a V2RestoreDeletedClusterBadRequest with default headers values
+func NewV2RestoreDeletedClusterBadRequest() *V2RestoreDeletedClusterBadRequest {
+	return &V2RestoreDeletedClusterBadRequest{}
+}
+
+/* V2RestoreDeletedClusterBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2RestoreDeletedClusterBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2RestoreDeletedClusterBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/deleted-clusters/{cluster_id}/actions/restore][%d] v2RestoreDeletedClusterBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2RestoreDeletedClusterBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RestoreDeletedClusterBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RestoreDeletedClusterUnauthorized creates a V2RestoreDeletedClusterUnauthorized with default headers values
+func NewV2RestoreDeletedClusterUnauthorized() *V2RestoreDeletedClusterUnauthorized {
+	return &V2RestoreDeletedClusterUnauthorized{}
+}
+
+/* V2RestoreDeletedClusterUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2RestoreDeletedClusterUnauthorized struct {
+	Payload *models.InfraError
+}
+
+func (o *V2RestoreDeletedClusterUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/deleted-clusters/{cluster_id}/actions/restore][%d] v2RestoreDeletedClusterUnauthorized  %+v", 401, o.Payload)
+}
+func (o *V2RestoreDeletedClusterUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RestoreDeletedClusterUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RestoreDeletedClusterForbidden creates a V2RestoreDeletedClusterForbidden with default headers values
+func NewV2RestoreDeletedClusterForbidden() *V2RestoreDeletedClusterForbidden {
+	return &V2RestoreDeletedClusterForbidden{}
+}
+
+/* V2RestoreDeletedClusterForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2RestoreDeletedClusterForbidden struct {
+	Payload *models.InfraError
+}
+
+func (o *V2RestoreDeletedClusterForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/deleted-clusters/{cluster_id}/actions/restore][%d] v2RestoreDeletedClusterForbidden  %+v", 403, o.Payload)
+}
+func (o *V2RestoreDeletedClusterForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2RestoreDeletedClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RestoreDeletedClusterNotFound creates a V2RestoreDeletedClusterNotFound with default headers values
+func NewV2RestoreDeletedClusterNotFound() *V2RestoreDeletedClusterNotFound {
+	return &V2RestoreDeletedClusterNotFound{}
+}
+
+/* V2RestoreDeletedClusterNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2RestoreDeletedClusterNotFound struct {
+	Payload *models.Error
+}
+
+func (o *V2RestoreDeletedClusterNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/deleted-clusters/{cluster_id}/actions/restore][%d] v2RestoreDeletedClusterNotFound  %+v", 404, o.Payload)
+}
+func (o *V2RestoreDeletedClusterNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RestoreDeletedClusterNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RestoreDeletedClusterMethodNotAllowed creates a V2RestoreDeletedClusterMethodNotAllowed with default headers values
+func NewV2RestoreDeletedClusterMethodNotAllowed() *V2RestoreDeletedClusterMethodNotAllowed {
+	return &V2RestoreDeletedClusterMethodNotAllowed{}
+}
+
+/* V2RestoreDeletedClusterMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2RestoreDeletedClusterMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+func (o *V2RestoreDeletedClusterMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/deleted-clusters/{cluster_id}/actions/restore][%d] v2RestoreDeletedClusterMethodNotAllowed  %+v", 405, o.Payload)
+}
+func (o *V2RestoreDeletedClusterMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RestoreDeletedClusterMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RestoreDeletedClusterConflict creates a V2RestoreDeletedClusterConflict with default headers values
+func NewV2RestoreDeletedClusterConflict() *V2RestoreDeletedClusterConflict {
+	return &V2RestoreDeletedClusterConflict{}
+}
+
+/* V2RestoreDeletedClusterConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2RestoreDeletedClusterConflict struct {
+	Payload *models.Error
+}
+
+func (o *V2RestoreDeletedClusterConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/deleted-clusters/{cluster_id}/actions/restore][%d] v2RestoreDeletedClusterConflict  %+v", 409, o.Payload)
+}
+func (o *V2RestoreDeletedClusterConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RestoreDeletedClusterConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2RestoreDeletedClusterInternalServerError creates a V2RestoreDeletedClusterInternalServerError with default headers values
+func NewV2RestoreDeletedClusterInternalServerError() *V2RestoreDeletedClusterInternalServerError {
+	return &V2RestoreDeletedClusterInternalServerError{}
+}
+
+/* V2RestoreDeletedClusterInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2RestoreDeletedClusterInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *V2RestoreDeletedClusterInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/deleted-clusters/{cluster_id}/actions/restore][%d] v2RestoreDeletedClusterInternalServerError  %+v", 500, o.Payload)
+}
+func (o *V2RestoreDeletedClusterInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2RestoreDeletedClusterInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -61,6 +61,12 @@ func NewV2UpdateClusterParamsWithHTTPClient(client *http.Client) *V2UpdateCluste
 */
 type V2UpdateClusterParams struct {
 
+	/* IfMatch.
+
+	   Update the cluster only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.
+	*/
+	IfMatch *string
+
 	/* ClusterUpdateParams.
 
 	   The properties to update.
@@ -128,6 +134,17 @@ func (o *V2UpdateClusterParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the v2 update cluster params
+func (o *V2UpdateClusterParams) WithIfMatch(ifMatch *string) *V2UpdateClusterParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the v2 update cluster params
+func (o *V2UpdateClusterParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithClusterUpdateParams adds the clusterUpdateParams to the v2 update cluster params
 func (o *V2UpdateClusterParams) WithClusterUpdateParams(clusterUpdateParams *models.V2ClusterUpdateParams) *V2UpdateClusterParams {
 	o.SetClusterUpdateParams(clusterUpdateParams)
@@ -157,6 +174,14 @@ func (o *V2UpdateClusterParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		return err
 	}
 	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
 	if o.ClusterUpdateParams != nil {
 		if err := r.SetBodyParam(o.ClusterUpdateParams); err != nil {
 			return err
@@ -65,6 +65,12 @@ func (o *V2UpdateClusterReader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewV2UpdateClusterPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewV2UpdateClusterInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -86,6 +92,11 @@ func NewV2UpdateClusterCreated() *V2UpdateClusterCreated {
 Success.
 */
 type V2UpdateClusterCreated struct {
+
+	/* The resource version of the cluster, to be sent in the If-Match header of its updates.
+	 */
+	ETag string
+
 	Payload *models.Cluster
 }
 
@@ -98,6 +109,13 @@ func (o *V2UpdateClusterCreated) GetPayload() *models.Cluster {
 
 func (o *V2UpdateClusterCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Cluster)
 
 	// response payload
@@ -300,6 +318,38 @@ func (o *V2UpdateClusterConflict) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewV2UpdateClusterPreconditionFailed creates a V2UpdateClusterPreconditionFailed with default headers values
+func NewV2UpdateClusterPreconditionFailed() *V2UpdateClusterPreconditionFailed {
+	return &V2UpdateClusterPreconditionFailed{}
+}
+
+/* V2UpdateClusterPreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed.
+*/
+type V2UpdateClusterPreconditionFailed struct {
+	Payload *models.Error
+}
+
+func (o *V2UpdateClusterPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /v2/clusters/{cluster_id}][%d] v2UpdateClusterPreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *V2UpdateClusterPreconditionFailed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewV2UpdateClusterInternalServerError creates a V2UpdateClusterInternalServerError with default headers values
 func NewV2UpdateClusterInternalServerError() *V2UpdateClusterInternalServerError {
 	return &V2UpdateClusterInternalServerError{}
@@ -61,6 +61,12 @@ func NewV2UpdateHostParamsWithHTTPClient(client *http.Client) *V2UpdateHostParam
 */
 type V2UpdateHostParams struct {
 
+	/* IfMatch.
+
+	   Update the host only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.
+	*/
+	IfMatch *string
+
 	/* HostUpdateParams.
 
 	   The properties to update.
@@ -136,6 +142,17 @@ func (o *V2UpdateHostParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the v2 update host params
+func (o *V2UpdateHostParams) WithIfMatch(ifMatch *string) *V2UpdateHostParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the v2 update host params
+func (o *V2UpdateHostParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithHostUpdateParams adds the hostUpdateParams to the v2 update host params
 func (o *V2UpdateHostParams) WithHostUpdateParams(hostUpdateParams *models.HostUpdateParams) *V2UpdateHostParams {
 	o.SetHostUpdateParams(hostUpdateParams)
@@ -176,6 +193,14 @@ func (o *V2UpdateHostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		return err
 	}
 	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
 	if o.HostUpdateParams != nil {
 		if err := r.SetBodyParam(o.HostUpdateParams); err != nil {
 			return err
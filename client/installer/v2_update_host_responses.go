@@ -65,6 +65,12 @@ func (o *V2UpdateHostReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewV2UpdateHostPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewV2UpdateHostInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -86,6 +92,11 @@ func NewV2UpdateHostCreated() *V2UpdateHostCreated {
 Success.
 */
 type V2UpdateHostCreated struct {
+
+	/* The resource version of the host, to be sent in the If-Match header of its updates.
+	 */
+	ETag string
+
 	Payload *models.Host
 }
 
@@ -98,6 +109,13 @@ func (o *V2UpdateHostCreated) GetPayload() *models.Host {
 
 func (o *V2UpdateHostCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Host)
 
 	// response payload
@@ -300,6 +318,38 @@ func (o *V2UpdateHostConflict) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewV2UpdateHostPreconditionFailed creates a V2UpdateHostPreconditionFailed with default headers values
+func NewV2UpdateHostPreconditionFailed() *V2UpdateHostPreconditionFailed {
+	return &V2UpdateHostPreconditionFailed{}
+}
+
+/* V2UpdateHostPreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed.
+*/
+type V2UpdateHostPreconditionFailed struct {
+	Payload *models.Error
+}
+
+func (o *V2UpdateHostPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /v2/infra-envs/{infra_env_id}/hosts/{host_id}][%d] v2UpdateHostPreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *V2UpdateHostPreconditionFailed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateHostPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewV2UpdateHostInternalServerError creates a V2UpdateHostInternalServerError with default headers values
 func NewV2UpdateHostInternalServerError() *V2UpdateHostInternalServerError {
 	return &V2UpdateHostInternalServerError{}
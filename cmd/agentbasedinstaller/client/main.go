@@ -57,6 +57,12 @@ var RegisterOptions struct {
 	ExtraManifests          string `envconfig:"EXTRA_MANIFESTS_PATH" default:"/extra-manifests"`
 }
 
+var ExportOptions struct {
+	ClusterID string `envconfig:"CLUSTER_ID" default:""`
+	ExportDir string `envconfig:"EXPORT_DIR" default:"/manifests"`
+	Namespace string `envconfig:"EXPORT_NAMESPACE" default:""`
+}
+
 var ConfigureOptions struct {
 	InfraEnvID    string `envconfig:"INFRA_ENV_ID" default:""`
 	HostConfigDir string `envconfig:"HOST_CONFIG_DIR" default:"/etc/assisted/hostconfig"`
@@ -96,6 +102,8 @@ func main() {
 		os.WriteFile("/etc/assisted/client_config", []byte("INFRA_ENV_ID="+infraEnvID), 0644)
 	case "configure":
 		configure(ctx, log, bmInventory)
+	case "export":
+		export(ctx, log, bmInventory)
 	default:
 		log.Fatalf("Unknown subcommand %s", os.Args[1])
 	}
@@ -169,6 +177,37 @@ func configure(ctx context.Context, log *log.Logger, bmInventory *client.Assiste
 	log.Info("Configured all hosts")
 }
 
+func export(ctx context.Context, log *log.Logger, bmInventory *client.AssistedInstall) {
+	err := envconfig.Process("", &ExportOptions)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if ExportOptions.ClusterID == "" {
+		log.Fatal("No CLUSTER_ID specified")
+	}
+
+	files, err := agentbasedinstaller.ExportCluster(ctx, log, bmInventory, strfmt.UUID(ExportOptions.ClusterID), ExportOptions.Namespace)
+	if err != nil {
+		log.Fatal("Failed to export cluster from assisted-service: ", err)
+	}
+
+	if err = os.MkdirAll(ExportOptions.ExportDir, 0755); err != nil {
+		log.Fatal("Failed to create export directory: ", err)
+	}
+	for fileName, content := range files {
+		filePath := path.Join(ExportOptions.ExportDir, fileName)
+		if err = os.MkdirAll(path.Dir(filePath), 0755); err != nil {
+			log.Fatal("Failed to create export directory: ", err)
+		}
+		if err = os.WriteFile(filePath, content, 0600); err != nil {
+			log.Fatal("Failed to write exported manifest: ", err)
+		}
+	}
+	log.Infof("Exported cluster %s to %s, create the %s secret before applying the manifests",
+		ExportOptions.ClusterID, ExportOptions.ExportDir, agentbasedinstaller.PullSecretName)
+}
+
 func recordFailures(failures []agentbasedinstaller.Failure) error {
 	if len(failures) == 0 {
 		err := os.Remove(failureOutputPath)
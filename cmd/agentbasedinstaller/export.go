@@ -0,0 +1,536 @@
+package agentbasedinstaller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/client/manifests"
+	"github.com/openshift/assisted-service/internal/controller/controllers"
+	"github.com/openshift/assisted-service/models"
+	errorutil "github.com/openshift/assisted-service/pkg/error"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/apis/hive/v1/agent"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// Names of the files written by ExportCluster. The cluster, infra-env and network files are the ones
+// consumed by RegisterCluster and RegisterInfraEnv.
+const (
+	ClusterDeploymentFileName   = "cluster-deployment.yaml"
+	AgentClusterInstallFileName = "agent-cluster-install.yaml"
+	ClusterImageSetFileName     = "cluster-image-set.yaml"
+	InfraEnvFileName            = "infraenv.yaml"
+	NMStateConfigFileName       = "nmstateconfig.yaml"
+	BareMetalHostsFileName      = "baremetalhosts.yaml"
+	ManifestsConfigMapFileName  = "manifests-configmap.yaml"
+)
+
+// PullSecretName is the name of the secret referenced by the exported resources. The pull secret
+// itself is not exported, it needs to be created by the user.
+const PullSecretName = "pull-secret"
+
+// clusterNameLabel is set on the agents of the exported infra-envs and selected by the exported ClusterDeployment
+const clusterNameLabel = "cluster-name"
+
+// ExportCluster fetches a cluster, its infra-envs and its manifests from the service and converts them
+// to the equivalent kube-api resources, keyed by file name.
+func ExportCluster(ctx context.Context, log *log.Logger, bmInventory *client.AssistedInstall, clusterID strfmt.UUID,
+	namespace string) (map[string][]byte, error) {
+
+	log.Infof("Exporting cluster %s", clusterID)
+
+	clusterResult, err := bmInventory.Installer.V2GetCluster(ctx, &installer.V2GetClusterParams{ClusterID: clusterID})
+	if err != nil {
+		return nil, errorutil.GetAssistedError(err)
+	}
+
+	infraEnvsResult, err := bmInventory.Installer.ListInfraEnvs(ctx, &installer.ListInfraEnvsParams{ClusterID: &clusterID})
+	if err != nil {
+		return nil, errorutil.GetAssistedError(err)
+	}
+
+	manifestsResult, err := bmInventory.Manifests.V2ListClusterManifests(ctx, &manifests.V2ListClusterManifestsParams{ClusterID: clusterID})
+	if err != nil {
+		return nil, errorutil.GetAssistedError(err)
+	}
+	manifestFiles := make(map[string][]byte)
+	for _, manifest := range manifestsResult.Payload {
+		var content bytes.Buffer
+		folder := manifest.Folder
+		if _, err = bmInventory.Manifests.V2DownloadClusterManifest(ctx, &manifests.V2DownloadClusterManifestParams{
+			ClusterID: clusterID,
+			FileName:  manifest.FileName,
+			Folder:    &folder,
+		}, &content); err != nil {
+			return nil, errorutil.GetAssistedError(err)
+		}
+		manifestFiles[path.Join(manifest.Folder, manifest.FileName)] = content.Bytes()
+	}
+
+	return GenerateZTPManifests(clusterResult.Payload, infraEnvsResult.Payload, manifestFiles, namespace)
+}
+
+// GenerateZTPManifests converts a cluster, its infra-envs and its manifests to the ClusterDeployment,
+// AgentClusterInstall, ClusterImageSet, InfraEnv, NMStateConfig, BareMetalHost and ConfigMap resources
+// that create the same cluster through the kube-api, keyed by file name. The resources are created in
+// the given namespace, or in a namespace named after the cluster if it is empty.
+// The manifests are keyed by their path within the manifests folders, e.g. openshift/50-masters.yaml. The
+// ConfigMap only holds the manifests of the openshift folder, which is where the kube-api creates the manifests
+// it references, the manifests of the other folders are returned as files at their path.
+func GenerateZTPManifests(cluster *models.Cluster, infraEnvs []*models.InfraEnv, manifestFiles map[string][]byte,
+	namespace string) (map[string][]byte, error) {
+
+	if namespace == "" {
+		namespace = cluster.Name
+	}
+
+	imageSet := exportClusterImageSet(cluster)
+	aci := exportAgentClusterInstall(cluster, namespace, imageSet.Name)
+	objects := map[string][]interface{}{
+		ClusterDeploymentFileName:   {exportClusterDeployment(cluster, namespace, aci.Name)},
+		AgentClusterInstallFileName: {aci},
+		ClusterImageSetFileName:     {imageSet},
+	}
+
+	configMapFiles := make(map[string][]byte)
+	folderFiles := make(map[string][]byte)
+	for filePath, content := range manifestFiles {
+		folder, fileName := path.Split(filePath)
+		if folder == "" || path.Clean(folder) == models.ManifestFolderOpenshift {
+			configMapFiles[fileName] = content
+		} else {
+			folderFiles[filePath] = content
+		}
+	}
+	if len(configMapFiles) > 0 {
+		configMap := exportManifestsConfigMap(cluster, namespace, configMapFiles)
+		aci.Spec.ManifestsConfigMapRefs = []hiveext.ManifestsConfigMapReference{{Name: configMap.Name}}
+		objects[ManifestsConfigMapFileName] = []interface{}{configMap}
+	}
+
+	infraEnvNames := make(map[strfmt.UUID]string)
+	for _, infraEnv := range infraEnvs {
+		nmStateConfigs, err := exportNMStateConfigs(infraEnv, namespace)
+		if err != nil {
+			return nil, err
+		}
+		objects[InfraEnvFileName] = append(objects[InfraEnvFileName], exportInfraEnv(cluster, infraEnv, namespace, len(nmStateConfigs) > 0))
+		for _, nmStateConfig := range nmStateConfigs {
+			objects[NMStateConfigFileName] = append(objects[NMStateConfigFileName], nmStateConfig)
+		}
+		infraEnvNames[*infraEnv.ID] = swag.StringValue(infraEnv.Name)
+	}
+
+	bmhNames := make(map[string]bool, len(cluster.Hosts))
+	for _, host := range cluster.Hosts {
+		bmh, err := exportBareMetalHost(host, namespace, infraEnvNames[host.InfraEnvID])
+		if err != nil {
+			return nil, err
+		}
+		// Different hostnames may have the same resource name
+		if bmhNames[bmh.Name] {
+			bmh.Name = host.ID.String()
+		}
+		bmhNames[bmh.Name] = true
+		objects[BareMetalHostsFileName] = append(objects[BareMetalHostsFileName], bmh)
+	}
+
+	files := make(map[string][]byte, len(objects))
+	for fileName, fileObjects := range objects {
+		docs := make([]string, 0, len(fileObjects))
+		for _, obj := range fileObjects {
+			doc, err := yaml.Marshal(obj)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal %s", fileName)
+			}
+			docs = append(docs, string(doc))
+		}
+		files[fileName] = []byte(strings.Join(docs, "---\n"))
+	}
+	for filePath, content := range folderFiles {
+		files[filePath] = content
+	}
+	return files, nil
+}
+
+func exportClusterImageSet(cluster *models.Cluster) *hivev1.ClusterImageSet {
+	return &hivev1.ClusterImageSet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: hivev1.SchemeGroupVersion.String(),
+			Kind:       "ClusterImageSet",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "openshift-" + cluster.OpenshiftVersion,
+		},
+		Spec: hivev1.ClusterImageSetSpec{
+			ReleaseImage: cluster.OcpReleaseImage,
+		},
+	}
+}
+
+func exportClusterDeployment(cluster *models.Cluster, namespace string, aciName string) *hivev1.ClusterDeployment {
+	return &hivev1.ClusterDeployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: hivev1.SchemeGroupVersion.String(),
+			Kind:       "ClusterDeployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cluster.Name,
+			Namespace: namespace,
+		},
+		Spec: hivev1.ClusterDeploymentSpec{
+			BaseDomain:  cluster.BaseDNSDomain,
+			ClusterName: cluster.Name,
+			ClusterInstallRef: &hivev1.ClusterInstallLocalReference{
+				Group:   hiveext.Group,
+				Version: hiveext.Version,
+				Kind:    "AgentClusterInstall",
+				Name:    aciName,
+			},
+			Platform: hivev1.Platform{
+				AgentBareMetal: &agent.BareMetalPlatform{
+					AgentSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{clusterNameLabel: cluster.Name},
+					},
+				},
+			},
+			PullSecretRef: &corev1.LocalObjectReference{Name: PullSecretName},
+		},
+	}
+}
+
+func exportAgentClusterInstall(cluster *models.Cluster, namespace string, imageSetName string) *hiveext.AgentClusterInstall {
+	aci := &hiveext.AgentClusterInstall{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: hiveext.GroupVersion.String(),
+			Kind:       "AgentClusterInstall",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cluster.Name,
+			Namespace: namespace,
+		},
+		Spec: hiveext.AgentClusterInstallSpec{
+			ImageSetRef:           &hivev1.ClusterImageSetReference{Name: imageSetName},
+			ClusterDeploymentRef:  corev1.LocalObjectReference{Name: cluster.Name},
+			SSHPublicKey:          cluster.SSHPublicKey,
+			APIVIP:                cluster.APIVip,
+			IngressVIP:            cluster.IngressVip,
+			PlatformType:          exportPlatformType(cluster.Platform),
+			ProvisionRequirements: exportProvisionRequirements(cluster),
+			Networking: hiveext.Networking{
+				UserManagedNetworking: cluster.UserManagedNetworking,
+				NetworkType:           swag.StringValue(cluster.NetworkType),
+			},
+		},
+	}
+
+	for _, network := range cluster.ClusterNetworks {
+		aci.Spec.Networking.ClusterNetwork = append(aci.Spec.Networking.ClusterNetwork, hiveext.ClusterNetworkEntry{
+			CIDR:       string(network.Cidr),
+			HostPrefix: int32(network.HostPrefix),
+		})
+	}
+	for _, network := range cluster.ServiceNetworks {
+		aci.Spec.Networking.ServiceNetwork = append(aci.Spec.Networking.ServiceNetwork, string(network.Cidr))
+	}
+	for _, network := range cluster.MachineNetworks {
+		aci.Spec.Networking.MachineNetwork = append(aci.Spec.Networking.MachineNetwork, hiveext.MachineNetworkEntry{
+			CIDR: string(network.Cidr),
+		})
+	}
+
+	exportHyperthreading(cluster.Hyperthreading, aci)
+
+	if cluster.DiskEncryption != nil && swag.StringValue(cluster.DiskEncryption.EnableOn) != models.DiskEncryptionEnableOnNone {
+		aci.Spec.DiskEncryption = &hiveext.DiskEncryption{
+			EnableOn:    cluster.DiskEncryption.EnableOn,
+			Mode:        cluster.DiskEncryption.Mode,
+			TangServers: cluster.DiskEncryption.TangServers,
+		}
+	}
+
+	if cluster.HTTPProxy != "" || cluster.HTTPSProxy != "" || cluster.NoProxy != "" {
+		aci.Spec.Proxy = &hiveext.Proxy{
+			HTTPProxy:  cluster.HTTPProxy,
+			HTTPSProxy: cluster.HTTPSProxy,
+			NoProxy:    cluster.NoProxy,
+		}
+	}
+
+	// The CA certificate of the ignition endpoint is referenced through a secret, which isn't exported
+	if cluster.IgnitionEndpoint != nil && swag.StringValue(cluster.IgnitionEndpoint.URL) != "" {
+		aci.Spec.IgnitionEndpoint = &hiveext.IgnitionEndpoint{Url: swag.StringValue(cluster.IgnitionEndpoint.URL)}
+	}
+
+	if cluster.InstallConfigOverrides != "" {
+		aci.Annotations = map[string]string{controllers.InstallConfigOverrides: cluster.InstallConfigOverrides}
+	}
+
+	return aci
+}
+
+func exportPlatformType(platform *models.Platform) hiveext.PlatformType {
+	if platform == nil || platform.Type == nil {
+		return ""
+	}
+
+	switch *platform.Type {
+	case models.PlatformTypeBaremetal:
+		return hiveext.BareMetalPlatformType
+	case models.PlatformTypeNone:
+		return hiveext.NonePlatformType
+	case models.PlatformTypeVsphere:
+		return hiveext.VSpherePlatformType
+	default:
+		return ""
+	}
+}
+
+func exportProvisionRequirements(cluster *models.Cluster) hiveext.ProvisionRequirements {
+	var requirements hiveext.ProvisionRequirements
+	for _, host := range cluster.Hosts {
+		switch host.Role {
+		case models.HostRoleMaster:
+			requirements.ControlPlaneAgents++
+		case models.HostRoleWorker:
+			requirements.WorkerAgents++
+		}
+	}
+
+	// Hosts with automatically assigned roles are counted by the installation, not the exported spec
+	if requirements.ControlPlaneAgents == 0 {
+		if swag.StringValue(cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
+			requirements.ControlPlaneAgents = 1
+		} else {
+			requirements.ControlPlaneAgents = 3
+		}
+	}
+	return requirements
+}
+
+// exportHyperthreading sets the machine pools of the AgentClusterInstall so that they are converted back to
+// the hyperthreading setting of the cluster. Without machine pools hyperthreading is enabled on all hosts.
+func exportHyperthreading(hyperthreading string, aci *hiveext.AgentClusterInstall) {
+	var masters, workers hiveext.HyperthreadingMode
+	switch hyperthreading {
+	case models.ClusterHyperthreadingNone:
+		masters, workers = hiveext.HyperthreadingDisabled, hiveext.HyperthreadingDisabled
+	case models.ClusterHyperthreadingMasters:
+		masters, workers = hiveext.HyperthreadingEnabled, hiveext.HyperthreadingDisabled
+	case models.ClusterHyperthreadingWorkers:
+		masters, workers = hiveext.HyperthreadingDisabled, hiveext.HyperthreadingEnabled
+	default:
+		return
+	}
+	aci.Spec.ControlPlane = &hiveext.AgentMachinePool{Name: hiveext.MasterAgentMachinePool, Hyperthreading: masters}
+	aci.Spec.Compute = []hiveext.AgentMachinePool{{Name: hiveext.WorkerAgentMachinePool, Hyperthreading: workers}}
+}
+
+// exportManifestsConfigMap puts the manifests of the cluster in a config map referenced by the AgentClusterInstall.
+// Manifests referenced by the AgentClusterInstall are all added to the openshift folder.
+func exportManifestsConfigMap(cluster *models.Cluster, namespace string, manifestFiles map[string][]byte) *corev1.ConfigMap {
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cluster.Name + "-manifests",
+			Namespace: namespace,
+		},
+		Data: make(map[string]string, len(manifestFiles)),
+	}
+	for fileName, content := range manifestFiles {
+		configMap.Data[fileName] = string(content)
+	}
+	return configMap
+}
+
+func exportInfraEnv(cluster *models.Cluster, infraEnv *models.InfraEnv, namespace string, hasNMStateConfigs bool) *aiv1beta1.InfraEnv {
+	name := swag.StringValue(infraEnv.Name)
+	exported := &aiv1beta1.InfraEnv{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: aiv1beta1.GroupVersion.String(),
+			Kind:       "InfraEnv",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: aiv1beta1.InfraEnvSpec{
+			ClusterRef:             &aiv1beta1.ClusterReference{Name: cluster.Name, Namespace: namespace},
+			AgentLabels:            map[string]string{clusterNameLabel: cluster.Name},
+			SSHAuthorizedKey:       infraEnv.SSHAuthorizedKey,
+			PullSecretRef:          &corev1.LocalObjectReference{Name: PullSecretName},
+			IgnitionConfigOverride: infraEnv.IgnitionConfigOverride,
+			CpuArchitecture:        infraEnv.CPUArchitecture,
+		},
+	}
+
+	if infraEnv.Proxy != nil {
+		exported.Spec.Proxy = &aiv1beta1.Proxy{
+			HTTPProxy:  swag.StringValue(infraEnv.Proxy.HTTPProxy),
+			HTTPSProxy: swag.StringValue(infraEnv.Proxy.HTTPSProxy),
+			NoProxy:    swag.StringValue(infraEnv.Proxy.NoProxy),
+		}
+	}
+
+	if infraEnv.AdditionalNtpSources != "" {
+		exported.Spec.AdditionalNTPSources = strings.Split(infraEnv.AdditionalNtpSources, ",")
+	}
+
+	if hasNMStateConfigs {
+		exported.Spec.NMStateConfigLabelSelector = metav1.LabelSelector{
+			MatchLabels: map[string]string{controllers.BMH_INFRA_ENV_LABEL: name},
+		}
+	}
+
+	return exported
+}
+
+func exportNMStateConfigs(infraEnv *models.InfraEnv, namespace string) ([]*aiv1beta1.NMStateConfig, error) {
+	if infraEnv.StaticNetworkConfig == "" {
+		return nil, nil
+	}
+
+	var staticNetworkConfig []*models.HostStaticNetworkConfig
+	if err := json.Unmarshal([]byte(infraEnv.StaticNetworkConfig), &staticNetworkConfig); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the static network config of infra-env %s", infraEnv.ID)
+	}
+
+	name := swag.StringValue(infraEnv.Name)
+	nmStateConfigs := make([]*aiv1beta1.NMStateConfig, 0, len(staticNetworkConfig))
+	for i, hostConfig := range staticNetworkConfig {
+		nmStateConfig := &aiv1beta1.NMStateConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: aiv1beta1.GroupVersion.String(),
+				Kind:       "NMStateConfig",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", name, i),
+				Namespace: namespace,
+				Labels:    map[string]string{controllers.BMH_INFRA_ENV_LABEL: name},
+			},
+			Spec: aiv1beta1.NMStateConfigSpec{
+				NetConfig: aiv1beta1.NetConfig{Raw: []byte(hostConfig.NetworkYaml)},
+			},
+		}
+		for _, item := range hostConfig.MacInterfaceMap {
+			nmStateConfig.Spec.Interfaces = append(nmStateConfig.Spec.Interfaces, &aiv1beta1.Interface{
+				Name:       item.LogicalNicName,
+				MacAddress: item.MacAddress,
+			})
+		}
+		nmStateConfigs = append(nmStateConfigs, nmStateConfig)
+	}
+	return nmStateConfigs, nil
+}
+
+// exportBareMetalHost creates a BareMetalHost that boots the host with the discovery image of its infra-env.
+// The BMC of the host isn't known to the service, so its address and credentials need to be filled in.
+func exportBareMetalHost(host *models.Host, namespace string, infraEnvName string) (*bmh_v1alpha1.BareMetalHost, error) {
+	hostname := host.RequestedHostname
+	bootMACAddress := ""
+	if host.Inventory != "" {
+		var inventory models.Inventory
+		if err := json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the inventory of host %s", host.ID)
+		}
+		if hostname == "" {
+			hostname = inventory.Hostname
+		}
+		bootMACAddress = getBootMACAddress(&inventory)
+	}
+	if hostname == "" {
+		hostname = host.ID.String()
+	}
+
+	annotations := map[string]string{
+		controllers.BMH_INSPECT_ANNOTATION: "disabled",
+		controllers.BMH_AGENT_HOSTNAME:     hostname,
+	}
+	if host.Role == models.HostRoleMaster || host.Role == models.HostRoleWorker {
+		annotations[controllers.BMH_AGENT_ROLE] = string(host.Role)
+	}
+	if host.MachineConfigPoolName != "" {
+		annotations[controllers.BMH_AGENT_MACHINE_CONFIG_POOL] = host.MachineConfigPoolName
+	}
+	if host.InstallerArgs != "" {
+		annotations[controllers.BMH_AGENT_INSTALLER_ARGS] = host.InstallerArgs
+	}
+	if host.IgnitionConfigOverrides != "" {
+		annotations[controllers.BMH_AGENT_IGNITION_CONFIG_OVERRIDES] = host.IgnitionConfigOverrides
+	}
+
+	return &bmh_v1alpha1.BareMetalHost{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: bmh_v1alpha1.GroupVersion.String(),
+			Kind:       "BareMetalHost",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        bareMetalHostName(hostname, host.ID),
+			Namespace:   namespace,
+			Labels:      map[string]string{controllers.BMH_INFRA_ENV_LABEL: infraEnvName},
+			Annotations: annotations,
+		},
+		Spec: bmh_v1alpha1.BareMetalHostSpec{
+			Online:                true,
+			BootMACAddress:        bootMACAddress,
+			AutomatedCleaningMode: bmh_v1alpha1.CleaningModeDisabled,
+		},
+	}, nil
+}
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// bareMetalHostName converts a hostname, which may have uppercase letters and underscores, to a valid resource name.
+// The hostname of the agent is set by an annotation, so the name doesn't need to match it.
+func bareMetalHostName(hostname string, hostID *strfmt.UUID) string {
+	name := invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(hostname), "-")
+	labels := make([]string, 0, strings.Count(name, ".")+1)
+	for _, label := range strings.Split(name, ".") {
+		if label = strings.Trim(label, "-"); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	name = strings.Join(labels, ".")
+	if len(validation.IsDNS1123Subdomain(name)) > 0 {
+		return hostID.String()
+	}
+	return name
+}
+
+// getBootMACAddress returns the MAC address of the first interface with an IP address, which is the
+// interface the host was reached through when it was discovered
+func getBootMACAddress(inventory *models.Inventory) string {
+	macAddress := ""
+	for _, intf := range inventory.Interfaces {
+		if intf.MacAddress == "" {
+			continue
+		}
+		if len(intf.IPV4Addresses) > 0 || len(intf.IPV6Addresses) > 0 {
+			return intf.MacAddress
+		}
+		if macAddress == "" {
+			macAddress = intf.MacAddress
+		}
+	}
+	return macAddress
+}
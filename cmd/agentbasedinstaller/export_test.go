@@ -0,0 +1,266 @@
+package agentbasedinstaller
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/controller/controllers"
+	"github.com/openshift/assisted-service/models"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("GenerateZTPManifests", func() {
+	var (
+		cluster  *models.Cluster
+		infraEnv *models.InfraEnv
+	)
+
+	BeforeEach(func() {
+		clusterID := strfmt.UUID("e679ea3f-3b85-40e0-8dc9-82fd6945d9b2")
+		infraEnvID := strfmt.UUID("a5f0c2a0-5ef5-4a1d-8b5f-2a5d3d8b6f0e")
+		hostID := strfmt.UUID("0a4f1d12-2b5c-4ad4-9e7f-0c9b1f2d3e4a")
+		inventory, err := json.Marshal(&models.Inventory{
+			Hostname: "discovered",
+			Interfaces: []*models.Interface{
+				{MacAddress: "52:54:00:00:00:01"},
+				{MacAddress: "52:54:00:00:00:02", IPV4Addresses: []string{"192.168.111.10/24"}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		cluster = &models.Cluster{
+			ID:                     &clusterID,
+			Name:                   "test-cluster",
+			BaseDNSDomain:          "example.com",
+			OpenshiftVersion:       "4.11.0",
+			OcpReleaseImage:        "quay.io/openshift-release-dev/ocp-release:4.11.0-x86_64",
+			APIVip:                 "192.168.111.5",
+			IngressVip:             "192.168.111.4",
+			SSHPublicKey:           "ssh-rsa key",
+			UserManagedNetworking:  swag.Bool(false),
+			NetworkType:            swag.String(models.ClusterNetworkTypeOVNKubernetes),
+			Platform:               &models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeBaremetal)},
+			Hyperthreading:         models.ClusterHyperthreadingMasters,
+			HTTPProxy:              "http://proxy.example.com",
+			ClusterNetworks:        []*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}},
+			ServiceNetworks:        []*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}},
+			MachineNetworks:        []*models.MachineNetwork{{Cidr: "192.168.111.0/24"}},
+			InstallConfigOverrides: `{"fips": true}`,
+			Hosts: []*models.Host{{
+				ID:                &hostID,
+				InfraEnvID:        infraEnvID,
+				Role:              models.HostRoleMaster,
+				RequestedHostname: "master-0",
+				Inventory:         string(inventory),
+			}},
+		}
+		infraEnv = &models.InfraEnv{
+			ID:                   &infraEnvID,
+			Name:                 swag.String("test-infraenv"),
+			SSHAuthorizedKey:     "ssh-rsa key",
+			AdditionalNtpSources: "ntp1.example.com,ntp2.example.com",
+			StaticNetworkConfig: `[{"mac_interface_map":[{"logical_nic_name":"eth0","mac_address":"52:54:00:00:00:02"}],` +
+				`"network_yaml":"interfaces:\n- name: eth0\n  type: ethernet\n"}]`,
+		}
+	})
+
+	It("converts the cluster to kube-api resources", func() {
+		files, err := GenerateZTPManifests(cluster, []*models.InfraEnv{infraEnv}, map[string][]byte{"openshift/custom.yaml": []byte("a: b")}, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(7))
+
+		var cd hivev1.ClusterDeployment
+		Expect(yaml.Unmarshal(files[ClusterDeploymentFileName], &cd)).To(Succeed())
+		Expect(cd.Namespace).To(Equal("test-cluster"))
+		Expect(cd.Spec.BaseDomain).To(Equal("example.com"))
+		Expect(cd.Spec.ClusterInstallRef.Name).To(Equal("test-cluster"))
+		Expect(cd.Spec.PullSecretRef.Name).To(Equal(PullSecretName))
+
+		var imageSet hivev1.ClusterImageSet
+		Expect(yaml.Unmarshal(files[ClusterImageSetFileName], &imageSet)).To(Succeed())
+		Expect(imageSet.Spec.ReleaseImage).To(Equal(cluster.OcpReleaseImage))
+
+		var aci hiveext.AgentClusterInstall
+		Expect(yaml.Unmarshal(files[AgentClusterInstallFileName], &aci)).To(Succeed())
+		Expect(aci.Spec.ImageSetRef.Name).To(Equal(imageSet.Name))
+		Expect(aci.Spec.APIVIP).To(Equal(cluster.APIVip))
+		Expect(aci.Spec.PlatformType).To(Equal(hiveext.BareMetalPlatformType))
+		Expect(aci.Spec.ProvisionRequirements.ControlPlaneAgents).To(Equal(1))
+		Expect(aci.Spec.Networking.ClusterNetwork).To(Equal([]hiveext.ClusterNetworkEntry{{CIDR: "10.128.0.0/14", HostPrefix: 23}}))
+		Expect(aci.Spec.Networking.ServiceNetwork).To(Equal([]string{"172.30.0.0/16"}))
+		Expect(aci.Spec.Networking.NetworkType).To(Equal(models.ClusterNetworkTypeOVNKubernetes))
+		Expect(aci.Spec.ControlPlane.Hyperthreading).To(Equal(hiveext.HyperthreadingEnabled))
+		Expect(aci.Spec.Compute[0].Hyperthreading).To(Equal(hiveext.HyperthreadingDisabled))
+		Expect(aci.Spec.Proxy.HTTPProxy).To(Equal(cluster.HTTPProxy))
+		Expect(aci.Annotations[controllers.InstallConfigOverrides]).To(Equal(cluster.InstallConfigOverrides))
+		Expect(aci.Spec.ManifestsConfigMapRefs).To(Equal([]hiveext.ManifestsConfigMapReference{{Name: "test-cluster-manifests"}}))
+
+		var exportedInfraEnv aiv1beta1.InfraEnv
+		Expect(yaml.Unmarshal(files[InfraEnvFileName], &exportedInfraEnv)).To(Succeed())
+		Expect(exportedInfraEnv.Spec.ClusterRef.Name).To(Equal("test-cluster"))
+		Expect(exportedInfraEnv.Spec.AdditionalNTPSources).To(Equal([]string{"ntp1.example.com", "ntp2.example.com"}))
+		Expect(exportedInfraEnv.Spec.NMStateConfigLabelSelector.MatchLabels).To(HaveKeyWithValue(controllers.BMH_INFRA_ENV_LABEL, "test-infraenv"))
+
+		var nmStateConfig aiv1beta1.NMStateConfig
+		Expect(yaml.Unmarshal(files[NMStateConfigFileName], &nmStateConfig)).To(Succeed())
+		Expect(nmStateConfig.Labels).To(Equal(exportedInfraEnv.Spec.NMStateConfigLabelSelector.MatchLabels))
+		Expect(nmStateConfig.Spec.Interfaces).To(HaveLen(1))
+		Expect(nmStateConfig.Spec.Interfaces[0].MacAddress).To(Equal("52:54:00:00:00:02"))
+		Expect(string(nmStateConfig.Spec.NetConfig.Raw)).To(ContainSubstring("name: eth0"))
+
+		var bmh bmh_v1alpha1.BareMetalHost
+		Expect(yaml.Unmarshal(files[BareMetalHostsFileName], &bmh)).To(Succeed())
+		Expect(bmh.Name).To(Equal("master-0"))
+		Expect(bmh.Spec.BootMACAddress).To(Equal("52:54:00:00:00:02"))
+		Expect(bmh.Labels).To(HaveKeyWithValue(controllers.BMH_INFRA_ENV_LABEL, "test-infraenv"))
+		Expect(bmh.Annotations).To(HaveKeyWithValue(controllers.BMH_AGENT_ROLE, "master"))
+	})
+
+	It("keeps the folders of the manifests", func() {
+		files, err := GenerateZTPManifests(cluster, nil, map[string][]byte{
+			"openshift/custom.yaml": []byte("a: b"),
+			"manifests/custom.yaml": []byte("c: d"),
+		}, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveKeyWithValue("manifests/custom.yaml", []byte("c: d")))
+
+		var configMap corev1.ConfigMap
+		Expect(yaml.Unmarshal(files[ManifestsConfigMapFileName], &configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"custom.yaml": "a: b"}))
+	})
+
+	It("doesn't reference a ConfigMap without manifests in the openshift folder", func() {
+		files, err := GenerateZTPManifests(cluster, nil, map[string][]byte{"manifests/custom.yaml": []byte("c: d")}, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).ToNot(HaveKey(ManifestsConfigMapFileName))
+
+		var aci hiveext.AgentClusterInstall
+		Expect(yaml.Unmarshal(files[AgentClusterInstallFileName], &aci)).To(Succeed())
+		Expect(aci.Spec.ManifestsConfigMapRefs).To(BeEmpty())
+	})
+
+	It("round-trips the cluster params", func() {
+		files, err := GenerateZTPManifests(cluster, nil, nil, "ztp")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).ToNot(HaveKey(ManifestsConfigMapFileName))
+		Expect(files).ToNot(HaveKey(InfraEnvFileName))
+
+		var cd hivev1.ClusterDeployment
+		Expect(yaml.Unmarshal(files[ClusterDeploymentFileName], &cd)).To(Succeed())
+		Expect(cd.Namespace).To(Equal("ztp"))
+		var aci hiveext.AgentClusterInstall
+		Expect(yaml.Unmarshal(files[AgentClusterInstallFileName], &aci)).To(Succeed())
+
+		params := controllers.CreateClusterParams(&cd, &aci, "secret", cluster.OpenshiftVersion, "x86_64", nil)
+		Expect(params.BaseDNSDomain).To(Equal(cluster.BaseDNSDomain))
+		Expect(swag.StringValue(params.Name)).To(Equal(cluster.Name))
+		Expect(params.APIVip).To(Equal(cluster.APIVip))
+		Expect(params.IngressVip).To(Equal(cluster.IngressVip))
+		Expect(params.SSHPublicKey).To(Equal(cluster.SSHPublicKey))
+		Expect(swag.StringValue(params.Hyperthreading)).To(Equal(cluster.Hyperthreading))
+		Expect(swag.StringValue(params.HTTPProxy)).To(Equal(cluster.HTTPProxy))
+		Expect(*params.Platform.Type).To(Equal(models.PlatformTypeBaremetal))
+		Expect(params.ClusterNetworks).To(Equal(cluster.ClusterNetworks))
+		Expect(params.ServiceNetworks).To(Equal(cluster.ServiceNetworks))
+		Expect(params.MachineNetworks).To(Equal(cluster.MachineNetworks))
+	})
+
+	It("names the BareMetalHosts with valid resource names", func() {
+		secondHostID := strfmt.UUID("5d0a7b1e-3c2f-4e8a-9b6d-1f2e3a4b5c6d")
+		thirdHostID := strfmt.UUID("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d")
+		cluster.Hosts[0].RequestedHostname = "Master_0.Example.COM"
+		cluster.Hosts = append(cluster.Hosts,
+			&models.Host{ID: &secondHostID, InfraEnvID: *infraEnv.ID, RequestedHostname: "master-0.example.com"},
+			&models.Host{ID: &thirdHostID, InfraEnvID: *infraEnv.ID, RequestedHostname: "___"})
+		files, err := GenerateZTPManifests(cluster, []*models.InfraEnv{infraEnv}, nil, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		path := filepath.Join(GinkgoT().TempDir(), BareMetalHostsFileName)
+		Expect(os.WriteFile(path, files[BareMetalHostsFileName], 0600)).To(Succeed())
+		documents, err := getFileDocuments(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(documents).To(HaveLen(3))
+		var names, hostnames []string
+		for _, document := range documents {
+			var bmh bmh_v1alpha1.BareMetalHost
+			Expect(yaml.Unmarshal(document, &bmh)).To(Succeed())
+			names = append(names, bmh.Name)
+			hostnames = append(hostnames, bmh.Annotations[controllers.BMH_AGENT_HOSTNAME])
+		}
+		Expect(names).To(Equal([]string{"master-0.example.com", secondHostID.String(), thirdHostID.String()}))
+		Expect(hostnames).To(Equal([]string{"Master_0.Example.COM", "master-0.example.com", "___"}))
+	})
+
+	It("registers the static network config of all the hosts", func() {
+		infraEnv.StaticNetworkConfig = `[` +
+			`{"mac_interface_map":[{"logical_nic_name":"eth0","mac_address":"52:54:00:00:00:02"}],` +
+			`"network_yaml":"interfaces:\n- name: eth0\n  type: ethernet\n"},` +
+			`{"mac_interface_map":[{"logical_nic_name":"eth0","mac_address":"52:54:00:00:00:12"}],` +
+			`"network_yaml":"interfaces:\n- name: eth0\n  type: ethernet\n  state: up\n"}]`
+		secondHostID := strfmt.UUID("5d0a7b1e-3c2f-4e8a-9b6d-1f2e3a4b5c6d")
+		cluster.Hosts = append(cluster.Hosts, &models.Host{ID: &secondHostID, InfraEnvID: *infraEnv.ID,
+			Role: models.HostRoleMaster, RequestedHostname: "master-1"})
+		files, err := GenerateZTPManifests(cluster, []*models.InfraEnv{infraEnv}, nil, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		dir := GinkgoT().TempDir()
+		for fileName, content := range files {
+			Expect(os.WriteFile(filepath.Join(dir, fileName), content, 0600)).To(Succeed())
+		}
+		transport := &registerInfraEnvTransport{}
+		_, err = RegisterInfraEnv(context.Background(), logrus.New(), &client.AssistedInstall{Installer: installer.New(transport, nil, nil)},
+			"secret", cluster, filepath.Join(dir, InfraEnvFileName), filepath.Join(dir, NMStateConfigFileName), string(models.ImageTypeFullIso))
+		Expect(err).ToNot(HaveOccurred())
+
+		var expected []*models.HostStaticNetworkConfig
+		Expect(json.Unmarshal([]byte(infraEnv.StaticNetworkConfig), &expected)).To(Succeed())
+		registered := transport.params.InfraenvCreateParams.StaticNetworkConfig
+		Expect(registered).To(HaveLen(2))
+		for i := range expected {
+			Expect(registered[i].MacInterfaceMap).To(Equal(expected[i].MacInterfaceMap))
+			Expect(registered[i].NetworkYaml).To(MatchYAML(expected[i].NetworkYaml))
+		}
+	})
+
+	It("doesn't register several infra-envs", func() {
+		secondInfraEnvID := strfmt.UUID("3e2d1c0b-9a8f-4e7d-a6c5-b4a3f2e1d0c9")
+		secondInfraEnv := &models.InfraEnv{ID: &secondInfraEnvID, Name: swag.String("second-infraenv")}
+		files, err := GenerateZTPManifests(cluster, []*models.InfraEnv{infraEnv, secondInfraEnv}, nil, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		dir := GinkgoT().TempDir()
+		for fileName, content := range files {
+			Expect(os.WriteFile(filepath.Join(dir, fileName), content, 0600)).To(Succeed())
+		}
+		_, err = RegisterInfraEnv(context.Background(), logrus.New(), &client.AssistedInstall{Installer: installer.New(&registerInfraEnvTransport{}, nil, nil)},
+			"secret", cluster, filepath.Join(dir, InfraEnvFileName), filepath.Join(dir, NMStateConfigFileName), string(models.ImageTypeFullIso))
+		Expect(err).To(MatchError(ContainSubstring("should hold a single infraenv, found 2")))
+	})
+})
+
+// registerInfraEnvTransport records the params of the infra-env registration
+type registerInfraEnvTransport struct {
+	params *installer.RegisterInfraEnvParams
+}
+
+func (t *registerInfraEnvTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	t.params = op.Params.(*installer.RegisterInfraEnvParams)
+	infraEnvID := strfmt.UUID("a5f0c2a0-5ef5-4a1d-8b5f-2a5d3d8b6f0e")
+	return &installer.RegisterInfraEnvCreated{Payload: &models.InfraEnv{ID: &infraEnvID}}, nil
+}
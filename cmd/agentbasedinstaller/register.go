@@ -1,9 +1,12 @@
 package agentbasedinstaller
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"reflect"
@@ -22,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/yaml"
 )
 
@@ -85,17 +89,31 @@ func RegisterInfraEnv(ctx context.Context, log *log.Logger, bmInventory *client.
 
 	log.Info("Registering infraenv")
 
+	infraEnvDocuments, infraenvErr := getFileDocuments(infraEnvPath)
+	if infraenvErr != nil {
+		return nil, infraenvErr
+	}
+	// All the hosts boot the discovery image of a single infra-env
+	if len(infraEnvDocuments) != 1 {
+		return nil, errors.Errorf("%s should hold a single infraenv, found %d", infraEnvPath, len(infraEnvDocuments))
+	}
 	var infraEnv aiv1beta1.InfraEnv
-	if infraenvErr := getFileData(infraEnvPath, &infraEnv); infraenvErr != nil {
+	if infraenvErr = unmarshalDocument(infraEnvPath, infraEnvDocuments[0], &infraEnv); infraenvErr != nil {
 		return nil, infraenvErr
 	}
 
-	var nmStateConfig aiv1beta1.NMStateConfig
-	if nmStateErr := getFileData(nmStateConfigPath, &nmStateConfig); nmStateErr != nil {
+	nmStateDocuments, nmStateErr := getFileDocuments(nmStateConfigPath)
+	if nmStateErr != nil {
 		return nil, nmStateErr
 	}
+	nmStateConfigs := make([]aiv1beta1.NMStateConfig, len(nmStateDocuments))
+	for i, document := range nmStateDocuments {
+		if nmStateErr = unmarshalDocument(nmStateConfigPath, document, &nmStateConfigs[i]); nmStateErr != nil {
+			return nil, nmStateErr
+		}
+	}
 
-	staticNetworkConfig, processErr := processNMStateConfig(log, infraEnv, nmStateConfig)
+	staticNetworkConfig, processErr := processNMStateConfigs(log, infraEnv, nmStateConfigs)
 	if processErr != nil {
 		return nil, processErr
 	}
@@ -174,6 +192,36 @@ func getFileData(filePath string, output interface{}) error {
 	return err
 }
 
+// Read a Yaml file and split it to its documents, a file may hold several resources of the same kind
+func getFileDocuments(filePath string) ([][]byte, error) {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file %s: %w", filePath, err)
+	}
+
+	var documents [][]byte
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(contents)))
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading the documents of %s: %w", filePath, err)
+		}
+		if len(bytes.TrimSpace(document)) > 0 {
+			documents = append(documents, document)
+		}
+	}
+}
+
+func unmarshalDocument(filePath string, document []byte, output interface{}) error {
+	if err := yaml.Unmarshal(document, output); err != nil {
+		return fmt.Errorf("error unmarshalling contents of %s: %w", filePath, err)
+	}
+	return nil
+}
+
 func getReleaseVersion(clusterImageSetPath string) (string, error) {
 	var clusterImageSet hivev1.ClusterImageSet
 	if err := getFileData(clusterImageSetPath, &clusterImageSet); err != nil {
@@ -221,17 +269,17 @@ func validateNMStateConfigAndInfraEnv(nmStateConfig aiv1beta1.NMStateConfig, inf
 	return nil
 }
 
-func processNMStateConfig(log log.FieldLogger, infraEnv aiv1beta1.InfraEnv, nmStateConfig aiv1beta1.NMStateConfig) ([]*models.HostStaticNetworkConfig, error) {
-
-	err := validateNMStateConfigAndInfraEnv(nmStateConfig, infraEnv)
-	if err != nil {
-		return nil, err
-	}
+func processNMStateConfigs(log log.FieldLogger, infraEnv aiv1beta1.InfraEnv, nmStateConfigs []aiv1beta1.NMStateConfig) ([]*models.HostStaticNetworkConfig, error) {
 
 	var staticNetworkConfig []*models.HostStaticNetworkConfig
-	staticNetworkConfig = append(staticNetworkConfig, &models.HostStaticNetworkConfig{
-		MacInterfaceMap: controllers.BuildMacInterfaceMap(log, nmStateConfig),
-		NetworkYaml:     string(nmStateConfig.Spec.NetConfig.Raw),
-	})
+	for _, nmStateConfig := range nmStateConfigs {
+		if err := validateNMStateConfigAndInfraEnv(nmStateConfig, infraEnv); err != nil {
+			return nil, errors.Wrapf(err, "nmstateconfig %s", nmStateConfig.Name)
+		}
+		staticNetworkConfig = append(staticNetworkConfig, &models.HostStaticNetworkConfig{
+			MacInterfaceMap: controllers.BuildMacInterfaceMap(log, nmStateConfig),
+			NetworkYaml:     string(nmStateConfig.Spec.NetConfig.Raw),
+		})
+	}
 	return staticNetworkConfig, nil
 }
@@ -19,9 +19,11 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	metal3_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift/assisted-image-service/pkg/servers"
+	"github.com/openshift/assisted-service/internal/audit"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
@@ -29,10 +31,13 @@ import (
 	"github.com/openshift/assisted-service/internal/domains"
 	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/eventsubscriptions"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/healthcheck"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
+	"github.com/openshift/assisted-service/internal/hostchanges"
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/infraenv"
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
@@ -64,6 +69,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/pkg/thread"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -99,6 +105,9 @@ const (
 
 var Options struct {
 	Auth                           auth.Config
+	AuditConfig                    audit.Config
+	KubeEventsConfig               controllers.KubeEventsConfig
+	HealthCheckConfig              healthcheck.Config
 	BMConfig                       bminventory.Config
 	DBConfig                       dbPkg.Config
 	HWValidatorConfig              hardware.ValidatorCfg
@@ -106,6 +115,8 @@ var Options struct {
 	InstructionConfig              hostcommands.InstructionConfig
 	OperatorsConfig                operators.Options
 	GCConfig                       garbagecollector.Config
+	EventSubscriptionsConfig       eventsubscriptions.Config
+	EventsRetentionConfig          events.RetentionConfig
 	ClusterStateMonitorInterval    time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
 	S3Config                       s3wrapper.Config
 	HostStateMonitorInterval       time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
@@ -121,6 +132,7 @@ var Options struct {
 	Storage                        string `envconfig:"STORAGE" default:"s3"`
 	OCMConfig                      ocm.Config
 	HostConfig                     host.Config
+	HostChangesConfig              hostchanges.Config
 	LogConfig                      logconfig.Config
 	LeaderConfig                   leader.Config
 	ValidationsConfig              validations.Config
@@ -141,6 +153,7 @@ var Options struct {
 	ConnMaxLifetime                time.Duration `envconfig:"DB_CONNECTIONS_MAX_LIFETIME" default:"30m"`
 	FileSystemUsageThreshold       int           `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
 	EnableElasticAPM               bool          `envconfig:"ENABLE_ELASTIC_APM" default:"false"`
+	TracingConfig                  tracing.Config
 	WorkDir                        string        `envconfig:"WORK_DIR" default:"/data/"`
 	LivenessValidationTimeout      time.Duration `envconfig:"LIVENESS_VALIDATION_TIMEOUT" default:"5m"`
 	ApproveCsrsRequeueDuration     time.Duration `envconfig:"APPROVE_CSRS_REQUEUE_DURATION" default:"1m"`
@@ -245,9 +258,20 @@ func main() {
 
 	failOnError(os.MkdirAll(Options.BMConfig.ISOCacheDir, 0700), "Failed to create ISO cache directory %s", Options.BMConfig.ISOCacheDir)
 
+	shutdownTracing, err := tracing.Init(context.Background(), Options.TracingConfig, log.WithField("pkg", "tracing"))
+	failOnError(err, "failed to initialize tracing")
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.WithError(err).Warn("Failed to flush the traces")
+		}
+	}()
+
 	// Connect to db
 	db := setupDB(log)
 	defer common.CloseDB(db)
+	if Options.TracingConfig.Enabled {
+		failOnError(db.Use(tracing.GormPlugin{}), "failed to trace the database operations")
+	}
 
 	ctrlMgr, err := createControllerManager()
 	failOnError(err, "failed to create controller manager")
@@ -260,7 +284,8 @@ func main() {
 	authzHandler := auth.NewAuthzHandler(&Options.Auth, ocmClient, log.WithField("pkg", "authz"), db)
 
 	crdEventsHandler := createCRDEventsHandler()
-	eventsHandler := createEventsHandler(crdEventsHandler, db, authzHandler, log)
+	kubeEvents := createKubeEvents(ctrlMgr, db, log)
+	eventsHandler := createEventsHandler(crdEventsHandler, kubeEvents, db, authzHandler, log)
 
 	prometheusRegistry := prometheus.DefaultRegisterer
 	metricsManager := metrics.NewMetricsManager(prometheusRegistry, eventsHandler)
@@ -309,7 +334,7 @@ func main() {
 	Options.InstructionConfig.DisabledSteps = disableFreeAddressesIfNeeded(Options.EnableKubeAPI, Options.InstructionConfig.DisabledSteps)
 	Options.InstructionConfig.HostFSMountDir = hostFSMountDir
 	instructionApi := hostcommands.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator,
-		releaseHandler, Options.InstructionConfig, connectivityValidator, eventsHandler, versionHandler)
+		releaseHandler, Options.InstructionConfig, connectivityValidator, eventsHandler, versionHandler, metricsManager)
 
 	images := []string{
 		Options.ReleaseImageMirror,
@@ -373,16 +398,15 @@ func main() {
 		eventsHandler, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager, ocmClient, objectHandler, dnsApi, authHandler)
 	infraEnvApi := infraenv.NewManager(log.WithField("pkg", "host-state"), db, objectHandler)
 
-	clusterStateMonitor := thread.New(
-		log.WithField("pkg", "cluster-monitor"), "Cluster State Monitor", Options.ClusterStateMonitorInterval, clusterApi.ClusterMonitoring)
-	clusterStateMonitor.Start()
-	defer clusterStateMonitor.Stop()
-
 	hostStateMonitor := thread.New(
 		log.WithField("pkg", "host-monitor"), "Host State Monitor", Options.HostStateMonitorInterval, hostApi.HostMonitoring)
 	hostStateMonitor.Start()
 	defer hostStateMonitor.Stop()
 
+	hostChangesListener := hostchanges.NewListener(log.WithField("pkg", "host-changes"), dbConnectionString(), Options.HostChangesConfig)
+	hostChangesListener.Start()
+	defer hostChangesListener.Stop()
+
 	newUrl, err := s3wrapper.FixEndpointURL(Options.BMConfig.S3EndpointURL)
 	failOnError(err, "failed to create valid bm config S3 endpoint URL from %s", Options.BMConfig.S3EndpointURL)
 	Options.BMConfig.S3EndpointURL = newUrl
@@ -439,17 +463,62 @@ func main() {
 	serverInfo := servers.New(Options.HTTPListenPort, swag.StringValue(port), Options.HTTPSKeyFile, Options.HTTPSCertFile)
 	generateInsecureIPXEURLs := serverInfo.HTTP != nil
 
+	clusterTemplatesManager := clustertemplates.NewManager(db, log.WithField("pkg", "cluster-templates"), authzHandler, manifestsApi)
 	bm := bminventory.NewBareMetalInventory(db, log.WithField("pkg", "Inventory"), hostApi, clusterApi, infraEnvApi, Options.BMConfig,
 		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, authzHandler, ocpClient, ocmClient,
 		lead, pullSecretValidator, versionHandler, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
-		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs)
+		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, hostChangesListener, clusterTemplatesManager)
 
-	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
+	// The cluster monitor starts the scheduled installations through the inventory, so it is started once it exists
+	clusterApi.SetInstallationStarter(bm)
+	clusterStateMonitor := thread.New(
+		log.WithField("pkg", "cluster-monitor"), "Cluster State Monitor", Options.ClusterStateMonitorInterval, clusterApi.ClusterMonitoring)
+	clusterStateMonitor.Start()
+	defer clusterStateMonitor.Stop()
+	installationDurationsRefresher := thread.New(log.WithField("pkg", "cluster-state"), "Installation Durations Refresher",
+		Options.ClusterConfig.EtaRefreshInterval, clusterApi.RefreshInstallationDurations)
+	go clusterApi.RefreshInstallationDurations()
+	installationDurationsRefresher.Start()
+	defer installationDurationsRefresher.Stop()
+
+	healthChecker := healthcheck.NewChecker(Options.HealthCheckConfig, log.WithField("pkg", "healthcheck"), metricsManager)
+	healthChecker.AddComponent("database", true, healthcheck.DatabaseCheck(db, metricsManager))
+	healthChecker.AddComponent("object-storage", true, healthcheck.ObjectStorageCheck(objectHandler))
+	healthChecker.AddComponent("monitors", true, healthcheck.ThreadsCheck(
+		[]*thread.Thread{hostStateMonitor, clusterStateMonitor}, Options.LivenessValidationTimeout))
+	healthChecker.AddComponent("leader-election", false, healthcheck.LeaderCheck(lead, metricsManager))
+	healthChecker.AddComponent("image-service", false, healthcheck.ImageServiceCheck(Options.BMConfig.ImageServiceBaseURL))
+	healthChecker.AddComponent("ocm", false, healthcheck.OCMCheck(ocmClient))
+	healthChecker.Start()
+	defer healthChecker.Stop()
+
+	eventsArchiver := events.NewArchiver(Options.EventsRetentionConfig, db, log.WithField("pkg", "events-retention"),
+		authzHandler, objectHandler, lead)
+	eventsRetentionWorker := thread.New(log.WithField("pkg", "events-retention"), "Events Retention Worker",
+		Options.EventsRetentionConfig.RetentionInterval, eventsArchiver.PruneEvents)
+	eventsRetentionWorker.Start()
+	defer eventsRetentionWorker.Stop()
+
+	events := events.NewApi(eventsHandler, eventsArchiver, logrus.WithField("pkg", "eventsApi"))
+
+	auditor, err := audit.NewAuditor(Options.AuditConfig, db, log.WithField("pkg", "audit"))
+	failOnError(err, "failed to create the auditor")
+	defer auditor.Close()
+	// The changes that the controllers make through the inventory are audited as kube-api changes
+	kubeInstaller := audit.NewInstallerRecorder(auditor, bm)
+
+	eventSubscriptionsManager := eventsubscriptions.NewManager(Options.EventSubscriptionsConfig, db,
+		log.WithField("pkg", "event-subscriptions"), authzHandler, lead)
+	eventDeliveryWorker := thread.New(log.WithField("pkg", "event-subscriptions"), "Event Delivery Worker",
+		Options.EventSubscriptionsConfig.DeliveryInterval, eventSubscriptionsManager.DeliverEvents)
+	eventDeliveryWorker.Start()
+	defer eventDeliveryWorker.Stop()
 
 	//Set inner handler chain. Inner handlers requires access to the Route
 	innerHandler := func() func(http.Handler) http.Handler {
 		return func(h http.Handler) http.Handler {
 			wrapped := metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(h)
+			wrapped = auditor.Middleware(wrapped)
 
 			if Options.EnableElasticAPM {
 				// For APM metrics, we only want to trace openapi (internal) requests.
@@ -460,6 +529,10 @@ func main() {
 				wrapped = apmhttp.Wrap(wrapped, apmOptions)
 			}
 
+			if Options.TracingConfig.Enabled {
+				wrapped = tracing.Middleware(wrapped)
+			}
+
 			wrapped = paramctx.ContextHandler()(wrapped)
 			return wrapped
 		}
@@ -467,21 +540,24 @@ func main() {
 
 	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi)
 	h, err := restapi.Handler(restapi.Config{
-		AuthAgentAuth:       authHandler.AuthAgentAuth,
-		AuthUserAuth:        authHandler.AuthUserAuth,
-		AuthURLAuth:         authHandler.AuthURLAuth,
-		AuthImageAuth:       authHandler.AuthImageAuth,
-		AuthImageURLAuth:    authHandler.AuthImageAuth,
-		APIKeyAuthenticator: authHandler.CreateAuthenticator(),
-		Authorizer:          authzHandler.CreateAuthorizer(),
-		InstallerAPI:        bm,
-		EventsAPI:           events,
-		Logger:              log.Printf,
-		VersionsAPI:         versionHandler,
-		ManagedDomainsAPI:   domainHandler,
-		InnerMiddleware:     innerHandler(),
-		ManifestsAPI:        manifestsApi,
-		OperatorsAPI:        operatorsHandler,
+		AuthAgentAuth:         authHandler.AuthAgentAuth,
+		AuthUserAuth:          authHandler.AuthUserAuth,
+		AuthURLAuth:           authHandler.AuthURLAuth,
+		AuthImageAuth:         authHandler.AuthImageAuth,
+		AuthImageURLAuth:      authHandler.AuthImageAuth,
+		APIKeyAuthenticator:   authHandler.CreateAuthenticator(),
+		Authorizer:            auditor.Authorizer(authzHandler.CreateAuthorizer()),
+		InstallerAPI:          bm,
+		EventsAPI:             events,
+		Logger:                log.Printf,
+		VersionsAPI:           versionHandler,
+		ManagedDomainsAPI:     domainHandler,
+		InnerMiddleware:       innerHandler(),
+		ManifestsAPI:          manifestsApi,
+		OperatorsAPI:          operatorsHandler,
+		ClusterTemplatesAPI:   clusterTemplatesManager,
+		EventSubscriptionsAPI: eventSubscriptionsManager,
+		AuditAPI:              auditor,
 	})
 	failOnError(err, "Failed to init rest handler")
 
@@ -495,6 +571,7 @@ func main() {
 	h = app.WithMetricsResponderMiddleware(h)
 	h = app.WithHealthMiddleware(h, []*thread.Thread{hostStateMonitor, clusterStateMonitor},
 		log.WithField("pkg", "healthcheck"), Options.LivenessValidationTimeout)
+	h = healthChecker.Middleware(h)
 	h = requestid.Middleware(h)
 	h = spec.WithSpecMiddleware(h)
 
@@ -521,7 +598,7 @@ func main() {
 				APIReader:           r,
 				Config:              Options.InfraEnvConfig,
 				Log:                 log,
-				Installer:           bm,
+				Installer:           kubeInstaller,
 				CRDEventsHandler:    crdEventsHandler,
 				ServiceBaseURL:      Options.BMConfig.ServiceBaseURL,
 				ImageServiceBaseURL: Options.BMConfig.ImageServiceBaseURL,
@@ -538,7 +615,7 @@ func main() {
 				APIReader:         cluster_reader,
 				Log:               log,
 				Scheme:            ctrlMgr.GetScheme(),
-				Installer:         bm,
+				Installer:         kubeInstaller,
 				ClusterApi:        clusterApi,
 				HostApi:           hostApi,
 				CRDEventsHandler:  crdEventsHandler,
@@ -553,8 +630,9 @@ func main() {
 				APIReader:                  ctrlMgr.GetAPIReader(),
 				Log:                        log,
 				Scheme:                     ctrlMgr.GetScheme(),
-				Installer:                  bm,
+				Installer:                  kubeInstaller,
 				CRDEventsHandler:           crdEventsHandler,
+				Recorder:                   kubeEvents,
 				ServiceBaseURL:             Options.BMConfig.ServiceBaseURL,
 				AuthType:                   Options.Auth.AuthType,
 				SpokeK8sClientFactory:      spoke_k8s_client.NewSpokeK8sClientFactory(log),
@@ -584,6 +662,14 @@ func main() {
 				Log:    log,
 			}).SetupWithManager(ctrlMgr), "unable to create controller AgentClassification")
 
+			failOnError((&controllers.EventSubscriptionReconciler{
+				Client:             ctrlMgr.GetClient(),
+				APIReader:          ctrlMgr.GetAPIReader(),
+				Log:                log,
+				Installer:          kubeInstaller,
+				EventSubscriptions: eventSubscriptionsManager,
+			}).SetupWithManager(ctrlMgr), "unable to create controller EventSubscription")
+
 			failOnError((&controllers.AgentLabelReconciler{
 				Client: ctrlMgr.GetClient(),
 				Log:    log,
@@ -597,7 +683,7 @@ func main() {
 				failOnError((&controllers.PreprovisioningImageReconciler{
 					Client:                 ctrlMgr.GetClient(),
 					Log:                    log,
-					Installer:              bm,
+					Installer:              kubeInstaller,
 					CRDEventsHandler:       crdEventsHandler,
 					VersionsHandler:        versionHandler,
 					OcRelease:              releaseHandler,
@@ -644,9 +730,13 @@ func generateAPMTransactionName(request *http.Request) string {
 	return route.Operation.ID
 }
 
-func setupDB(log logrus.FieldLogger) *gorm.DB {
-	dbConnectionStr := fmt.Sprintf("host=%s port=%s user=%s database=%s password=%s sslmode=disable",
+func dbConnectionString() string {
+	return fmt.Sprintf("host=%s port=%s user=%s database=%s password=%s sslmode=disable",
 		Options.DBConfig.Host, Options.DBConfig.Port, Options.DBConfig.User, Options.DBConfig.Name, Options.DBConfig.Pass)
+}
+
+func setupDB(log logrus.FieldLogger) *gorm.DB {
+	dbConnectionStr := dbConnectionString()
 	var db *gorm.DB
 	var err error
 	// Tries to open a db connection every 2 seconds
@@ -736,6 +826,9 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 			log.Fatalf("unsupported deploy target %s", deployTarget)
 		}
 	}
+	if Options.TracingConfig.Enabled {
+		storageClient = s3wrapper.NewTracedClient(storageClient)
+	}
 	return storageClient
 }
 
@@ -769,15 +862,23 @@ func autoMigrationWithLeader(migrationLeader leader.ElectorInterface, db *gorm.D
 	})
 }
 
-func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, db *gorm.DB, authzHandler auth.Authorizer, log logrus.FieldLogger) eventsapi.Handler {
+func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, kubeEvents *controllers.KubeEvents, db *gorm.DB, authzHandler auth.Authorizer, log logrus.FieldLogger) eventsapi.Handler {
 	eventsHandler := events.New(db, authzHandler, log.WithField("pkg", "events"))
 
 	if crdEventsHandler != nil {
-		return controllers.NewControllerEventsWrapper(crdEventsHandler, eventsHandler, db, log)
+		return controllers.NewControllerEventsWrapper(crdEventsHandler, kubeEvents, eventsHandler, db, log)
 	}
 	return eventsHandler
 }
 
+func createKubeEvents(ctrlMgr manager.Manager, db *gorm.DB, log logrus.FieldLogger) *controllers.KubeEvents {
+	if ctrlMgr == nil {
+		return nil
+	}
+	return controllers.NewKubeEvents(Options.KubeEventsConfig, ctrlMgr.GetClient(),
+		ctrlMgr.GetEventRecorderFor("assisted-service"), db, log.WithField("pkg", "kube-events"))
+}
+
 func createCRDEventsHandler() controllers.CRDEventsHandler {
 	if Options.EnableKubeAPI {
 		return controllers.NewCRDEventsHandler()
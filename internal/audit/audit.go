@@ -0,0 +1,224 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/secretdump"
+	operations "github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"AUDIT_LOG_ENABLED" default:"true"`
+	// The file to which the entries are also appended as JSON lines, in addition to the database
+	FilePath string `envconfig:"AUDIT_LOG_FILE" default:""`
+}
+
+// Auditor records the changes made to clusters, hosts and infra-envs through the REST API and through the kube-api,
+// with the user that made them and the fields that changed
+type Auditor struct {
+	Config
+	db     *gorm.DB
+	log    logrus.FieldLogger
+	fileMu sync.Mutex
+	file   io.WriteCloser
+}
+
+func NewAuditor(cfg Config, db *gorm.DB, log logrus.FieldLogger) (*Auditor, error) {
+	a := &Auditor{Config: cfg, db: db, log: log}
+	if cfg.Enabled && cfg.FilePath != "" {
+		file, err := os.OpenFile(cfg.FilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open the audit log file %s", cfg.FilePath)
+		}
+		a.file = file
+	}
+	return a, nil
+}
+
+// Close closes the file of the audit log, if any
+func (a *Auditor) Close() error {
+	a.fileMu.Lock()
+	defer a.fileMu.Unlock()
+	if a.file == nil {
+		return nil
+	}
+	err := a.file.Close()
+	a.file = nil
+	return err
+}
+
+// resource identifies the cluster, host or infra-env that a change is made to
+type resource struct {
+	kind       string
+	id         strfmt.UUID
+	infraEnvID strfmt.UUID
+}
+
+func (r resource) isSet() bool {
+	return r.kind != "" && r.id != ""
+}
+
+// snapshot returns the resource as stored in the database, or nil when it does not exist
+func (a *Auditor) snapshot(r resource) interface{} {
+	if !a.Enabled || !r.isSet() {
+		return nil
+	}
+	switch r.kind {
+	case models.AuditLogEntryResourceKindCluster:
+		if cluster, err := common.GetClusterFromDB(a.db, r.id, common.SkipEagerLoading); err == nil {
+			return cluster
+		}
+	case models.AuditLogEntryResourceKindHost:
+		if host, err := common.GetHostFromDB(a.db, r.infraEnvID.String(), r.id.String()); err == nil {
+			return host
+		}
+	case models.AuditLogEntryResourceKindInfraEnv:
+		if infraEnv, err := common.GetInfraEnvFromDB(a.db, r.id); err == nil {
+			return infraEnv
+		}
+	}
+	return nil
+}
+
+const redactedValue = "<SECRET>"
+
+// redactedFields are the fields that are not tagged as secrets, but whose values may embed credentials, such as
+// the pull secret or passwords in the overrides of the install config and of the ignition configs
+var redactedFields = map[string]bool{
+	"install_config_overrides":  true,
+	"ignition_config_override":  true,
+	"ignition_config_overrides": true,
+}
+
+// proxyFields are the URLs of proxies, which may embed the credentials of the proxy as user:password@
+var proxyFields = map[string]bool{
+	"http_proxy":        true,
+	"https_proxy":       true,
+	"proxy.http_proxy":  true,
+	"proxy.https_proxy": true,
+}
+
+// changes returns the fields of a resource that changed between before and after, with secrets redacted
+func changes(before, after interface{}) []*models.AuditLogChange {
+	ret := make([]*models.AuditLogChange, 0)
+	for _, change := range secretdump.DiffSecretStructs(before, after) {
+		from, to := change.From, change.To
+		switch {
+		case redactedFields[change.Field]:
+			from, to = redact(from), redact(to)
+		case proxyFields[change.Field]:
+			from, to = redactURLCredentials(from), redactURLCredentials(to)
+		}
+		ret = append(ret, &models.AuditLogChange{Field: change.Field, From: from, To: to})
+	}
+	return ret
+}
+
+func redact(value string) string {
+	if value == "" {
+		return value
+	}
+	return redactedValue
+}
+
+// redactURLCredentials replaces the user information of the URL, the whole value when it can't be parsed
+func redactURLCredentials(value string) string {
+	u, err := url.Parse(value)
+	if err != nil {
+		if strings.Contains(value, "@") {
+			return redactedValue
+		}
+		return value
+	}
+	if u.User == nil {
+		return value
+	}
+	u.User = nil
+	return strings.Replace(u.String(), "//", "//"+redactedValue+"@", 1)
+}
+
+// record stores the entry of a change of the resource made by the user of payload
+func (a *Auditor) record(ctx context.Context, payload *ocm.AuthPayload, entry *models.AuditLogEntry, r resource) {
+	if !a.Enabled {
+		return
+	}
+	entry.CreatedAt = strfmt.DateTime(time.Now())
+	entry.Actor = payload.Username
+	entry.OrgID = payload.Organization
+	entry.RequestID = requestid.FromContext(ctx)
+	if r.isSet() {
+		entry.ResourceKind = r.kind
+		resourceID := r.id
+		entry.ResourceID = &resourceID
+	}
+
+	log := logutil.FromContext(ctx, a.log)
+	if err := a.db.Create(entry).Error; err != nil {
+		// The change was already made, failing to audit it must not fail the operation
+		log.WithError(err).Errorf("failed to record the audit log entry of %s by %s", entry.Action, entry.Actor)
+	}
+	a.writeToFile(log, entry)
+}
+
+func (a *Auditor) writeToFile(log logrus.FieldLogger, entry *models.AuditLogEntry) {
+	a.fileMu.Lock()
+	defer a.fileMu.Unlock()
+	if a.file == nil {
+		return
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.WithError(err).Errorf("failed to marshal the audit log entry of %s by %s", entry.Action, entry.Actor)
+		return
+	}
+	if _, err = a.file.Write(append(line, '\n')); err != nil {
+		log.WithError(err).Errorf("failed to write the audit log entry of %s by %s to %s", entry.Action, entry.Actor, a.FilePath)
+	}
+}
+
+func (a *Auditor) V2ListAuditLog(ctx context.Context, params operations.V2ListAuditLogParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	db := a.db.Order("created_at DESC, id DESC")
+	if params.ResourceID != nil {
+		db = db.Where("resource_id = ?", params.ResourceID.String())
+	}
+	if params.Actor != nil {
+		db = db.Where("actor = ?", *params.Actor)
+	}
+	if params.OrgID != nil {
+		db = db.Where("org_id = ?", *params.OrgID)
+	}
+	if params.Source != nil {
+		db = db.Where("source = ?", *params.Source)
+	}
+	if params.Since != nil {
+		db = db.Where("created_at >= ?", time.Time(*params.Since))
+	}
+	db = db.Limit(int(swag.Int64Value(params.Limit))).Offset(int(swag.Int64Value(params.Offset)))
+
+	entries := models.AuditLogEntryList{}
+	if err := db.Find(&entries).Error; err != nil {
+		log.WithError(err).Error("failed to list the audit log entries")
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	return operations.NewV2ListAuditLogOK().WithPayload(entries)
+}
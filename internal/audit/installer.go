@@ -0,0 +1,225 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The actions of the kube-api changes that are not made by a REST API operation
+const (
+	actionUpdateHostApproved    = "UpdateHostApproved"
+	actionUpdateInstallSchedule = "UpdateInstallSchedule"
+)
+
+// installerRecorder records the changes that the controllers of the kube-api make through the inventory. The
+// actions are named after the REST API operations that make the same changes.
+type installerRecorder struct {
+	bminventory.InstallerInternals
+	auditor *Auditor
+}
+
+// NewInstallerRecorder returns the inventory of the controllers, which records the changes that they make
+func NewInstallerRecorder(auditor *Auditor, inner bminventory.InstallerInternals) bminventory.InstallerInternals {
+	return &installerRecorder{InstallerInternals: inner, auditor: auditor}
+}
+
+func clusterResource(id strfmt.UUID) resource {
+	return resource{kind: models.AuditLogEntryResourceKindCluster, id: id}
+}
+
+func hostResource(infraEnvID, id strfmt.UUID) resource {
+	return resource{kind: models.AuditLogEntryResourceKindHost, id: id, infraEnvID: infraEnvID}
+}
+
+func infraEnvResource(id strfmt.UUID) resource {
+	return resource{kind: models.AuditLogEntryResourceKindInfraEnv, id: id}
+}
+
+// change makes the change of an existing resource and records it
+func (r *installerRecorder) change(ctx context.Context, action string, res resource, do func() error) error {
+	before := r.auditor.snapshot(res)
+	err := do()
+	r.recordChange(ctx, action, res, before, err)
+	return err
+}
+
+// recordChange records the change, unless it succeeded without changing the resource, as the controllers apply
+// their resources again on each reconcile
+func (r *installerRecorder) recordChange(ctx context.Context, action string, res resource, before interface{}, err error) {
+	if !r.auditor.Enabled {
+		return
+	}
+	entry := &models.AuditLogEntry{
+		Source:    models.AuditLogEntrySourceKubeAPI,
+		Action:    action,
+		Succeeded: err == nil,
+		Changes:   changes(before, r.auditor.snapshot(res)),
+	}
+	if entry.Succeeded && len(entry.Changes) == 0 {
+		return
+	}
+	r.auditor.record(ctx, ocm.PayloadFromContext(ctx), entry, res)
+}
+
+func (r *installerRecorder) RegisterClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, params installer.V2RegisterClusterParams) (*common.Cluster, error) {
+	cluster, err := r.InstallerInternals.RegisterClusterInternal(ctx, kubeKey, params)
+	var res resource
+	if err == nil {
+		res = clusterResource(*cluster.ID)
+	}
+	r.recordChange(ctx, "v2RegisterCluster", res, nil, err)
+	return cluster, err
+}
+
+func (r *installerRecorder) V2ImportClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, id *strfmt.UUID, params installer.V2ImportClusterParams) (*common.Cluster, error) {
+	cluster, err := r.InstallerInternals.V2ImportClusterInternal(ctx, kubeKey, id, params)
+	var res resource
+	if err == nil {
+		res = clusterResource(*cluster.ID)
+	}
+	r.recordChange(ctx, "v2ImportCluster", res, nil, err)
+	return cluster, err
+}
+
+func (r *installerRecorder) UpdateClusterNonInteractive(ctx context.Context, params installer.V2UpdateClusterParams) (cluster *common.Cluster, err error) {
+	err = r.change(ctx, "V2UpdateCluster", clusterResource(params.ClusterID), func() error {
+		cluster, err = r.InstallerInternals.UpdateClusterNonInteractive(ctx, params)
+		return err
+	})
+	return cluster, err
+}
+
+func (r *installerRecorder) InstallClusterInternal(ctx context.Context, params installer.V2InstallClusterParams) (cluster *common.Cluster, err error) {
+	err = r.change(ctx, "v2InstallCluster", clusterResource(params.ClusterID), func() error {
+		cluster, err = r.InstallerInternals.InstallClusterInternal(ctx, params)
+		return err
+	})
+	return cluster, err
+}
+
+func (r *installerRecorder) DeregisterClusterInternal(ctx context.Context, cluster *common.Cluster) error {
+	return r.change(ctx, "v2DeregisterCluster", clusterResource(*cluster.ID), func() error {
+		return r.InstallerInternals.DeregisterClusterInternal(ctx, cluster)
+	})
+}
+
+func (r *installerRecorder) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) (cluster *common.Cluster, err error) {
+	err = r.change(ctx, "v2UpdateClusterInstallConfig", clusterResource(params.ClusterID), func() error {
+		cluster, err = r.InstallerInternals.UpdateClusterInstallConfigInternal(ctx, params)
+		return err
+	})
+	return cluster, err
+}
+
+func (r *installerRecorder) UpdateInstallScheduleInternal(ctx context.Context, clusterID strfmt.UUID, schedule *models.InstallSchedule) (cluster *common.Cluster, err error) {
+	err = r.change(ctx, actionUpdateInstallSchedule, clusterResource(clusterID), func() error {
+		cluster, err = r.InstallerInternals.UpdateInstallScheduleInternal(ctx, clusterID, schedule)
+		return err
+	})
+	return cluster, err
+}
+
+func (r *installerRecorder) CancelInstallationInternal(ctx context.Context, params installer.V2CancelInstallationParams) (cluster *common.Cluster, err error) {
+	err = r.change(ctx, "V2CancelInstallation", clusterResource(params.ClusterID), func() error {
+		cluster, err = r.InstallerInternals.CancelInstallationInternal(ctx, params)
+		return err
+	})
+	return cluster, err
+}
+
+func (r *installerRecorder) TransformClusterToDay2Internal(ctx context.Context, clusterID strfmt.UUID) (cluster *common.Cluster, err error) {
+	err = r.change(ctx, "TransformClusterToDay2", clusterResource(clusterID), func() error {
+		cluster, err = r.InstallerInternals.TransformClusterToDay2Internal(ctx, clusterID)
+		return err
+	})
+	return cluster, err
+}
+
+func (r *installerRecorder) V2DeregisterHostInternal(ctx context.Context, params installer.V2DeregisterHostParams) error {
+	return r.change(ctx, "v2DeregisterHost", hostResource(params.InfraEnvID, params.HostID), func() error {
+		return r.InstallerInternals.V2DeregisterHostInternal(ctx, params)
+	})
+}
+
+func (r *installerRecorder) UpdateHostApprovedInternal(ctx context.Context, infraEnvId string, hostId string, approved bool) error {
+	return r.change(ctx, actionUpdateHostApproved, hostResource(strfmt.UUID(infraEnvId), strfmt.UUID(hostId)), func() error {
+		return r.InstallerInternals.UpdateHostApprovedInternal(ctx, infraEnvId, hostId, approved)
+	})
+}
+
+func (r *installerRecorder) V2UpdateHostInstallerArgsInternal(ctx context.Context, params installer.V2UpdateHostInstallerArgsParams) (host *models.Host, err error) {
+	err = r.change(ctx, "v2UpdateHostInstallerArgs", hostResource(params.InfraEnvID, params.HostID), func() error {
+		host, err = r.InstallerInternals.V2UpdateHostInstallerArgsInternal(ctx, params)
+		return err
+	})
+	return host, err
+}
+
+func (r *installerRecorder) V2UpdateHostIgnitionInternal(ctx context.Context, params installer.V2UpdateHostIgnitionParams) (host *models.Host, err error) {
+	err = r.change(ctx, "v2UpdateHostIgnition", hostResource(params.InfraEnvID, params.HostID), func() error {
+		host, err = r.InstallerInternals.V2UpdateHostIgnitionInternal(ctx, params)
+		return err
+	})
+	return host, err
+}
+
+func (r *installerRecorder) V2UpdateHostInternal(ctx context.Context, params installer.V2UpdateHostParams, interactivity bminventory.Interactivity) (host *common.Host, err error) {
+	err = r.change(ctx, "v2UpdateHost", hostResource(params.InfraEnvID, params.HostID), func() error {
+		host, err = r.InstallerInternals.V2UpdateHostInternal(ctx, params, interactivity)
+		return err
+	})
+	return host, err
+}
+
+func (r *installerRecorder) InstallSingleDay2HostInternal(ctx context.Context, clusterId strfmt.UUID, infraEnvId strfmt.UUID, hostId strfmt.UUID) error {
+	return r.change(ctx, "v2InstallHost", hostResource(infraEnvId, hostId), func() error {
+		return r.InstallerInternals.InstallSingleDay2HostInternal(ctx, clusterId, infraEnvId, hostId)
+	})
+}
+
+func (r *installerRecorder) BindHostInternal(ctx context.Context, params installer.BindHostParams) (host *common.Host, err error) {
+	err = r.change(ctx, "BindHost", hostResource(params.InfraEnvID, params.HostID), func() error {
+		host, err = r.InstallerInternals.BindHostInternal(ctx, params)
+		return err
+	})
+	return host, err
+}
+
+func (r *installerRecorder) UnbindHostInternal(ctx context.Context, params installer.UnbindHostParams, reclaimHost bool) (host *common.Host, err error) {
+	err = r.change(ctx, "UnbindHost", hostResource(params.InfraEnvID, params.HostID), func() error {
+		host, err = r.InstallerInternals.UnbindHostInternal(ctx, params, reclaimHost)
+		return err
+	})
+	return host, err
+}
+
+func (r *installerRecorder) RegisterInfraEnvInternal(ctx context.Context, kubeKey *types.NamespacedName, params installer.RegisterInfraEnvParams) (*common.InfraEnv, error) {
+	infraEnv, err := r.InstallerInternals.RegisterInfraEnvInternal(ctx, kubeKey, params)
+	var res resource
+	if err == nil {
+		res = infraEnvResource(*infraEnv.ID)
+	}
+	r.recordChange(ctx, "RegisterInfraEnv", res, nil, err)
+	return infraEnv, err
+}
+
+func (r *installerRecorder) UpdateInfraEnvInternal(ctx context.Context, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string) (infraEnv *common.InfraEnv, err error) {
+	err = r.change(ctx, "UpdateInfraEnv", infraEnvResource(params.InfraEnvID), func() error {
+		infraEnv, err = r.InstallerInternals.UpdateInfraEnvInternal(ctx, params, internalIgnitionConfig)
+		return err
+	})
+	return infraEnv, err
+}
+
+func (r *installerRecorder) DeregisterInfraEnvInternal(ctx context.Context, params installer.DeregisterInfraEnvParams) error {
+	return r.change(ctx, "DeregisterInfraEnv", infraEnvResource(params.InfraEnvID), func() error {
+		return r.InstallerInternals.DeregisterInfraEnvInternal(ctx, params)
+	})
+}
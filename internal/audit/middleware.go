@@ -0,0 +1,156 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+)
+
+const agentAuthScheme = "agentAuth"
+
+type requestAuditKey struct{}
+
+// requestAudit keeps the user of a request that is audited, and the resource of the request before the change, once
+// the request is authorized
+type requestAudit struct {
+	resource resource
+	payload  *ocm.AuthPayload
+	agent    bool
+	before   interface{}
+}
+
+// Authorizer wraps the authorizer of the REST API so the users of the audited requests are known. The user is only
+// stored in the context of the request once it is authorized, which is after the audit middleware called the handler
+// of the request. The resource is only loaded then too, so unauthorized requests don't load it.
+func (a *Auditor) Authorizer(authorize func(*http.Request) error) func(*http.Request) error {
+	return func(r *http.Request) error {
+		if err := authorize(r); err != nil {
+			return err
+		}
+		if audit, ok := r.Context().Value(requestAuditKey{}).(*requestAudit); ok {
+			audit.payload = ocm.PayloadFromContext(r.Context())
+			audit.agent = authenticatedByAgent(r)
+			if !audit.agent {
+				audit.before = a.snapshot(audit.resource)
+			}
+		}
+		return nil
+	}
+}
+
+func authenticatedByAgent(r *http.Request) bool {
+	route := middleware.MatchedRouteFrom(r)
+	if route == nil || route.Authenticator == nil {
+		return false
+	}
+	for _, scheme := range route.Authenticator.Schemes {
+		if scheme == agentAuthScheme {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware records the authorized requests that may change clusters, hosts or infra-envs. The requests of the
+// agents are not recorded, they report the progress of the hosts rather than change them.
+// It requires the matched route, so it must be an inner middleware of the REST API.
+func (a *Auditor) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if !a.Enabled || route == nil || route.Operation == nil || !isMutating(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		res := resourceFromParams(route.Params)
+		audit := &requestAudit{resource: res}
+		ctx := context.WithValue(r.Context(), requestAuditKey{}, audit)
+		recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK, keepBody: !res.isSet()}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		if audit.payload == nil || audit.agent {
+			return
+		}
+		if !res.isSet() && recorder.statusCode == http.StatusCreated {
+			res = resourceFromBody(recorder.body.Bytes())
+		}
+		entry := &models.AuditLogEntry{
+			Source:     models.AuditLogEntrySourceRestAPI,
+			Action:     route.Operation.ID,
+			StatusCode: int64(recorder.statusCode),
+			Succeeded:  recorder.statusCode < http.StatusBadRequest,
+			Changes:    changes(audit.before, a.snapshot(res)),
+		}
+		a.record(ctx, audit.payload, entry, res)
+	})
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// resourceFromParams returns the resource of the path of the request: the host when there is a host in the path,
+// otherwise the cluster or the infra-env
+func resourceFromParams(params middleware.RouteParams) resource {
+	clusterID, infraEnvID, hostID := params.Get("cluster_id"), params.Get("infra_env_id"), params.Get("host_id")
+	switch {
+	case hostID != "" && infraEnvID != "":
+		return resource{kind: models.AuditLogEntryResourceKindHost, id: strfmt.UUID(hostID), infraEnvID: strfmt.UUID(infraEnvID)}
+	case clusterID != "":
+		return resource{kind: models.AuditLogEntryResourceKindCluster, id: strfmt.UUID(clusterID)}
+	case infraEnvID != "":
+		return resource{kind: models.AuditLogEntryResourceKindInfraEnv, id: strfmt.UUID(infraEnvID)}
+	}
+	return resource{}
+}
+
+// resourceFromBody returns the resource that a request created, from the response of the request
+func resourceFromBody(body []byte) resource {
+	var created struct {
+		Kind       string      `json:"kind"`
+		ID         strfmt.UUID `json:"id"`
+		InfraEnvID strfmt.UUID `json:"infra_env_id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return resource{}
+	}
+	switch created.Kind {
+	case models.ClusterKindCluster, models.ClusterKindAddHostsCluster:
+		return resource{kind: models.AuditLogEntryResourceKindCluster, id: created.ID}
+	case models.HostKindHost, models.HostKindAddToExistingClusterHost:
+		return resource{kind: models.AuditLogEntryResourceKindHost, id: created.ID, infraEnvID: created.InfraEnvID}
+	case models.InfraEnvKindInfraEnv:
+		return resource{kind: models.AuditLogEntryResourceKindInfraEnv, id: created.ID}
+	}
+	return resource{}
+}
+
+// responseRecorder keeps the status code of the response, and its body when it identifies the created resource
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	keepBody   bool
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.keepBody {
+		r.body.Write(b)
+	}
+	return r.ResponseWriter.Write(b)
+}
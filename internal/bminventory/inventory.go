@@ -14,7 +14,10 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"path/filepath"
+	"reflect"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	"github.com/kennygrant/sanitize"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
@@ -38,6 +42,7 @@ import (
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/hostchanges"
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/infraenv"
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
@@ -64,6 +69,7 @@ import (
 	pkgvalidations "github.com/openshift/assisted-service/pkg/validations"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
 	"gorm.io/gorm"
@@ -158,6 +164,7 @@ type InstallerInternals interface {
 	V2ImportClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, id *strfmt.UUID, params installer.V2ImportClusterParams) (*common.Cluster, error)
 	InstallSingleDay2HostInternal(ctx context.Context, clusterId strfmt.UUID, infraEnvId strfmt.UUID, hostId strfmt.UUID) error
 	UpdateClusterInstallConfigInternal(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) (*common.Cluster, error)
+	UpdateInstallScheduleInternal(ctx context.Context, clusterID strfmt.UUID, schedule *models.InstallSchedule) (*common.Cluster, error)
 	CancelInstallationInternal(ctx context.Context, params installer.V2CancelInstallationParams) (*common.Cluster, error)
 	TransformClusterToDay2Internal(ctx context.Context, clusterID strfmt.UUID) (*common.Cluster, error)
 	AddReleaseImage(ctx context.Context, releaseImageUrl, pullSecret, ocpReleaseVersion string, cpuArchitectures []string) (*models.ReleaseImage, error)
@@ -210,6 +217,8 @@ type bareMetalInventory struct {
 	gcConfig             garbagecollector.Config
 	providerRegistry     registry.ProviderRegistry
 	insecureIPXEURLs     bool
+	hostChangesWaiter    hostchanges.Waiter
+	clusterTemplatesApi  clustertemplates.API
 }
 
 func NewBareMetalInventory(
@@ -241,6 +250,8 @@ func NewBareMetalInventory(
 	gcConfig garbagecollector.Config,
 	providerRegistry registry.ProviderRegistry,
 	insecureIPXEURLs bool,
+	hostChangesWaiter hostchanges.Waiter,
+	clusterTemplatesApi clustertemplates.API,
 ) *bareMetalInventory {
 	return &bareMetalInventory{
 		db:                   db,
@@ -271,6 +282,8 @@ func NewBareMetalInventory(
 		gcConfig:             gcConfig,
 		providerRegistry:     providerRegistry,
 		insecureIPXEURLs:     insecureIPXEURLs,
+		hostChangesWaiter:    hostChangesWaiter,
+		clusterTemplatesApi:  clusterTemplatesApi,
 	}
 }
 
@@ -388,6 +401,12 @@ func (b *bareMetalInventory) validateRegisterClusterInternalParams(params *insta
 		}
 	}
 
+	if params.NewClusterParams.HostStageTimeouts != nil {
+		if _, err := host.ParseHostStageTimeouts(swag.StringValue(params.NewClusterParams.HostStageTimeouts)); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	return nil
 }
 
@@ -422,6 +441,17 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		}
 	}()
 
+	var template *models.ClusterTemplate
+	if params.FromTemplate != nil {
+		if template, err = b.clusterTemplatesApi.GetClusterTemplateInternal(ctx, *params.FromTemplate); err != nil {
+			return nil, err
+		}
+		if params.NewClusterParams, err = clustertemplates.MergeClusterParams(template, params.NewClusterParams); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		log.Infof("Creating cluster %s from cluster template %s", id, *params.FromTemplate)
+	}
+
 	if err = validations.ValidateIPAddresses(b.IPv6Support, params.NewClusterParams); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
@@ -515,6 +545,8 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			CPUArchitecture:              cpuArchitecture,
 			IgnitionEndpoint:             params.NewClusterParams.IgnitionEndpoint,
 			Tags:                         swag.StringValue(params.NewClusterParams.Tags),
+			HostStageTimeouts:            swag.StringValue(params.NewClusterParams.HostStageTimeouts),
+			DeletionProtected:            swag.Bool(swag.BoolValue(params.NewClusterParams.DeletionProtected)),
 		},
 		KubeKeyName:                 kubeKey.Name,
 		KubeKeyNamespace:            kubeKey.Namespace,
@@ -553,11 +585,25 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if template != nil && template.InstallConfigOverrides != "" {
+		if err = b.installConfigBuilder.ValidateInstallConfigPatch(&cluster, template.InstallConfigOverrides); err != nil {
+			err = errors.Wrapf(err, "install config overrides of cluster template %s are invalid", *template.ID)
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		cluster.InstallConfigOverrides = template.InstallConfigOverrides
+	}
+
 	err = b.clusterApi.RegisterCluster(ctx, &cluster)
 	if err != nil {
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
+	if template != nil {
+		if err = b.applyClusterTemplate(ctx, template, &cluster); err != nil {
+			return nil, err
+		}
+	}
+
 	if b.ocmClient != nil {
 		if err = b.integrateWithAMSClusterRegistration(ctx, &cluster); err != nil {
 			err = errors.Wrapf(err, "cluster %s failed to integrate with AMS on cluster registration", id)
@@ -621,6 +667,27 @@ func (b *bareMetalInventory) validateDNSName(cluster common.Cluster) error {
 	return b.dnsApi.ValidateDNSName(cluster.Name, cluster.BaseDNSDomain)
 }
 
+func (b *bareMetalInventory) applyClusterTemplate(ctx context.Context, template *models.ClusterTemplate, cluster *common.Cluster) error {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.clusterTemplatesApi.CreateClusterManifests(ctx, template, *cluster.ID); err != nil {
+		log.WithError(err).Errorf("Failed to apply cluster template %s, rolling back cluster %s registration", *template.ID, *cluster.ID)
+		if deregisterErr := b.clusterApi.DeregisterCluster(ctx, cluster); deregisterErr != nil {
+			log.WithError(deregisterErr).Errorf("Failed to rollback cluster %s registration", *cluster.ID)
+		}
+		return err
+	}
+	if cluster.InstallConfigOverrides != "" {
+		if err := b.recordInstallConfigOverrides(ctx, b.db, *cluster.ID, cluster.InstallConfigOverrides); err != nil {
+			log.WithError(err).Errorf("failed to record install config overrides history for cluster %s", *cluster.ID)
+		}
+		if err := b.setInstallConfigOverridesUsage(cluster.FeatureUsage, cluster.InstallConfigOverrides, *cluster.ID, b.db); err != nil {
+			// Failure to set the feature usage isn't a failure to apply the template so we only print the error instead of returning it
+			log.WithError(err).Errorf("failed to set install config overrides feature usage for cluster %s", *cluster.ID)
+		}
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) integrateWithAMSClusterRegistration(ctx context.Context, cluster *common.Cluster) error {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Creating AMS subscription for cluster %s", *cluster.ID)
@@ -869,6 +936,11 @@ func (b *bareMetalInventory) DeregisterClusterInternal(ctx context.Context, clus
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Deregister cluster id %s", cluster.ID)
 
+	if err := verifyClusterNotDeletionProtected(cluster); err != nil {
+		log.WithError(err).Errorf("failed to deregister cluster %s", cluster.ID)
+		return err
+	}
+
 	if err := b.clusterApi.DeregisterCluster(ctx, cluster); err != nil {
 		log.WithError(err).Errorf("failed to deregister cluster %s", cluster.ID)
 		return common.NewApiError(http.StatusNotFound, err)
@@ -876,6 +948,107 @@ func (b *bareMetalInventory) DeregisterClusterInternal(ctx context.Context, clus
 	return nil
 }
 
+// verifyClusterNotDeletionProtected fails with a conflict when the cluster is deletion protected, as its protection must
+// be removed explicitly before it can be deregistered
+func verifyClusterNotDeletionProtected(cluster *common.Cluster) error {
+	if swag.BoolValue(cluster.DeletionProtected) {
+		return common.NewApiError(http.StatusConflict,
+			errors.Errorf("cluster %s is deletion protected, remove its deletion protection first", cluster.ID))
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) ListDeletedClustersInternal(ctx context.Context, _ installer.V2ListDeletedClustersParams) (models.DeletedClusterList, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var clusters []*common.Cluster
+	deletedSince := time.Now().Add(-b.gcConfig.DeletedUnregisteredAfter)
+	if err := b.db.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at > ?", deletedSince).
+		Order("deleted_at").Find(&clusters).Error; err != nil {
+		log.WithError(err).Error("failed to list deleted clusters")
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	deletedClusters := models.DeletedClusterList{}
+	for _, c := range clusters {
+		deletedAt := strfmt.DateTime(c.DeletedAt.Time)
+		restorableUntil := strfmt.DateTime(c.DeletedAt.Time.Add(b.gcConfig.DeletedUnregisteredAfter))
+		deletedClusters = append(deletedClusters, &models.DeletedCluster{
+			ID:               c.ID,
+			Name:             c.Name,
+			OpenshiftVersion: c.OpenshiftVersion,
+			Status:           swag.StringValue(c.Status),
+			UserName:         c.UserName,
+			OrgID:            c.OrgID,
+			DeletedAt:        &deletedAt,
+			RestorableUntil:  &restorableUntil,
+		})
+	}
+	return deletedClusters, nil
+}
+
+// clusterStatusesWithoutDNSRecords are the statuses of the clusters whose installation didn't start, so their DNS
+// records weren't created yet
+var clusterStatusesWithoutDNSRecords = []string{
+	models.ClusterStatusInsufficient, models.ClusterStatusReady, models.ClusterStatusPendingForInput,
+	models.ClusterStatusPreparingForInstallation, models.ClusterStatusAddingHosts,
+}
+
+func (b *bareMetalInventory) RestoreDeletedClusterInternal(ctx context.Context, params installer.V2RestoreDeletedClusterParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDBWhere(b.db, common.SkipEagerLoading, common.IncludeDeletedRecords, "id = ?", params.ClusterID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("deleted cluster %s not found", params.ClusterID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !cluster.DeletedAt.Valid {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("cluster %s is not deleted", params.ClusterID))
+	}
+	if time.Since(cluster.DeletedAt.Time) >= b.gcConfig.DeletedUnregisteredAfter {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("cluster %s was deleted more than %s ago and can no longer be restored", params.ClusterID, b.gcConfig.DeletedUnregisteredAfter))
+	}
+	if cluster.KubeKeyName != "" {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("cluster %s is managed by kube-api and can't be restored, recreate its ClusterDeployment instead", params.ClusterID))
+	}
+
+	if err = b.clusterApi.RestoreCluster(ctx, cluster); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// The DNS records of the cluster are created when its installation starts, and were deleted when it was deregistered
+	if !funk.ContainsString(clusterStatusesWithoutDNSRecords, swag.StringValue(cluster.Status)) {
+		restored, getErr := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if err = b.dnsApi.CreateDNSRecordSets(ctx, restored); err != nil {
+			log.WithError(err).Errorf("failed to create the DNS records of restored cluster %s", params.ClusterID)
+			return nil, common.NewApiError(http.StatusInternalServerError,
+				errors.Wrapf(err, "cluster %s was restored but its DNS records could not be created", params.ClusterID))
+		}
+	}
+
+	if b.ocmClient != nil && swag.StringValue(cluster.Kind) == models.ClusterKindCluster {
+		// A reserved subscription is deleted when its cluster is deregistered, so a new one is created instead
+		if _, err = b.ocmClient.AccountsMgmt.GetSubscription(ctx, cluster.AmsSubscriptionID); err != nil {
+			log.Infof("AMS subscription %s of cluster %s is gone, creating a new one", cluster.AmsSubscriptionID, params.ClusterID)
+			sub, subErr := b.ocmClient.AccountsMgmt.CreateSubscription(ctx, *cluster.ID, cluster.Name)
+			if subErr != nil {
+				log.WithError(subErr).Errorf("failed to create AMS subscription for restored cluster %s", params.ClusterID)
+				return nil, common.NewApiError(http.StatusInternalServerError, subErr)
+			}
+			if err = b.clusterApi.UpdateAmsSubscriptionID(ctx, *cluster.ID, strfmt.UUID(sub.ID())); err != nil {
+				log.WithError(err).Errorf("failed to update ams_subscription_id of restored cluster %s", params.ClusterID)
+				return nil, common.NewApiError(http.StatusInternalServerError, err)
+			}
+		}
+	}
+
+	return b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+}
+
 func (b *bareMetalInventory) deleteOrUnbindHosts(ctx context.Context, cluster *common.Cluster) error {
 	log := logutil.FromContext(ctx, b.log)
 	for _, h := range cluster.Hosts {
@@ -1098,6 +1271,15 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 	cluster := &common.Cluster{}
 	var err error
 
+	if params.InstallSchedule != nil {
+		if params.InstallSchedule.StartAt == nil || time.Now().Before(time.Time(*params.InstallSchedule.StartAt)) {
+			return b.UpdateInstallScheduleInternal(ctx, params.ClusterID, params.InstallSchedule)
+		}
+		if params.InstallSchedule.EndAt != nil && time.Now().After(time.Time(*params.InstallSchedule.EndAt)) {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.New("install schedule end time must be in the future"))
+		}
+	}
+
 	log.Infof("preparing for cluster %s installation", params.ClusterID)
 	if cluster, err = common.GetClusterFromDBWithHosts(b.db, params.ClusterID); err != nil {
 		return nil, common.NewApiError(http.StatusNotFound, err)
@@ -1159,6 +1341,10 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		if err = b.setBootstrapHost(ctx, *cluster, tx); err != nil {
 			return err
 		}
+
+		if cluster.InstallScheduleStartAt != nil {
+			return clusterPkg.ClearInstallSchedule(tx, *cluster.ID)
+		}
 		return nil
 	})
 	if err != nil {
@@ -1429,6 +1615,28 @@ func (b *bareMetalInventory) GetClusterSupportedPlatforms(ctx context.Context, p
 	return installer.NewGetClusterSupportedPlatformsOK().WithPayload(*supportedPlatforms)
 }
 
+// UpdateInstallScheduleInternal schedules the installation of the cluster, or cancels its scheduled installation when
+// the schedule is nil
+func (b *bareMetalInventory) UpdateInstallScheduleInternal(ctx context.Context, clusterID strfmt.UUID, schedule *models.InstallSchedule) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster *common.Cluster
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if cluster, err = common.GetClusterFromDBForUpdate(tx, clusterID, common.SkipEagerLoading); err != nil {
+			log.WithError(err).Errorf("failed to find cluster %s", clusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		if schedule == nil {
+			return b.clusterApi.CancelInstallSchedule(ctx, cluster, tx)
+		}
+		return b.clusterApi.ScheduleInstallation(ctx, cluster, schedule, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: clusterID})
+}
+
 func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) (*common.Cluster, error) {
 	log := logutil.FromContext(ctx, b.log)
 	var cluster *common.Cluster
@@ -1470,6 +1678,10 @@ func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Cont
 		log.WithError(err).Errorf("failed to update install config overrides")
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
+	if err = b.recordInstallConfigOverrides(ctx, tx, params.ClusterID, params.InstallConfigParams); err != nil {
+		log.WithError(err).Errorf("failed to record install config overrides history")
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
 	err = tx.Commit().Error
 	if err != nil {
 		log.Error(err)
@@ -1481,6 +1693,169 @@ func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Cont
 	return cluster, nil
 }
 
+// recordInstallConfigOverrides adds the overrides as the next version of the install config overrides history of the cluster.
+// The cluster is locked while the version is picked, so that concurrent updates of the overrides get consecutive versions.
+func (b *bareMetalInventory) recordInstallConfigOverrides(ctx context.Context, db *gorm.DB, clusterID strfmt.UUID, overrides string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if _, err := common.GetClusterFromDBForUpdate(tx, clusterID, common.SkipEagerLoading); err != nil {
+			return err
+		}
+		var latest int64
+		if err := tx.Model(&models.InstallConfigOverrideVersion{}).Where("cluster_id = ?", clusterID.String()).
+			Select("COALESCE(MAX(version), 0)").Scan(&latest).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.InstallConfigOverrideVersion{
+			ClusterID: clusterID,
+			Version:   latest + 1,
+			Overrides: overrides,
+			UserName:  ocm.UserNameFromContext(ctx),
+			CreatedAt: strfmt.DateTime(time.Now()),
+		}).Error
+	})
+}
+
+func (b *bareMetalInventory) ListClusterInstallConfigHistoryInternal(ctx context.Context, params installer.V2ListClusterInstallConfigHistoryParams) (models.InstallConfigOverrideVersionList, error) {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := b.getCluster(ctx, params.ClusterID.String()); err != nil {
+		return nil, err
+	}
+	history := models.InstallConfigOverrideVersionList{}
+	if err := b.db.Where("cluster_id = ?", params.ClusterID.String()).Order("version").Find(&history).Error; err != nil {
+		log.WithError(err).Errorf("failed to list install config overrides history of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return history, nil
+}
+
+func (b *bareMetalInventory) GetClusterInstallationTimelineInternal(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) (*models.InstallationTimeline, error) {
+	log := logutil.FromContext(ctx, b.log)
+	c, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+	var transitions []*common.HostStageTransition
+	if err = b.db.Where("cluster_id = ?", params.ClusterID.String()).Order("started_at, id").Find(&transitions).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the host stage transitions of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return clusterPkg.BuildInstallationTimeline(c, transitions, time.Now()), nil
+}
+
+func (b *bareMetalInventory) ListClusterStateTransitionsInternal(ctx context.Context, params installer.V2ListClusterStateTransitionsParams) (models.StateTransitionList, error) {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := b.getCluster(ctx, params.ClusterID.String()); err != nil {
+		return nil, err
+	}
+	transitions := models.StateTransitionList{}
+	if err := b.db.Where("object_kind = ? and object_id = ?", models.StateTransitionObjectKindCluster, params.ClusterID.String()).
+		Order("transitioned_at, id").Find(&transitions).Error; err != nil {
+		log.WithError(err).Errorf("failed to list the status transitions of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return transitions, nil
+}
+
+func (b *bareMetalInventory) ListHostStateTransitionsInternal(ctx context.Context, params installer.V2ListHostStateTransitionsParams) (models.StateTransitionList, error) {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String()); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	transitions := models.StateTransitionList{}
+	if err := b.db.Where("object_kind = ? and object_id = ? and infra_env_id = ?", models.StateTransitionObjectKindHost,
+		params.HostID.String(), params.InfraEnvID.String()).Order("transitioned_at, id").Find(&transitions).Error; err != nil {
+		log.WithError(err).Errorf("failed to list the status transitions of host %s", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return transitions, nil
+}
+
+func (b *bareMetalInventory) ListHostValidationStatsInternal(ctx context.Context, params installer.V2ListHostValidationStatsParams) (models.HostValidationStatsList, error) {
+	log := logutil.FromContext(ctx, b.log)
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	stats, err := b.hostApi.GetValidationStats(ctx, &host.Host)
+	if err != nil {
+		log.WithError(err).Errorf("failed to list the validation statistics of host %s", params.HostID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return stats, nil
+}
+
+// renderInstallConfig returns the install config of the cluster rendered with the given version of the install config
+// overrides. A nil version renders the current overrides and version 0 renders the install config without overrides.
+func (b *bareMetalInventory) renderInstallConfig(cluster *common.Cluster, version *int64) ([]byte, error) {
+	if version != nil {
+		overrides := ""
+		if *version > 0 {
+			var overrideVersion models.InstallConfigOverrideVersion
+			err := b.db.Take(&overrideVersion, "cluster_id = ? and version = ?", cluster.ID.String(), *version).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, common.NewApiError(http.StatusNotFound,
+					errors.Errorf("version %d of the install config overrides of cluster %s not found", *version, cluster.ID))
+			}
+			if err != nil {
+				return nil, common.NewApiError(http.StatusInternalServerError, err)
+			}
+			overrides = overrideVersion.Overrides
+		}
+		renderedCluster := *cluster
+		renderedCluster.InstallConfigOverrides = overrides
+		cluster = &renderedCluster
+	}
+	cfg, err := b.installConfigBuilder.GetInstallConfig(cluster, false, "")
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	return cfg, nil
+}
+
+func (b *bareMetalInventory) GetClusterInstallConfigInternal(ctx context.Context, params installer.V2GetClusterInstallConfigParams) (string, error) {
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return "", err
+	}
+	cfg, err := b.renderInstallConfig(cluster, params.Version)
+	if err != nil {
+		return "", err
+	}
+	return string(cfg), nil
+}
+
+// GetClusterInstallConfigDiffInternal returns a unified diff between the install config rendered with two versions of
+// the install config overrides
+func (b *bareMetalInventory) GetClusterInstallConfigDiffInternal(ctx context.Context, params installer.V2GetClusterInstallConfigDiffParams) (string, error) {
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return "", err
+	}
+	fromVersion := swag.Int64Value(params.From)
+	from, err := b.renderInstallConfig(cluster, &fromVersion)
+	if err != nil {
+		return "", err
+	}
+	to, err := b.renderInstallConfig(cluster, params.To)
+	if err != nil {
+		return "", err
+	}
+
+	toName := "current"
+	if params.To != nil {
+		toName = fmt.Sprintf("version %d", *params.To)
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(from)),
+		B:        difflib.SplitLines(string(to)),
+		FromFile: fmt.Sprintf("version %d", fromVersion),
+		ToFile:   toName,
+		Context:  3,
+	})
+}
+
 func (b *bareMetalInventory) setInstallConfigOverridesUsage(featureUsages string, installConfigParams string, clusterID strfmt.UUID, db *gorm.DB) error {
 	usages, err := usage.Unmarshal(featureUsages)
 	if err != nil {
@@ -1521,11 +1896,30 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
 	}
 
+	releaseImage, installerReleaseImageOverride, err := b.getInstallationReleaseImages(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, releaseImage, installerReleaseImageOverride); err != nil {
+		msg := fmt.Sprintf("failed generating install config for cluster %s", cluster.ID)
+		log.WithError(err).Error(msg)
+		return errors.Wrap(err, msg)
+	}
+
+	return nil
+}
+
+// getInstallationReleaseImages returns the release image of the cluster and the release image to extract the
+// installer from, if it's a different one
+func (b *bareMetalInventory) getInstallationReleaseImages(ctx context.Context, cluster common.Cluster) (string, string, error) {
+	log := logutil.FromContext(ctx, b.log)
+
 	releaseImage, err := b.versionsHandler.GetReleaseImage(cluster.OpenshiftVersion, cluster.CPUArchitecture)
 	if err != nil {
 		msg := fmt.Sprintf("failed to get OpenshiftVersion for cluster %s with openshift version %s", cluster.ID, cluster.OpenshiftVersion)
 		log.WithError(err).Errorf(msg)
-		return errors.Wrapf(err, msg)
+		return "", "", errors.Wrapf(err, msg)
 	}
 
 	installerReleaseImageOverride := ""
@@ -1535,20 +1929,134 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 			msg := fmt.Sprintf("failed to get image for installer image override "+
 				"for cluster %s with openshift version %s and %s arch", cluster.ID, cluster.OpenshiftVersion, cluster.CPUArchitecture)
 			log.WithError(err).Errorf(msg)
-			return errors.Wrapf(err, msg)
+			return "", "", errors.Wrapf(err, msg)
 		}
 		log.Infof("Overriding %s baremetal installer image image: %s with %s: %s", cluster.CPUArchitecture,
 			*releaseImage.URL, common.DefaultCPUArchitecture, *defaultArchImage.URL)
 		installerReleaseImageOverride = *defaultArchImage.URL
 	}
 
-	if err := b.generator.GenerateInstallConfig(ctx, cluster, cfg, *releaseImage.URL, installerReleaseImageOverride); err != nil {
-		msg := fmt.Sprintf("failed generating install config for cluster %s", cluster.ID)
-		log.WithError(err).Error(msg)
-		return errors.Wrap(err, msg)
+	return *releaseImage.URL, installerReleaseImageOverride, nil
+}
+
+// dryRunClusterStatuses are the statuses in which the installation of a cluster can still be started
+var dryRunClusterStatuses = []string{
+	models.ClusterStatusPendingForInput,
+	models.ClusterStatusInsufficient,
+	models.ClusterStatusReady,
+}
+
+// DryRunInstallationInternal generates the installation files of the cluster the way the installation does, but
+// only in memory and in a scratch directory. The roles and the bootstrap host that the installation would choose
+// are only set on a copy of the cluster.
+func (b *bareMetalInventory) DryRunInstallationInternal(ctx context.Context, params installer.V2DryRunInstallationParams) (*models.DryRunResult, error) {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("dry run of the installation of cluster %s", params.ClusterID)
+
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if common.IsDay2Cluster(cluster) {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("cluster %s is a day-2 cluster, it has no installation to try", params.ClusterID))
+	}
+	if !funk.ContainsString(dryRunClusterStatuses, swag.StringValue(cluster.Status)) {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("cannot try the installation of cluster %s in status %s", params.ClusterID, swag.StringValue(cluster.Status)))
+	}
+	setDryRunRoles(cluster)
+
+	result := &models.DryRunResult{
+		Succeeded: swag.Bool(true),
+		Stages:    make([]*models.DryRunStage, 0),
+		Artifacts: make([]*models.DryRunArtifact, 0),
+	}
+	addStage := func(name models.DryRunStageName, stageErr error, skipped bool) {
+		stage := &models.DryRunStage{Name: models.NewDryRunStageName(name), Status: swag.String(models.DryRunStageStatusSucceeded)}
+		if skipped {
+			stage.Status = swag.String(models.DryRunStageStatusSkipped)
+			result.Succeeded = swag.Bool(false)
+		} else if stageErr != nil {
+			log.WithError(stageErr).Warnf("dry run stage %s of cluster %s failed", name, params.ClusterID)
+			stage.Status = swag.String(models.DryRunStageStatusFailed)
+			stage.Error = stageErr.Error()
+			result.Succeeded = swag.Bool(false)
+		}
+		result.Stages = append(result.Stages, stage)
+	}
+	addArtifacts := func(stage models.DryRunStageName, files map[string][]byte) {
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			result.Artifacts = append(result.Artifacts, &models.DryRunArtifact{
+				Name:    swag.String(name),
+				Stage:   models.NewDryRunStageName(stage),
+				Content: swag.String(string(files[name])),
+			})
+		}
 	}
 
-	return nil
+	cfg, err := b.installConfigBuilder.GetInstallConfig(cluster, b.Config.InstallRHCa, ignition.RedhatRootCA)
+	addStage(models.DryRunStageNameInstallConfig, err, false)
+	if err == nil {
+		addArtifacts(models.DryRunStageNameInstallConfig, map[string][]byte{"install-config.yaml": cfg})
+	}
+
+	manifests := make(map[string][]byte)
+	openshiftManifests, customManifest, err := b.operatorManagerApi.RenderManifests(ctx, cluster)
+	addStage(models.DryRunStageNameOperatorManifests, err, false)
+	if err == nil {
+		operatorFiles := make(map[string][]byte)
+		for name, content := range openshiftManifests {
+			manifests[filepath.Join(models.ManifestFolderOpenshift, name)] = content
+			operatorFiles[filepath.Join(models.ManifestFolderOpenshift, name)] = content
+		}
+		if customManifest != nil {
+			operatorFiles[operators.CustomManifestFile] = customManifest
+		}
+		addArtifacts(models.DryRunStageNameOperatorManifests, operatorFiles)
+	}
+
+	if !swag.BoolValue(result.Succeeded) {
+		addStage(models.DryRunStageNameIgnition, nil, true)
+		return result, nil
+	}
+	var files map[string][]byte
+	releaseImage, installerReleaseImageOverride, err := b.getInstallationReleaseImages(ctx, *cluster)
+	if err == nil {
+		files, err = b.generator.DryRunInstallConfig(ctx, *cluster, cfg, releaseImage, installerReleaseImageOverride, manifests)
+	}
+	addStage(models.DryRunStageNameIgnition, err, false)
+	if err == nil {
+		// The install config is already returned by its own stage
+		delete(files, "install-config.yaml")
+		addArtifacts(models.DryRunStageNameIgnition, files)
+	}
+	return result, nil
+}
+
+// setDryRunRoles sets the roles that the installation would assign to the hosts and picks a bootstrap host,
+// the same way the installation does, if there is none
+func setDryRunRoles(cluster *common.Cluster) {
+	var lastMaster *models.Host
+	hasBootstrap := false
+	for _, h := range cluster.Hosts {
+		h.Role = common.GetEffectiveRole(h)
+		if h.Role == models.HostRoleMaster {
+			lastMaster = h
+		}
+		hasBootstrap = hasBootstrap || h.Bootstrap
+	}
+	if !hasBootstrap && lastMaster != nil {
+		lastMaster.Bootstrap = true
+	}
 }
 
 func (b *bareMetalInventory) refreshClusterHosts(ctx context.Context, cluster *common.Cluster, tx *gorm.DB, log logrus.FieldLogger) error {
@@ -1672,7 +2180,7 @@ func (b *bareMetalInventory) V2UpdateCluster(ctx context.Context, params install
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewV2UpdateClusterCreated().WithPayload(&c.Cluster)
+	return installer.NewV2UpdateClusterCreated().WithPayload(&c.Cluster).WithETag(common.ETag(c.ResourceVersion))
 }
 
 func (b *bareMetalInventory) UpdateClusterNonInteractive(ctx context.Context, params installer.V2UpdateClusterParams) (*common.Cluster, error) {
@@ -1772,12 +2280,17 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 	var err error
 	log.Infof("update cluster %s with params: %+v", params.ClusterID, params.ClusterUpdateParams)
 
+	// The deletion protection of a cluster can be updated in any state, e.g. to protect an installed cluster
+	if isDeletionProtectionUpdateOnly(params.ClusterUpdateParams) {
+		return b.updateClusterDeletionProtection(ctx, params.ClusterID, params.IfMatch, *params.ClusterUpdateParams.DeletionProtected)
+	}
+
 	if params, err = b.validateAndUpdateClusterParams(ctx, &params); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := b.db.WithContext(ctx).Begin()
 	defer func() {
 		if !txSuccess {
 			log.Error("update cluster failed")
@@ -1802,6 +2315,11 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
 
+	if err = common.BumpResourceVersion(tx, &common.Cluster{}, params.IfMatch, "id = ?", params.ClusterID.String()); err != nil {
+		log.WithError(err).Errorf("failed to update the resource version of cluster %s", params.ClusterID)
+		return nil, err
+	}
+
 	alreadyDualStack := network.CheckIfClusterIsDualStack(cluster)
 	if err = validations.ValidateDualStackNetworks(params.ClusterUpdateParams, alreadyDualStack); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
@@ -1892,7 +2410,7 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 		eventgen.SendProxySettingsChangedEvent(ctx, b.eventsHandler, params.ClusterID)
 	}
 
-	if cluster, err = common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading); err != nil {
+	if cluster, err = common.GetClusterFromDB(b.db.WithContext(ctx), params.ClusterID, common.UseEagerLoading); err != nil {
 		log.WithError(err).Errorf("failed to get cluster %s after update", params.ClusterID)
 		return nil, err
 	}
@@ -1907,6 +2425,42 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 	return cluster, nil
 }
 
+func isDeletionProtectionUpdateOnly(params *models.V2ClusterUpdateParams) bool {
+	return params.DeletionProtected != nil &&
+		reflect.DeepEqual(*params, models.V2ClusterUpdateParams{DeletionProtected: params.DeletionProtected})
+}
+
+func (b *bareMetalInventory) updateClusterDeletionProtection(ctx context.Context, clusterID strfmt.UUID, ifMatch *string, deletionProtected bool) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		dbReply := tx.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Update("deletion_protected", deletionProtected)
+		if dbReply.Error != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(dbReply.Error, "failed to update cluster %s", clusterID))
+		}
+		if dbReply.RowsAffected == 0 {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("cluster %s can not be found", clusterID))
+		}
+		return common.BumpResourceVersion(tx, &common.Cluster{}, ifMatch, "id = ?", clusterID.String())
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Set the deletion protection of cluster %s to %t", clusterID, deletionProtected)
+
+	cluster, err := common.GetClusterFromDB(b.db, clusterID, common.UseEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s after update", clusterID)
+		return nil, err
+	}
+	cluster.HostNetworks = b.calculateHostNetworks(log, cluster)
+	for _, host := range cluster.Hosts {
+		b.customizeHost(&cluster.Cluster, host)
+		// Clear this field as it is not needed to be sent via API
+		host.FreeAddresses = ""
+	}
+	return cluster, nil
+}
+
 func (b *bareMetalInventory) integrateWithAMSClusterUpdateName(ctx context.Context, cluster *common.Cluster, newClusterName string) error {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Updating AMS subscription for cluster %s with new name %s", *cluster.ID, newClusterName)
@@ -2097,6 +2651,18 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		return err
 	}
 
+	if params.ClusterUpdateParams.HostStageTimeouts != nil {
+		hostStageTimeouts := swag.StringValue(params.ClusterUpdateParams.HostStageTimeouts)
+		if _, err = host.ParseHostStageTimeouts(hostStageTimeouts); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		updates["host_stage_timeouts"] = hostStageTimeouts
+	}
+
+	if params.ClusterUpdateParams.DeletionProtected != nil {
+		updates["deletion_protected"] = swag.BoolValue(params.ClusterUpdateParams.DeletionProtected)
+	}
+
 	if params.ClusterUpdateParams.PullSecret != nil {
 		cluster.PullSecret = *params.ClusterUpdateParams.PullSecret
 		updates["pull_secret"] = *params.ClusterUpdateParams.PullSecret
@@ -2724,7 +3290,7 @@ func (b *bareMetalInventory) calculateHostNetworks(log logrus.FieldLogger, clust
 
 func (b *bareMetalInventory) listClustersInternal(ctx context.Context, params installer.V2ListClustersParams) ([]*models.Cluster, error) {
 	log := logutil.FromContext(ctx, b.log)
-	db := b.db
+	db := b.db.WithContext(ctx)
 
 	var dbClusters []*common.Cluster
 	var clusters []*models.Cluster
@@ -2778,7 +3344,7 @@ func (b *bareMetalInventory) GetClusterInternal(ctx context.Context, params inst
 	}
 
 	eager := common.UseEagerLoading
-	db := b.db
+	db := b.db.WithContext(ctx)
 	if swag.BoolValue(params.ExcludeHosts) {
 		db = common.LoadClusterTablesFromDB(db, common.HostsTable)
 		eager = common.SkipEagerLoading
@@ -2965,6 +3531,9 @@ func (b *bareMetalInventory) handleReplyError(params installer.V2PostStepReplyPa
 	case models.StepTypeDownloadBootArtifacts:
 		log.Errorf("Failed to download boot artifacts to reclaim host %s, output: %s, error: %s", h.ID, params.Reply.Output, params.Reply.Error)
 		return b.hostApi.HandleReclaimFailure(ctx, h)
+
+	case models.StepTypeExecute:
+		return b.hostApi.UpdateDiagnosticResult(ctx, h, params.Reply)
 	}
 	return nil
 }
@@ -3206,6 +3775,8 @@ func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInven
 		err = b.processUpgradeAgentResponse(ctx, &host, stepReply)
 	case models.StepTypeDownloadBootArtifacts:
 		err = b.hostApi.HandleReclaimBootArtifactDownload(ctx, &host)
+	case models.StepTypeExecute:
+		err = b.hostApi.UpdateDiagnosticResult(ctx, &host, params.Reply)
 	}
 	return err
 }
@@ -3224,7 +3795,8 @@ func logReplyReceived(params installer.V2PostStepReplyParams, log logrus.FieldLo
 		return
 	}
 
-	if params.Reply.StepType == models.StepTypeFreeNetworkAddresses {
+	// The output of free addresses and of diagnostics is too large to be logged
+	if params.Reply.StepType == models.StepTypeFreeNetworkAddresses || params.Reply.StepType == models.StepTypeExecute {
 		log.Info(message)
 	} else {
 		log.Info(messageWithOutput)
@@ -3681,6 +4253,109 @@ func (b *bareMetalInventory) CancelInstallationInternal(ctx context.Context, par
 	return cluster, nil
 }
 
+// reinstallFailedHostsClusterStatuses are the statuses in which the control plane of a cluster is up, so its
+// failed workers can be re-installed with the day-2 flow
+var reinstallFailedHostsClusterStatuses = []string{
+	models.ClusterStatusInstalling,
+	models.ClusterStatusFinalizing,
+	models.ClusterStatusInstalled,
+}
+
+// reinstallFailedHostsHostStatuses are the statuses of the hosts that failed to install, either with an error or
+// because their installation was cancelled
+var reinstallFailedHostsHostStatuses = []string{
+	models.HostStatusError,
+	models.HostStatusCancelled,
+}
+
+func (b *bareMetalInventory) ReinstallFailedHostsInternal(ctx context.Context, params installer.V2ReinstallFailedHostsParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("re-installing the failed hosts of cluster %s", params.ClusterID)
+
+	var cluster *common.Cluster
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if cluster, err = common.GetClusterFromDBForUpdate(tx, params.ClusterID, common.UseEagerLoading); err != nil {
+			log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.NewApiError(http.StatusNotFound, err)
+			}
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+
+		if common.IsDay2Cluster(cluster) {
+			return common.NewApiError(http.StatusConflict,
+				errors.Errorf("cluster %s is a day-2 cluster, its hosts can be reset and installed one by one", params.ClusterID))
+		}
+		if !funk.ContainsString(reinstallFailedHostsClusterStatuses, swag.StringValue(cluster.Status)) {
+			return common.NewApiError(http.StatusConflict,
+				errors.Errorf("cannot re-install the failed hosts of cluster %s in status %s", params.ClusterID, swag.StringValue(cluster.Status)))
+		}
+
+		failedHosts := make([]*models.Host, 0)
+		hostNames := make([]string, 0)
+		for _, h := range cluster.Hosts {
+			if !funk.ContainsString(reinstallFailedHostsHostStatuses, swag.StringValue(h.Status)) {
+				continue
+			}
+			if common.GetEffectiveRole(h) != models.HostRoleWorker {
+				return common.NewApiError(http.StatusConflict,
+					errors.Errorf("host %s failed with role %s, only failed workers can be re-installed", hostutil.GetHostnameForMsg(h), common.GetEffectiveRole(h)))
+			}
+			failedHosts = append(failedHosts, h)
+			hostNames = append(hostNames, hostutil.GetHostnameForMsg(h))
+		}
+		if len(failedHosts) == 0 {
+			return common.NewApiError(http.StatusConflict, errors.Errorf("cluster %s has no failed hosts", params.ClusterID))
+		}
+
+		// The day-2 ignition of the hosts is pulled from the API of the cluster
+		if cluster.APIVipDNSName == nil || *cluster.APIVipDNSName == "" {
+			apiVipDNSName := common.GetConvertedClusterAPIVipDNSName(cluster)
+			if err = tx.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).
+				Update("api_vip_dns_name", apiVipDNSName).Error; err != nil {
+				return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update the API hostname of cluster %s", params.ClusterID))
+			}
+			cluster.APIVipDNSName = swag.String(apiVipDNSName)
+		}
+
+		for _, h := range failedHosts {
+			if err = tx.Model(&models.Host{}).Where("id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).Updates(map[string]interface{}{
+				"kind":                     models.HostKindAddToExistingClusterHost,
+				"role":                     models.HostRoleWorker,
+				"machine_config_pool_name": string(models.HostRoleWorker),
+			}).Error; err != nil {
+				return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update host %s", h.ID.String()))
+			}
+			h.Kind = swag.String(models.HostKindAddToExistingClusterHost)
+			h.Role = models.HostRoleWorker
+			h.MachineConfigPoolName = string(models.HostRoleWorker)
+
+			if errResponse := b.hostApi.ResetHost(ctx, h, "host was reset for re-installation by user", tx); errResponse != nil {
+				return errResponse
+			}
+			eventgen.SendHostReinstallRequestedEvent(ctx, b.eventsHandler, *h.ID, hostutil.GetHostnameForMsg(h), h.InfraEnvID, h.ClusterID)
+		}
+
+		if cluster, err = common.GetClusterFromDB(tx, params.ClusterID, common.UseEagerLoading); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if cluster, err = b.clusterApi.RefreshStatus(ctx, cluster, tx); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to refresh the status of cluster %s", params.ClusterID))
+		}
+		eventgen.SendFailedHostsReinstallStartedEvent(ctx, b.eventsHandler, params.ClusterID, strings.Join(hostNames, ", "))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range cluster.Hosts {
+		b.customizeHost(&cluster.Cluster, h)
+	}
+	return cluster, nil
+}
+
 func (b *bareMetalInventory) V2ResetHost(ctx context.Context, params installer.V2ResetHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Info("Resetting host: ", params.HostID)
@@ -4047,10 +4722,16 @@ func (b *bareMetalInventory) DeregisterInfraEnvInternal(ctx context.Context, par
 		}
 	}()
 
-	if _, err = common.GetInfraEnvFromDB(b.db, params.InfraEnvID); err != nil {
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	if err != nil {
 		return common.NewApiError(http.StatusNotFound, err)
 	}
 
+	if swag.BoolValue(infraEnv.DeletionProtected) {
+		err = errors.Errorf("infraEnv %s is deletion protected, remove its deletion protection first", params.InfraEnvID)
+		return common.NewApiError(http.StatusConflict, err)
+	}
+
 	hosts, err := common.GetHostsFromDBWhere(b.db, "infra_env_id = ?", params.InfraEnvID)
 	if err != nil {
 		return err
@@ -4078,11 +4759,11 @@ func (b *bareMetalInventory) GetInfraEnv(ctx context.Context, params installer.G
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewGetInfraEnvOK().WithPayload(&i.InfraEnv)
+	return installer.NewGetInfraEnvOK().WithPayload(&i.InfraEnv).WithETag(common.ETag(i.ResourceVersion))
 }
 
 func (b *bareMetalInventory) GetInfraEnvInternal(ctx context.Context, params installer.GetInfraEnvParams) (*common.InfraEnv, error) {
-	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	infraEnv, err := common.GetInfraEnvFromDB(b.db.WithContext(ctx), params.InfraEnvID)
 	if err != nil {
 		return nil, err
 	}
@@ -4223,6 +4904,7 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(
 			AdditionalNtpSources:   swag.StringValue(params.InfraenvCreateParams.AdditionalNtpSources),
 			SSHAuthorizedKey:       swag.StringValue(params.InfraenvCreateParams.SSHAuthorizedKey),
 			CPUArchitecture:        params.InfraenvCreateParams.CPUArchitecture,
+			DeletionProtected:      swag.Bool(swag.BoolValue(params.InfraenvCreateParams.DeletionProtected)),
 		},
 		KubeKeyNamespace: kubeKey.Namespace,
 		ImageTokenKey:    imageTokenKey,
@@ -4423,7 +5105,7 @@ func (b *bareMetalInventory) UpdateInfraEnv(ctx context.Context, params installe
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewUpdateInfraEnvCreated().WithPayload(&i.InfraEnv)
+	return installer.NewUpdateInfraEnvCreated().WithPayload(&i.InfraEnv).WithETag(common.ETag(i.ResourceVersion))
 }
 
 func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string) (*common.InfraEnv, error) {
@@ -4475,6 +5157,10 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
 
+	if err = common.BumpResourceVersion(tx, &common.InfraEnv{}, params.IfMatch, "id = ?", params.InfraEnvID.String()); err != nil {
+		return nil, err
+	}
+
 	if params.InfraEnvUpdateParams.Proxy != nil {
 		if err = validateProxySettings(params.InfraEnvUpdateParams.Proxy.HTTPProxy,
 			params.InfraEnvUpdateParams.Proxy.HTTPSProxy,
@@ -4599,6 +5285,15 @@ func (b *bareMetalInventory) updateInfraEnvData(ctx context.Context, infraEnv *c
 		}
 	}
 
+	// The deletion protection doesn't affect the image, so it is updated without marking the image as not generated
+	if params.InfraEnvUpdateParams.DeletionProtected != nil {
+		dbReply := db.Model(&common.InfraEnv{}).Where("id = ?", infraEnv.ID.String()).
+			Update("deletion_protected", swag.BoolValue(params.InfraEnvUpdateParams.DeletionProtected))
+		if dbReply.Error != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(dbReply.Error, "failed to update infraEnv: %s", params.InfraEnvID))
+		}
+	}
+
 	return nil
 }
 
@@ -4674,7 +5369,7 @@ func (b *bareMetalInventory) V2RegisterHost(ctx context.Context, params installe
 	log.Infof("Register host: %+v", params)
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := b.db.WithContext(ctx).Begin()
 	defer func() {
 		if !txSuccess {
 			log.Error("RegisterHost failed")
@@ -4755,6 +5450,12 @@ func (b *bareMetalInventory) V2RegisterHost(ctx context.Context, params installe
 			host.Bootstrap = true
 		}
 
+		// Failed hosts of a day-1 cluster that are re-installed keep joining the cluster as day-2 hosts
+		if !newRecord && hostutil.IsDay2Host(&dbHost.Host) &&
+			funk.ContainsString(reinstallFailedHostsClusterStatuses, swag.StringValue(cluster.Status)) {
+			host.Kind = swag.String(models.HostKindAddToExistingClusterHost)
+		}
+
 		host.ClusterID = cluster.ID
 		c = &cluster.Cluster
 	}
@@ -4844,8 +5545,17 @@ func (b *bareMetalInventory) V2GetNextSteps(ctx context.Context, params installe
 	log := logutil.FromContext(ctx, b.log)
 	var steps models.Steps
 
+	// The host is watched before it is read, so that a change made after it was read wakes the request up
+	var changed <-chan struct{}
+	waitTimeout := time.Duration(swag.Int64Value(params.WaitTimeout)) * time.Second
+	if waitTimeout > 0 {
+		var stopWatching func()
+		changed, stopWatching = b.hostChangesWaiter.Watch(params.HostID)
+		defer stopWatching()
+	}
+
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := b.db.WithContext(ctx).Begin()
 	defer func() {
 		if !txSuccess {
 			log.Error("get next steps failed")
@@ -4872,6 +5582,7 @@ func (b *bareMetalInventory) V2GetNextSteps(ctx context.Context, params installe
 			WithPayload(common.GenerateError(http.StatusNotFound, err))
 	}
 
+	lastCheckedInAt := time.Time(host.CheckedInAt)
 	updates := make(map[string]interface{})
 	updates["checked_in_at"] = time.Now()
 	if swag.Int64Value(params.Timestamp) != 0 {
@@ -4891,18 +5602,54 @@ func (b *bareMetalInventory) V2GetNextSteps(ctx context.Context, params installe
 	}
 	txSuccess = true
 
+	// The periodic steps are always due, so the request is held, before the steps are computed, as long as nothing
+	// changed since the steps were last delivered to the host
+	if changed != nil && !b.hostChangedSince(ctx, log, &host.Host, lastCheckedInAt) {
+		timer := time.NewTimer(waitTimeout)
+		defer timer.Stop()
+		select {
+		case <-changed:
+		case <-timer.C:
+		case <-ctx.Done():
+			return installer.NewV2GetNextStepsOK().WithPayload(&steps)
+		}
+
+		// The host may have changed, or have been deleted, while the request was held
+		host, err = common.GetHostFromDB(b.db.WithContext(ctx), params.InfraEnvID.String(), params.HostID.String())
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host: %s", params.HostID)
+			return installer.NewV2GetNextStepsNotFound().
+				WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+	}
+
 	steps, err = b.hostApi.GetNextSteps(ctx, &host.Host)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get steps for host %s infra-env %s", params.HostID.String(), params.InfraEnvID.String())
 	}
-
 	return installer.NewV2GetNextStepsOK().WithPayload(&steps)
 }
 
+// hostChangedSince returns whether the host may have new steps since it last checked in: it never checked in, its
+// status changed or a diagnostic was requested for it since
+func (b *bareMetalInventory) hostChangedSince(ctx context.Context, log logrus.FieldLogger, host *models.Host, lastCheckedInAt time.Time) bool {
+	if lastCheckedInAt.IsZero() || !time.Time(host.StatusUpdatedAt).Before(lastCheckedInAt) {
+		return true
+	}
+	var count int64
+	if err := b.db.WithContext(ctx).Model(&models.HostDiagnostic{}).
+		Where("host_id = ? and infra_env_id = ? and status = ?", host.ID.String(), host.InfraEnvID.String(), models.HostDiagnosticStatusPending).
+		Count(&count).Error; err != nil {
+		log.WithError(err).Warnf("failed to find the pending diagnostics of host %s", host.ID.String())
+		return true
+	}
+	return count > 0
+}
+
 func (b *bareMetalInventory) V2PostStepReply(ctx context.Context, params installer.V2PostStepReplyParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
-	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	host, err := common.GetHostFromDB(b.db.WithContext(ctx), params.InfraEnvID.String(), params.HostID.String())
 
 	if err != nil {
 		log.WithError(err).Errorf("Failed to find host <%s> infra-env <%s> step <%s> exit code %d stdout <%s> stderr <%s>",
@@ -4913,6 +5660,11 @@ func (b *bareMetalInventory) V2PostStepReply(ctx context.Context, params install
 
 	logReplyReceived(params, log, host)
 
+	if err = hostcommands.RecordStepReply(b.db.WithContext(ctx), &host.Host, params.Reply); err != nil {
+		log.WithError(err).Warnf("Failed to record the reply of step <%s> for host <%s> infra-env <%s>",
+			params.Reply.StepID, params.HostID, params.InfraEnvID)
+	}
+
 	if params.Reply.ExitCode != 0 {
 		handlingError := b.handleReplyError(params, ctx, log, &host.Host, params.Reply.ExitCode)
 		if handlingError != nil {
@@ -4954,7 +5706,7 @@ func (b *bareMetalInventory) V2PostStepReply(ctx context.Context, params install
 }
 
 func (b *bareMetalInventory) V2GetHost(ctx context.Context, params installer.V2GetHostParams) middleware.Responder {
-	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	host, err := common.GetHostFromDB(b.db.WithContext(ctx), params.InfraEnvID.String(), params.HostID.String())
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return installer.NewV2GetHostNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
@@ -4965,7 +5717,7 @@ func (b *bareMetalInventory) V2GetHost(ctx context.Context, params installer.V2G
 
 	var c *models.Cluster
 	if host.ClusterID != nil {
-		cluster, err := common.GetClusterFromDB(b.db, *host.ClusterID, common.SkipEagerLoading)
+		cluster, err := common.GetClusterFromDB(b.db.WithContext(ctx), *host.ClusterID, common.SkipEagerLoading)
 		if err != nil {
 			err = fmt.Errorf("can not find a cluster for host %s", params.HostID.String())
 			return common.NewApiError(http.StatusInternalServerError, err)
@@ -4977,13 +5729,13 @@ func (b *bareMetalInventory) V2GetHost(ctx context.Context, params installer.V2G
 
 	// Clear this field as it is not needed to be sent via API
 	host.FreeAddresses = ""
-	return installer.NewV2GetHostOK().WithPayload(&host.Host)
+	return installer.NewV2GetHostOK().WithPayload(&host.Host).WithETag(common.ETag(host.ResourceVersion))
 }
 
 func (b *bareMetalInventory) V2UpdateHostInstallProgress(ctx context.Context, params installer.V2UpdateHostInstallProgressParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Update host %s install progress", params.HostID)
-	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	host, err := common.GetHostFromDB(b.db.WithContext(ctx), params.InfraEnvID.String(), params.HostID.String())
 	if err != nil {
 		log.WithError(err).Errorf("failed to find host %s", params.HostID)
 		return installer.NewV2UpdateHostInstallProgressNotFound().
@@ -5145,7 +5897,7 @@ func (b *bareMetalInventory) V2ListHosts(ctx context.Context, params installer.V
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	hosts, err := common.GetInfraEnvHostsFromDB(b.db, params.InfraEnvID)
+	hosts, err := common.GetInfraEnvHostsFromDB(b.db.WithContext(ctx), params.InfraEnvID)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get list of hosts for infra-env %s", params.InfraEnvID)
 		return installer.NewV2ListHostsInternalServerError().
@@ -5416,6 +6168,12 @@ func (b *bareMetalInventory) V2UpdateHostInternal(ctx context.Context, params in
 		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
 
+	if err = common.BumpResourceVersion(tx, &models.Host{}, params.IfMatch, "id = ? and infra_env_id = ?",
+		params.HostID.String(), params.InfraEnvID.String()); err != nil {
+		log.WithError(err).Errorf("failed to update the resource version of host <%s>, infra env <%s>", params.HostID, params.InfraEnvID)
+		return nil, err
+	}
+
 	err = b.updateHostRole(ctx, host, params.HostUpdateParams.HostRole, tx)
 	if err != nil {
 		return nil, err
@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	amgmtv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
@@ -45,6 +47,7 @@ import (
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/hostchanges"
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/infraenv"
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
@@ -94,6 +97,8 @@ var (
 	mockInstallConfigBuilder *installcfg.MockInstallConfigBuilder
 	mockStaticNetworkConfig  *staticnetworkconfig.MockStaticNetworkConfig
 	mockProviderRegistry     *registry.MockProviderRegistry
+	mockHostChangesWaiter    *hostchanges.MockWaiter
+	mockClusterTemplatesApi  *clustertemplates.MockAPI
 	secondDayWorkerIgnition  = []byte(`{
 		"ignition": {
 		  "version": "3.1.0",
@@ -895,6 +900,162 @@ var _ = Describe("v2GetNextSteps", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(h1.CheckedInAt).ToNot(Equal(h2.CheckedInAt))
 	})
+
+	Context("long-poll", func() {
+		var (
+			infraEnvId strfmt.UUID
+			hostId     strfmt.UUID
+		)
+
+		periodicSteps := models.Steps{NextInstructionSeconds: defaultNextStepIn, Instructions: []*models.Step{
+			{StepType: models.StepTypeInventory}, {StepType: models.StepTypeConnectivityCheck}}}
+
+		BeforeEach(func() {
+			infraEnvId = strfmt.UUID(uuid.New().String())
+			hostId = strfmt.UUID(uuid.New().String())
+			clusterId := strfmt.UUID(uuid.New().String())
+			host := models.Host{
+				ID:              &hostId,
+				InfraEnvID:      infraEnvId,
+				ClusterID:       &clusterId,
+				Status:          swag.String(models.HostStatusKnown),
+				StatusUpdatedAt: strfmt.DateTime(time.Now().Add(-2 * time.Minute)),
+				CheckedInAt:     strfmt.DateTime(time.Now().Add(-time.Minute)),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("holds a known host with only periodic steps until the timeout", func() {
+			mockHostChangesWaiter.EXPECT().Watch(hostId).Return(make(chan struct{}), func() {})
+			mockHostApi.EXPECT().GetNextSteps(gomock.Any(), gomock.Any()).Return(periodicSteps, nil).Times(1)
+			start := time.Now()
+			reply := bm.V2GetNextSteps(ctx, installer.V2GetNextStepsParams{
+				InfraEnvID:  infraEnvId,
+				HostID:      hostId,
+				WaitTimeout: swag.Int64(1),
+			})
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetNextStepsOK()))
+			Expect(reply.(*installer.V2GetNextStepsOK).Payload.Instructions).To(HaveLen(2))
+		})
+
+		It("holds a known host with only periodic steps until it changes and returns the steps of its new status", func() {
+			changed := make(chan struct{})
+			mockHostChangesWaiter.EXPECT().Watch(hostId).Return(changed, func() {})
+			mockHostApi.EXPECT().GetNextSteps(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, host *models.Host) (models.Steps, error) {
+					Expect(swag.StringValue(host.Status)).To(Equal(models.HostStatusPreparingForInstallation))
+					return models.Steps{NextInstructionSeconds: defaultNextStepIn,
+						Instructions: []*models.Step{{StepType: models.StepTypeInstallationDiskSpeedCheck}}}, nil
+				}).Times(1)
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(100 * time.Millisecond)
+				Expect(db.Model(&common.Host{}).Where("id = ?", hostId.String()).
+					Update("status", models.HostStatusPreparingForInstallation).Error).ShouldNot(HaveOccurred())
+				close(changed)
+			}()
+			start := time.Now()
+			reply := bm.V2GetNextSteps(ctx, installer.V2GetNextStepsParams{
+				InfraEnvID:  infraEnvId,
+				HostID:      hostId,
+				WaitTimeout: swag.Int64(30),
+			})
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetNextStepsOK()))
+			Expect(reply.(*installer.V2GetNextStepsOK).Payload.Instructions).To(HaveLen(1))
+		})
+
+		It("does not wait when the status changed since the last check-in", func() {
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostId.String()).
+				Update("status_updated_at", strfmt.DateTime(time.Now())).Error).ShouldNot(HaveOccurred())
+			mockHostChangesWaiter.EXPECT().Watch(hostId).Return(make(chan struct{}), func() {})
+			mockHostApi.EXPECT().GetNextSteps(gomock.Any(), gomock.Any()).Return(periodicSteps, nil).Times(1)
+			start := time.Now()
+			reply := bm.V2GetNextSteps(ctx, installer.V2GetNextStepsParams{
+				InfraEnvID:  infraEnvId,
+				HostID:      hostId,
+				WaitTimeout: swag.Int64(30),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetNextStepsOK()))
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+
+		It("does not wait on the first check-in", func() {
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostId.String()).
+				Update("checked_in_at", nil).Error).ShouldNot(HaveOccurred())
+			mockHostChangesWaiter.EXPECT().Watch(hostId).Return(make(chan struct{}), func() {})
+			mockHostApi.EXPECT().GetNextSteps(gomock.Any(), gomock.Any()).Return(periodicSteps, nil).Times(1)
+			start := time.Now()
+			reply := bm.V2GetNextSteps(ctx, installer.V2GetNextStepsParams{
+				InfraEnvID:  infraEnvId,
+				HostID:      hostId,
+				WaitTimeout: swag.Int64(30),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetNextStepsOK()))
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+
+		It("does not wait for a pending diagnostic", func() {
+			Expect(db.Create(&models.HostDiagnostic{
+				ID:         strfmt.UUID(uuid.New().String()),
+				HostID:     hostId,
+				InfraEnvID: infraEnvId,
+				Action:     models.HostDiagnosticActionJournal,
+				Status:     models.HostDiagnosticStatusPending,
+			}).Error).ShouldNot(HaveOccurred())
+			mockHostChangesWaiter.EXPECT().Watch(hostId).Return(make(chan struct{}), func() {})
+			mockHostApi.EXPECT().GetNextSteps(gomock.Any(), gomock.Any()).Return(periodicSteps, nil).Times(1)
+			start := time.Now()
+			reply := bm.V2GetNextSteps(ctx, installer.V2GetNextStepsParams{
+				InfraEnvID:  infraEnvId,
+				HostID:      hostId,
+				WaitTimeout: swag.Int64(30),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetNextStepsOK()))
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+
+		It("does not wait when the changes of the host can't be watched", func() {
+			mockHostChangesWaiter.EXPECT().Watch(hostId).Return(nil, func() {})
+			mockHostApi.EXPECT().GetNextSteps(gomock.Any(), gomock.Any()).Return(periodicSteps, nil).Times(1)
+			start := time.Now()
+			reply := bm.V2GetNextSteps(ctx, installer.V2GetNextStepsParams{
+				InfraEnvID:  infraEnvId,
+				HostID:      hostId,
+				WaitTimeout: swag.Int64(30),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetNextStepsOK()))
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+
+		It("host deleted while waiting", func() {
+			changed := make(chan struct{})
+			mockHostChangesWaiter.EXPECT().Watch(hostId).Return(changed, func() {})
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(100 * time.Millisecond)
+				Expect(db.Delete(&common.Host{}, "id = ?", hostId.String()).Error).ShouldNot(HaveOccurred())
+				close(changed)
+			}()
+			reply := bm.V2GetNextSteps(ctx, installer.V2GetNextStepsParams{
+				InfraEnvID:  infraEnvId,
+				HostID:      hostId,
+				WaitTimeout: swag.Int64(30),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetNextStepsNotFound()))
+		})
+
+		It("does not wait without a timeout", func() {
+			mockHostApi.EXPECT().GetNextSteps(gomock.Any(), gomock.Any()).Return(periodicSteps, nil)
+			reply := bm.V2GetNextSteps(ctx, installer.V2GetNextStepsParams{
+				InfraEnvID:  infraEnvId,
+				HostID:      hostId,
+				WaitTimeout: swag.Int64(0),
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetNextStepsOK()))
+		})
+	})
 })
 
 func makeFreeAddresses(network string, ips ...strfmt.IPv4) *models.FreeNetworkAddresses {
@@ -2737,6 +2898,134 @@ var _ = Describe("cluster", func() {
 			})
 		})
 
+		Context("Update Host Stage Timeouts", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID:   &clusterID,
+					Kind: swag.String(models.ClusterKindAddHostsCluster),
+				}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+			})
+
+			It("Update host stage timeouts success", func() {
+				mockSuccess()
+				timeouts := `[{"stage": "Writing image to disk", "role": "worker", "timeout": 600}]`
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						HostStageTimeouts: swag.String(timeouts),
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				Expect(reply.(*installer.V2UpdateClusterCreated).Payload.HostStageTimeouts).To(Equal(timeouts))
+			})
+
+			It("Update cluster with invalid host stage timeouts", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						HostStageTimeouts: swag.String(`[{"stage": "Writing image to disk", "timeout": 0}]`),
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "invalid host stage timeout")
+			})
+		})
+
+		Context("Deletion Protection", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID:     &clusterID,
+					Status: swag.String(models.ClusterStatusInstalled),
+				}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("updates the deletion protection of an installed cluster", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						DeletionProtected: swag.Bool(true),
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				Expect(swag.BoolValue(reply.(*installer.V2UpdateClusterCreated).Payload.DeletionProtected)).To(BeTrue())
+			})
+
+			It("returns the incremented resource version as the ETag", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						DeletionProtected: swag.Bool(true),
+					},
+					IfMatch: swag.String(common.ETag(1)),
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				Expect(reply.(*installer.V2UpdateClusterCreated).ETag).To(Equal(common.ETag(2)))
+				Expect(reply.(*installer.V2UpdateClusterCreated).Payload.ResourceVersion).To(Equal(int64(2)))
+			})
+
+			It("fails to update the cluster with a stale If-Match", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						DeletionProtected: swag.Bool(true),
+					},
+					IfMatch: swag.String(common.ETag(2)),
+				})
+				verifyApiError(reply, http.StatusPreconditionFailed)
+				c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(swag.BoolValue(c.DeletionProtected)).To(BeFalse())
+			})
+
+			It("fails to update the deletion protection of a missing cluster", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: strfmt.UUID(uuid.New().String()),
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						DeletionProtected: swag.Bool(true),
+					},
+				})
+				verifyApiErrorString(reply, http.StatusNotFound, "can not be found")
+			})
+
+			It("verifies the state of the cluster when other parameters are updated", func() {
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(errors.Errorf("wrong state")).Times(1)
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						DeletionProtected: swag.Bool(true),
+						Name:              swag.String("new-name"),
+					},
+				})
+				verifyApiErrorString(reply, http.StatusConflict, "wrong state")
+			})
+
+			It("fails to deregister a deletion protected cluster", func() {
+				Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+					Update("deletion_protected", true).Error).ShouldNot(HaveOccurred())
+				reply := bm.V2DeregisterCluster(ctx, installer.V2DeregisterClusterParams{ClusterID: clusterID})
+				verifyApiErrorString(reply, http.StatusConflict, "remove its deletion protection first")
+			})
+
+			It("deregisters the cluster once its deletion protection is removed", func() {
+				Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+					Update("deletion_protected", true).Error).ShouldNot(HaveOccurred())
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						DeletionProtected: swag.Bool(false),
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				mockClusterApi.EXPECT().DeregisterCluster(ctx, gomock.Any()).Return(nil).Times(1)
+				reply = bm.V2DeregisterCluster(ctx, installer.V2DeregisterClusterParams{ClusterID: clusterID})
+				Expect(reply).Should(BeAssignableToTypeOf(&installer.V2DeregisterClusterNoContent{}))
+			})
+		})
+
 		Context("Update Network", func() {
 			BeforeEach(func() {
 				clusterID = strfmt.UUID(uuid.New().String())
@@ -4194,6 +4483,34 @@ var _ = Describe("cluster", func() {
 			verifyApiError(reply, http.StatusNotFound)
 		})
 
+		It("schedules the installation when the window starts in the future", func() {
+			startAt := strfmt.DateTime(time.Now().Add(time.Hour))
+			schedule := &models.InstallSchedule{StartAt: &startAt}
+			mockClusterApi.EXPECT().ScheduleInstallation(gomock.Any(), gomock.Any(), schedule, gomock.Any()).Return(nil).Times(1)
+
+			reply := bm.V2InstallCluster(ctx, installer.V2InstallClusterParams{
+				ClusterID:       clusterID,
+				InstallSchedule: schedule,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2InstallClusterAccepted()))
+		})
+
+		It("rejects a schedule whose window ended", func() {
+			startAt := strfmt.DateTime(time.Now().Add(-2 * time.Hour))
+			endAt := strfmt.DateTime(time.Now().Add(-time.Hour))
+			reply := bm.V2InstallCluster(ctx, installer.V2InstallClusterParams{
+				ClusterID:       clusterID,
+				InstallSchedule: &models.InstallSchedule{StartAt: &startAt, EndAt: &endAt},
+			})
+			verifyApiError(reply, http.StatusBadRequest)
+		})
+
+		It("cancels the scheduled installation", func() {
+			mockClusterApi.EXPECT().CancelInstallSchedule(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			reply := bm.V2CancelInstallSchedule(ctx, installer.V2CancelInstallScheduleParams{ClusterID: clusterID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2CancelInstallScheduleAccepted()))
+		})
+
 		It("failed to auto-assign role", func() {
 			mockAutoAssignFailed()
 			reply := bm.V2InstallCluster(ctx, installer.V2InstallClusterParams{
@@ -5392,6 +5709,16 @@ var _ = Describe("infraEnvs", func() {
 				reply := bm.DeregisterInfraEnv(ctx, installer.DeregisterInfraEnvParams{InfraEnvID: infraEnvID})
 				Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusConflict, errors.Errorf(""))))
 			})
+
+			It("failure - deletion protected", func() {
+				Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID.String()).
+					Update("deletion_protected", true).Error).ShouldNot(HaveOccurred())
+				mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.InfraEnvDeregisterFailedEventName),
+					eventstest.WithInfraEnvIdMatcher(infraEnvID.String()))).Times(1)
+				reply := bm.DeregisterInfraEnv(ctx, installer.DeregisterInfraEnvParams{InfraEnvID: infraEnvID})
+				verifyApiErrorString(reply, http.StatusConflict, "remove its deletion protection first")
+			})
 		})
 	})
 
@@ -6132,6 +6459,28 @@ var _ = Describe("infraEnvs", func() {
 				err = db.Model(&common.InfraEnv{}).Where("id = ?", i.ID).Update("generated_at", strfmt.DateTime(time.Now().AddDate(0, 0, -1))).Error
 				Expect(err).ToNot(HaveOccurred())
 			})
+			It("Update with a stale If-Match", func() {
+				reply := bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID: *i.ID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{
+						AdditionalNtpSources: swag.String("1.1.1.1"),
+					},
+					IfMatch: swag.String(common.ETag(i.ResourceVersion + 1)),
+				})
+				verifyApiError(reply, http.StatusPreconditionFailed)
+			})
+			It("Update with a matching If-Match", func() {
+				mockInfraEnvUpdateSuccess()
+				reply := bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID: *i.ID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{
+						AdditionalNtpSources: swag.String("1.1.1.1"),
+					},
+					IfMatch: swag.String(common.ETag(i.ResourceVersion)),
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+				Expect(reply.(*installer.UpdateInfraEnvCreated).ETag).To(Equal(common.ETag(i.ResourceVersion + 1)))
+			})
 			It("Update AdditionalNtpSources", func() {
 				mockInfraEnvUpdateSuccess()
 				Expect(i.AdditionalNtpSources).To(Equal(""))
@@ -6968,6 +7317,36 @@ var _ = Describe("infraEnvs host", func() {
 			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2UpdateHostCreated()))
 		})
 
+		It("update host role with a matching If-Match", func() {
+			mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), models.HostRole("master"), gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			resp := bm.V2UpdateHost(ctx, installer.V2UpdateHostParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostUpdateParams: &models.HostUpdateParams{
+					HostRole: swag.String("master"),
+				},
+				IfMatch: swag.String(common.ETag(host.ResourceVersion)),
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2UpdateHostCreated()))
+			Expect(resp.(*installer.V2UpdateHostCreated).ETag).To(Equal(common.ETag(host.ResourceVersion + 1)))
+		})
+
+		It("update host role with a stale If-Match", func() {
+			mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			resp := bm.V2UpdateHost(ctx, installer.V2UpdateHostParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostUpdateParams: &models.HostUpdateParams{
+					HostRole: swag.String("master"),
+				},
+				IfMatch: swag.String(common.ETag(host.ResourceVersion - 1)),
+			})
+			verifyApiError(resp, http.StatusPreconditionFailed)
+		})
+
 		It("update host role failure", func() {
 			mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), models.HostRole("master"), gomock.Any()).Return(fmt.Errorf("some error")).Times(1)
 			mockHostApi.EXPECT().UpdateHostname(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
@@ -8575,32 +8954,120 @@ var _ = Describe("GetClusterInstallConfig", func() {
 		_, ok := response.(*installer.V2GetClusterInstallConfigOK)
 		Expect(ok).To(BeTrue())
 	})
+
+	Context("install config overrides history", func() {
+		renderOverrides := func(cluster *common.Cluster, _ bool, _ string) ([]byte, error) {
+			return []byte("baseDomain: example.com\noverrides: '" + cluster.InstallConfigOverrides + "'\n"), nil
+		}
+
+		BeforeEach(func() {
+			Expect(db.Create(&models.InstallConfigOverrideVersion{
+				ClusterID: clusterID,
+				Version:   1,
+				Overrides: `{"fips": true}`,
+				UserName:  "user1",
+			}).Error).ShouldNot(HaveOccurred())
+			mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), false, "").DoAndReturn(renderOverrides).AnyTimes()
+		})
+
+		It("renders the install config with a version of the overrides", func() {
+			response := bm.V2GetClusterInstallConfig(ctx, installer.V2GetClusterInstallConfigParams{ClusterID: clusterID, Version: swag.Int64(1)})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetClusterInstallConfigOK()))
+			Expect(response.(*installer.V2GetClusterInstallConfigOK).Payload).To(ContainSubstring(`{"fips": true}`))
+
+			response = bm.V2GetClusterInstallConfig(ctx, installer.V2GetClusterInstallConfigParams{ClusterID: clusterID, Version: swag.Int64(0)})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetClusterInstallConfigOK()))
+			Expect(response.(*installer.V2GetClusterInstallConfigOK).Payload).To(ContainSubstring("overrides: ''"))
+		})
+
+		It("fails to render an unknown version of the overrides", func() {
+			response := bm.V2GetClusterInstallConfig(ctx, installer.V2GetClusterInstallConfigParams{ClusterID: clusterID, Version: swag.Int64(2)})
+			verifyApiError(response, http.StatusNotFound)
+		})
+
+		It("diffs the current install config against the defaults", func() {
+			response := bm.V2GetClusterInstallConfigDiff(ctx, installer.V2GetClusterInstallConfigDiffParams{ClusterID: clusterID})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetClusterInstallConfigDiffOK()))
+			diff := response.(*installer.V2GetClusterInstallConfigDiffOK).Payload
+			Expect(diff).To(ContainSubstring("--- version 0\n+++ current\n"))
+			Expect(diff).To(ContainSubstring("-overrides: ''\n"))
+			Expect(diff).To(ContainSubstring(`+overrides: '{"controlPlane": {"hyperthreading": "Disabled"}}'`))
+			Expect(diff).ToNot(ContainSubstring("-baseDomain"))
+		})
+
+		It("diffs two versions of the install config", func() {
+			response := bm.V2GetClusterInstallConfigDiff(ctx, installer.V2GetClusterInstallConfigDiffParams{
+				ClusterID: clusterID,
+				From:      swag.Int64(1),
+				To:        swag.Int64(1),
+			})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetClusterInstallConfigDiffOK()))
+			Expect(response.(*installer.V2GetClusterInstallConfigDiffOK).Payload).To(BeEmpty())
+		})
+
+		It("lists the history of the overrides", func() {
+			response := bm.V2ListClusterInstallConfigHistory(ctx, installer.V2ListClusterInstallConfigHistoryParams{ClusterID: clusterID})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2ListClusterInstallConfigHistoryOK()))
+			history := response.(*installer.V2ListClusterInstallConfigHistoryOK).Payload
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].UserName).To(Equal("user1"))
+
+			response = bm.V2ListClusterInstallConfigHistory(ctx, installer.V2ListClusterInstallConfigHistoryParams{ClusterID: strfmt.UUID(uuid.New().String())})
+			verifyApiError(response, http.StatusNotFound)
+		})
+
+		It("gives concurrent updates of the overrides consecutive versions", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(bm.recordInstallConfigOverrides(ctx, db, clusterID, fmt.Sprintf(`{"fips": %t}`, i%2 == 0))).To(Succeed())
+				}(i)
+			}
+			wg.Wait()
+
+			var versions []int64
+			Expect(db.Model(&models.InstallConfigOverrideVersion{}).Where("cluster_id = ?", clusterID.String()).
+				Order("version").Pluck("version", &versions).Error).ToNot(HaveOccurred())
+			Expect(versions).To(Equal([]int64{1, 2, 3, 4, 5, 6}))
+		})
+	})
 })
 
-var _ = Describe("UpdateClusterInstallConfig", func() {
+var _ = Describe("V2GetClusterInstallationTimeline", func() {
 	var (
 		bm        *bareMetalInventory
 		cfg       Config
 		db        *gorm.DB
 		ctx       = context.Background()
 		clusterID strfmt.UUID
-		c         common.Cluster
+		hostID    strfmt.UUID
 		dbName    string
 	)
 
 	BeforeEach(func() {
 		db, dbName = common.PrepareTestDB()
 		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
 		bm = createInventory(db, cfg)
-		c = common.Cluster{
-			Cluster: models.Cluster{
-				ID:               &clusterID,
-				OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
-			},
+		start := time.Now().Add(-time.Hour)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Status:           swag.String(models.ClusterStatusInstalling),
+			InstallStartedAt: strfmt.DateTime(start),
+		}}).Error).ShouldNot(HaveOccurred())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusInstallingInProgress, models.HostKindHost, clusterID, clusterID, "", db)
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Update("bootstrap", true).Error).ShouldNot(HaveOccurred())
+		for i, stage := range []models.HostStage{models.HostStageStartingInstallation, models.HostStageWritingImageToDisk} {
+			Expect(db.Create(&common.HostStageTransition{
+				ClusterID: clusterID,
+				HostID:    hostID,
+				Stage:     stage,
+				StartedAt: start.Add(time.Duration(i+1) * time.Minute),
+			}).Error).ShouldNot(HaveOccurred())
 		}
-
-		err := db.Create(&c).Error
-		Expect(err).ShouldNot(HaveOccurred())
 	})
 
 	AfterEach(func() {
@@ -8608,55 +9075,247 @@ var _ = Describe("UpdateClusterInstallConfig", func() {
 		ctrl.Finish()
 	})
 
-	It("saves the given string to the cluster", func() {
-		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
-		params := installer.V2UpdateClusterInstallConfigParams{
-			ClusterID:           clusterID,
-			InstallConfigParams: override,
-		}
-		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
-			eventstest.WithNameMatcher(eventgen.InstallConfigAppliedEventName),
-			eventstest.WithClusterIdMatcher(params.ClusterID.String())))
-		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), params.InstallConfigParams).Return(nil).Times(1)
-		mockUsageReports()
-		response := bm.V2UpdateClusterInstallConfig(ctx, params)
-		Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateClusterInstallConfigCreated{}))
-
-		var updated common.Cluster
-		err := db.First(&updated, "id = ?", clusterID).Error
-		Expect(err).ShouldNot(HaveOccurred())
-		Expect(updated.InstallConfigOverrides).To(Equal(override))
+	It("returns the timeline of the hosts", func() {
+		response := bm.V2GetClusterInstallationTimeline(ctx, installer.V2GetClusterInstallationTimelineParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetClusterInstallationTimelineOK()))
+		timeline := response.(*installer.V2GetClusterInstallationTimelineOK).Payload
+		Expect(timeline.Hosts).To(HaveLen(1))
+		Expect(timeline.Hosts[0].Bootstrap).To(BeTrue())
+		Expect(timeline.Hosts[0].Stages).To(HaveLen(2))
+		Expect(timeline.Hosts[0].Stages[1].EndedAt).To(BeNil())
+		Expect(timeline.CriticalPath).To(HaveLen(2))
 	})
 
-	It("returns not found with a non-existant cluster", func() {
-		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
-		params := installer.V2UpdateClusterInstallConfigParams{
-			ClusterID:           strfmt.UUID(uuid.New().String()),
-			InstallConfigParams: override,
-		}
-		response := bm.V2UpdateClusterInstallConfig(ctx, params)
+	It("fails for a missing cluster", func() {
+		response := bm.V2GetClusterInstallationTimeline(ctx, installer.V2GetClusterInstallationTimelineParams{ClusterID: strfmt.UUID(uuid.New().String())})
 		verifyApiError(response, http.StatusNotFound)
 	})
+})
 
-	It("returns bad request when validation fails", func() {
-		override := `{"controlPlane": {"hyperthreading": "Disabled"`
-		params := installer.V2UpdateClusterInstallConfigParams{
-			ClusterID:           clusterID,
-			InstallConfigParams: override,
-		}
-		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), params.InstallConfigParams).Return(fmt.Errorf("some error")).Times(1)
-		response := bm.V2UpdateClusterInstallConfig(ctx, params)
-		verifyApiError(response, http.StatusBadRequest)
+var _ = Describe("State transitions", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		clusterID  strfmt.UUID
+		hostID     strfmt.UUID
+		infraEnvID strfmt.UUID
+		dbName     string
+	)
+
+	createTransition := func(kind string, objectID strfmt.UUID, fromState, toState string, age time.Duration) {
+		Expect(db.Create(&models.StateTransition{
+			ObjectKind:     kind,
+			ObjectID:       objectID,
+			ClusterID:      &clusterID,
+			InfraEnvID:     &infraEnvID,
+			FromState:      fromState,
+			ToState:        toState,
+			TransitionedAt: strfmt.DateTime(time.Now().Add(-age)),
+		}).Error).ShouldNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = clusterID
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:     &clusterID,
+			Status: swag.String(models.ClusterStatusReady),
+		}}).Error).ShouldNot(HaveOccurred())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "", db)
+		createTransition(models.StateTransitionObjectKindCluster, clusterID, models.ClusterStatusInsufficient, models.ClusterStatusReady, time.Minute)
+		createTransition(models.StateTransitionObjectKindCluster, clusterID, "", models.ClusterStatusInsufficient, time.Hour)
+		createTransition(models.StateTransitionObjectKindHost, hostID, models.HostStatusDiscovering, models.HostStatusKnown, 2*time.Minute)
 	})
 
-	It("updates the install config overrides feature usage", func() {
-		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
-		params := installer.V2UpdateClusterInstallConfigParams{
-			ClusterID:           clusterID,
-			InstallConfigParams: override,
-		}
-		usages := map[string]models.Usage{}
-		overrideUsage := make(map[string]interface{})
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("lists the transitions of the cluster, oldest first", func() {
+		response := bm.V2ListClusterStateTransitions(ctx, installer.V2ListClusterStateTransitionsParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2ListClusterStateTransitionsOK()))
+		transitions := response.(*installer.V2ListClusterStateTransitionsOK).Payload
+		Expect(transitions).To(HaveLen(2))
+		Expect(transitions[0].ToState).To(Equal(models.ClusterStatusInsufficient))
+		Expect(transitions[1].ToState).To(Equal(models.ClusterStatusReady))
+	})
+
+	It("lists the transitions of the host", func() {
+		response := bm.V2ListHostStateTransitions(ctx, installer.V2ListHostStateTransitionsParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2ListHostStateTransitionsOK()))
+		transitions := response.(*installer.V2ListHostStateTransitionsOK).Payload
+		Expect(transitions).To(HaveLen(1))
+		Expect(transitions[0].ObjectID).To(Equal(hostID))
+		Expect(transitions[0].ToState).To(Equal(models.HostStatusKnown))
+	})
+
+	It("fails for a missing cluster", func() {
+		response := bm.V2ListClusterStateTransitions(ctx, installer.V2ListClusterStateTransitionsParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("fails for a missing host", func() {
+		response := bm.V2ListHostStateTransitions(ctx, installer.V2ListHostStateTransitionsParams{InfraEnvID: infraEnvID, HostID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
+var _ = Describe("Host validation statistics", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		hostID     strfmt.UUID
+		infraEnvID strfmt.UUID
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		addHost(hostID, models.HostRoleWorker, models.HostStatusKnown, models.HostKindHost, infraEnvID, "", "", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("lists the statistics of the validations of the host", func() {
+		stats := []*models.HostValidationStats{{InfraEnvID: infraEnvID, HostID: hostID, ValidationID: "has-min-memory", FlipCount: 5, Flapping: true}}
+		mockHostApi.EXPECT().GetValidationStats(gomock.Any(), gomock.Any()).Return(stats, nil).Times(1)
+		response := bm.V2ListHostValidationStats(ctx, installer.V2ListHostValidationStatsParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2ListHostValidationStatsOK()))
+		Expect(response.(*installer.V2ListHostValidationStatsOK).Payload).To(Equal(models.HostValidationStatsList(stats)))
+	})
+
+	It("fails for a missing host", func() {
+		response := bm.V2ListHostValidationStats(ctx, installer.V2ListHostValidationStatsParams{InfraEnvID: infraEnvID, HostID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("fails when the statistics can't be read", func() {
+		mockHostApi.EXPECT().GetValidationStats(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+		response := bm.V2ListHostValidationStats(ctx, installer.V2ListHostValidationStatsParams{InfraEnvID: infraEnvID, HostID: hostID})
+		verifyApiError(response, http.StatusInternalServerError)
+	})
+})
+
+var _ = Describe("UpdateClusterInstallConfig", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		c         common.Cluster
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		c = common.Cluster{
+			Cluster: models.Cluster{
+				ID:               &clusterID,
+				OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			},
+		}
+
+		err := db.Create(&c).Error
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("saves the given string to the cluster", func() {
+		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
+		params := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: override,
+		}
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.InstallConfigAppliedEventName),
+			eventstest.WithClusterIdMatcher(params.ClusterID.String())))
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), params.InstallConfigParams).Return(nil).Times(1)
+		mockUsageReports()
+		response := bm.V2UpdateClusterInstallConfig(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateClusterInstallConfigCreated{}))
+
+		var updated common.Cluster
+		err := db.First(&updated, "id = ?", clusterID).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(updated.InstallConfigOverrides).To(Equal(override))
+	})
+
+	It("records every update in the install config overrides history", func() {
+		overrides := []string{`{"controlPlane": {"hyperthreading": "Disabled"}}`, `{"fips": true}`}
+		for _, override := range overrides {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InstallConfigAppliedEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String())))
+			mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), override).Return(nil).Times(1)
+			mockUsageReports()
+			response := bm.V2UpdateClusterInstallConfig(ctx, installer.V2UpdateClusterInstallConfigParams{
+				ClusterID:           clusterID,
+				InstallConfigParams: override,
+			})
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateClusterInstallConfigCreated{}))
+		}
+
+		response := bm.V2ListClusterInstallConfigHistory(ctx, installer.V2ListClusterInstallConfigHistoryParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2ListClusterInstallConfigHistoryOK()))
+		history := response.(*installer.V2ListClusterInstallConfigHistoryOK).Payload
+		Expect(history).To(HaveLen(2))
+		for i, version := range history {
+			Expect(version.Version).To(Equal(int64(i + 1)))
+			Expect(version.Overrides).To(Equal(overrides[i]))
+			Expect(time.Time(version.CreatedAt)).ToNot(BeZero())
+		}
+	})
+
+	It("returns not found with a non-existant cluster", func() {
+		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
+		params := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           strfmt.UUID(uuid.New().String()),
+			InstallConfigParams: override,
+		}
+		response := bm.V2UpdateClusterInstallConfig(ctx, params)
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("returns bad request when validation fails", func() {
+		override := `{"controlPlane": {"hyperthreading": "Disabled"`
+		params := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: override,
+		}
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), params.InstallConfigParams).Return(fmt.Errorf("some error")).Times(1)
+		response := bm.V2UpdateClusterInstallConfig(ctx, params)
+		verifyApiError(response, http.StatusBadRequest)
+	})
+
+	It("updates the install config overrides feature usage", func() {
+		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
+		params := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: override,
+		}
+		usages := map[string]models.Usage{}
+		overrideUsage := make(map[string]interface{})
 		overrideUsage["controlPlane hyperthreading"] = true
 		mockUsage.EXPECT().Add(usages, usage.InstallConfigOverrides, gomock.Any()).Times(1)
 		mockUsage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
@@ -9540,6 +10199,336 @@ var _ = Describe("Reset Host test", func() {
 	})
 })
 
+var _ = Describe("Reinstall failed hosts test", func() {
+	var (
+		bm           *bareMetalInventory
+		cfg          Config
+		db           *gorm.DB
+		ctx          = context.Background()
+		clusterID    strfmt.UUID
+		failedHostID strfmt.UUID
+		dbName       string
+	)
+
+	createCluster := func(status string) {
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Kind:             swag.String(models.ClusterKindCluster),
+			Name:             "test-cluster",
+			BaseDNSDomain:    "example.com",
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			Status:           swag.String(status),
+		}}).Error).ShouldNot(HaveOccurred())
+	}
+
+	reinstall := func() middleware.Responder {
+		return bm.V2ReinstallFailedHosts(ctx, installer.V2ReinstallFailedHostsParams{ClusterID: clusterID})
+	}
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		failedHostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		addHost(strfmt.UUID(uuid.New().String()), models.HostRoleMaster, models.HostStatusInstalled, models.HostKindHost, clusterID, clusterID, getInventoryStr("master", "bootMode", "1.2.3.4/24", "10.11.50.90/16"), db)
+		addHost(strfmt.UUID(uuid.New().String()), models.HostRoleWorker, models.HostStatusInstalled, models.HostKindHost, clusterID, clusterID, getInventoryStr("worker", "bootMode", "1.2.3.5/24", "10.11.50.91/16"), db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("resets only the failed workers and makes them day-2 hosts", func() {
+		createCluster(models.ClusterStatusInstalled)
+		addHost(failedHostID, models.HostRoleWorker, models.HostStatusError, models.HostKindHost, clusterID, clusterID, getInventoryStr("failed", "bootMode", "1.2.3.6/24", "10.11.50.92/16"), db)
+
+		mockHostApi.EXPECT().ResetHost(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, h *models.Host, _ string, _ *gorm.DB) *common.ApiErrorResponse {
+				Expect(*h.ID).To(Equal(failedHostID))
+				Expect(hostutil.IsDay2Host(h)).To(BeTrue())
+				return nil
+			}).Times(1)
+		mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, c *common.Cluster, _ *gorm.DB) (*common.Cluster, error) { return c, nil }).Times(1)
+		mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostReinstallRequestedEventName),
+			eventstest.WithHostIdMatcher(failedHostID.String()))).Times(1)
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.FailedHostsReinstallStartedEventName),
+			eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+
+		Expect(reinstall()).Should(BeAssignableToTypeOf(installer.NewV2ReinstallFailedHostsAccepted()))
+
+		host, err := common.GetHostFromDB(db, clusterID.String(), failedHostID.String())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(host.Kind)).To(Equal(models.HostKindAddToExistingClusterHost))
+		Expect(host.MachineConfigPoolName).To(Equal(string(models.HostRoleWorker)))
+		cluster, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(cluster.APIVipDNSName)).To(Equal("api.test-cluster.example.com"))
+	})
+
+	It("resets the cancelled workers", func() {
+		createCluster(models.ClusterStatusInstalling)
+		addHost(failedHostID, models.HostRoleWorker, models.HostStatusCancelled, models.HostKindHost, clusterID, clusterID, getInventoryStr("failed", "bootMode", "1.2.3.6/24", "10.11.50.92/16"), db)
+
+		mockHostApi.EXPECT().ResetHost(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, h *models.Host, _ string, _ *gorm.DB) *common.ApiErrorResponse {
+				Expect(*h.ID).To(Equal(failedHostID))
+				return nil
+			}).Times(1)
+		mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, c *common.Cluster, _ *gorm.DB) (*common.Cluster, error) { return c, nil }).Times(1)
+		mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostReinstallRequestedEventName),
+			eventstest.WithHostIdMatcher(failedHostID.String()))).Times(1)
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.FailedHostsReinstallStartedEventName),
+			eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+
+		Expect(reinstall()).Should(BeAssignableToTypeOf(installer.NewV2ReinstallFailedHostsAccepted()))
+
+		host, err := common.GetHostFromDB(db, clusterID.String(), failedHostID.String())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(host.Kind)).To(Equal(models.HostKindAddToExistingClusterHost))
+	})
+
+	It("fails when no host failed", func() {
+		createCluster(models.ClusterStatusInstalled)
+		verifyApiError(reinstall(), http.StatusConflict)
+	})
+
+	It("fails when a master failed", func() {
+		createCluster(models.ClusterStatusInstalling)
+		addHost(failedHostID, models.HostRoleMaster, models.HostStatusError, models.HostKindHost, clusterID, clusterID, getInventoryStr("failed", "bootMode", "1.2.3.6/24", "10.11.50.92/16"), db)
+		verifyApiError(reinstall(), http.StatusConflict)
+	})
+
+	It("fails before the installation started", func() {
+		createCluster(models.ClusterStatusReady)
+		addHost(failedHostID, models.HostRoleWorker, models.HostStatusError, models.HostKindHost, clusterID, clusterID, getInventoryStr("failed", "bootMode", "1.2.3.6/24", "10.11.50.92/16"), db)
+		verifyApiError(reinstall(), http.StatusConflict)
+	})
+
+	It("fails for a missing cluster", func() {
+		verifyApiError(reinstall(), http.StatusNotFound)
+	})
+})
+
+var _ = Describe("Host diagnostics test", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		infraEnvID strfmt.UUID
+		hostID     strfmt.UUID
+		dbName     string
+	)
+
+	createDiagnostic := func(status string) *models.HostDiagnostic {
+		diagnostic := &models.HostDiagnostic{
+			ID:          strfmt.UUID(uuid.New().String()),
+			HostID:      hostID,
+			InfraEnvID:  infraEnvID,
+			Action:      models.HostDiagnosticActionJournal,
+			Status:      status,
+			Output:      "output",
+			RequestedAt: strfmt.DateTime(time.Now()),
+		}
+		Expect(db.Create(diagnostic).Error).ShouldNot(HaveOccurred())
+		return diagnostic
+	}
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		addHost(hostID, models.HostRoleWorker, models.HostStatusKnown, models.HostKindHost, infraEnvID, infraEnvID, getInventoryStr("host", "bootMode", "1.2.3.4/24", "10.11.50.90/16"), db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("creates a diagnostic", func() {
+		action := models.HostDiagnosticActionIPAddresses
+		diagnostic := &models.HostDiagnostic{ID: strfmt.UUID(uuid.New().String()), Status: models.HostDiagnosticStatusPending}
+		mockHostApi.EXPECT().CreateDiagnostic(gomock.Any(), gomock.Any(), action, gomock.Any()).
+			DoAndReturn(func(_ context.Context, h *models.Host, _ models.HostDiagnosticAction, _ string) (*models.HostDiagnostic, error) {
+				Expect(*h.ID).To(Equal(hostID))
+				return diagnostic, nil
+			}).Times(1)
+		reply := bm.V2CreateHostDiagnostic(ctx, installer.V2CreateHostDiagnosticParams{
+			InfraEnvID:                 infraEnvID,
+			HostID:                     hostID,
+			HostDiagnosticCreateParams: &models.HostDiagnosticCreateParams{Action: &action},
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2CreateHostDiagnosticCreated()))
+		Expect(reply.(*installer.V2CreateHostDiagnosticCreated).Payload).To(Equal(diagnostic))
+	})
+
+	It("fails to create a diagnostic that the host API rejects", func() {
+		action := models.HostDiagnosticActionIPAddresses
+		mockHostApi.EXPECT().CreateDiagnostic(gomock.Any(), gomock.Any(), action, gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusConflict, errors.New("host is installing"))).Times(1)
+		verifyApiError(bm.V2CreateHostDiagnostic(ctx, installer.V2CreateHostDiagnosticParams{
+			InfraEnvID:                 infraEnvID,
+			HostID:                     hostID,
+			HostDiagnosticCreateParams: &models.HostDiagnosticCreateParams{Action: &action},
+		}), http.StatusConflict)
+	})
+
+	It("fails to create a diagnostic for a missing host", func() {
+		action := models.HostDiagnosticActionIPAddresses
+		verifyApiError(bm.V2CreateHostDiagnostic(ctx, installer.V2CreateHostDiagnosticParams{
+			InfraEnvID:                 infraEnvID,
+			HostID:                     strfmt.UUID(uuid.New().String()),
+			HostDiagnosticCreateParams: &models.HostDiagnosticCreateParams{Action: &action},
+		}), http.StatusNotFound)
+	})
+
+	It("lists the diagnostics of the host without their output", func() {
+		first := createDiagnostic(models.HostDiagnosticStatusCompleted)
+		second := createDiagnostic(models.HostDiagnosticStatusPending)
+		reply := bm.V2ListHostDiagnostics(ctx, installer.V2ListHostDiagnosticsParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2ListHostDiagnosticsOK()))
+		diagnostics := reply.(*installer.V2ListHostDiagnosticsOK).Payload
+		Expect(diagnostics).To(HaveLen(2))
+		Expect(diagnostics[0].ID).To(Equal(first.ID))
+		Expect(diagnostics[1].ID).To(Equal(second.ID))
+		Expect(diagnostics[0].Output).To(BeEmpty())
+	})
+
+	It("gets a diagnostic with its output", func() {
+		diagnostic := createDiagnostic(models.HostDiagnosticStatusCompleted)
+		reply := bm.V2GetHostDiagnostic(ctx, installer.V2GetHostDiagnosticParams{InfraEnvID: infraEnvID, HostID: hostID, DiagnosticID: diagnostic.ID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetHostDiagnosticOK()))
+		Expect(reply.(*installer.V2GetHostDiagnosticOK).Payload.Output).To(Equal("output"))
+
+		verifyApiError(bm.V2GetHostDiagnostic(ctx, installer.V2GetHostDiagnosticParams{
+			InfraEnvID: infraEnvID, HostID: strfmt.UUID(uuid.New().String()), DiagnosticID: diagnostic.ID}), http.StatusNotFound)
+	})
+})
+
+var _ = Describe("Dry run installation test", func() {
+	var (
+		bm           *bareMetalInventory
+		cfg          Config
+		db           *gorm.DB
+		ctx          = context.Background()
+		clusterID    strfmt.UUID
+		masterHostID strfmt.UUID
+		dbName       string
+	)
+
+	dryRun := func() *models.DryRunResult {
+		reply := bm.V2DryRunInstallation(ctx, installer.V2DryRunInstallationParams{ClusterID: clusterID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2DryRunInstallationOK()))
+		return reply.(*installer.V2DryRunInstallationOK).Payload
+	}
+
+	stageStatuses := func(result *models.DryRunResult) []string {
+		statuses := make([]string, 0, len(result.Stages))
+		for _, stage := range result.Stages {
+			statuses = append(statuses, swag.StringValue(stage.Status))
+		}
+		return statuses
+	}
+
+	artifactNames := func(result *models.DryRunResult) []string {
+		names := make([]string, 0, len(result.Artifacts))
+		for _, artifact := range result.Artifacts {
+			names = append(names, swag.StringValue(artifact.Name))
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		masterHostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Kind:             swag.String(models.ClusterKindCluster),
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			Status:           swag.String(models.ClusterStatusReady),
+		}}).Error).ShouldNot(HaveOccurred())
+		addHost(masterHostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, clusterID, clusterID, getInventoryStr("master", "bootMode", "1.2.3.4/24", "10.11.50.90/16"), db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("returns the artifacts of all the stages without changing the cluster", func() {
+		mockGetInstallConfigSuccess(mockInstallConfigBuilder)
+		mockOperatorManager.EXPECT().RenderManifests(gomock.Any(), gomock.Any()).
+			Return(map[string][]byte{"50_lso.yaml": []byte("kind: Namespace")}, []byte("[]"), nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockGenerator.EXPECT().DryRunInstallConfig(gomock.Any(), gomock.Any(), []byte("some string"), gomock.Any(), gomock.Any(),
+			map[string][]byte{"openshift/50_lso.yaml": []byte("kind: Namespace")}).
+			DoAndReturn(func(_ context.Context, c common.Cluster, _ []byte, _, _ string, _ map[string][]byte) (map[string][]byte, error) {
+				Expect(c.Hosts).To(HaveLen(1))
+				Expect(c.Hosts[0].Bootstrap).To(BeTrue())
+				return map[string][]byte{"install-config.yaml": []byte("some string"), "master.ign": []byte("{}")}, nil
+			}).Times(1)
+
+		result := dryRun()
+		Expect(swag.BoolValue(result.Succeeded)).To(BeTrue())
+		Expect(stageStatuses(result)).To(Equal([]string{models.DryRunStageStatusSucceeded, models.DryRunStageStatusSucceeded, models.DryRunStageStatusSucceeded}))
+		Expect(artifactNames(result)).To(Equal([]string{"install-config.yaml", "custom_manifests.json", "openshift/50_lso.yaml", "master.ign"}))
+
+		host, err := common.GetHostFromDB(db, clusterID.String(), masterHostID.String())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(host.Bootstrap).To(BeFalse())
+	})
+
+	It("skips the ignition when the install config fails", func() {
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("bad networks")).Times(1)
+		mockOperatorManager.EXPECT().RenderManifests(gomock.Any(), gomock.Any()).Return(map[string][]byte{}, nil, nil).Times(1)
+
+		result := dryRun()
+		Expect(swag.BoolValue(result.Succeeded)).To(BeFalse())
+		Expect(stageStatuses(result)).To(Equal([]string{models.DryRunStageStatusFailed, models.DryRunStageStatusSucceeded, models.DryRunStageStatusSkipped}))
+		Expect(result.Stages[0].Error).To(Equal("bad networks"))
+		Expect(result.Artifacts).To(BeEmpty())
+	})
+
+	It("reports the errors of the ignition generation", func() {
+		mockGetInstallConfigSuccess(mockInstallConfigBuilder)
+		mockOperatorManager.EXPECT().RenderManifests(gomock.Any(), gomock.Any()).Return(map[string][]byte{}, nil, nil).Times(1)
+		mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockGenerator.EXPECT().DryRunInstallConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("openshift-install failed")).Times(1)
+
+		result := dryRun()
+		Expect(swag.BoolValue(result.Succeeded)).To(BeFalse())
+		Expect(stageStatuses(result)).To(Equal([]string{models.DryRunStageStatusSucceeded, models.DryRunStageStatusSucceeded, models.DryRunStageStatusFailed}))
+		Expect(result.Stages[2].Error).To(Equal("openshift-install failed"))
+	})
+
+	It("fails once the installation started", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Update("status", models.ClusterStatusInstalling).Error).ShouldNot(HaveOccurred())
+		verifyApiError(bm.V2DryRunInstallation(ctx, installer.V2DryRunInstallationParams{ClusterID: clusterID}), http.StatusConflict)
+	})
+
+	It("fails for a missing cluster", func() {
+		verifyApiError(bm.V2DryRunInstallation(ctx, installer.V2DryRunInstallationParams{ClusterID: strfmt.UUID(uuid.New().String())}), http.StatusNotFound)
+	})
+})
+
 var _ = Describe("Install Host test", func() {
 	var (
 		bm         *bareMetalInventory
@@ -12975,6 +13964,8 @@ func createInventory(db *gorm.DB, cfg Config) *bareMetalInventory {
 	mockOperatorManager = operators.NewMockAPI(ctrl)
 	mockIgnitionBuilder = ignition.NewMockIgnitionBuilder(ctrl)
 	mockProviderRegistry = registry.NewMockProviderRegistry(ctrl)
+	mockHostChangesWaiter = hostchanges.NewMockWaiter(ctrl)
+	mockClusterTemplatesApi = clustertemplates.NewMockAPI(ctrl)
 	mockInstallConfigBuilder = installcfg.NewMockInstallConfigBuilder(ctrl)
 	mockHwValidator = hardware.NewMockValidator(ctrl)
 	mockStaticNetworkConfig = staticnetworkconfig.NewMockStaticNetworkConfig(ctrl)
@@ -12985,7 +13976,7 @@ func createInventory(db *gorm.DB, cfg Config) *bareMetalInventory {
 		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, mockOperatorManager,
 		getTestAuthHandler(), getTestAuthzHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
 		mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder, mockStaticNetworkConfig,
-		gcConfig, mockProviderRegistry, true)
+		gcConfig, mockProviderRegistry, true, mockHostChangesWaiter, mockClusterTemplatesApi)
 
 	bm.ImageServiceBaseURL = imageServiceBaseURL
 	return bm
@@ -13348,6 +14339,150 @@ var _ = Describe("GetCredentials", func() {
 	})
 })
 
+var _ = Describe("Restore deleted clusters", func() {
+	var (
+		ctx    = context.Background()
+		cfg    = Config{}
+		bm     *bareMetalInventory
+		db     *gorm.DB
+		dbName string
+		c      common.Cluster
+	)
+
+	deleteCluster := func(deletedAt time.Time) {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("deleted_at", deletedAt).Error).ShouldNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		bm.gcConfig.DeletedUnregisteredAfter = 72 * time.Hour
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		c = common.Cluster{
+			Cluster: models.Cluster{
+				ID:     &clusterID,
+				Name:   "deleted-cluster",
+				Kind:   swag.String(models.ClusterKindCluster),
+				Status: swag.String(models.ClusterStatusReady),
+			},
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	Context("List", func() {
+		It("lists the clusters deleted within the retention window", func() {
+			deletedAt := time.Now().Add(-time.Hour)
+			deleteCluster(deletedAt)
+
+			expiredID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &expiredID}}).Error).ShouldNot(HaveOccurred())
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", expiredID.String()).
+				Update("deleted_at", time.Now().Add(-100*time.Hour)).Error).ShouldNot(HaveOccurred())
+
+			activeID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &activeID}}).Error).ShouldNot(HaveOccurred())
+
+			reply := bm.V2ListDeletedClusters(ctx, installer.V2ListDeletedClustersParams{})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2ListDeletedClustersOK()))
+			payload := reply.(*installer.V2ListDeletedClustersOK).Payload
+			Expect(payload).To(HaveLen(1))
+			Expect(*payload[0].ID).To(Equal(*c.ID))
+			Expect(payload[0].Name).To(Equal("deleted-cluster"))
+			Expect(time.Time(*payload[0].RestorableUntil).Sub(time.Time(*payload[0].DeletedAt))).To(Equal(72 * time.Hour))
+		})
+	})
+
+	Context("Restore", func() {
+		It("restores a deleted cluster", func() {
+			deleteCluster(time.Now().Add(-time.Hour))
+			mockAccountsMgmt.EXPECT().GetSubscription(ctx, gomock.Any()).Return(&amgmtv1.Subscription{}, nil).Times(1)
+			mockClusterApi.EXPECT().RestoreCluster(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, cluster *common.Cluster) error {
+				Expect(*cluster.ID).To(Equal(*c.ID))
+				Expect(db.Unscoped().Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("deleted_at", nil).Error).ShouldNot(HaveOccurred())
+				return nil
+			}).Times(1)
+
+			reply := bm.V2RestoreDeletedCluster(ctx, installer.V2RestoreDeletedClusterParams{ClusterID: *c.ID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RestoreDeletedClusterCreated()))
+			Expect(*reply.(*installer.V2RestoreDeletedClusterCreated).Payload.ID).To(Equal(*c.ID))
+		})
+
+		It("creates a new AMS subscription when the previous one was deleted", func() {
+			deleteCluster(time.Now().Add(-time.Hour))
+			mockClusterApi.EXPECT().RestoreCluster(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, _ *common.Cluster) error {
+				return db.Unscoped().Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("deleted_at", nil).Error
+			}).Times(1)
+			mockAccountsMgmt.EXPECT().GetSubscription(ctx, gomock.Any()).Return(nil, errors.New("not found")).Times(1)
+			mockAMSSubscription(ctx)
+			mockClusterApi.EXPECT().UpdateAmsSubscriptionID(ctx, *c.ID, gomock.Any()).Return(nil).Times(1)
+
+			reply := bm.V2RestoreDeletedCluster(ctx, installer.V2RestoreDeletedClusterParams{ClusterID: *c.ID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RestoreDeletedClusterCreated()))
+		})
+
+		It("fails for a cluster that isn't deleted", func() {
+			reply := bm.V2RestoreDeletedCluster(ctx, installer.V2RestoreDeletedClusterParams{ClusterID: *c.ID})
+			verifyApiErrorString(reply, http.StatusConflict, "is not deleted")
+		})
+
+		It("fails for a missing cluster", func() {
+			reply := bm.V2RestoreDeletedCluster(ctx, installer.V2RestoreDeletedClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+			verifyApiError(reply, http.StatusNotFound)
+		})
+
+		It("fails for a cluster deleted before the retention window", func() {
+			deleteCluster(time.Now().Add(-100 * time.Hour))
+			reply := bm.V2RestoreDeletedCluster(ctx, installer.V2RestoreDeletedClusterParams{ClusterID: *c.ID})
+			verifyApiErrorString(reply, http.StatusConflict, "can no longer be restored")
+		})
+
+		It("recreates the DNS records of a cluster whose installation started", func() {
+			mockDNSApi := dns.NewMockDNSApi(ctrl)
+			bm.dnsApi = mockDNSApi
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("status", models.ClusterStatusInstalled).Error).ShouldNot(HaveOccurred())
+			deleteCluster(time.Now().Add(-time.Hour))
+			mockAccountsMgmt.EXPECT().GetSubscription(ctx, gomock.Any()).Return(&amgmtv1.Subscription{}, nil).Times(1)
+			mockClusterApi.EXPECT().RestoreCluster(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, _ *common.Cluster) error {
+				return db.Unscoped().Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("deleted_at", nil).Error
+			}).Times(1)
+			mockDNSApi.EXPECT().CreateDNSRecordSets(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, cluster *common.Cluster) error {
+				Expect(*cluster.ID).To(Equal(*c.ID))
+				return nil
+			}).Times(1)
+
+			reply := bm.V2RestoreDeletedCluster(ctx, installer.V2RestoreDeletedClusterParams{ClusterID: *c.ID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RestoreDeletedClusterCreated()))
+		})
+
+		It("fails when the DNS records of the cluster can't be created", func() {
+			mockDNSApi := dns.NewMockDNSApi(ctrl)
+			bm.dnsApi = mockDNSApi
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("status", models.ClusterStatusInstalling).Error).ShouldNot(HaveOccurred())
+			deleteCluster(time.Now().Add(-time.Hour))
+			mockClusterApi.EXPECT().RestoreCluster(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, _ *common.Cluster) error {
+				return db.Unscoped().Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("deleted_at", nil).Error
+			}).Times(1)
+			mockDNSApi.EXPECT().CreateDNSRecordSets(ctx, gomock.Any()).Return(errors.New("route53 is down")).Times(1)
+
+			reply := bm.V2RestoreDeletedCluster(ctx, installer.V2RestoreDeletedClusterParams{ClusterID: *c.ID})
+			verifyApiErrorString(reply, http.StatusInternalServerError, "DNS records could not be created")
+		})
+
+		It("fails for a kube-api cluster", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("kube_key_name", "cluster").Error).ShouldNot(HaveOccurred())
+			deleteCluster(time.Now().Add(-time.Hour))
+			reply := bm.V2RestoreDeletedCluster(ctx, installer.V2RestoreDeletedClusterParams{ClusterID: *c.ID})
+			verifyApiErrorString(reply, http.StatusBadRequest, "managed by kube-api")
+		})
+	})
+})
+
 var _ = Describe("AddReleaseImage", func() {
 	var (
 		cfg          = Config{}
@@ -14357,3 +15492,86 @@ var _ = Describe("GetHostByKubeKey", func() {
 		Expect(err).To(HaveOccurred())
 	})
 })
+
+var _ = Describe("Register cluster from template", func() {
+	var (
+		bm       *bareMetalInventory
+		cfg      Config
+		db       *gorm.DB
+		dbName   string
+		ctx      = context.Background()
+		template *models.ClusterTemplate
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		bm.ocmClient = nil
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		mockUsageReports()
+
+		templateID := strfmt.UUID(uuid.New().String())
+		template = &models.ClusterTemplate{
+			ID: &templateID,
+			ClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("template"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				BaseDNSDomain:    "template.example.com",
+				HTTPProxy:        swag.String("http://proxy.example.com"),
+			},
+			InstallConfigOverrides: `{"fips": true}`,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	registerCluster := func() middleware.Responder {
+		return bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+			NewClusterParams: getDefaultClusterCreateParams(),
+			FromTemplate:     template.ID,
+		})
+	}
+
+	It("creates the cluster from the template", func() {
+		mockClusterRegisterSuccess(true)
+		mockClusterTemplatesApi.EXPECT().GetClusterTemplateInternal(ctx, *template.ID).Return(template, nil).Times(1)
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), template.InstallConfigOverrides).Return(nil).Times(1)
+		mockClusterTemplatesApi.EXPECT().CreateClusterManifests(ctx, template, gomock.Any()).Return(nil).Times(1)
+
+		reply := registerCluster()
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewV2RegisterClusterCreated()))
+		actual := reply.(*installer.V2RegisterClusterCreated).Payload
+		Expect(actual.Name).To(Equal(swag.StringValue(getDefaultClusterCreateParams().Name)))
+		Expect(actual.BaseDNSDomain).To(Equal("template.example.com"))
+		Expect(actual.HTTPProxy).To(Equal("http://proxy.example.com"))
+		Expect(actual.InstallConfigOverrides).To(Equal(template.InstallConfigOverrides))
+	})
+
+	It("fails when the template is not found", func() {
+		mockClusterTemplatesApi.EXPECT().GetClusterTemplateInternal(ctx, *template.ID).
+			Return(nil, common.NewApiError(http.StatusNotFound, errors.New("cluster template not found"))).Times(1)
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterRegistrationFailedEventName))).Times(1)
+
+		verifyApiError(registerCluster(), http.StatusNotFound)
+	})
+
+	It("fails when the install config overrides of the template are invalid", func() {
+		mockClusterRegisterSteps()
+		mockClusterTemplatesApi.EXPECT().GetClusterTemplateInternal(ctx, *template.ID).Return(template, nil).Times(1)
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), template.InstallConfigOverrides).
+			Return(errors.New("invalid")).Times(1)
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterRegistrationFailedEventName))).Times(1)
+
+		verifyApiError(registerCluster(), http.StatusBadRequest)
+		var count int64
+		Expect(db.Model(&common.Cluster{}).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+})
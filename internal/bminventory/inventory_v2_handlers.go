@@ -25,6 +25,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -35,7 +36,7 @@ func (b *bareMetalInventory) V2UpdateHost(ctx context.Context, params installer.
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewV2UpdateHostCreated().WithPayload(&host.Host)
+	return installer.NewV2UpdateHostCreated().WithPayload(&host.Host).WithETag(common.ETag(host.ResourceVersion))
 }
 
 func (b *bareMetalInventory) V2RegisterCluster(ctx context.Context, params installer.V2RegisterClusterParams) middleware.Responder {
@@ -59,7 +60,7 @@ func (b *bareMetalInventory) V2GetCluster(ctx context.Context, params installer.
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewV2GetClusterOK().WithPayload(&c.Cluster)
+	return installer.NewV2GetClusterOK().WithPayload(&c.Cluster).WithETag(common.ETag(c.ResourceVersion))
 }
 
 func (b *bareMetalInventory) V2DeregisterCluster(ctx context.Context, params installer.V2DeregisterClusterParams) middleware.Responder {
@@ -69,6 +70,11 @@ func (b *bareMetalInventory) V2DeregisterCluster(ctx context.Context, params ins
 		return common.NewApiError(http.StatusNotFound, err)
 	}
 
+	if err = verifyClusterNotDeletionProtected(cluster); err != nil {
+		log.WithError(err).Errorf("failed to deregister cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
 	if b.ocmClient != nil {
 		if err = b.integrateWithAMSClusterDeregistration(ctx, cluster); err != nil {
 			log.WithError(err).Errorf("Cluster %s failed to integrate with AMS on cluster deregistration", params.ClusterID)
@@ -92,17 +98,76 @@ func (b *bareMetalInventory) V2DeregisterCluster(ctx context.Context, params ins
 }
 
 func (b *bareMetalInventory) V2GetClusterInstallConfig(ctx context.Context, params installer.V2GetClusterInstallConfigParams) middleware.Responder {
-	c, err := b.getCluster(ctx, params.ClusterID.String())
+	cfg, err := b.GetClusterInstallConfigInternal(ctx, params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
 
-	cfg, err := b.installConfigBuilder.GetInstallConfig(c, false, "")
+	return installer.NewV2GetClusterInstallConfigOK().WithPayload(cfg)
+}
+
+func (b *bareMetalInventory) V2ListClusterInstallConfigHistory(ctx context.Context, params installer.V2ListClusterInstallConfigHistoryParams) middleware.Responder {
+	history, err := b.ListClusterInstallConfigHistoryInternal(ctx, params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
+	return installer.NewV2ListClusterInstallConfigHistoryOK().WithPayload(history)
+}
 
-	return installer.NewV2GetClusterInstallConfigOK().WithPayload(string(cfg))
+func (b *bareMetalInventory) V2ListDeletedClusters(ctx context.Context, params installer.V2ListDeletedClustersParams) middleware.Responder {
+	clusters, err := b.ListDeletedClustersInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListDeletedClustersOK().WithPayload(clusters)
+}
+
+func (b *bareMetalInventory) V2RestoreDeletedCluster(ctx context.Context, params installer.V2RestoreDeletedClusterParams) middleware.Responder {
+	c, err := b.RestoreDeletedClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2RestoreDeletedClusterCreated().WithPayload(&c.Cluster)
+}
+
+func (b *bareMetalInventory) V2GetClusterInstallConfigDiff(ctx context.Context, params installer.V2GetClusterInstallConfigDiffParams) middleware.Responder {
+	diff, err := b.GetClusterInstallConfigDiffInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterInstallConfigDiffOK().WithPayload(diff)
+}
+
+func (b *bareMetalInventory) V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	timeline, err := b.GetClusterInstallationTimelineInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterInstallationTimelineOK().WithPayload(timeline)
+}
+
+func (b *bareMetalInventory) V2ListClusterStateTransitions(ctx context.Context, params installer.V2ListClusterStateTransitionsParams) middleware.Responder {
+	transitions, err := b.ListClusterStateTransitionsInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListClusterStateTransitionsOK().WithPayload(transitions)
+}
+
+func (b *bareMetalInventory) V2ListHostStateTransitions(ctx context.Context, params installer.V2ListHostStateTransitionsParams) middleware.Responder {
+	transitions, err := b.ListHostStateTransitionsInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListHostStateTransitionsOK().WithPayload(transitions)
+}
+
+func (b *bareMetalInventory) V2ListHostValidationStats(ctx context.Context, params installer.V2ListHostValidationStatsParams) middleware.Responder {
+	stats, err := b.ListHostValidationStatsInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListHostValidationStatsOK().WithPayload(stats)
 }
 
 func (b *bareMetalInventory) V2UpdateClusterInstallConfig(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) middleware.Responder {
@@ -113,6 +178,14 @@ func (b *bareMetalInventory) V2UpdateClusterInstallConfig(ctx context.Context, p
 	return installer.NewV2UpdateClusterInstallConfigCreated()
 }
 
+func (b *bareMetalInventory) V2CancelInstallSchedule(ctx context.Context, params installer.V2CancelInstallScheduleParams) middleware.Responder {
+	c, err := b.UpdateInstallScheduleInternal(ctx, params.ClusterID, nil)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2CancelInstallScheduleAccepted().WithPayload(&c.Cluster)
+}
+
 func (b *bareMetalInventory) V2InstallCluster(ctx context.Context, params installer.V2InstallClusterParams) middleware.Responder {
 	c, err := b.InstallClusterInternal(ctx, params)
 	if err != nil {
@@ -199,6 +272,22 @@ func (b *bareMetalInventory) V2ResetCluster(ctx context.Context, params installe
 	return installer.NewV2ResetClusterAccepted().WithPayload(&cluster.Cluster)
 }
 
+func (b *bareMetalInventory) V2DryRunInstallation(ctx context.Context, params installer.V2DryRunInstallationParams) middleware.Responder {
+	result, err := b.DryRunInstallationInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2DryRunInstallationOK().WithPayload(result)
+}
+
+func (b *bareMetalInventory) V2ReinstallFailedHosts(ctx context.Context, params installer.V2ReinstallFailedHostsParams) middleware.Responder {
+	c, err := b.ReinstallFailedHostsInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ReinstallFailedHostsAccepted().WithPayload(&c.Cluster)
+}
+
 func (b *bareMetalInventory) V2GetPreflightRequirements(ctx context.Context, params installer.V2GetPreflightRequirementsParams) middleware.Responder {
 	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
 	if err != nil {
@@ -743,3 +832,38 @@ func (b *bareMetalInventory) GetInfraEnvPresignedFileURL(ctx context.Context, pa
 		},
 	}
 }
+
+func (b *bareMetalInventory) V2CreateHostDiagnostic(ctx context.Context, params installer.V2CreateHostDiagnosticParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to get host %s in infra-env %s", params.HostID, params.InfraEnvID)
+		return common.GenerateErrorResponder(err)
+	}
+	diagnostic, err := b.hostApi.CreateDiagnostic(ctx, &host.Host, *params.HostDiagnosticCreateParams.Action, ocm.UserNameFromContext(ctx))
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2CreateHostDiagnosticCreated().WithPayload(diagnostic)
+}
+
+func (b *bareMetalInventory) V2ListHostDiagnostics(ctx context.Context, params installer.V2ListHostDiagnosticsParams) middleware.Responder {
+	if _, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String()); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	var diagnostics models.HostDiagnosticList
+	if err := b.db.Omit("output", "error").Where("host_id = ? and infra_env_id = ?", params.HostID.String(), params.InfraEnvID.String()).
+		Order("requested_at").Find(&diagnostics).Error; err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListHostDiagnosticsOK().WithPayload(diagnostics)
+}
+
+func (b *bareMetalInventory) V2GetHostDiagnostic(ctx context.Context, params installer.V2GetHostDiagnosticParams) middleware.Responder {
+	var diagnostic models.HostDiagnostic
+	if err := b.db.Take(&diagnostic, "id = ? and host_id = ? and infra_env_id = ?",
+		params.DiagnosticID.String(), params.HostID.String(), params.InfraEnvID.String()).Error; err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetHostDiagnosticOK().WithPayload(&diagnostic)
+}
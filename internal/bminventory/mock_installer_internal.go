@@ -442,6 +442,21 @@ func (mr *MockInstallerInternalsMockRecorder) UpdateInfraEnvInternal(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfraEnvInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateInfraEnvInternal), arg0, arg1, arg2)
 }
 
+// UpdateInstallScheduleInternal mocks base method.
+func (m *MockInstallerInternals) UpdateInstallScheduleInternal(arg0 context.Context, arg1 strfmt.UUID, arg2 *models.InstallSchedule) (*common.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstallScheduleInternal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*common.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstallScheduleInternal indicates an expected call of UpdateInstallScheduleInternal.
+func (mr *MockInstallerInternalsMockRecorder) UpdateInstallScheduleInternal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstallScheduleInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateInstallScheduleInternal), arg0, arg1, arg2)
+}
+
 // V2DeregisterHostInternal mocks base method.
 func (m *MockInstallerInternals) V2DeregisterHostInternal(arg0 context.Context, arg1 installer.V2DeregisterHostParams) error {
 	m.ctrl.T.Helper()
@@ -119,9 +119,12 @@ type API interface {
 	GenerateAdditionalManifests(ctx context.Context, cluster *common.Cluster) error
 	CompleteInstallation(ctx context.Context, db *gorm.DB, cluster *common.Cluster, successfullyFinished bool, reason string) (*common.Cluster, error)
 	PermanentClustersDeletion(ctx context.Context, olderThan strfmt.DateTime, objectHandler s3wrapper.API) error
+	RestoreCluster(ctx context.Context, c *common.Cluster) error
 	DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime) error
 	TransformClusterToDay2(ctx context.Context, cluster *common.Cluster, db *gorm.DB) error
 	RefreshSchedulableMastersForcedTrue(ctx context.Context, clusterID strfmt.UUID) error
+	ScheduleInstallation(ctx context.Context, c *common.Cluster, schedule *models.InstallSchedule, db *gorm.DB) error
+	CancelInstallSchedule(ctx context.Context, c *common.Cluster, db *gorm.DB) error
 }
 
 type LogTimeoutConfig struct {
@@ -138,6 +141,8 @@ type Config struct {
 	InstallationTimeout time.Duration `envconfig:"INSTALLATION_TIMEOUT" default:"24h"`
 	FinalizingTimeout   time.Duration `envconfig:"FINALIZING_TIMEOUT" default:"5h"`
 	MonitorBatchSize    int           `envconfig:"CLUSTER_MONITOR_BATCH_SIZE" default:"100"`
+	EtaRefreshInterval  time.Duration `envconfig:"INSTALLATION_ETA_REFRESH_INTERVAL" default:"1h"`
+	EtaWindow           time.Duration `envconfig:"INSTALLATION_ETA_WINDOW" default:"720h"`
 }
 
 type Manager struct {
@@ -159,6 +164,8 @@ type Manager struct {
 	dnsApi                dns.DNSApi
 	monitorQueryGenerator *common.MonitorClusterQueryGenerator
 	authHandler           auth.Authenticator
+	installationStarter   InstallationStarter
+	durationsCache        *installationDurationsCache
 }
 
 func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler,
@@ -180,7 +187,7 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler e
 		registrationAPI:       NewRegistrar(log, db),
 		installationAPI:       NewInstaller(log, db, eventsHandler),
 		eventsHandler:         eventsHandler,
-		sm:                    NewClusterStateMachine(th),
+		sm:                    newTransitionRecorder(NewClusterStateMachine(th), log, db, metricApi),
 		metricAPI:             metricApi,
 		manifestsGeneratorAPI: manifestsGeneratorAPI,
 		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi),
@@ -191,6 +198,7 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler e
 		objectHandler:         objectHandler,
 		dnsApi:                dnsApi,
 		authHandler:           authHandler,
+		durationsCache:        &installationDurationsCache{},
 	}
 }
 
@@ -590,6 +598,7 @@ func (m *Manager) ClusterMonitoring() {
 		}
 		offset += limit
 	}
+	m.processInstallSchedules(ctx, log)
 	m.log.Debugf("Monitored %d clusters", monitored)
 	m.metricAPI.MonitoredClusterCount(monitored)
 }
@@ -772,6 +781,7 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, clusterID strfmt.UU
 		"progress_installing_stage_percentage": installingStagePercentage,
 		"progress_total_percentage":            totalPercentage,
 	}
+	m.estimateInstallationCompletion(ctx, cluster, updates)
 
 	return m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).UpdateColumns(updates).Error
 }
@@ -1165,7 +1175,8 @@ func (m Manager) DeregisterInactiveCluster(ctx context.Context, maxDeregisterPer
 
 	var clusters []*common.Cluster
 
-	if err := m.db.Limit(maxDeregisterPerInterval).Where("updated_at < ?", inactiveSince).Find(&clusters).Error; err != nil {
+	// Deletion protected clusters are never deregistered due to inactivity
+	if err := m.db.Limit(maxDeregisterPerInterval).Where("updated_at < ? AND deletion_protected = ?", inactiveSince, false).Find(&clusters).Error; err != nil {
 		return err
 	}
 	for _, c := range clusters {
@@ -1214,6 +1225,9 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 			&models.ClusterNetwork{},
 			&models.ServiceNetwork{},
 			&models.MachineNetwork{},
+			&models.InstallConfigOverrideVersion{},
+			&common.HostStageTransition{},
+			&models.StateTransition{},
 		}
 		for _, model := range modelsToDelete {
 			if err := common.DeleteRecordsByClusterID(m.db.Unscoped(), *c.ID, []interface{}{model}); err != nil {
@@ -1304,6 +1318,11 @@ func (m *Manager) CompleteInstallation(ctx context.Context, db *gorm.DB,
 		log.Error(err)
 		return nil, err
 	}
+	// The cluster is not returned when its status was changed concurrently
+	if clusterAfterUpdate != nil {
+		recordStateTransition(log, db, m.metricAPI, clusterAfterUpdate, models.ClusterStatusFinalizing, cluster.StatusUpdatedAt,
+			triggerCompleteInstallation)
+	}
 
 	if !successfullyFinished {
 		result = models.ClusterStatusError
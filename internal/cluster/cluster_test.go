@@ -128,6 +128,7 @@ var _ = Describe("TestClusterMonitoring", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
@@ -704,6 +705,7 @@ var _ = Describe("lease timeout event", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
@@ -817,6 +819,7 @@ var _ = Describe("Auto assign machine CIDR", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
@@ -1442,6 +1445,7 @@ var _ = Describe("CancelInstallation", func() {
 		eventsHandler = events.New(db, nil, logrus.New())
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
 		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil)
@@ -1466,6 +1470,23 @@ var _ = Describe("CancelInstallation", func() {
 			Expect(*cancelEvent.Severity).Should(Equal(models.EventSeverityInfo))
 			Expect(*cancelEvent.Message).Should(Equal("Canceled cluster installation"))
 		})
+		It("records the status transition", func() {
+			c.Status = swag.String(models.ClusterStatusInstalling)
+			c.StatusUpdatedAt = strfmt.DateTime(time.Now().Add(-time.Hour))
+			Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+			mockMetric.EXPECT().ClusterInstallationFinished(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+			Expect(state.CancelInstallation(ctx, &c, "some reason", db)).ShouldNot(HaveOccurred())
+
+			var transitions []*models.StateTransition
+			Expect(db.Where("object_id = ?", c.ID.String()).Find(&transitions).Error).ShouldNot(HaveOccurred())
+			Expect(transitions).To(HaveLen(1))
+			Expect(transitions[0].ObjectKind).To(Equal(models.StateTransitionObjectKindCluster))
+			Expect(*transitions[0].ClusterID).To(Equal(*c.ID))
+			Expect(transitions[0].FromState).To(Equal(models.ClusterStatusInstalling))
+			Expect(transitions[0].ToState).To(Equal(models.ClusterStatusCancelled))
+			Expect(transitions[0].Trigger).To(Equal(TransitionTypeCancelInstallation))
+			Expect(transitions[0].TimeInStateSeconds).To(BeNumerically("~", time.Hour.Seconds(), 60))
+		})
 		It("cancel_failed_installation", func() {
 			c.Status = swag.String(models.ClusterStatusError)
 			c.InstallStartedAt = strfmt.DateTime(time.Now().Add(-time.Minute))
@@ -1745,6 +1766,7 @@ var _ = Describe("PrepareForInstallation", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockEventsHandler = eventsapi.NewMockHandler(ctrl)
 		db, dbName = common.PrepareTestDB()
 		dummy := &leader.DummyElector{}
@@ -2096,6 +2118,7 @@ var _ = Describe("Majority groups", func() {
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockOperators := operators.NewMockAPI(ctrl)
 		mockMetricApi = metrics.NewMockAPI(ctrl)
+		mockMetricApi.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
 			mockEvents, nil, mockMetricApi, nil, dummy, mockOperators, nil, nil, nil, nil)
@@ -2548,6 +2571,7 @@ var _ = Describe("GenerateAdditionalManifests", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		manifestsGenerator = network.NewMockManifestsGeneratorAPI(ctrl)
 		db, dbName = common.PrepareTestDB()
 		eventsHandler = events.New(db, nil, logrus.New())
@@ -2676,6 +2700,7 @@ var _ = Describe("Deregister inactive clusters", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockOperators := operators.NewMockAPI(ctrl)
 		db, dbName = common.PrepareTestDB()
 		eventsHandler = events.New(db, nil, logrus.New())
@@ -2696,6 +2721,13 @@ var _ = Describe("Deregister inactive clusters", func() {
 		Expect(wasDeregisterd(db, *c.ID)).To(BeFalse())
 	})
 
+	It("Do nothing, deletion protected inactive cluster", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).
+			UpdateColumn("deletion_protected", true).Error).ShouldNot(HaveOccurred())
+		Expect(state.DeregisterInactiveCluster(ctx, 10, strfmt.DateTime(time.Now()))).ShouldNot(HaveOccurred())
+		Expect(wasDeregisterd(db, *c.ID)).To(BeFalse())
+	})
+
 	It("Deregister inactive cluster with new clusters", func() {
 		inactiveCluster1 := registerCluster()
 		inactiveCluster2 := registerCluster()
@@ -2810,6 +2842,7 @@ var _ = Describe("Permanently delete clusters", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockS3Api = s3wrapper.NewMockAPI(ctrl)
 		mockOperators := operators.NewMockAPI(ctrl)
 		db, dbName = common.PrepareTestDB()
@@ -3195,6 +3228,7 @@ var _ = Describe("Validation metrics and events", func() {
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockHost = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ClusterStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
 		m = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, mockHost, mockMetric, nil, nil, nil, nil, mockS3Client, nil, nil)
 		c = registerTestClusterWithValidationsAndHost()
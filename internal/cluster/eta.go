@@ -0,0 +1,266 @@
+package cluster
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/thoas/go-funk"
+)
+
+// An estimated completion time that moved by less than this isn't reported by an event
+const etaEventThreshold = 10 * time.Minute
+
+// The estimated completion time of a host that moved by less than this isn't updated
+const etaUpdateThreshold = time.Minute
+
+type stageDurationKey struct {
+	role      models.HostRole
+	bootstrap bool
+	stage     models.HostStage
+}
+
+// installationDurations holds the average durations of previous installations with the same OpenShift version and
+// topology as the estimated cluster
+type installationDurations struct {
+	stages     map[stageDurationKey]time.Duration
+	finalizing time.Duration
+}
+
+type installationDurationsKey struct {
+	openshiftVersion     string
+	highAvailabilityMode string
+}
+
+type installationDurationsCache struct {
+	sync.RWMutex
+	durations map[installationDurationsKey]*installationDurations
+}
+
+// RefreshInstallationDurations loads the average duration of each host stage, per host role, and of the cluster
+// finalization in the clusters installed within the ETA window, per OpenShift minor version and high availability
+// mode. The estimations use the loaded durations, so that they don't query the whole installation history whenever a
+// host reports its progress.
+func (m *Manager) RefreshInstallationDurations() {
+	durations, err := m.loadInstallationDurations()
+	if err != nil {
+		m.log.WithError(err).Warn("failed to load the durations of previous installations")
+		return
+	}
+	m.durationsCache.Lock()
+	defer m.durationsCache.Unlock()
+	m.durationsCache.durations = durations
+}
+
+func (m *Manager) getInstallationDurations(c *common.Cluster) *installationDurations {
+	m.durationsCache.RLock()
+	defer m.durationsCache.RUnlock()
+	return m.durationsCache.durations[installationDurationsKey{
+		openshiftVersion:     openshiftMinorVersion(c.OpenshiftVersion),
+		highAvailabilityMode: swag.StringValue(c.HighAvailabilityMode),
+	}]
+}
+
+func (m *Manager) loadInstallationDurations() (map[installationDurationsKey]*installationDurations, error) {
+	// The installed clusters within the window, with their OpenShift minor version
+	recent := `WITH recent AS (
+		SELECT id, high_availability_mode, install_started_at, install_completed_at,
+			split_part(openshift_version, '.', 1) || '.' || split_part(openshift_version, '.', 2) AS version
+		FROM clusters
+		WHERE status = ? AND deleted_at IS NULL AND install_completed_at >= ?)`
+	recentArgs := []interface{}{models.ClusterStatusInstalled, time.Now().Add(-m.EtaWindow)}
+
+	var stages []struct {
+		Version              string
+		HighAvailabilityMode string
+		Role                 models.HostRole
+		Bootstrap            bool
+		Stage                models.HostStage
+		Seconds              float64
+	}
+	err := m.db.Raw(recent+`
+		SELECT c.version, c.high_availability_mode, h.role, h.bootstrap, t.stage,
+			avg(extract(epoch from t.ended_at - t.started_at)) AS seconds
+		FROM (SELECT cluster_id, host_id, infra_env_id, stage, started_at,
+				lead(started_at) OVER (PARTITION BY cluster_id, host_id ORDER BY started_at, id) AS ended_at
+			FROM host_stage_transitions
+			WHERE cluster_id IN (SELECT id FROM recent)) t
+		JOIN recent c ON c.id = t.cluster_id
+		JOIN hosts h ON h.id = t.host_id AND h.infra_env_id = t.infra_env_id AND h.deleted_at IS NULL
+		WHERE t.started_at >= c.install_started_at AND t.ended_at IS NOT NULL
+		GROUP BY c.version, c.high_availability_mode, h.role, h.bootstrap, t.stage`, recentArgs...).Scan(&stages).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var finalizing []struct {
+		Version              string
+		HighAvailabilityMode string
+		Seconds              sql.NullFloat64
+	}
+	err = m.db.Raw(recent+`
+		SELECT c.version, c.high_availability_mode, avg(extract(epoch from c.install_completed_at - d.done_at)) AS seconds
+		FROM recent c
+		JOIN (SELECT cluster_id, max(started_at) AS done_at FROM host_stage_transitions
+			WHERE stage = ? AND cluster_id IN (SELECT id FROM recent) GROUP BY cluster_id) d
+			ON d.cluster_id = c.id
+		WHERE c.install_completed_at > d.done_at
+		GROUP BY c.version, c.high_availability_mode`, append(recentArgs, models.HostStageDone)...).Scan(&finalizing).Error
+	if err != nil {
+		return nil, err
+	}
+
+	durations := make(map[installationDurationsKey]*installationDurations)
+	get := func(version, highAvailabilityMode string) *installationDurations {
+		key := installationDurationsKey{openshiftVersion: version, highAvailabilityMode: highAvailabilityMode}
+		if durations[key] == nil {
+			durations[key] = &installationDurations{stages: make(map[stageDurationKey]time.Duration)}
+		}
+		return durations[key]
+	}
+	for _, s := range stages {
+		get(s.Version, s.HighAvailabilityMode).stages[stageDurationKey{role: s.Role, bootstrap: s.Bootstrap, stage: s.Stage}] =
+			time.Duration(s.Seconds * float64(time.Second))
+	}
+	for _, f := range finalizing {
+		get(f.Version, f.HighAvailabilityMode).finalizing = time.Duration(f.Seconds.Float64 * float64(time.Second))
+	}
+	return durations, nil
+}
+
+// hostCompletion returns the estimated completion time of the host installation: the time left in its current stage
+// and the durations of the stages it didn't reach yet. Stages that no previous installation went through are skipped.
+func (d *installationDurations) hostCompletion(h *models.Host, isSno bool, now time.Time) *time.Time {
+	var currentStage models.HostStage
+	var stageStartedAt time.Time
+	if h.Progress != nil {
+		currentStage = h.Progress.CurrentStage
+		stageStartedAt = time.Time(h.Progress.StageStartedAt)
+	}
+	switch currentStage {
+	case models.HostStageDone:
+		return &stageStartedAt
+	case models.HostStageFailed:
+		return nil
+	}
+
+	stages := host.FindMatchingStages(h.Role, h.Bootstrap, isSno)
+	currentIndex := funk.IndexOf(stages, currentStage)
+	if currentIndex < 0 {
+		currentIndex = 0
+		stageStartedAt = time.Time{}
+	}
+	var remaining time.Duration
+	known := false
+	for i, stage := range stages[currentIndex:] {
+		if stage == models.HostStageDone {
+			break
+		}
+		duration, ok := d.stages[stageDurationKey{role: h.Role, bootstrap: h.Bootstrap, stage: stage}]
+		if !ok {
+			continue
+		}
+		known = true
+		if i == 0 && !stageStartedAt.IsZero() {
+			duration -= now.Sub(stageStartedAt)
+			if duration < 0 {
+				duration = 0
+			}
+		}
+		remaining += duration
+	}
+	if !known {
+		return nil
+	}
+	completion := now.Add(remaining)
+	return &completion
+}
+
+// estimateInstallationCompletion sets the estimated completion time of the hosts of the cluster and adds the estimated
+// completion time of the cluster to the cluster updates. The cluster completes once its last host completes and the
+// cluster finalization is done.
+func (m *Manager) estimateInstallationCompletion(ctx context.Context, c *common.Cluster, updates map[string]interface{}) {
+	log := logutil.FromContext(ctx, m.log)
+	if swag.StringValue(c.Status) == models.ClusterStatusInstalled {
+		return
+	}
+	durations := m.getInstallationDurations(c)
+	if durations == nil {
+		return
+	}
+	var hosts []*models.Host
+	if err := m.db.Where("cluster_id = ?", c.ID.String()).Find(&hosts).Error; err != nil {
+		log.WithError(err).Warnf("failed to get the hosts of cluster %s", c.ID)
+		return
+	}
+
+	now := time.Now()
+	isSno := swag.StringValue(c.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone
+	var clusterCompletion *time.Time
+	for _, h := range hosts {
+		completion := durations.hostCompletion(h, isSno, now)
+		var estimatedCompletionAt *strfmt.DateTime
+		if completion != nil {
+			estimatedCompletionAt = (*strfmt.DateTime)(completion)
+			if clusterCompletion == nil || completion.After(*clusterCompletion) {
+				clusterCompletion = completion
+			}
+		}
+		if h.Progress != nil && similarDateTime(h.Progress.EstimatedCompletionAt, estimatedCompletionAt) {
+			continue
+		}
+		if err := m.db.Model(&models.Host{}).Where("id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).
+			UpdateColumn("progress_estimated_completion_at", estimatedCompletionAt).Error; err != nil {
+			log.WithError(err).Warnf("failed to update the estimated completion time of host %s", h.ID)
+		}
+	}
+	if clusterCompletion == nil {
+		return
+	}
+
+	completion := clusterCompletion.Add(durations.finalizing)
+	if completion.Before(now) {
+		completion = now
+	}
+	updates["progress_estimated_completion_at"] = strfmt.DateTime(completion)
+
+	var previous *strfmt.DateTime
+	if c.Progress != nil {
+		previous = c.Progress.EstimatedCompletionAt
+	}
+	if previous == nil || time.Time(*previous).Sub(completion) >= etaEventThreshold || completion.Sub(time.Time(*previous)) >= etaEventThreshold {
+		remaining := strings.TrimSuffix(completion.Sub(now).Round(time.Minute).String(), "0s")
+		if remaining == "" {
+			remaining = "0m"
+		}
+		eventgen.SendClusterInstallationEtaUpdatedEvent(ctx, m.eventsHandler, *c.ID,
+			completion.UTC().Format(time.RFC3339), remaining)
+	}
+}
+
+func openshiftMinorVersion(openshiftVersion string) string {
+	parts := strings.SplitN(openshiftVersion, ".", 3)
+	if len(parts) < 2 {
+		return openshiftVersion
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// similarDateTime returns whether the estimated completion times are both unset or differ by less than the update
+// threshold
+func similarDateTime(a, b *strfmt.DateTime) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	diff := time.Time(*a).Sub(time.Time(*b))
+	return diff > -etaUpdateThreshold && diff < etaUpdateThreshold
+}
@@ -0,0 +1,193 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("hostCompletion", func() {
+	var (
+		now       = time.Now()
+		durations *installationDurations
+		h         *models.Host
+	)
+
+	BeforeEach(func() {
+		durations = &installationDurations{stages: map[stageDurationKey]time.Duration{
+			{role: models.HostRoleMaster, stage: models.HostStageWritingImageToDisk}: 10 * time.Minute,
+			{role: models.HostRoleMaster, stage: models.HostStageRebooting}:          5 * time.Minute,
+			{role: models.HostRoleMaster, stage: models.HostStageJoined}:             3 * time.Minute,
+		}}
+		h = &models.Host{Role: models.HostRoleMaster, Progress: &models.HostProgressInfo{
+			CurrentStage:   models.HostStageWritingImageToDisk,
+			StageStartedAt: strfmt.DateTime(now.Add(-4 * time.Minute)),
+		}}
+	})
+
+	It("adds the time left in the current stage to the remaining stages", func() {
+		Expect(*durations.hostCompletion(h, false, now)).To(BeTemporally("==", now.Add(14*time.Minute)))
+	})
+
+	It("doesn't count an overdue stage", func() {
+		h.Progress.StageStartedAt = strfmt.DateTime(now.Add(-time.Hour))
+		Expect(*durations.hostCompletion(h, false, now)).To(BeTemporally("==", now.Add(8*time.Minute)))
+	})
+
+	It("counts all the stages of a host that didn't start installing", func() {
+		h.Progress = &models.HostProgressInfo{}
+		Expect(*durations.hostCompletion(h, false, now)).To(BeTemporally("==", now.Add(18*time.Minute)))
+	})
+
+	It("returns the completion time of a done host", func() {
+		h.Progress.CurrentStage = models.HostStageDone
+		Expect(*durations.hostCompletion(h, false, now)).To(BeTemporally("==", now.Add(-4*time.Minute)))
+	})
+
+	It("doesn't estimate failed hosts or hosts without history", func() {
+		h.Progress.CurrentStage = models.HostStageFailed
+		Expect(durations.hostCompletion(h, false, now)).To(BeNil())
+		h.Progress.CurrentStage = models.HostStageWritingImageToDisk
+		h.Role = models.HostRoleWorker
+		Expect(durations.hostCompletion(h, false, now)).To(BeNil())
+	})
+})
+
+var _ = Describe("Installation ETA", func() {
+	var (
+		ctx         = context.Background()
+		db          *gorm.DB
+		dbName      string
+		ctrl        *gomock.Controller
+		clusterApi  *Manager
+		mockEvents  *eventsapi.MockHandler
+		mockHostAPI *host.MockAPI
+		installedID strfmt.UUID
+	)
+
+	createCluster := func(version, status string, startedAt time.Time) strfmt.UUID {
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:                   &clusterID,
+			Kind:                 swag.String(models.ClusterKindCluster),
+			OpenshiftVersion:     version,
+			HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeFull),
+			Status:               swag.String(status),
+			InstallStartedAt:     strfmt.DateTime(startedAt),
+			InstallCompletedAt:   strfmt.DateTime(startedAt.Add(35 * time.Minute)),
+		}}).Error).ShouldNot(HaveOccurred())
+		return clusterID
+	}
+
+	createHost := func(clusterID strfmt.UUID, progress *models.HostProgressInfo) strfmt.UUID {
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Host{
+			ID:         &hostID,
+			ClusterID:  &clusterID,
+			InfraEnvID: clusterID,
+			Role:       models.HostRoleMaster,
+			Status:     swag.String(models.HostStatusInstallingInProgress),
+			Progress:   progress,
+		}).Error).ShouldNot(HaveOccurred())
+		return hostID
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockHostAPI = host.NewMockAPI(ctrl)
+		mockHostAPI.EXPECT().IndexOfStage(gomock.Any(), gomock.Any()).Return(2).AnyTimes()
+		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, mockHostAPI, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		start := time.Now().Add(-24 * time.Hour)
+		installedID = createCluster("4.11.0", models.ClusterStatusInstalled, start)
+		installedHostID := createHost(installedID, &models.HostProgressInfo{CurrentStage: models.HostStageDone})
+		for _, t := range []struct {
+			stage   models.HostStage
+			minutes int
+		}{
+			{models.HostStageStartingInstallation, 0},
+			{models.HostStageInstalling, 1},
+			{models.HostStageWritingImageToDisk, 2},
+			{models.HostStageRebooting, 12},
+			{models.HostStageConfiguring, 17},
+			{models.HostStageJoined, 20},
+			{models.HostStageDone, 25},
+		} {
+			Expect(db.Create(&common.HostStageTransition{
+				ClusterID:  installedID,
+				HostID:     installedHostID,
+				InfraEnvID: installedID,
+				Stage:      t.stage,
+				StartedAt:  start.Add(time.Duration(t.minutes) * time.Minute),
+			}).Error).ShouldNot(HaveOccurred())
+		}
+		clusterApi.RefreshInstallationDurations()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("estimates the completion from installations of the same OpenShift version", func() {
+		clusterID := createCluster("4.11.3", models.ClusterStatusInstalling, time.Now().Add(-10*time.Minute))
+		hostID := createHost(clusterID, &models.HostProgressInfo{
+			CurrentStage:   models.HostStageWritingImageToDisk,
+			StageStartedAt: strfmt.DateTime(time.Now().Add(-4 * time.Minute)),
+		})
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterInstallationEtaUpdatedEventName),
+			eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+		Expect(clusterApi.UpdateInstallProgress(ctx, clusterID)).To(Succeed())
+
+		// 6 minutes left writing the image, then rebooting, configuring and joining
+		h := hostutil.GetHostFromDB(hostID, clusterID, db)
+		Expect(time.Time(*h.Progress.EstimatedCompletionAt)).To(BeTemporally("~", time.Now().Add(19*time.Minute), time.Minute))
+		c := getClusterFromDB(clusterID, db)
+		Expect(time.Time(*c.Progress.EstimatedCompletionAt)).To(BeTemporally("~", time.Now().Add(29*time.Minute), time.Minute))
+
+		By("not reporting a similar estimation again")
+		Expect(clusterApi.UpdateInstallProgress(ctx, clusterID)).To(Succeed())
+	})
+
+	It("doesn't estimate without installations of the same OpenShift version", func() {
+		clusterID := createCluster("4.12.0", models.ClusterStatusInstalling, time.Now().Add(-10*time.Minute))
+		createHost(clusterID, &models.HostProgressInfo{CurrentStage: models.HostStageWritingImageToDisk})
+		Expect(clusterApi.UpdateInstallProgress(ctx, clusterID)).To(Succeed())
+		Expect(getClusterFromDB(clusterID, db).Progress.EstimatedCompletionAt).To(BeNil())
+	})
+
+	It("doesn't use installations outside the window", func() {
+		clusterApi.EtaWindow = time.Hour
+		clusterApi.RefreshInstallationDurations()
+		clusterID := createCluster("4.11.3", models.ClusterStatusInstalling, time.Now().Add(-10*time.Minute))
+		createHost(clusterID, &models.HostProgressInfo{CurrentStage: models.HostStageWritingImageToDisk})
+		Expect(clusterApi.UpdateInstallProgress(ctx, clusterID)).To(Succeed())
+		Expect(getClusterFromDB(clusterID, db).Progress.EstimatedCompletionAt).To(BeNil())
+	})
+
+	It("doesn't use deleted installations", func() {
+		Expect(db.Delete(&common.Cluster{}, "id = ?", installedID.String()).Error).ShouldNot(HaveOccurred())
+		clusterApi.RefreshInstallationDurations()
+		clusterID := createCluster("4.11.3", models.ClusterStatusInstalling, time.Now().Add(-10*time.Minute))
+		createHost(clusterID, &models.HostProgressInfo{CurrentStage: models.HostStageWritingImageToDisk})
+		Expect(clusterApi.UpdateInstallProgress(ctx, clusterID)).To(Succeed())
+		Expect(getClusterFromDB(clusterID, db).Progress.EstimatedCompletionAt).To(BeNil())
+	})
+})
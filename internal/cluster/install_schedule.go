@@ -0,0 +1,154 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	installerops "github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// InstallationStarter starts the installation of a cluster the same way the install action does
+type InstallationStarter interface {
+	InstallClusterInternal(ctx context.Context, params installerops.V2InstallClusterParams) (*common.Cluster, error)
+}
+
+var installSchedulableStatuses = []string{
+	models.ClusterStatusPendingForInput,
+	models.ClusterStatusInsufficient,
+	models.ClusterStatusReady,
+}
+
+// SetInstallationStarter sets the starter used by the cluster monitor to start the scheduled installations.
+// It has to be set after the manager is created, as the starter depends on the manager.
+func (m *Manager) SetInstallationStarter(starter InstallationStarter) {
+	m.installationStarter = starter
+}
+
+func (m *Manager) ScheduleInstallation(ctx context.Context, c *common.Cluster, schedule *models.InstallSchedule, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, m.log)
+	if !funk.ContainsString(installSchedulableStatuses, swag.StringValue(c.Status)) {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("cluster %s is in %s status, installation can be scheduled only when status is one of: %s",
+			c.ID, swag.StringValue(c.Status), installSchedulableStatuses))
+	}
+	if schedule.StartAt == nil {
+		return common.NewApiError(http.StatusBadRequest, errors.New("install schedule start time is required"))
+	}
+	if schedule.EndAt != nil {
+		if !time.Time(*schedule.EndAt).After(time.Time(*schedule.StartAt)) {
+			return common.NewApiError(http.StatusBadRequest, errors.New("install schedule end time must be after its start time"))
+		}
+		if !time.Time(*schedule.EndAt).After(time.Now()) {
+			return common.NewApiError(http.StatusBadRequest, errors.New("install schedule end time must be in the future"))
+		}
+	}
+	if SameDateTime(c.InstallScheduleStartAt, schedule.StartAt) && SameDateTime(c.InstallScheduleEndAt, schedule.EndAt) {
+		return nil
+	}
+
+	if err := updateInstallSchedule(db, *c.ID, schedule.StartAt, schedule.EndAt); err != nil {
+		log.WithError(err).Errorf("failed to schedule the installation of cluster %s", c.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	c.InstallScheduleStartAt = schedule.StartAt
+	c.InstallScheduleEndAt = schedule.EndAt
+	eventgen.SendInstallScheduledEvent(ctx, m.eventsHandler, *c.ID, installScheduleWindow(schedule.StartAt, schedule.EndAt))
+	log.Infof("Scheduled the installation of cluster %s to start %s", c.ID, installScheduleWindow(schedule.StartAt, schedule.EndAt))
+	return nil
+}
+
+func (m *Manager) CancelInstallSchedule(ctx context.Context, c *common.Cluster, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, m.log)
+	if c.InstallScheduleStartAt == nil {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("cluster %s has no scheduled installation", c.ID))
+	}
+	if err := ClearInstallSchedule(db, *c.ID); err != nil {
+		log.WithError(err).Errorf("failed to cancel the scheduled installation of cluster %s", c.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	eventgen.SendInstallScheduleCancelledEvent(ctx, m.eventsHandler, *c.ID, installScheduleWindow(c.InstallScheduleStartAt, c.InstallScheduleEndAt))
+	c.InstallScheduleStartAt = nil
+	c.InstallScheduleEndAt = nil
+	return nil
+}
+
+// ClearInstallSchedule removes the scheduled installation of the cluster, if any
+func ClearInstallSchedule(db *gorm.DB, clusterID strfmt.UUID) error {
+	return updateInstallSchedule(db, clusterID, nil, nil)
+}
+
+func updateInstallSchedule(db *gorm.DB, clusterID strfmt.UUID, startAt, endAt *strfmt.DateTime) error {
+	return db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(map[string]interface{}{
+		"install_schedule_start_at": startAt,
+		"install_schedule_end_at":   endAt,
+	}).Error
+}
+
+// processInstallSchedules expires the scheduled installations whose window ended and starts the ones whose window
+// started if the cluster is ready. Clusters that are managed by the kube-api are only expired, their installation is
+// started by the ClusterDeployments controller that also waits for the agents to be approved.
+func (m *Manager) processInstallSchedules(ctx context.Context, log logrus.FieldLogger) {
+	var clusters []*common.Cluster
+	now := time.Now()
+	if err := m.db.Where("install_schedule_start_at <= ?", now).Find(&clusters).Error; err != nil {
+		log.WithError(err).Error("failed to get clusters with scheduled installations")
+		return
+	}
+	for _, c := range clusters {
+		window := installScheduleWindow(c.InstallScheduleStartAt, c.InstallScheduleEndAt)
+		if c.InstallScheduleEndAt != nil && now.After(time.Time(*c.InstallScheduleEndAt)) {
+			if err := ClearInstallSchedule(m.db, *c.ID); err != nil {
+				log.WithError(err).Errorf("failed to expire the scheduled installation of cluster %s", c.ID)
+				continue
+			}
+			log.Infof("Scheduled installation of cluster %s expired", c.ID)
+			eventgen.SendInstallScheduleExpiredEvent(ctx, m.eventsHandler, *c.ID, window)
+			continue
+		}
+		if m.installationStarter == nil || c.KubeKeyName != "" || swag.StringValue(c.Status) != models.ClusterStatusReady {
+			continue
+		}
+
+		// The schedule is cleared before the installation starts so it is not started twice
+		if err := ClearInstallSchedule(m.db, *c.ID); err != nil {
+			log.WithError(err).Errorf("failed to clear the scheduled installation of cluster %s", c.ID)
+			continue
+		}
+		log.Infof("Starting the scheduled installation of cluster %s", c.ID)
+		if _, err := m.installationStarter.InstallClusterInternal(ctx, installerops.V2InstallClusterParams{ClusterID: *c.ID}); err != nil {
+			log.WithError(err).Errorf("failed to start the scheduled installation of cluster %s", c.ID)
+			eventgen.SendScheduledInstallFailedEvent(ctx, m.eventsHandler, *c.ID, err.Error())
+			continue
+		}
+		eventgen.SendScheduledInstallStartedEvent(ctx, m.eventsHandler, *c.ID)
+	}
+}
+
+func installScheduleWindow(startAt, endAt *strfmt.DateTime) string {
+	if startAt == nil {
+		return ""
+	}
+	if endAt == nil {
+		return fmt.Sprintf("at %s", time.Time(*startAt).UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("between %s and %s", time.Time(*startAt).UTC().Format(time.RFC3339), time.Time(*endAt).UTC().Format(time.RFC3339))
+}
+
+// SameDateTime returns whether both times are unset, or are set to the same instant
+func SameDateTime(a, b *strfmt.DateTime) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return time.Time(*a).Equal(time.Time(*b))
+}
@@ -0,0 +1,171 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/models"
+	installerops "github.com/openshift/assisted-service/restapi/operations/installer"
+	"gorm.io/gorm"
+)
+
+type fakeInstallationStarter struct {
+	started []strfmt.UUID
+	err     error
+}
+
+func (f *fakeInstallationStarter) InstallClusterInternal(ctx context.Context, params installerops.V2InstallClusterParams) (*common.Cluster, error) {
+	f.started = append(f.started, params.ClusterID)
+	return nil, f.err
+}
+
+var _ = Describe("Install schedule", func() {
+	var (
+		ctrl       *gomock.Controller
+		ctx        = context.Background()
+		db         *gorm.DB
+		dbName     string
+		mockEvents *eventsapi.MockHandler
+		starter    *fakeInstallationStarter
+		manager    *Manager
+		c          common.Cluster
+	)
+
+	dateTime := func(d time.Duration) *strfmt.DateTime {
+		t := strfmt.DateTime(time.Now().Add(d).Truncate(time.Second))
+		return &t
+	}
+
+	expectEvent := func(name string) {
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(name),
+			eventstest.WithClusterIdMatcher(c.ID.String()))).Times(1)
+	}
+
+	createCluster := func(status string, startAt, endAt *strfmt.DateTime) {
+		clusterID := strfmt.UUID(uuid.New().String())
+		c = common.Cluster{Cluster: models.Cluster{
+			ID:                     &clusterID,
+			Status:                 swag.String(status),
+			InstallScheduleStartAt: startAt,
+			InstallScheduleEndAt:   endAt,
+		}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		db, dbName = common.PrepareTestDB()
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		starter = &fakeInstallationStarter{}
+		manager = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		manager.SetInstallationStarter(starter)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("ScheduleInstallation", func() {
+		It("stores the schedule", func() {
+			createCluster(models.ClusterStatusInsufficient, nil, nil)
+			expectEvent(eventgen.InstallScheduledEventName)
+			schedule := &models.InstallSchedule{StartAt: dateTime(time.Hour), EndAt: dateTime(2 * time.Hour)}
+			Expect(manager.ScheduleInstallation(ctx, &c, schedule, db)).To(Succeed())
+
+			c = getClusterFromDB(*c.ID, db)
+			Expect(time.Time(*c.InstallScheduleStartAt)).To(BeTemporally("==", time.Time(*schedule.StartAt)))
+			Expect(time.Time(*c.InstallScheduleEndAt)).To(BeTemporally("==", time.Time(*schedule.EndAt)))
+
+			By("ignoring the same schedule")
+			Expect(manager.ScheduleInstallation(ctx, &c, schedule, db)).To(Succeed())
+		})
+
+		It("rejects a window that ends before it starts", func() {
+			createCluster(models.ClusterStatusReady, nil, nil)
+			err := manager.ScheduleInstallation(ctx, &c, &models.InstallSchedule{StartAt: dateTime(time.Hour), EndAt: dateTime(time.Minute)}, db)
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		})
+
+		It("rejects an installing cluster", func() {
+			createCluster(models.ClusterStatusInstalling, nil, nil)
+			err := manager.ScheduleInstallation(ctx, &c, &models.InstallSchedule{StartAt: dateTime(time.Hour)}, db)
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+		})
+	})
+
+	Context("CancelInstallSchedule", func() {
+		It("clears the schedule", func() {
+			createCluster(models.ClusterStatusReady, dateTime(time.Hour), nil)
+			expectEvent(eventgen.InstallScheduleCancelledEventName)
+			Expect(manager.CancelInstallSchedule(ctx, &c, db)).To(Succeed())
+			Expect(getClusterFromDB(*c.ID, db).InstallScheduleStartAt).To(BeNil())
+		})
+
+		It("fails without a schedule", func() {
+			createCluster(models.ClusterStatusReady, nil, nil)
+			err := manager.CancelInstallSchedule(ctx, &c, db)
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+		})
+	})
+
+	Context("processInstallSchedules", func() {
+		It("starts the installation of a ready cluster in the window", func() {
+			createCluster(models.ClusterStatusReady, dateTime(-time.Minute), dateTime(time.Hour))
+			expectEvent(eventgen.ScheduledInstallStartedEventName)
+			manager.processInstallSchedules(ctx, common.GetTestLog())
+			Expect(starter.started).To(Equal([]strfmt.UUID{*c.ID}))
+			Expect(getClusterFromDB(*c.ID, db).InstallScheduleStartAt).To(BeNil())
+		})
+
+		It("reports a failure to start the installation", func() {
+			createCluster(models.ClusterStatusReady, dateTime(-time.Minute), nil)
+			starter.err = common.NewApiError(http.StatusConflict, nil)
+			expectEvent(eventgen.ScheduledInstallFailedEventName)
+			manager.processInstallSchedules(ctx, common.GetTestLog())
+			Expect(starter.started).To(HaveLen(1))
+		})
+
+		It("waits for the window to start", func() {
+			createCluster(models.ClusterStatusReady, dateTime(time.Hour), nil)
+			manager.processInstallSchedules(ctx, common.GetTestLog())
+			Expect(starter.started).To(BeEmpty())
+		})
+
+		It("waits for the cluster to be ready", func() {
+			createCluster(models.ClusterStatusInsufficient, dateTime(-time.Minute), nil)
+			manager.processInstallSchedules(ctx, common.GetTestLog())
+			Expect(starter.started).To(BeEmpty())
+			Expect(getClusterFromDB(*c.ID, db).InstallScheduleStartAt).ToNot(BeNil())
+		})
+
+		It("does not start clusters managed by the kube-api", func() {
+			createCluster(models.ClusterStatusReady, dateTime(-time.Minute), nil)
+			Expect(db.Model(&c).Update("kube_key_name", "cluster").Error).ShouldNot(HaveOccurred())
+			manager.processInstallSchedules(ctx, common.GetTestLog())
+			Expect(starter.started).To(BeEmpty())
+		})
+
+		It("expires the schedule when the window ended", func() {
+			createCluster(models.ClusterStatusInsufficient, dateTime(-2*time.Hour), dateTime(-time.Hour))
+			expectEvent(eventgen.InstallScheduleExpiredEventName)
+			manager.processInstallSchedules(ctx, common.GetTestLog())
+			Expect(starter.started).To(BeEmpty())
+			c = getClusterFromDB(*c.ID, db)
+			Expect(c.InstallScheduleStartAt).To(BeNil())
+			Expect(c.InstallScheduleEndAt).To(BeNil())
+		})
+	})
+})
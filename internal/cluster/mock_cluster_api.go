@@ -11,6 +11,7 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
 	s3wrapper "github.com/openshift/assisted-service/pkg/s3wrapper"
 	gorm "gorm.io/gorm"
 	types "k8s.io/apimachinery/pkg/types"
@@ -221,6 +222,20 @@ func (mr *MockAPIMockRecorder) AcceptRegistration(c interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptRegistration", reflect.TypeOf((*MockAPI)(nil).AcceptRegistration), c)
 }
 
+// CancelInstallSchedule mocks base method.
+func (m *MockAPI) CancelInstallSchedule(ctx context.Context, c *common.Cluster, db *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelInstallSchedule", ctx, c, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelInstallSchedule indicates an expected call of CancelInstallSchedule.
+func (mr *MockAPIMockRecorder) CancelInstallSchedule(ctx, c, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelInstallSchedule", reflect.TypeOf((*MockAPI)(nil).CancelInstallSchedule), ctx, c, db)
+}
+
 // CancelInstallation mocks base method.
 func (m *MockAPI) CancelInstallation(ctx context.Context, c *common.Cluster, reason string, db *gorm.DB) *common.ApiErrorResponse {
 	m.ctrl.T.Helper()
@@ -543,6 +558,34 @@ func (mr *MockAPIMockRecorder) ResetCluster(ctx, c, reason, db interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetCluster", reflect.TypeOf((*MockAPI)(nil).ResetCluster), ctx, c, reason, db)
 }
 
+// RestoreCluster mocks base method.
+func (m *MockAPI) RestoreCluster(ctx context.Context, c *common.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCluster", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreCluster indicates an expected call of RestoreCluster.
+func (mr *MockAPIMockRecorder) RestoreCluster(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCluster", reflect.TypeOf((*MockAPI)(nil).RestoreCluster), ctx, c)
+}
+
+// ScheduleInstallation mocks base method.
+func (m *MockAPI) ScheduleInstallation(ctx context.Context, c *common.Cluster, schedule *models.InstallSchedule, db *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleInstallation", ctx, c, schedule, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleInstallation indicates an expected call of ScheduleInstallation.
+func (mr *MockAPIMockRecorder) ScheduleInstallation(ctx, c, schedule, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleInstallation", reflect.TypeOf((*MockAPI)(nil).ScheduleInstallation), ctx, c, schedule, db)
+}
+
 // SetConnectivityMajorityGroupsForCluster mocks base method.
 func (m *MockAPI) SetConnectivityMajorityGroupsForCluster(clusterID strfmt.UUID, db *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -13,6 +13,14 @@ import (
 	"gorm.io/gorm"
 )
 
+// deregisteredClusterRecords are the records of a deregistered cluster that are kept until it is permanently deleted
+var deregisteredClusterRecords = []interface{}{
+	&models.MonitoredOperator{},
+	&models.ClusterNetwork{},
+	&models.ServiceNetwork{},
+	&models.MachineNetwork{},
+}
+
 func NewRegistrar(log logrus.FieldLogger, db *gorm.DB) *registrar {
 	return &registrar{
 		log: log,
@@ -64,6 +72,10 @@ func (r *registrar) registerCluster(ctx context.Context, cluster *common.Cluster
 					"error while trying to delete previews record from db (if exists) of cluster %s",
 					cluster.ID.String())
 			}
+			if err = common.DeleteRecordsByClusterID(tx.Unscoped(), *cluster.ID, deregisteredClusterRecords); err != nil {
+				r.log.WithError(err).Errorf("Error registering cluster %s", cluster.Name)
+				return errors.Wrapf(err, "error while trying to delete previews records from db of cluster %s", cluster.ID.String())
+			}
 		}
 	}
 	if err = tx.Create(cluster).Error; err != nil {
@@ -105,16 +117,7 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Clust
 		return errors.Errorf("cluster %s can not be removed while being installed", cluster.ID)
 	}
 
-	if txErr = common.DeleteRecordsByClusterID(tx, *cluster.ID, []interface{}{
-		&models.MonitoredOperator{},
-		&models.ClusterNetwork{},
-		&models.ServiceNetwork{},
-		&models.MachineNetwork{},
-	}); txErr != nil {
-		tx.Rollback()
-		return errors.Errorf("failed to delete cluster records %s", cluster.ID)
-	}
-
+	// The records of the cluster are kept so it can be restored, and are deleted when it is permanently deleted
 	if txErr = tx.Delete(cluster).Error; txErr != nil {
 		tx.Rollback()
 		return errors.Errorf("failed to delete cluster %s", cluster.ID)
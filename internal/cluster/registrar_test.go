@@ -101,10 +101,23 @@ var _ = Describe("registrar", func() {
 
 			Expect(db.First(&common.Cluster{}, "id = ?", cluster.ID).Error).Should(HaveOccurred())
 			Expect(db.First(&models.Host{}, "cluster_id = ?", *cluster.ID).Error).Should(HaveOccurred())
-			Expect(db.First(&models.MonitoredOperator{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
-			Expect(db.First(&models.ClusterNetwork{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
-			Expect(db.First(&models.ServiceNetwork{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
-			Expect(db.First(&models.MachineNetwork{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
+
+			// Kept until the cluster is permanently deleted, so it can be restored
+			Expect(db.First(&models.MonitoredOperator{}, "cluster_id = ?", cluster.ID).Error).ShouldNot(HaveOccurred())
+			Expect(db.First(&models.ClusterNetwork{}, "cluster_id = ?", cluster.ID).Error).ShouldNot(HaveOccurred())
+			Expect(db.First(&models.ServiceNetwork{}, "cluster_id = ?", cluster.ID).Error).ShouldNot(HaveOccurred())
+			Expect(db.First(&models.MachineNetwork{}, "cluster_id = ?", cluster.ID).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("register an unregistered cluster again", func() {
+			Expect(registerManager.DeregisterCluster(ctx, &cluster)).Should(BeNil())
+
+			Expect(registerManager.RegisterCluster(ctx, &cluster)).Should(BeNil())
+			dbCluster := getClusterFromDB(*cluster.ID, db)
+			Expect(dbCluster.MonitoredOperators).To(HaveLen(1))
+			Expect(dbCluster.ClusterNetworks).To(HaveLen(len(common.TestIPv4Networking.ClusterNetworks)))
+			Expect(dbCluster.ServiceNetworks).To(HaveLen(len(common.TestIPv4Networking.ServiceNetworks)))
+			Expect(dbCluster.MachineNetworks).To(HaveLen(len(common.TestIPv4Networking.MachineNetworks)))
 		})
 
 		It("unregister a cluster in installing state", func() {
@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// deregisteredHostsWindow is how long before its cluster a host may have been deregistered to be considered as
+// deregistered together with the cluster. Hosts deregistered earlier were deregistered on their own, and aren't
+// restored with the cluster.
+const deregisteredHostsWindow = time.Minute
+
+// RestoreCluster undeletes a deregistered cluster, together with the hosts that were deregistered with it. The records
+// of the cluster that are only removed when it is permanently deleted, such as its networks, its infra-envs and its
+// files, are kept as they are.
+// The late-binding hosts that were unbound from the cluster when it was deregistered remain in their infra-envs, and
+// need to be bound to the cluster again.
+func (m *Manager) RestoreCluster(ctx context.Context, c *common.Cluster) error {
+	log := logutil.FromContext(ctx, m.log)
+	if !c.DeletedAt.Valid {
+		return errors.Errorf("cluster %s is not deregistered", c.ID)
+	}
+
+	var hosts []*models.Host
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		hostsQuery := tx.Unscoped().Where("cluster_id = ? AND deleted_at >= ?",
+			c.ID.String(), c.DeletedAt.Time.Add(-deregisteredHostsWindow))
+		if err := hostsQuery.Find(&hosts).Error; err != nil {
+			return errors.Wrapf(err, "failed to get the deregistered hosts of cluster %s", c.ID)
+		}
+		for _, h := range hosts {
+			if err := tx.Unscoped().Model(&models.Host{}).Where("id = ? AND infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).
+				Update("deleted_at", nil).Error; err != nil {
+				return errors.Wrapf(err, "failed to restore host %s of cluster %s", h.ID, c.ID)
+			}
+		}
+		// updated_at is refreshed so the cluster isn't deregistered again right away due to inactivity
+		if err := tx.Unscoped().Model(&common.Cluster{}).Where("id = ?", c.ID.String()).
+			Updates(map[string]interface{}{"deleted_at": nil, "updated_at": time.Now()}).Error; err != nil {
+			return errors.Wrapf(err, "failed to restore cluster %s", c.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to restore cluster %s", c.ID)
+		return err
+	}
+
+	log.Infof("Restored cluster %s with %d hosts", c.ID, len(hosts))
+	for _, h := range hosts {
+		eventgen.SendHostRestoredEvent(ctx, m.eventsHandler, *h.ID, h.InfraEnvID, c.ID, hostutil.GetHostnameForMsg(h))
+	}
+	eventgen.SendClusterRestoredEvent(ctx, m.eventsHandler, *c.ID, len(hosts))
+	return nil
+}
@@ -0,0 +1,120 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Restore cluster", func() {
+	var (
+		ctrl       *gomock.Controller
+		ctx        = context.Background()
+		db         *gorm.DB
+		dbName     string
+		mockEvents *eventsapi.MockHandler
+		manager    *Manager
+		c          common.Cluster
+		infraEnvID strfmt.UUID
+	)
+
+	createHost := func() *models.Host {
+		hostID := strfmt.UUID(uuid.New().String())
+		h := &models.Host{
+			ID:         &hostID,
+			InfraEnvID: infraEnvID,
+			ClusterID:  c.ID,
+			Status:     swag.String(models.HostStatusKnown),
+			Inventory:  common.GenerateTestDefaultInventory(),
+		}
+		Expect(db.Create(h).Error).ShouldNot(HaveOccurred())
+		return h
+	}
+
+	isDeleted := func(model interface{}, id strfmt.UUID) bool {
+		err := db.Take(model, "id = ?", id.String()).Error
+		if err != nil {
+			Expect(err).To(Equal(gorm.ErrRecordNotFound))
+		}
+		return err != nil
+	}
+
+	getCluster := func() *common.Cluster {
+		cluster, err := common.GetClusterFromDBWhere(db, common.SkipEagerLoading, common.IncludeDeletedRecords, "id = ?", c.ID.String())
+		Expect(err).ShouldNot(HaveOccurred())
+		return cluster
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		db, dbName = common.PrepareTestDB()
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		manager = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		c = common.Cluster{Cluster: models.Cluster{
+			ID:     &clusterID,
+			Status: swag.String(models.ClusterStatusReady),
+		}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("restores the cluster with the hosts deregistered with it", func() {
+		h1 := createHost()
+		h2 := createHost()
+		Expect(db.Delete(&models.Host{}, "cluster_id = ?", c.ID.String()).Error).ShouldNot(HaveOccurred())
+		Expect(db.Delete(&common.Cluster{}, "id = ?", c.ID.String()).Error).ShouldNot(HaveOccurred())
+
+		for _, h := range []*models.Host{h1, h2} {
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostRestoredEventName),
+				eventstest.WithHostIdMatcher(h.ID.String()),
+				eventstest.WithClusterIdMatcher(c.ID.String()))).Times(1)
+		}
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterRestoredEventName),
+			eventstest.WithClusterIdMatcher(c.ID.String()))).Times(1)
+
+		Expect(manager.RestoreCluster(ctx, getCluster())).ShouldNot(HaveOccurred())
+		Expect(isDeleted(&common.Cluster{}, *c.ID)).To(BeFalse())
+		Expect(isDeleted(&models.Host{}, *h1.ID)).To(BeFalse())
+		Expect(isDeleted(&models.Host{}, *h2.ID)).To(BeFalse())
+	})
+
+	It("doesn't restore hosts that were deregistered before the cluster", func() {
+		h := createHost()
+		deletedAt := time.Now().Add(-time.Hour)
+		Expect(db.Model(&models.Host{}).Where("id = ?", h.ID.String()).Update("deleted_at", deletedAt).Error).ShouldNot(HaveOccurred())
+		Expect(db.Delete(&common.Cluster{}, "id = ?", c.ID.String()).Error).ShouldNot(HaveOccurred())
+
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterRestoredEventName),
+			eventstest.WithClusterIdMatcher(c.ID.String()))).Times(1)
+
+		Expect(manager.RestoreCluster(ctx, getCluster())).ShouldNot(HaveOccurred())
+		Expect(isDeleted(&common.Cluster{}, *c.ID)).To(BeFalse())
+		Expect(isDeleted(&models.Host{}, *h.ID)).To(BeTrue())
+	})
+
+	It("fails for a cluster that isn't deregistered", func() {
+		Expect(manager.RestoreCluster(ctx, getCluster())).Should(HaveOccurred())
+	})
+})
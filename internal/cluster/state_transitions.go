@@ -0,0 +1,99 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/filanov/stateswitch"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// triggerCompleteInstallation is the trigger of the status transitions that the completion of the installation makes
+// without the state machine
+const triggerCompleteInstallation = "CompleteInstallation"
+
+// transitionRecorder records the status transitions that the state machine makes
+type transitionRecorder struct {
+	stateswitch.StateMachine
+	log       logrus.FieldLogger
+	db        *gorm.DB
+	metricApi metrics.API
+}
+
+func newTransitionRecorder(sm stateswitch.StateMachine, log logrus.FieldLogger, db *gorm.DB, metricApi metrics.API) stateswitch.StateMachine {
+	return &transitionRecorder{StateMachine: sm, log: log, db: db, metricApi: metricApi}
+}
+
+func (r *transitionRecorder) Run(transitionType stateswitch.TransitionType, stateSwitch stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sCluster, ok := stateSwitch.(*stateCluster)
+	if !ok {
+		return r.StateMachine.Run(transitionType, stateSwitch, args)
+	}
+	srcStatus := swag.StringValue(sCluster.cluster.Status)
+	statusUpdatedAt := sCluster.cluster.StatusUpdatedAt
+	if err := r.StateMachine.Run(transitionType, stateSwitch, args); err != nil {
+		return err
+	}
+	db := r.db
+	if argsWithDB, ok := args.(transitionArgsWithDB); ok && argsWithDB.transitionDB() != nil {
+		db = argsWithDB.transitionDB()
+	}
+	recordStateTransition(r.log, db, r.metricApi, sCluster.cluster, srcStatus, statusUpdatedAt, string(transitionType))
+	return nil
+}
+
+// transitionArgsWithDB are the arguments of the transitions that update the database through the db of the caller.
+// The transitions are recorded with the same db, so that they are rolled back when it is a transaction that is rolled
+// back.
+type transitionArgsWithDB interface {
+	transitionDB() *gorm.DB
+}
+
+func (a *TransitionArgsCancelInstallation) transitionDB() *gorm.DB     { return a.db }
+func (a *TransitionArgsResetCluster) transitionDB() *gorm.DB           { return a.db }
+func (a *TransitionArgsPrepareForInstallation) transitionDB() *gorm.DB { return a.db }
+func (a *TransitionArgsRefreshCluster) transitionDB() *gorm.DB         { return a.db }
+
+// recordStateTransition records the transition of the cluster from srcStatus to its current status, if it changed,
+// and reports the time that the cluster spent in srcStatus since statusUpdatedAt
+func recordStateTransition(log logrus.FieldLogger, db *gorm.DB, metricApi metrics.API, c *common.Cluster, srcStatus string,
+	statusUpdatedAt strfmt.DateTime, trigger string) {
+	dstStatus := swag.StringValue(c.Status)
+	if dstStatus == srcStatus || c.ID == nil {
+		return
+	}
+	now := time.Now()
+	var timeInState time.Duration
+	if srcStatus != "" && !time.Time(statusUpdatedAt).IsZero() {
+		timeInState = now.Sub(time.Time(statusUpdatedAt))
+	}
+	clusterID := *c.ID
+	transition := &models.StateTransition{
+		ObjectKind:         models.StateTransitionObjectKindCluster,
+		ObjectID:           clusterID,
+		ClusterID:          &clusterID,
+		FromState:          srcStatus,
+		ToState:            dstStatus,
+		Trigger:            trigger,
+		TimeInStateSeconds: timeInState.Seconds(),
+		TransitionedAt:     strfmt.DateTime(now),
+	}
+	if err := db.Create(transition).Error; err != nil {
+		// The status already changed, failing to record the transition only affects its history
+		log.WithError(err).Errorf("failed to record the transition of cluster %s from %s to %s", c.ID, srcStatus, dstStatus)
+	}
+
+	if metricApi == nil {
+		return
+	}
+	var platform string
+	if c.Platform != nil && c.Platform.Type != nil {
+		platform = string(*c.Platform.Type)
+	}
+	metricApi.ClusterStateTransition(srcStatus, dstStatus, trigger, c.OpenshiftVersion, platform, timeInState)
+}
@@ -0,0 +1,160 @@
+package cluster
+
+import (
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+// FinalizingTimelineStage is the stage of the cluster between the last host that finished installing and the
+// completion of the installation
+const FinalizingTimelineStage = "Finalizing"
+
+// BuildInstallationTimeline builds the installation timeline of the cluster from the stage transitions of its hosts.
+// Each stage of a host lasts until the next stage of the host, the Done and Failed stages only end the previous one.
+func BuildInstallationTimeline(c *common.Cluster, transitions []*common.HostStageTransition, now time.Time) *models.InstallationTimeline {
+	timeline := &models.InstallationTimeline{
+		ClusterID:        *c.ID,
+		InstallStartedAt: c.InstallStartedAt,
+		Hosts:            make([]*models.HostInstallationTimeline, 0),
+		SlowestHosts:     make([]*models.InstallationTimelineSegment, 0),
+		CriticalPath:     make([]*models.InstallationTimelineSegment, 0),
+	}
+	if swag.StringValue(c.Status) == models.ClusterStatusInstalled {
+		completedAt := c.InstallCompletedAt
+		timeline.InstallCompletedAt = &completedAt
+	}
+
+	hosts := make(map[strfmt.UUID]*models.Host)
+	for _, h := range c.Hosts {
+		hosts[*h.ID] = h
+	}
+	hostTransitions := make(map[strfmt.UUID][]*common.HostStageTransition)
+	var hostOrder []strfmt.UUID
+	for _, t := range transitions {
+		if time.Time(c.InstallStartedAt).After(t.StartedAt) {
+			// Left from a previous installation attempt
+			continue
+		}
+		if _, ok := hostTransitions[t.HostID]; !ok {
+			hostOrder = append(hostOrder, t.HostID)
+		}
+		hostTransitions[t.HostID] = append(hostTransitions[t.HostID], t)
+	}
+	sort.SliceStable(hostOrder, func(i, j int) bool {
+		return hostTransitions[hostOrder[i]][0].StartedAt.Before(hostTransitions[hostOrder[j]][0].StartedAt)
+	})
+
+	var bootstrap *models.HostInstallationTimeline
+	var lastDone *models.HostInstallationTimeline
+	var lastDoneAt time.Time
+	for _, hostID := range hostOrder {
+		hostTimeline := &models.HostInstallationTimeline{HostID: hostID}
+		if h, ok := hosts[hostID]; ok {
+			hostTimeline.HostName = hostutil.GetHostnameForMsg(h)
+			hostTimeline.Role = h.Role
+			hostTimeline.Bootstrap = h.Bootstrap
+		}
+		hostTimeline.Stages = hostTimelineSegments(hostTimeline, hostTransitions[hostID], now)
+		timeline.Hosts = append(timeline.Hosts, hostTimeline)
+
+		if hostTimeline.Bootstrap {
+			bootstrap = hostTimeline
+		}
+		last := hostTransitions[hostID][len(hostTransitions[hostID])-1]
+		if last.Stage == models.HostStageDone && last.StartedAt.After(lastDoneAt) {
+			lastDone = hostTimeline
+			lastDoneAt = last.StartedAt
+		}
+	}
+
+	timeline.SlowestHosts = slowestHostsPerStage(timeline.Hosts)
+	timeline.CriticalPath = criticalPath(timeline, bootstrap, lastDone, lastDoneAt)
+	return timeline
+}
+
+func hostTimelineSegments(hostTimeline *models.HostInstallationTimeline, transitions []*common.HostStageTransition, now time.Time) []*models.InstallationTimelineSegment {
+	segments := make([]*models.InstallationTimelineSegment, 0, len(transitions))
+	for i, t := range transitions {
+		if t.Stage == models.HostStageDone || t.Stage == models.HostStageFailed {
+			break
+		}
+		segment := &models.InstallationTimelineSegment{
+			HostID:    hostTimeline.HostID,
+			HostName:  hostTimeline.HostName,
+			Stage:     string(t.Stage),
+			StartedAt: strfmt.DateTime(t.StartedAt),
+		}
+		end := now
+		if i+1 < len(transitions) {
+			end = transitions[i+1].StartedAt
+			endedAt := strfmt.DateTime(end)
+			segment.EndedAt = &endedAt
+		}
+		segment.DurationSeconds = end.Sub(t.StartedAt).Seconds()
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+func slowestHostsPerStage(hosts []*models.HostInstallationTimeline) []*models.InstallationTimelineSegment {
+	slowest := make(map[string]*models.InstallationTimelineSegment)
+	var stages []string
+	for _, h := range hosts {
+		for _, segment := range h.Stages {
+			current, ok := slowest[segment.Stage]
+			if !ok {
+				stages = append(stages, segment.Stage)
+			}
+			if !ok || segment.DurationSeconds > current.DurationSeconds {
+				slowest[segment.Stage] = segment
+			}
+		}
+	}
+	ret := make([]*models.InstallationTimelineSegment, 0, len(stages))
+	for _, stage := range stages {
+		ret = append(ret, slowest[stage])
+	}
+	return ret
+}
+
+// criticalPath returns the stages of the bootstrap host, then the stages of the last host to finish that ended after
+// the bootstrap host finished, then the cluster finalization up to the completion of the installation
+func criticalPath(timeline *models.InstallationTimeline, bootstrap, lastDone *models.HostInstallationTimeline, lastDoneAt time.Time) []*models.InstallationTimelineSegment {
+	path := make([]*models.InstallationTimelineSegment, 0)
+	if bootstrap == nil {
+		return path
+	}
+	path = append(path, bootstrap.Stages...)
+	if len(path) == 0 {
+		return path
+	}
+	bootstrapEnd := path[len(path)-1].EndedAt
+	if bootstrapEnd == nil {
+		return path
+	}
+
+	if lastDone != nil && lastDone != bootstrap {
+		for _, segment := range lastDone.Stages {
+			if segment.EndedAt != nil && time.Time(*segment.EndedAt).After(time.Time(*bootstrapEnd)) {
+				path = append(path, segment)
+			}
+		}
+	}
+
+	if timeline.InstallCompletedAt != nil && !lastDoneAt.IsZero() {
+		endedAt := *timeline.InstallCompletedAt
+		path = append(path, &models.InstallationTimelineSegment{
+			Stage:           FinalizingTimelineStage,
+			StartedAt:       strfmt.DateTime(lastDoneAt),
+			EndedAt:         &endedAt,
+			DurationSeconds: time.Time(endedAt).Sub(lastDoneAt).Seconds(),
+		})
+	}
+	return path
+}
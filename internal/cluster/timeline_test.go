@@ -0,0 +1,112 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("BuildInstallationTimeline", func() {
+	var (
+		start       time.Time
+		c           *common.Cluster
+		bootstrapID strfmt.UUID
+		masterID    strfmt.UUID
+		transitions []*common.HostStageTransition
+	)
+
+	transition := func(hostID strfmt.UUID, stage models.HostStage, minutes int) *common.HostStageTransition {
+		return &common.HostStageTransition{HostID: hostID, Stage: stage, StartedAt: start.Add(time.Duration(minutes) * time.Minute)}
+	}
+
+	BeforeEach(func() {
+		start = time.Now().Add(-time.Hour).Truncate(time.Second)
+		clusterID := strfmt.UUID(uuid.New().String())
+		bootstrapID = strfmt.UUID(uuid.New().String())
+		masterID = strfmt.UUID(uuid.New().String())
+		c = &common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Status:           swag.String(models.ClusterStatusInstalling),
+			InstallStartedAt: strfmt.DateTime(start),
+			Hosts: []*models.Host{
+				{ID: &bootstrapID, RequestedHostname: "bootstrap", Role: models.HostRoleMaster, Bootstrap: true},
+				{ID: &masterID, RequestedHostname: "master", Role: models.HostRoleMaster},
+			},
+		}}
+		transitions = []*common.HostStageTransition{
+			transition(masterID, models.HostStageStartingInstallation, 0),
+			transition(bootstrapID, models.HostStageStartingInstallation, 1),
+			transition(masterID, models.HostStageWritingImageToDisk, 2),
+			transition(bootstrapID, models.HostStageWritingImageToDisk, 3),
+			transition(masterID, models.HostStageRebooting, 10),
+			transition(bootstrapID, models.HostStageRebooting, 15),
+			transition(bootstrapID, models.HostStageDone, 30),
+			transition(masterID, models.HostStageJoined, 32),
+			transition(masterID, models.HostStageDone, 40),
+		}
+	})
+
+	It("builds the stages of the hosts", func() {
+		timeline := BuildInstallationTimeline(c, transitions, start.Add(time.Hour))
+		Expect(timeline.Hosts).To(HaveLen(2))
+		Expect(timeline.Hosts[0].HostID).To(Equal(masterID))
+		Expect(timeline.Hosts[1].HostName).To(Equal("bootstrap"))
+		Expect(timeline.Hosts[1].Bootstrap).To(BeTrue())
+
+		stages := timeline.Hosts[0].Stages
+		Expect(stages).To(HaveLen(4))
+		Expect(stages[1].Stage).To(Equal(string(models.HostStageWritingImageToDisk)))
+		Expect(stages[1].DurationSeconds).To(Equal(float64(8 * 60)))
+		Expect(time.Time(*stages[3].EndedAt)).To(BeTemporally("==", start.Add(40*time.Minute)))
+	})
+
+	It("returns the slowest host per stage", func() {
+		timeline := BuildInstallationTimeline(c, transitions, start.Add(time.Hour))
+		Expect(timeline.SlowestHosts).To(HaveLen(4))
+		Expect(timeline.SlowestHosts[1].Stage).To(Equal(string(models.HostStageWritingImageToDisk)))
+		Expect(timeline.SlowestHosts[1].HostID).To(Equal(bootstrapID))
+		Expect(timeline.SlowestHosts[2].Stage).To(Equal(string(models.HostStageRebooting)))
+		Expect(timeline.SlowestHosts[2].HostID).To(Equal(masterID))
+	})
+
+	It("returns the critical path to the completion of the installation", func() {
+		c.Status = swag.String(models.ClusterStatusInstalled)
+		c.InstallCompletedAt = strfmt.DateTime(start.Add(50 * time.Minute))
+		timeline := BuildInstallationTimeline(c, transitions, start.Add(time.Hour))
+		Expect(timeline.InstallCompletedAt).ToNot(BeNil())
+
+		var path []string
+		for _, segment := range timeline.CriticalPath {
+			path = append(path, segment.HostName+"/"+segment.Stage)
+		}
+		Expect(path).To(Equal([]string{
+			"bootstrap/" + string(models.HostStageStartingInstallation),
+			"bootstrap/" + string(models.HostStageWritingImageToDisk),
+			"bootstrap/" + string(models.HostStageRebooting),
+			"master/" + string(models.HostStageRebooting),
+			"master/" + string(models.HostStageJoined),
+			"/" + FinalizingTimelineStage,
+		}))
+		Expect(timeline.CriticalPath[5].DurationSeconds).To(Equal(float64(10 * 60)))
+	})
+
+	It("leaves the current stage open", func() {
+		timeline := BuildInstallationTimeline(c, transitions[:5], start.Add(time.Hour))
+		stages := timeline.Hosts[0].Stages
+		Expect(stages[2].EndedAt).To(BeNil())
+		Expect(stages[2].DurationSeconds).To(Equal(float64(50 * 60)))
+		Expect(timeline.CriticalPath).To(HaveLen(2))
+	})
+
+	It("ignores transitions of previous installations", func() {
+		transitions = append([]*common.HostStageTransition{transition(masterID, models.HostStageFailed, -10)}, transitions...)
+		timeline := BuildInstallationTimeline(c, transitions, start.Add(time.Hour))
+		Expect(timeline.Hosts[0].Stages[0].Stage).To(Equal(string(models.HostStageStartingInstallation)))
+	})
+})
@@ -15,6 +15,7 @@ import (
 	"github.com/openshift/assisted-service/internal/dns"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -30,7 +31,7 @@ import (
 
 var resetLogsField = []interface{}{"logs_info", "", "controller_logs_started_at", strfmt.DateTime(time.Time{}), "controller_logs_collected_at", strfmt.DateTime(time.Time{})}
 var resetProgressFields = []interface{}{"progress_finalizing_stage_percentage", 0, "progress_installing_stage_percentage", 0,
-	"progress_preparing_for_installation_stage_percentage", 0, "progress_total_percentage", 0}
+	"progress_preparing_for_installation_stage_percentage", 0, "progress_total_percentage", 0, "progress_estimated_completion_at", nil}
 
 var resetFields = append(append(resetProgressFields, resetLogsField...), "openshift_cluster_id", "")
 
@@ -350,6 +351,10 @@ func (th *transitionHandler) areAllHostsDone(sw stateswitch.StateSwitch, _ state
 	sCluster, _ := sw.(*stateCluster)
 	doneStatuses := []string{models.HostStatusInstalled, models.HostStatusError}
 	for _, h := range sCluster.cluster.Hosts {
+		// Failed workers that are re-installed join the cluster on their own, with the day-2 flow
+		if hostutil.IsDay2Host(h) {
+			continue
+		}
 		if !funk.ContainsString(doneStatuses, swag.StringValue(h.Status)) {
 			return false, nil
 		}
@@ -0,0 +1,206 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/manifests"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	manifestsoperations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ API = &Manager{}
+
+//go:generate mockgen --build_flags=--mod=mod -package=clustertemplates -destination=mock_clustertemplates_api.go . API
+type API interface {
+	restapi.ClusterTemplatesAPI
+	// GetClusterTemplateInternal returns the cluster template if it is owned by the current user
+	GetClusterTemplateInternal(ctx context.Context, templateID strfmt.UUID) (*models.ClusterTemplate, error)
+	// CreateClusterManifests adds the manifests of the cluster template to the cluster
+	CreateClusterManifests(ctx context.Context, template *models.ClusterTemplate, clusterID strfmt.UUID) error
+}
+
+// Manager stores the cluster templates of the users. A template is owned by the user that created it,
+// or by the organization of the user when tenancy is enabled.
+type Manager struct {
+	db           *gorm.DB
+	log          logrus.FieldLogger
+	authzHandler auth.Authorizer
+	manifestsAPI manifestsapi.ManifestsAPI
+}
+
+func NewManager(db *gorm.DB, log logrus.FieldLogger, authzHandler auth.Authorizer, manifestsAPI manifestsapi.ManifestsAPI) *Manager {
+	return &Manager{
+		db:           db,
+		log:          log,
+		authzHandler: authzHandler,
+		manifestsAPI: manifestsAPI,
+	}
+}
+
+func (m *Manager) V2ListClusterTemplates(ctx context.Context, params operations.V2ListClusterTemplatesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	templates := models.ClusterTemplateList{}
+	if err := m.authzHandler.OwnedBy(ctx, m.db).Order("created_at").Find(&templates).Error; err != nil {
+		log.WithError(err).Error("Failed to list cluster templates")
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	return operations.NewV2ListClusterTemplatesOK().WithPayload(templates)
+}
+
+func (m *Manager) V2RegisterClusterTemplate(ctx context.Context, params operations.V2RegisterClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	if err := validateTemplateParams(params.NewClusterTemplateParams); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	template := &models.ClusterTemplate{
+		ID:        &id,
+		UserName:  ocm.UserNameFromContext(ctx),
+		OrgID:     ocm.OrgIDFromContext(ctx),
+		CreatedAt: strfmt.DateTime(time.Now()),
+	}
+	setTemplateParams(template, params.NewClusterTemplateParams)
+	if err := m.db.Create(template).Error; err != nil {
+		log.WithError(err).Errorf("Failed to create cluster template %s", id)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	log.Infof("Created cluster template %s", id)
+	return operations.NewV2RegisterClusterTemplateCreated().WithPayload(template)
+}
+
+func (m *Manager) V2GetClusterTemplate(ctx context.Context, params operations.V2GetClusterTemplateParams) middleware.Responder {
+	template, err := m.GetClusterTemplateInternal(ctx, params.TemplateID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2GetClusterTemplateOK().WithPayload(template)
+}
+
+func (m *Manager) V2UpdateClusterTemplate(ctx context.Context, params operations.V2UpdateClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	if err := validateTemplateParams(params.ClusterTemplateParams); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	template, err := m.GetClusterTemplateInternal(ctx, params.TemplateID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	setTemplateParams(template, params.ClusterTemplateParams)
+	if err = m.db.Select("*").Save(template).Error; err != nil {
+		log.WithError(err).Errorf("Failed to update cluster template %s", params.TemplateID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	log.Infof("Updated cluster template %s", params.TemplateID)
+	return operations.NewV2UpdateClusterTemplateOK().WithPayload(template)
+}
+
+func (m *Manager) V2DeregisterClusterTemplate(ctx context.Context, params operations.V2DeregisterClusterTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	reply := m.authzHandler.OwnedBy(ctx, m.db).Delete(&models.ClusterTemplate{}, "id = ?", params.TemplateID.String())
+	if reply.Error != nil {
+		log.WithError(reply.Error).Errorf("Failed to delete cluster template %s", params.TemplateID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, reply.Error))
+	}
+	if reply.RowsAffected == 0 {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusNotFound, errors.Errorf("cluster template %s not found", params.TemplateID)))
+	}
+	log.Infof("Deleted cluster template %s", params.TemplateID)
+	return operations.NewV2DeregisterClusterTemplateNoContent()
+}
+
+func (m *Manager) GetClusterTemplateInternal(ctx context.Context, templateID strfmt.UUID) (*models.ClusterTemplate, error) {
+	var template models.ClusterTemplate
+	if err := m.authzHandler.OwnedBy(ctx, m.db).Take(&template, "id = ?", templateID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("cluster template %s not found", templateID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &template, nil
+}
+
+func (m *Manager) CreateClusterManifests(ctx context.Context, template *models.ClusterTemplate, clusterID strfmt.UUID) error {
+	for _, manifest := range template.Manifests {
+		if _, err := m.manifestsAPI.CreateClusterManifestInternal(ctx, manifestsoperations.V2CreateClusterManifestParams{
+			ClusterID:            clusterID,
+			CreateManifestParams: manifest,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add manifest %s of cluster template %s", *manifest.FileName, template.ID)
+		}
+	}
+	return nil
+}
+
+// MergeClusterParams returns the cluster params of the template overridden by the fields that are set in params
+func MergeClusterParams(template *models.ClusterTemplate, params *models.ClusterCreateParams) (*models.ClusterCreateParams, error) {
+	merged := make(map[string]interface{})
+	for _, p := range []*models.ClusterCreateParams{template.ClusterParams, params} {
+		if p == nil {
+			continue
+		}
+		b, err := json.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]interface{}
+		if err = json.Unmarshal(b, &fields); err != nil {
+			return nil, err
+		}
+		for key, value := range fields {
+			if value != nil {
+				merged[key] = value
+			}
+		}
+	}
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	var result models.ClusterCreateParams
+	if err = json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func validateTemplateParams(params *models.ClusterTemplateCreateParams) error {
+	if params.InstallConfigOverrides != "" && !json.Valid([]byte(params.InstallConfigOverrides)) {
+		return common.NewApiError(http.StatusBadRequest, errors.New("install config overrides of the cluster template are not a valid JSON"))
+	}
+	for _, manifest := range params.Manifests {
+		if _, err := manifests.DecodeManifest(manifest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setTemplateParams(template *models.ClusterTemplate, params *models.ClusterTemplateCreateParams) {
+	clusterParams := *params.ClusterParams
+	clusterParams.PullSecret = nil
+	template.Name = params.Name
+	template.Description = params.Description
+	template.ClusterParams = &clusterParams
+	template.Manifests = params.Manifests
+	template.InstallConfigOverrides = params.InstallConfigOverrides
+	template.UpdatedAt = strfmt.DateTime(time.Now())
+}
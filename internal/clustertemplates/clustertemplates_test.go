@@ -0,0 +1,220 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	manifestsoperations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func TestClusterTemplates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "cluster templates test")
+}
+
+var _ = Describe("cluster templates", func() {
+	var (
+		db               *gorm.DB
+		dbName           string
+		ctrl             *gomock.Controller
+		mockManifestsApi *manifestsapi.MockManifestsAPI
+		manager          *Manager
+		ctx              context.Context
+	)
+
+	userContext := func(userName, orgID string) context.Context {
+		payload := &ocm.AuthPayload{Username: userName, Organization: orgID, Role: ocm.UserRole}
+		return context.WithValue(context.Background(), restapi.AuthKey, payload)
+	}
+
+	newTemplateParams := func(name string) *models.ClusterTemplateCreateParams {
+		return &models.ClusterTemplateCreateParams{
+			Name: swag.String(name),
+			ClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("template"),
+				OpenshiftVersion: swag.String("4.11"),
+				PullSecret:       swag.String("secret"),
+				BaseDNSDomain:    "example.com",
+			},
+			Manifests: []*models.CreateManifestParams{{
+				FileName: swag.String("config.yaml"),
+				Content:  swag.String(base64.StdEncoding.EncodeToString([]byte("a: b"))),
+			}},
+			InstallConfigOverrides: `{"fips": true}`,
+		}
+	}
+
+	registerTemplate := func(ctx context.Context, name string) *models.ClusterTemplate {
+		reply := manager.V2RegisterClusterTemplate(ctx, operations.V2RegisterClusterTemplateParams{
+			NewClusterTemplateParams: newTemplateParams(name),
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RegisterClusterTemplateCreated()))
+		return reply.(*operations.V2RegisterClusterTemplateCreated).Payload
+	}
+
+	verifyApiError := func(reply interface{}, expectedHttpStatus int32) {
+		Expect(reply).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(expectedHttpStatus))
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockManifestsApi = manifestsapi.NewMockManifestsAPI(ctrl)
+		authzHandler := auth.NewAuthzHandler(&auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}, nil, logrus.New(), db)
+		manager = NewManager(db, common.GetTestLog(), authzHandler, mockManifestsApi)
+		ctx = userContext("user1", "org1")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("registers a template without the pull secret", func() {
+		template := registerTemplate(ctx, "template1")
+		Expect(template.UserName).To(Equal("user1"))
+		Expect(template.OrgID).To(Equal("org1"))
+		Expect(template.ClusterParams.PullSecret).To(BeNil())
+
+		template, err := manager.GetClusterTemplateInternal(ctx, *template.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(swag.StringValue(template.Name)).To(Equal("template1"))
+		Expect(template.ClusterParams.BaseDNSDomain).To(Equal("example.com"))
+		Expect(template.ClusterParams.PullSecret).To(BeNil())
+		Expect(template.Manifests).To(HaveLen(1))
+		Expect(template.InstallConfigOverrides).To(Equal(`{"fips": true}`))
+	})
+
+	It("rejects invalid install config overrides", func() {
+		params := newTemplateParams("template1")
+		params.InstallConfigOverrides = "{"
+		reply := manager.V2RegisterClusterTemplate(ctx, operations.V2RegisterClusterTemplateParams{NewClusterTemplateParams: params})
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("rejects invalid manifests", func() {
+		params := newTemplateParams("template1")
+		params.Manifests[0].FileName = swag.String("config.txt")
+		reply := manager.V2RegisterClusterTemplate(ctx, operations.V2RegisterClusterTemplateParams{NewClusterTemplateParams: params})
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("lists the templates of the organization", func() {
+		registerTemplate(ctx, "template1")
+		registerTemplate(userContext("user2", "org1"), "template2")
+		registerTemplate(userContext("user3", "org2"), "template3")
+
+		reply := manager.V2ListClusterTemplates(ctx, operations.V2ListClusterTemplatesParams{})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListClusterTemplatesOK()))
+		templates := reply.(*operations.V2ListClusterTemplatesOK).Payload
+		Expect(templates).To(HaveLen(2))
+		Expect(swag.StringValue(templates[0].Name)).To(Equal("template1"))
+		Expect(swag.StringValue(templates[1].Name)).To(Equal("template2"))
+	})
+
+	It("updates a template", func() {
+		template := registerTemplate(ctx, "template1")
+		params := newTemplateParams("template1-updated")
+		params.Manifests = nil
+		reply := manager.V2UpdateClusterTemplate(ctx, operations.V2UpdateClusterTemplateParams{
+			TemplateID:            *template.ID,
+			ClusterTemplateParams: params,
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2UpdateClusterTemplateOK()))
+
+		template, err := manager.GetClusterTemplateInternal(ctx, *template.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(swag.StringValue(template.Name)).To(Equal("template1-updated"))
+		Expect(template.Manifests).To(BeEmpty())
+		Expect(template.UserName).To(Equal("user1"))
+	})
+
+	It("deletes a template", func() {
+		template := registerTemplate(ctx, "template1")
+		reply := manager.V2DeregisterClusterTemplate(ctx, operations.V2DeregisterClusterTemplateParams{TemplateID: *template.ID})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2DeregisterClusterTemplateNoContent()))
+
+		_, err := manager.GetClusterTemplateInternal(ctx, *template.ID)
+		verifyApiError(err, http.StatusNotFound)
+		reply = manager.V2DeregisterClusterTemplate(ctx, operations.V2DeregisterClusterTemplateParams{TemplateID: *template.ID})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("hides the templates of other organizations", func() {
+		template := registerTemplate(ctx, "template1")
+		otherCtx := userContext("user3", "org2")
+
+		_, err := manager.GetClusterTemplateInternal(otherCtx, *template.ID)
+		verifyApiError(err, http.StatusNotFound)
+		reply := manager.V2UpdateClusterTemplate(otherCtx, operations.V2UpdateClusterTemplateParams{
+			TemplateID:            *template.ID,
+			ClusterTemplateParams: newTemplateParams("stolen"),
+		})
+		verifyApiError(reply, http.StatusNotFound)
+		reply = manager.V2DeregisterClusterTemplate(otherCtx, operations.V2DeregisterClusterTemplateParams{TemplateID: *template.ID})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("creates the manifests of the template in the cluster", func() {
+		template := registerTemplate(ctx, "template1")
+		clusterID := strfmt.UUID(uuid.New().String())
+		mockManifestsApi.EXPECT().CreateClusterManifestInternal(ctx, manifestsoperations.V2CreateClusterManifestParams{
+			ClusterID:            clusterID,
+			CreateManifestParams: template.Manifests[0],
+		}).Return(&models.Manifest{}, nil).Times(1)
+		Expect(manager.CreateClusterManifests(ctx, template, clusterID)).To(Succeed())
+	})
+})
+
+var _ = Describe("MergeClusterParams", func() {
+	It("overrides the template with the set fields", func() {
+		template := &models.ClusterTemplate{
+			ClusterParams: &models.ClusterCreateParams{
+				Name:                  swag.String("template"),
+				OpenshiftVersion:      swag.String("4.10"),
+				BaseDNSDomain:         "example.com",
+				HTTPProxy:             swag.String("http://proxy.example.com"),
+				UserManagedNetworking: swag.Bool(true),
+				ServiceNetworks:       []*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}},
+			},
+		}
+		params := &models.ClusterCreateParams{
+			Name:             swag.String("cluster"),
+			OpenshiftVersion: swag.String("4.11"),
+			PullSecret:       swag.String("secret"),
+			APIVip:           "1.2.3.4",
+		}
+
+		merged, err := MergeClusterParams(template, params)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(swag.StringValue(merged.Name)).To(Equal("cluster"))
+		Expect(swag.StringValue(merged.OpenshiftVersion)).To(Equal("4.11"))
+		Expect(swag.StringValue(merged.PullSecret)).To(Equal("secret"))
+		Expect(merged.APIVip).To(Equal("1.2.3.4"))
+		Expect(merged.BaseDNSDomain).To(Equal("example.com"))
+		Expect(swag.StringValue(merged.HTTPProxy)).To(Equal("http://proxy.example.com"))
+		Expect(swag.BoolValue(merged.UserManagedNetworking)).To(BeTrue())
+		Expect(merged.ServiceNetworks).To(HaveLen(1))
+		Expect(string(merged.ServiceNetworks[0].Cidr)).To(Equal("172.30.0.0/16"))
+	})
+})
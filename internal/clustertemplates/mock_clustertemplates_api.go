@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/clustertemplates (interfaces: API)
+
+// Package clustertemplates is a generated GoMock package.
+package clustertemplates
+
+import (
+	context "context"
+	reflect "reflect"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+	cluster_templates "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateClusterManifests mocks base method.
+func (m *MockAPI) CreateClusterManifests(arg0 context.Context, arg1 *models.ClusterTemplate, arg2 strfmt.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterManifests", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClusterManifests indicates an expected call of CreateClusterManifests.
+func (mr *MockAPIMockRecorder) CreateClusterManifests(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterManifests", reflect.TypeOf((*MockAPI)(nil).CreateClusterManifests), arg0, arg1, arg2)
+}
+
+// GetClusterTemplateInternal mocks base method.
+func (m *MockAPI) GetClusterTemplateInternal(arg0 context.Context, arg1 strfmt.UUID) (*models.ClusterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterTemplateInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.ClusterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterTemplateInternal indicates an expected call of GetClusterTemplateInternal.
+func (mr *MockAPIMockRecorder) GetClusterTemplateInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterTemplateInternal", reflect.TypeOf((*MockAPI)(nil).GetClusterTemplateInternal), arg0, arg1)
+}
+
+// V2DeregisterClusterTemplate mocks base method.
+func (m *MockAPI) V2DeregisterClusterTemplate(arg0 context.Context, arg1 cluster_templates.V2DeregisterClusterTemplateParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DeregisterClusterTemplate", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DeregisterClusterTemplate indicates an expected call of V2DeregisterClusterTemplate.
+func (mr *MockAPIMockRecorder) V2DeregisterClusterTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DeregisterClusterTemplate", reflect.TypeOf((*MockAPI)(nil).V2DeregisterClusterTemplate), arg0, arg1)
+}
+
+// V2GetClusterTemplate mocks base method.
+func (m *MockAPI) V2GetClusterTemplate(arg0 context.Context, arg1 cluster_templates.V2GetClusterTemplateParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterTemplate", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterTemplate indicates an expected call of V2GetClusterTemplate.
+func (mr *MockAPIMockRecorder) V2GetClusterTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterTemplate", reflect.TypeOf((*MockAPI)(nil).V2GetClusterTemplate), arg0, arg1)
+}
+
+// V2ListClusterTemplates mocks base method.
+func (m *MockAPI) V2ListClusterTemplates(arg0 context.Context, arg1 cluster_templates.V2ListClusterTemplatesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListClusterTemplates", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListClusterTemplates indicates an expected call of V2ListClusterTemplates.
+func (mr *MockAPIMockRecorder) V2ListClusterTemplates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusterTemplates", reflect.TypeOf((*MockAPI)(nil).V2ListClusterTemplates), arg0, arg1)
+}
+
+// V2RegisterClusterTemplate mocks base method.
+func (m *MockAPI) V2RegisterClusterTemplate(arg0 context.Context, arg1 cluster_templates.V2RegisterClusterTemplateParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2RegisterClusterTemplate", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2RegisterClusterTemplate indicates an expected call of V2RegisterClusterTemplate.
+func (mr *MockAPIMockRecorder) V2RegisterClusterTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RegisterClusterTemplate", reflect.TypeOf((*MockAPI)(nil).V2RegisterClusterTemplate), arg0, arg1)
+}
+
+// V2UpdateClusterTemplate mocks base method.
+func (m *MockAPI) V2UpdateClusterTemplate(arg0 context.Context, arg1 cluster_templates.V2UpdateClusterTemplateParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2UpdateClusterTemplate", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2UpdateClusterTemplate indicates an expected call of V2UpdateClusterTemplate.
+func (mr *MockAPIMockRecorder) V2UpdateClusterTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UpdateClusterTemplate", reflect.TypeOf((*MockAPI)(nil).V2UpdateClusterTemplate), arg0, arg1)
+}
@@ -341,3 +341,19 @@ func createClusterFromHosts(hosts []*models.Host) Cluster {
 		},
 	}
 }
+
+var _ = Describe("ETag", func() {
+	It("quotes the resource version", func() {
+		Expect(ETag(3)).To(Equal(`"3"`))
+	})
+
+	DescribeTable("parse If-Match",
+		func(ifMatch string, expected []int64) {
+			Expect(parseIfMatch(ifMatch)).To(Equal(expected))
+		},
+		Entry("single", `"3"`, []int64{3}),
+		Entry("list", `"3", W/"5"`, []int64{3, 5}),
+		Entry("any", `*`, nil),
+		Entry("invalid", `"abc"`, []int64{}),
+	)
+})
@@ -25,7 +25,7 @@ const (
 type Cluster struct {
 	models.Cluster
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
-	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
+	PullSecret string `json:"pull_secret" gorm:"type:TEXT" secret:"true"`
 
 	// The compute hash value of the http-proxy, https-proxy and no-proxy attributes, used internally to indicate
 	// if the proxy settings were changed while downloading ISO
@@ -81,14 +81,14 @@ type Host struct {
 	TriggerMonitorTimestamp time.Time
 
 	// A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.
-	IgnitionEndpointToken string `json:"ignition_endpoint_token" gorm:"type:TEXT"`
+	IgnitionEndpointToken string `json:"ignition_endpoint_token" gorm:"type:TEXT" secret:"true"`
 }
 
 type InfraEnv struct {
 	models.InfraEnv
 
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
-	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
+	PullSecret string `json:"pull_secret" gorm:"type:TEXT" secret:"true"`
 
 	// Namespace of the KubeAPI resource
 	KubeKeyNamespace string `json:"kube_key_namespace"`
@@ -111,13 +111,57 @@ type InfraEnv struct {
 	// TODO Add a helper function(s) to load InfraEnv(s) with eager-loading parameter
 	Hosts []*Host `json:"hosts" gorm:"foreignkey:InfraEnvID;references:ID"`
 
-	ImageTokenKey string `json:"image_token_key"`
+	ImageTokenKey string `json:"image_token_key" secret:"true"`
 
 	// Json formatted string containing internal overrides for the default ignition config.
 	// This is used for adding ironic ignition config to the assisted ignition config
 	InternalIgnitionConfigOverride string `json:"internal_ignition_config_override,omitempty"`
 }
 
+// HostStepSchedule records when a step was last sent to a host, the inputs it was sent with and its result,
+// so steps that are expensive for the agent are not sent again while their last result is still valid
+type HostStepSchedule struct {
+	HostID     strfmt.UUID     `gorm:"primaryKey"`
+	InfraEnvID strfmt.UUID     `gorm:"primaryKey"`
+	StepType   models.StepType `gorm:"primaryKey"`
+	StepID     string
+	ArgsHash   string
+	SentAt     time.Time
+	RepliedAt  *time.Time
+	ExitCode   int64
+}
+
+// HostStageTransition records when a host reached an installation stage, so the installation timeline can be
+// built once the host moved on from the stage
+type HostStageTransition struct {
+	ID         int64       `gorm:"primaryKey"`
+	ClusterID  strfmt.UUID `gorm:"index"`
+	HostID     strfmt.UUID `gorm:"index"`
+	InfraEnvID strfmt.UUID
+	Stage      models.HostStage
+	StartedAt  time.Time
+}
+
+// EventSubscription is a subscription that delivers events to an HTTP endpoint
+type EventSubscription struct {
+	models.EventSubscription
+
+	// The secret that signs the delivered events
+	Secret string `json:"-" gorm:"type:text" secret:"true"`
+
+	// Indication that the events of all users are delivered, when the subscription was created by an admin
+	CreatedByAdmin bool `json:"-"`
+
+	// The ID of the last event that was checked for delivery
+	LastEventID uint `json:"-"`
+
+	// Name of the KubeAPI resource
+	KubeKeyName string `json:"-"`
+
+	// Namespace of the KubeAPI resource
+	KubeKeyNamespace string `json:"-"`
+}
+
 type EagerLoadingState bool
 
 const (
@@ -144,7 +188,9 @@ var ClusterSubTables = [...]string{HostsTable, MonitoredOperatorsTable, ClusterN
 
 func AutoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}, &InfraEnv{},
-		&models.ClusterNetwork{}, &models.ServiceNetwork{}, &models.MachineNetwork{})
+		&models.ClusterNetwork{}, &models.ServiceNetwork{}, &models.MachineNetwork{}, &models.HostDiagnostic{}, &HostStepSchedule{}, &models.ClusterTemplate{},
+		&models.InstallConfigOverrideVersion{}, &HostStageTransition{}, &EventSubscription{}, &models.EventSubscriptionDelivery{},
+		&models.StateTransition{}, &models.AuditLogEntry{}, &models.HostValidationStats{})
 }
 
 func LoadTableFromDB(db *gorm.DB, tableName string, conditions ...interface{}) *gorm.DB {
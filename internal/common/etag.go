@@ -0,0 +1,60 @@
+package common
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ETag returns the entity tag of a resource with the given resource version
+func ETag(resourceVersion int64) string {
+	return strconv.Quote(strconv.FormatInt(resourceVersion, 10))
+}
+
+// parseIfMatch returns the resource versions of the entity tags of an If-Match header, or nil if it matches any
+// resource version
+func parseIfMatch(ifMatch string) []int64 {
+	versions := make([]int64, 0)
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return nil
+		}
+		tag = strings.TrimPrefix(tag, "W/")
+		if version, err := strconv.ParseInt(strings.Trim(tag, `"`), 10, 64); err == nil {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// BumpResourceVersion increments the resource version of the record that matches the given conditions, and must be
+// called in the transaction that updates the record. When ifMatch is set, the record is updated only if its resource
+// version matches one of the entity tags of ifMatch, and a precondition failed error is returned otherwise.
+func BumpResourceVersion(db *gorm.DB, model interface{}, ifMatch *string, query string, args ...interface{}) error {
+	q := db.Model(model).Where(query, args...)
+	if ifMatch != nil {
+		versions := parseIfMatch(*ifMatch)
+		if versions != nil {
+			if len(versions) == 0 {
+				return NewApiError(http.StatusPreconditionFailed, errors.Errorf("invalid If-Match header %s", *ifMatch))
+			}
+			q = q.Where("resource_version IN (?)", versions)
+		}
+	}
+	reply := q.UpdateColumn("resource_version", gorm.Expr("resource_version + 1"))
+	if reply.Error != nil {
+		return NewApiError(http.StatusInternalServerError, errors.Wrap(reply.Error, "failed to update resource version"))
+	}
+	if reply.RowsAffected == 0 {
+		if ifMatch != nil {
+			return NewApiError(http.StatusPreconditionFailed,
+				errors.Errorf("resource was modified since %s, get it again and retry the update", *ifMatch))
+		}
+		return NewApiError(http.StatusNotFound, errors.Wrap(gorm.ErrRecordNotFound, "failed to update resource version"))
+	}
+	return nil
+}
@@ -3454,6 +3454,125 @@ func (e *HostValidationFixedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_validation_flapping
+//
+type HostValidationFlappingEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    ValidationId string
+    FlipCount int64
+    Window string
+}
+
+var HostValidationFlappingEventName string = "host_validation_flapping"
+
+func NewHostValidationFlappingEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    validationId string,
+    flipCount int64,
+    window string,
+) *HostValidationFlappingEvent {
+    return &HostValidationFlappingEvent{
+        eventName: HostValidationFlappingEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        ValidationId: validationId,
+        FlipCount: flipCount,
+        Window: window,
+    }
+}
+
+func SendHostValidationFlappingEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    validationId string,
+    flipCount int64,
+    window string,) {
+    ev := NewHostValidationFlappingEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        validationId,
+        flipCount,
+        window,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostValidationFlappingEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    validationId string,
+    flipCount int64,
+    window string,
+    eventTime time.Time) {
+    ev := NewHostValidationFlappingEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        validationId,
+        flipCount,
+        window,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostValidationFlappingEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostValidationFlappingEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *HostValidationFlappingEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostValidationFlappingEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostValidationFlappingEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostValidationFlappingEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{validation_id}", fmt.Sprint(e.ValidationId),
+        "{flip_count}", fmt.Sprint(e.FlipCount),
+        "{window}", fmt.Sprint(e.Window),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostValidationFlappingEvent) FormatMessage() string {
+    s := "Host {host_name}: validation '{validation_id}' changed between success and failure {flip_count} times in the last {window}"
+    return e.format(&s)
+}
+
 //
 // Event quick_disk_format_performed
 //
@@ -5882,3 +6001,1102 @@ func (e *UpgradeAgentFailedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_diagnostic_requested
+//
+type HostDiagnosticRequestedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    HostName string
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    Action string
+    RequestedBy string
+}
+
+var HostDiagnosticRequestedEventName string = "host_diagnostic_requested"
+
+func NewHostDiagnosticRequestedEvent(
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,
+    requestedBy string,
+) *HostDiagnosticRequestedEvent {
+    return &HostDiagnosticRequestedEvent{
+        eventName: HostDiagnosticRequestedEventName,
+        HostId: hostId,
+        HostName: hostName,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        Action: action,
+        RequestedBy: requestedBy,
+    }
+}
+
+func SendHostDiagnosticRequestedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,
+    requestedBy string,) {
+    ev := NewHostDiagnosticRequestedEvent(
+        hostId,
+        hostName,
+        infraEnvId,
+        clusterId,
+        action,
+        requestedBy,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDiagnosticRequestedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,
+    requestedBy string,
+    eventTime time.Time) {
+    ev := NewHostDiagnosticRequestedEvent(
+        hostId,
+        hostName,
+        infraEnvId,
+        clusterId,
+        action,
+        requestedBy,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDiagnosticRequestedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDiagnosticRequestedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostDiagnosticRequestedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostDiagnosticRequestedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDiagnosticRequestedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDiagnosticRequestedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{action}", fmt.Sprint(e.Action),
+        "{requested_by}", fmt.Sprint(e.RequestedBy),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDiagnosticRequestedEvent) FormatMessage() string {
+    s := "Host {host_name}: diagnostic action '{action}' was requested by {requested_by}"
+    return e.format(&s)
+}
+
+//
+// Event host_diagnostic_completed
+//
+type HostDiagnosticCompletedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    HostName string
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    Action string
+}
+
+var HostDiagnosticCompletedEventName string = "host_diagnostic_completed"
+
+func NewHostDiagnosticCompletedEvent(
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,
+) *HostDiagnosticCompletedEvent {
+    return &HostDiagnosticCompletedEvent{
+        eventName: HostDiagnosticCompletedEventName,
+        HostId: hostId,
+        HostName: hostName,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        Action: action,
+    }
+}
+
+func SendHostDiagnosticCompletedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,) {
+    ev := NewHostDiagnosticCompletedEvent(
+        hostId,
+        hostName,
+        infraEnvId,
+        clusterId,
+        action,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDiagnosticCompletedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,
+    eventTime time.Time) {
+    ev := NewHostDiagnosticCompletedEvent(
+        hostId,
+        hostName,
+        infraEnvId,
+        clusterId,
+        action,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDiagnosticCompletedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDiagnosticCompletedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostDiagnosticCompletedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostDiagnosticCompletedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDiagnosticCompletedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDiagnosticCompletedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{action}", fmt.Sprint(e.Action),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDiagnosticCompletedEvent) FormatMessage() string {
+    s := "Host {host_name}: diagnostic action '{action}' completed, its output is available through the host diagnostics API"
+    return e.format(&s)
+}
+
+//
+// Event host_diagnostic_failed
+//
+type HostDiagnosticFailedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    HostName string
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    Action string
+    ExitCode int64
+}
+
+var HostDiagnosticFailedEventName string = "host_diagnostic_failed"
+
+func NewHostDiagnosticFailedEvent(
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,
+    exitCode int64,
+) *HostDiagnosticFailedEvent {
+    return &HostDiagnosticFailedEvent{
+        eventName: HostDiagnosticFailedEventName,
+        HostId: hostId,
+        HostName: hostName,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        Action: action,
+        ExitCode: exitCode,
+    }
+}
+
+func SendHostDiagnosticFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,
+    exitCode int64,) {
+    ev := NewHostDiagnosticFailedEvent(
+        hostId,
+        hostName,
+        infraEnvId,
+        clusterId,
+        action,
+        exitCode,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDiagnosticFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    action string,
+    exitCode int64,
+    eventTime time.Time) {
+    ev := NewHostDiagnosticFailedEvent(
+        hostId,
+        hostName,
+        infraEnvId,
+        clusterId,
+        action,
+        exitCode,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDiagnosticFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDiagnosticFailedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *HostDiagnosticFailedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostDiagnosticFailedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDiagnosticFailedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDiagnosticFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{action}", fmt.Sprint(e.Action),
+        "{exit_code}", fmt.Sprint(e.ExitCode),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDiagnosticFailedEvent) FormatMessage() string {
+    s := "Host {host_name}: diagnostic action '{action}' failed with exit code {exit_code}"
+    return e.format(&s)
+}
+
+//
+// Event failed_hosts_reinstall_started
+//
+type FailedHostsReinstallStartedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Hosts string
+}
+
+var FailedHostsReinstallStartedEventName string = "failed_hosts_reinstall_started"
+
+func NewFailedHostsReinstallStartedEvent(
+    clusterId strfmt.UUID,
+    hosts string,
+) *FailedHostsReinstallStartedEvent {
+    return &FailedHostsReinstallStartedEvent{
+        eventName: FailedHostsReinstallStartedEventName,
+        ClusterId: clusterId,
+        Hosts: hosts,
+    }
+}
+
+func SendFailedHostsReinstallStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    hosts string,) {
+    ev := NewFailedHostsReinstallStartedEvent(
+        clusterId,
+        hosts,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendFailedHostsReinstallStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    hosts string,
+    eventTime time.Time) {
+    ev := NewFailedHostsReinstallStartedEvent(
+        clusterId,
+        hosts,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *FailedHostsReinstallStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *FailedHostsReinstallStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *FailedHostsReinstallStartedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *FailedHostsReinstallStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{hosts}", fmt.Sprint(e.Hosts),
+    )
+    return r.Replace(*message)
+}
+
+func (e *FailedHostsReinstallStartedEvent) FormatMessage() string {
+    s := "Re-installation of the failed hosts {hosts} started, they will be installed as day-2 hosts once they are booted with the discovery image"
+    return e.format(&s)
+}
+
+//
+// Event host_reinstall_requested
+//
+type HostReinstallRequestedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    HostName string
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+}
+
+var HostReinstallRequestedEventName string = "host_reinstall_requested"
+
+func NewHostReinstallRequestedEvent(
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+) *HostReinstallRequestedEvent {
+    return &HostReinstallRequestedEvent{
+        eventName: HostReinstallRequestedEventName,
+        HostId: hostId,
+        HostName: hostName,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+    }
+}
+
+func SendHostReinstallRequestedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,) {
+    ev := NewHostReinstallRequestedEvent(
+        hostId,
+        hostName,
+        infraEnvId,
+        clusterId,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostReinstallRequestedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    hostName string,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    eventTime time.Time) {
+    ev := NewHostReinstallRequestedEvent(
+        hostId,
+        hostName,
+        infraEnvId,
+        clusterId,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostReinstallRequestedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostReinstallRequestedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostReinstallRequestedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostReinstallRequestedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostReinstallRequestedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostReinstallRequestedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostReinstallRequestedEvent) FormatMessage() string {
+    s := "Host {host_name}: reset for re-installation with the add-hosts flow, boot it with the discovery image to re-install it"
+    return e.format(&s)
+}
+
+//
+// Event install_scheduled
+//
+type InstallScheduledEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Window string
+}
+
+var InstallScheduledEventName string = "install_scheduled"
+
+func NewInstallScheduledEvent(
+    clusterId strfmt.UUID,
+    window string,
+) *InstallScheduledEvent {
+    return &InstallScheduledEvent{
+        eventName: InstallScheduledEventName,
+        ClusterId: clusterId,
+        Window: window,
+    }
+}
+
+func SendInstallScheduledEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    window string,) {
+    ev := NewInstallScheduledEvent(
+        clusterId,
+        window,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendInstallScheduledEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    window string,
+    eventTime time.Time) {
+    ev := NewInstallScheduledEvent(
+        clusterId,
+        window,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *InstallScheduledEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *InstallScheduledEvent) GetSeverity() string {
+    return "info"
+}
+func (e *InstallScheduledEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *InstallScheduledEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{window}", fmt.Sprint(e.Window),
+    )
+    return r.Replace(*message)
+}
+
+func (e *InstallScheduledEvent) FormatMessage() string {
+    s := "Installation was scheduled to start {window}"
+    return e.format(&s)
+}
+
+//
+// Event install_schedule_cancelled
+//
+type InstallScheduleCancelledEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Window string
+}
+
+var InstallScheduleCancelledEventName string = "install_schedule_cancelled"
+
+func NewInstallScheduleCancelledEvent(
+    clusterId strfmt.UUID,
+    window string,
+) *InstallScheduleCancelledEvent {
+    return &InstallScheduleCancelledEvent{
+        eventName: InstallScheduleCancelledEventName,
+        ClusterId: clusterId,
+        Window: window,
+    }
+}
+
+func SendInstallScheduleCancelledEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    window string,) {
+    ev := NewInstallScheduleCancelledEvent(
+        clusterId,
+        window,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendInstallScheduleCancelledEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    window string,
+    eventTime time.Time) {
+    ev := NewInstallScheduleCancelledEvent(
+        clusterId,
+        window,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *InstallScheduleCancelledEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *InstallScheduleCancelledEvent) GetSeverity() string {
+    return "info"
+}
+func (e *InstallScheduleCancelledEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *InstallScheduleCancelledEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{window}", fmt.Sprint(e.Window),
+    )
+    return r.Replace(*message)
+}
+
+func (e *InstallScheduleCancelledEvent) FormatMessage() string {
+    s := "Scheduled installation {window} was cancelled"
+    return e.format(&s)
+}
+
+//
+// Event install_schedule_expired
+//
+type InstallScheduleExpiredEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Window string
+}
+
+var InstallScheduleExpiredEventName string = "install_schedule_expired"
+
+func NewInstallScheduleExpiredEvent(
+    clusterId strfmt.UUID,
+    window string,
+) *InstallScheduleExpiredEvent {
+    return &InstallScheduleExpiredEvent{
+        eventName: InstallScheduleExpiredEventName,
+        ClusterId: clusterId,
+        Window: window,
+    }
+}
+
+func SendInstallScheduleExpiredEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    window string,) {
+    ev := NewInstallScheduleExpiredEvent(
+        clusterId,
+        window,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendInstallScheduleExpiredEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    window string,
+    eventTime time.Time) {
+    ev := NewInstallScheduleExpiredEvent(
+        clusterId,
+        window,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *InstallScheduleExpiredEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *InstallScheduleExpiredEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *InstallScheduleExpiredEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *InstallScheduleExpiredEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{window}", fmt.Sprint(e.Window),
+    )
+    return r.Replace(*message)
+}
+
+func (e *InstallScheduleExpiredEvent) FormatMessage() string {
+    s := "Scheduled installation expired, the cluster was not ready to be installed {window}"
+    return e.format(&s)
+}
+
+//
+// Event scheduled_install_started
+//
+type ScheduledInstallStartedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+}
+
+var ScheduledInstallStartedEventName string = "scheduled_install_started"
+
+func NewScheduledInstallStartedEvent(
+    clusterId strfmt.UUID,
+) *ScheduledInstallStartedEvent {
+    return &ScheduledInstallStartedEvent{
+        eventName: ScheduledInstallStartedEventName,
+        ClusterId: clusterId,
+    }
+}
+
+func SendScheduledInstallStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,) {
+    ev := NewScheduledInstallStartedEvent(
+        clusterId,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    eventTime time.Time) {
+    ev := NewScheduledInstallStartedEvent(
+        clusterId,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ScheduledInstallStartedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallStartedEvent) FormatMessage() string {
+    s := "Scheduled installation started"
+    return e.format(&s)
+}
+
+//
+// Event scheduled_install_failed
+//
+type ScheduledInstallFailedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Error string
+}
+
+var ScheduledInstallFailedEventName string = "scheduled_install_failed"
+
+func NewScheduledInstallFailedEvent(
+    clusterId strfmt.UUID,
+    error string,
+) *ScheduledInstallFailedEvent {
+    return &ScheduledInstallFailedEvent{
+        eventName: ScheduledInstallFailedEventName,
+        ClusterId: clusterId,
+        Error: error,
+    }
+}
+
+func SendScheduledInstallFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,) {
+    ev := NewScheduledInstallFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,
+    eventTime time.Time) {
+    ev := NewScheduledInstallFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *ScheduledInstallFailedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallFailedEvent) FormatMessage() string {
+    s := "Failed to start the scheduled installation: {error}"
+    return e.format(&s)
+}
+
+//
+// Event cluster_installation_eta_updated
+//
+type ClusterInstallationEtaUpdatedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    EstimatedCompletionAt string
+    Remaining string
+}
+
+var ClusterInstallationEtaUpdatedEventName string = "cluster_installation_eta_updated"
+
+func NewClusterInstallationEtaUpdatedEvent(
+    clusterId strfmt.UUID,
+    estimatedCompletionAt string,
+    remaining string,
+) *ClusterInstallationEtaUpdatedEvent {
+    return &ClusterInstallationEtaUpdatedEvent{
+        eventName: ClusterInstallationEtaUpdatedEventName,
+        ClusterId: clusterId,
+        EstimatedCompletionAt: estimatedCompletionAt,
+        Remaining: remaining,
+    }
+}
+
+func SendClusterInstallationEtaUpdatedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    estimatedCompletionAt string,
+    remaining string,) {
+    ev := NewClusterInstallationEtaUpdatedEvent(
+        clusterId,
+        estimatedCompletionAt,
+        remaining,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterInstallationEtaUpdatedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    estimatedCompletionAt string,
+    remaining string,
+    eventTime time.Time) {
+    ev := NewClusterInstallationEtaUpdatedEvent(
+        clusterId,
+        estimatedCompletionAt,
+        remaining,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterInstallationEtaUpdatedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterInstallationEtaUpdatedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterInstallationEtaUpdatedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterInstallationEtaUpdatedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{estimated_completion_at}", fmt.Sprint(e.EstimatedCompletionAt),
+        "{remaining}", fmt.Sprint(e.Remaining),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterInstallationEtaUpdatedEvent) FormatMessage() string {
+    s := "Installation is estimated to complete at {estimated_completion_at} ({remaining} remaining)"
+    return e.format(&s)
+}
+
+//
+// Event cluster_restored
+//
+type ClusterRestoredEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    HostsCount int
+}
+
+var ClusterRestoredEventName string = "cluster_restored"
+
+func NewClusterRestoredEvent(
+    clusterId strfmt.UUID,
+    hostsCount int,
+) *ClusterRestoredEvent {
+    return &ClusterRestoredEvent{
+        eventName: ClusterRestoredEventName,
+        ClusterId: clusterId,
+        HostsCount: hostsCount,
+    }
+}
+
+func SendClusterRestoredEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    hostsCount int,) {
+    ev := NewClusterRestoredEvent(
+        clusterId,
+        hostsCount,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterRestoredEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    hostsCount int,
+    eventTime time.Time) {
+    ev := NewClusterRestoredEvent(
+        clusterId,
+        hostsCount,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterRestoredEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterRestoredEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterRestoredEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterRestoredEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{hosts_count}", fmt.Sprint(e.HostsCount),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterRestoredEvent) FormatMessage() string {
+    s := "Restored the deregistered cluster with {hosts_count} hosts"
+    return e.format(&s)
+}
+
+//
+// Event host_restored
+//
+type HostRestoredEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+}
+
+var HostRestoredEventName string = "host_restored"
+
+func NewHostRestoredEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+) *HostRestoredEvent {
+    return &HostRestoredEvent{
+        eventName: HostRestoredEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+    }
+}
+
+func SendHostRestoredEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,) {
+    ev := NewHostRestoredEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostRestoredEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    eventTime time.Time) {
+    ev := NewHostRestoredEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostRestoredEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostRestoredEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostRestoredEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostRestoredEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostRestoredEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostRestoredEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostRestoredEvent) FormatMessage() string {
+    s := "Host {host_name} restored with its cluster"
+    return e.format(&s)
+}
+
@@ -53,6 +53,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -81,6 +82,7 @@ type AgentReconciler struct {
 	Scheme                     *runtime.Scheme
 	Installer                  bminventory.InstallerInternals
 	CRDEventsHandler           CRDEventsHandler
+	Recorder                   record.EventRecorder
 	ServiceBaseURL             string
 	AuthType                   auth.AuthType
 	SpokeK8sClientFactory      spoke_k8s_client.SpokeK8sClientFactory
@@ -95,6 +97,7 @@ type AgentReconciler struct {
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents/ai-deprovision,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ctx := addRequestIdIfNeeded(origCtx)
@@ -277,6 +280,7 @@ func (r *AgentReconciler) approveAIHostsCSRs(clients spoke_k8s_client.SpokeK8sCl
 				r.Log.WithError(err).Errorf("Failed to approve CSR %s for agent %s/%s", csr.Name, agent.Namespace, agent.Name)
 				continue
 			}
+			r.Recorder.Eventf(agent, corev1.EventTypeNormal, "CSRApproved", "Approved CSR %s requested by %s", csr.Name, csr.Spec.Username)
 		}
 	}
 }
@@ -1234,6 +1238,7 @@ func (r *AgentReconciler) updateIfNeeded(ctx context.Context, log logrus.FieldLo
 		HostID:           *internalHost.ID,
 		InfraEnvID:       internalHost.InfraEnvID,
 		HostUpdateParams: &models.HostUpdateParams{},
+		IfMatch:          swag.String(common.ETag(internalHost.ResourceVersion)),
 	}
 	if spec.Hostname != "" && spec.Hostname != internalHost.RequestedHostname {
 		hostUpdate = true
@@ -34,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -1770,6 +1771,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 		hostId                strfmt.UUID
 		mockInstallerInternal *bminventory.MockInstallerInternals
 		mockClientFactory     *spoke_k8s_client.MockSpokeK8sClientFactory
+		recorder              *record.FakeRecorder
 	)
 	newAciWithUserManagedNetworkingNoSNO := func(name, namespace string) *hiveext.AgentClusterInstall {
 		return &hiveext.AgentClusterInstall{
@@ -1922,6 +1924,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockInstallerInternal = bminventory.NewMockInstallerInternals(mockCtrl)
 		mockClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
+		recorder = record.NewFakeRecorder(10)
 		hr = &AgentReconciler{
 			Client:                     c,
 			Scheme:                     scheme.Scheme,
@@ -1930,6 +1933,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 			APIReader:                  c,
 			SpokeK8sClientFactory:      mockClientFactory,
 			ApproveCsrsRequeueDuration: time.Minute,
+			Recorder:                   recorder,
 		}
 		sId := strfmt.UUID(uuid.New().String())
 		hostId = strfmt.UUID(uuid.New().String())
@@ -2282,6 +2286,11 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 			Expect(c.Get(ctx, agentKey, agent)).To(BeNil())
 			Expect(agent.Status.DebugInfo.State).To(Equal(t.expectedStatus))
 			Expect(agent.Status.Progress.CurrentStage).To(Equal(t.expectedStage))
+			if t.approveExpected {
+				Expect(recorder.Events).To(Receive(HavePrefix("Normal CSRApproved Approved CSR")))
+			} else {
+				Expect(recorder.Events).ToNot(Receive())
+			}
 		})
 	}
 
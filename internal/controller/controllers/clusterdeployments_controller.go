@@ -71,6 +71,8 @@ const (
 	adminPasswordSecretStringTemplate = "%s-admin-password"
 	adminKubeConfigStringTemplate     = "%s-admin-kubeconfig"
 	InstallConfigOverrides            = aiv1beta1.Group + "/install-config-overrides"
+	HostStageTimeouts                 = aiv1beta1.Group + "/host-stage-timeouts"
+	DeletionProtected                 = aiv1beta1.Group + "/deletion-protected"
 	ClusterDeploymentFinalizerName    = "clusterdeployments." + aiv1beta1.Group + "/ai-deprovision"
 	AgentClusterInstallFinalizerName  = "agentclusterinstall." + aiv1beta1.Group + "/ai-deprovision"
 )
@@ -213,6 +215,13 @@ func (r *ClusterDeploymentsReconciler) Reconcile(origCtx context.Context, req ct
 		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
 	}
 
+	// check for deletion protection and update if needed
+	cluster, err = r.updateDeletionProtection(ctx, log, clusterInstall, cluster)
+	if err != nil {
+		log.WithError(err).Error("failed to update deletion protection")
+		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
+	}
+
 	// In case the Cluster is a Day 1 cluster and is installed, update the Metadata and create secrets for credentials
 	if *cluster.Status == models.ClusterStatusInstalled && swag.StringValue(cluster.Kind) == models.ClusterKindCluster {
 		return r.handleClusterInstalled(ctx, log, clusterDeployment, cluster, clusterInstall, req.NamespacedName)
@@ -272,6 +281,17 @@ func (r *ClusterDeploymentsReconciler) agentClusterInstallFinalizer(ctx context.
 				return &ctrl.Result{Requeue: true}, err
 			}
 			if err == nil {
+				if isDeletionProtected(clusterInstall) {
+					log.Infof("ClusterInstall is deletion protected, remove the %s annotation to delete cluster %s",
+						DeletionProtected, *cluster.ID)
+					return &ctrl.Result{RequeueAfter: longerRequeueAfterOnError}, nil
+				}
+				// the annotation was removed after the deletion was requested
+				if swag.BoolValue(cluster.DeletionProtected) {
+					if cluster, err = r.updateDeletionProtection(ctx, log, clusterInstall, cluster); err != nil {
+						return &ctrl.Result{Requeue: true}, err
+					}
+				}
 				if swag.StringValue(cluster.Status) == models.ClusterStatusInstalling || swag.StringValue(cluster.Status) == models.ClusterStatusPreparingForInstallation {
 					log.Infof("ClusterInstall is being deleted, cancel installation for cluster %s", *cluster.ID)
 					if _, err = r.Installer.CancelInstallationInternal(ctx, installer.V2CancelInstallationParams{
@@ -354,12 +374,30 @@ func isInstalled(clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hi
 
 func (r *ClusterDeploymentsReconciler) installDay1(ctx context.Context, log logrus.FieldLogger, clusterDeployment *hivev1.ClusterDeployment,
 	clusterInstall *hiveext.AgentClusterInstall, cluster *common.Cluster, pullSecret string) (ctrl.Result, error) {
+	cluster, err := r.syncInstallSchedule(ctx, log, clusterInstall, cluster)
+	if err != nil {
+		log.WithError(err).Error("failed to update the install schedule")
+		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
+	}
 	ready, err := r.isReadyForInstallation(ctx, log, clusterInstall, cluster)
 	if err != nil {
 		log.WithError(err).Error("failed to check if cluster ready for installation")
 		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
 	}
 	if ready {
+		if schedule := clusterInstall.Spec.InstallSchedule; schedule != nil {
+			now := time.Now()
+			if schedule.EndAt != nil && now.After(schedule.EndAt.Time) {
+				log.Infof("Install schedule of clusterDeployment %s %s ended, not installing", clusterDeployment.Name, clusterDeployment.Namespace)
+				return r.updateStatus(ctx, log, clusterInstall, cluster, nil)
+			}
+			if now.Before(schedule.StartAt.Time) {
+				log.Infof("Waiting for the install schedule of clusterDeployment %s %s to start", clusterDeployment.Name, clusterDeployment.Namespace)
+				_, _ = r.updateStatus(ctx, log, clusterInstall, cluster, nil)
+				return ctrl.Result{Requeue: true, RequeueAfter: schedule.StartAt.Sub(now)}, nil
+			}
+		}
+
 		// create custom manifests if needed before installation
 		err = r.addCustomManifests(ctx, log, clusterInstall, cluster)
 		if err != nil {
@@ -392,6 +430,39 @@ func (r *ClusterDeploymentsReconciler) installDay1(ctx context.Context, log logr
 	return r.updateStatus(ctx, log, clusterInstall, cluster, nil)
 }
 
+// syncInstallSchedule sets the install schedule of the AgentClusterInstall to the cluster, so it is visible there and the
+// cluster monitor expires it if the window ends before the installation starts
+func (r *ClusterDeploymentsReconciler) syncInstallSchedule(ctx context.Context, log logrus.FieldLogger,
+	clusterInstall *hiveext.AgentClusterInstall, c *common.Cluster) (*common.Cluster, error) {
+	if !funk.ContainsString([]string{models.ClusterStatusPendingForInput, models.ClusterStatusInsufficient, models.ClusterStatusReady},
+		swag.StringValue(c.Status)) {
+		return c, nil
+	}
+
+	schedule := clusterInstall.Spec.InstallSchedule
+	if schedule == nil {
+		if c.InstallScheduleStartAt == nil {
+			return c, nil
+		}
+		log.Infof("Cancelling the scheduled installation of cluster %s", *c.ID)
+		return r.Installer.UpdateInstallScheduleInternal(ctx, *c.ID, nil)
+	}
+	if schedule.EndAt != nil && time.Now().After(schedule.EndAt.Time) {
+		// The window already ended, the schedule was expired by the cluster monitor
+		return c, nil
+	}
+
+	installSchedule := &models.InstallSchedule{StartAt: (*strfmt.DateTime)(&schedule.StartAt.Time)}
+	if schedule.EndAt != nil {
+		installSchedule.EndAt = (*strfmt.DateTime)(&schedule.EndAt.Time)
+	}
+	if cluster.SameDateTime(c.InstallScheduleStartAt, installSchedule.StartAt) && cluster.SameDateTime(c.InstallScheduleEndAt, installSchedule.EndAt) {
+		return c, nil
+	}
+	log.Infof("Scheduling the installation of cluster %s", *c.ID)
+	return r.Installer.UpdateInstallScheduleInternal(ctx, *c.ID, installSchedule)
+}
+
 func (r *ClusterDeploymentsReconciler) installDay2Hosts(ctx context.Context, log logrus.FieldLogger, clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall, cluster *common.Cluster) (ctrl.Result, error) {
 	hosts, err := r.Installer.GetKnownApprovedHosts(*cluster.ID)
 	if err != nil {
@@ -883,6 +954,8 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(ctx context.Context,
 	sshPublicKey := strings.TrimSpace(clusterInstall.Spec.SSHPublicKey)
 	updateString(sshPublicKey, cluster.SSHPublicKey, &params.SSHPublicKey)
 
+	updateString(clusterInstall.Annotations[HostStageTimeouts], cluster.HostStageTimeouts, &params.HostStageTimeouts)
+
 	// Update ignition endpoint if needed
 	shouldUpdate, err := r.updateIgnitionInUpdateParams(ctx, log, clusterInstall, cluster, params)
 	if err != nil {
@@ -946,6 +1019,7 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(ctx context.Context,
 	clusterAfterUpdate, err = r.Installer.UpdateClusterNonInteractive(ctx, installer.V2UpdateClusterParams{
 		ClusterUpdateParams: params,
 		ClusterID:           *cluster.ID,
+		IfMatch:             swag.String(common.ETag(cluster.ResourceVersion)),
 	})
 	if err != nil {
 		return cluster, err
@@ -1015,6 +1089,31 @@ func (r *ClusterDeploymentsReconciler) updateInstallConfigOverrides(ctx context.
 	return nil
 }
 
+// updateDeletionProtection mirrors the deletion protection annotation of the clusterInstall to the cluster. The
+// protection is updated on its own as it can be updated in any state of the cluster.
+func (r *ClusterDeploymentsReconciler) updateDeletionProtection(ctx context.Context, log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall,
+	cluster *common.Cluster) (*common.Cluster, error) {
+	deletionProtected := isDeletionProtected(clusterInstall)
+	if swag.BoolValue(cluster.DeletionProtected) == deletionProtected {
+		return cluster, nil
+	}
+	clusterAfterUpdate, err := r.Installer.UpdateClusterNonInteractive(ctx, installer.V2UpdateClusterParams{
+		ClusterUpdateParams: &models.V2ClusterUpdateParams{DeletionProtected: swag.Bool(deletionProtected)},
+		ClusterID:           *cluster.ID,
+		IfMatch:             swag.String(common.ETag(cluster.ResourceVersion)),
+	})
+	if err != nil {
+		return cluster, err
+	}
+	log.Infof("Updated deletion protection of clusterInstall %s/%s to %t", clusterInstall.Namespace, clusterInstall.Name, deletionProtected)
+	return clusterAfterUpdate, nil
+}
+
+// isDeletionProtected returns true if the deletion protection annotation of the object is set to true
+func isDeletionProtected(obj metav1.Object) bool {
+	return obj.GetAnnotations()[DeletionProtected] == "true"
+}
+
 func (r *ClusterDeploymentsReconciler) syncManifests(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster,
 	clusterInstall *hiveext.AgentClusterInstall, alreadyCreatedManifests models.ListManifests) error {
 
@@ -1145,6 +1244,7 @@ func CreateClusterParams(clusterDeployment *hivev1.ClusterDeployment, clusterIns
 		CPUArchitecture:       releaseImageCPUArch,
 		UserManagedNetworking: swag.Bool(isUserManagedNetwork(clusterInstall)),
 		Platform:              getPlatform(clusterInstall.Spec.PlatformType),
+		DeletionProtected:     swag.Bool(isDeletionProtected(clusterInstall)),
 	}
 
 	if len(clusterInstall.Spec.Networking.ClusterNetwork) > 0 {
@@ -1538,6 +1638,11 @@ func (r *ClusterDeploymentsReconciler) updateStatus(ctx context.Context, log log
 				clusterInstall.Status.Progress = hiveext.ClusterProgressInfo{}
 			} else {
 				clusterInstall.Status.Progress.TotalPercentage = c.Progress.TotalPercentage
+				clusterInstall.Status.Progress.EstimatedCompletionTime = nil
+				if c.Progress.EstimatedCompletionAt != nil {
+					estimatedCompletionTime := metav1.NewTime(time.Time(*c.Progress.EstimatedCompletionAt))
+					clusterInstall.Status.Progress.EstimatedCompletionTime = &estimatedCompletionTime
+				}
 			}
 			clusterInstall.Status.APIVIP = c.APIVip
 			clusterInstall.Status.IngressVIP = c.IngressVip
@@ -1107,6 +1107,45 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(result).Should(Equal(ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}))
 		})
 
+		It("agentClusterInstall resource deleted - deletion protected", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                &sId,
+					DeletionProtected: swag.Bool(true),
+				},
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+			mockInstallerInternal.EXPECT().DeregisterClusterInternal(gomock.Any(), gomock.Any()).Times(0)
+
+			aci.ObjectMeta.SetAnnotations(map[string]string{DeletionProtected: "true"})
+			simulateACIDeletionWithFinalizer(ctx, c, aci)
+			request := newClusterDeploymentRequest(cd)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).Should(Equal(ctrl.Result{RequeueAfter: longerRequeueAfterOnError}))
+		})
+
+		It("agentClusterInstall resource deleted - deletion protection removed", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                &sId,
+					DeletionProtected: swag.Bool(true),
+				},
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(2)
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterParams) {
+					Expect(param.ClusterUpdateParams.DeletionProtected).To(Equal(swag.Bool(false)))
+				}).Return(&common.Cluster{Cluster: models.Cluster{ID: &sId}}, nil).Times(1)
+			mockInstallerInternal.EXPECT().DeregisterClusterInternal(gomock.Any(), gomock.Any()).Return(nil)
+
+			simulateACIDeletionWithFinalizer(ctx, c, aci)
+			request := newClusterDeploymentRequest(cd)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).Should(Equal(ctrl.Result{}))
+		})
+
 		It("agentClusterInstall resource deleted and created again", func() {
 			backEndCluster := &common.Cluster{
 				Cluster: models.Cluster{
@@ -1217,6 +1256,32 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterCompletedCondition).Status).To(Equal(corev1.ConditionFalse))
 		})
 
+		It("waits for the install schedule", func() {
+			backEndCluster.Status = swag.String(models.ClusterStatusReady)
+			startAt := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+			scheduledCluster := *backEndCluster
+			scheduledCluster.InstallScheduleStartAt = (*strfmt.DateTime)(&startAt.Time)
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockInstallerInternal.EXPECT().UpdateInstallScheduleInternal(gomock.Any(), *backEndCluster.ID, &models.InstallSchedule{
+				StartAt: (*strfmt.DateTime)(&startAt.Time),
+			}).Return(&scheduledCluster, nil).Times(1)
+			mockClusterApi.EXPECT().IsReadyForInstallation(gomock.Any()).Return(true, "").Times(1)
+			mockInstallerInternal.EXPECT().GetKnownHostApprovedCounts(gomock.Any()).Return(5, 5, nil).Times(1)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil).AnyTimes()
+			mockInstallerInternal.EXPECT().InstallClusterInternal(gomock.Any(), gomock.Any()).Times(0)
+
+			aci = getTestClusterInstall()
+			aci.Spec.InstallSchedule = &hiveext.InstallSchedule{StartAt: startAt}
+			Expect(c.Update(ctx, aci)).To(BeNil())
+
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result.Requeue).To(BeTrue())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 59*time.Minute))
+		})
+
 		It("hold installation", func() {
 			backEndCluster.Status = swag.String(models.ClusterStatusReady)
 			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(2)
@@ -2734,6 +2799,50 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(result).To(Equal(ctrl.Result{}))
 		})
 
+		It("add deletion protection annotation", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &sId,
+					Name:             clusterName,
+					OpenshiftVersion: "4.8",
+					ClusterNetworks:  clusterNetworksEntriesToArray(defaultAgentClusterInstallSpec.Networking.ClusterNetwork),
+					ServiceNetworks:  serviceNetworksEntriesToArray(defaultAgentClusterInstallSpec.Networking.ServiceNetwork),
+					NetworkType:      swag.String(models.ClusterNetworkTypeOpenShiftSDN),
+					Status:           swag.String(models.ClusterStatusInsufficient),
+					IngressVip:       defaultAgentClusterInstallSpec.IngressVIP,
+					APIVip:           defaultAgentClusterInstallSpec.APIVIP,
+					BaseDNSDomain:    defaultClusterSpec.BaseDomain,
+					SSHPublicKey:     defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:   models.ClusterHyperthreadingAll,
+					ResourceVersion:  3,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any()).Return(nil)
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                &sId,
+					Status:            swag.String(models.ClusterStatusInsufficient),
+					DeletionProtected: swag.Bool(true),
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterParams) {
+					Expect(param.ClusterID).To(Equal(sId))
+					Expect(swag.StringValue(param.IfMatch)).To(Equal(`"3"`))
+					Expect(*param.ClusterUpdateParams).To(Equal(models.V2ClusterUpdateParams{DeletionProtected: swag.Bool(true)}))
+				}).Return(updateReply, nil)
+			aci.ObjectMeta.SetAnnotations(map[string]string{DeletionProtected: "true"})
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
 		It("Remove existing install config overrides annotation", func() {
 			backEndCluster := &common.Cluster{
 				Cluster: models.Cluster{
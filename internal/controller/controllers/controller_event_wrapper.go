@@ -15,14 +15,17 @@ import (
 type controllerEventsWrapper struct {
 	events           eventsapi.Handler
 	crdEventsHandler CRDEventsHandler
+	kubeEvents       *KubeEvents
 	db               *gorm.DB
 	log              logrus.FieldLogger
 }
 
 var _ eventsapi.Handler = &controllerEventsWrapper{}
 
-func NewControllerEventsWrapper(crdEventsHandler CRDEventsHandler, events eventsapi.Handler, db *gorm.DB, log logrus.FieldLogger) *controllerEventsWrapper {
-	return &controllerEventsWrapper{crdEventsHandler: crdEventsHandler,
+// NewControllerEventsWrapper returns a handler of events that notifies the controllers of the resources that the
+// events relate to. When kubeEvents is set, the events are also recorded as Kubernetes events of the resources.
+func NewControllerEventsWrapper(crdEventsHandler CRDEventsHandler, kubeEvents *KubeEvents, events eventsapi.Handler, db *gorm.DB, log logrus.FieldLogger) *controllerEventsWrapper {
+	return &controllerEventsWrapper{crdEventsHandler: crdEventsHandler, kubeEvents: kubeEvents,
 		events: events, db: db, log: log}
 }
 
@@ -31,8 +34,10 @@ func (c *controllerEventsWrapper) V2AddEvent(ctx context.Context, clusterID *str
 
 	if hostID != nil {
 		c.NotifyKubeApiHostEvent(common.StrFmtUUIDVal(infraEnvID), common.StrFmtUUIDVal(hostID))
+		c.mirrorHostEvent(ctx, common.StrFmtUUIDVal(infraEnvID), common.StrFmtUUIDVal(hostID), name, severity, msg)
 	} else {
 		c.NotifyKubeApiClusterEvent(common.StrFmtUUIDVal(clusterID))
+		c.mirrorClusterEvent(ctx, common.StrFmtUUIDVal(clusterID), name, severity, msg)
 	}
 }
 
@@ -61,40 +66,50 @@ func (c *controllerEventsWrapper) V2GetEvents(ctx context.Context, clusterID *st
 	return c.events.V2GetEvents(ctx, clusterID, hostID, infraEnvID, categories...)
 }
 
+func (c *controllerEventsWrapper) V2GetFilteredEvents(ctx context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
+	return c.events.V2GetFilteredEvents(ctx, params)
+}
+
 func (c *controllerEventsWrapper) SendClusterEvent(ctx context.Context, event eventsapi.ClusterEvent) {
 	c.events.SendClusterEvent(ctx, event)
 
 	c.NotifyKubeApiClusterEvent(event.GetClusterId())
+	c.mirrorClusterEvent(ctx, event.GetClusterId(), event.GetName(), event.GetSeverity(), event.FormatMessage())
 }
 
 func (c *controllerEventsWrapper) SendClusterEventAtTime(ctx context.Context, event eventsapi.ClusterEvent, eventTime time.Time) {
 	c.events.SendClusterEventAtTime(ctx, event, eventTime)
 
 	c.NotifyKubeApiClusterEvent(event.GetClusterId())
+	c.mirrorClusterEvent(ctx, event.GetClusterId(), event.GetName(), event.GetSeverity(), event.FormatMessage())
 }
 
 func (c *controllerEventsWrapper) SendHostEvent(ctx context.Context, event eventsapi.HostEvent) {
 	c.events.SendHostEvent(ctx, event)
 
 	c.NotifyKubeApiHostEvent(event.GetInfraEnvId(), event.GetHostId())
+	c.mirrorHostEvent(ctx, event.GetInfraEnvId(), event.GetHostId(), event.GetName(), event.GetSeverity(), event.FormatMessage())
 }
 
 func (c *controllerEventsWrapper) SendHostEventAtTime(ctx context.Context, event eventsapi.HostEvent, eventTime time.Time) {
 	c.events.SendHostEventAtTime(ctx, event, eventTime)
 
 	c.NotifyKubeApiHostEvent(event.GetInfraEnvId(), event.GetHostId())
+	c.mirrorHostEvent(ctx, event.GetInfraEnvId(), event.GetHostId(), event.GetName(), event.GetSeverity(), event.FormatMessage())
 }
 
 func (c *controllerEventsWrapper) SendInfraEnvEvent(ctx context.Context, event eventsapi.InfraEnvEvent) {
 	c.events.SendInfraEnvEvent(ctx, event)
 
 	c.NotifyKubeApiInfraEnvEvent(event.GetInfraEnvId())
+	c.mirrorInfraEnvEvent(ctx, event.GetInfraEnvId(), event.GetName(), event.GetSeverity(), event.FormatMessage())
 }
 
 func (c *controllerEventsWrapper) SendInfraEnvEventAtTime(ctx context.Context, event eventsapi.InfraEnvEvent, eventTime time.Time) {
 	c.events.SendInfraEnvEventAtTime(ctx, event, eventTime)
 
 	c.NotifyKubeApiInfraEnvEvent(event.GetInfraEnvId())
+	c.mirrorInfraEnvEvent(ctx, event.GetInfraEnvId(), event.GetName(), event.GetSeverity(), event.FormatMessage())
 }
 
 func (c *controllerEventsWrapper) NotifyKubeApiClusterEvent(clusterID strfmt.UUID) {
@@ -139,3 +154,21 @@ func (c *controllerEventsWrapper) NotifyKubeApiInfraEnvEvent(infraEnvId strfmt.U
 	c.log.Debugf("Pushing InfraEnv event %s %s", swag.StringValue(ie.Name), ie.KubeKeyNamespace)
 	c.crdEventsHandler.NotifyInfraEnvUpdates(swag.StringValue(ie.Name), ie.KubeKeyNamespace)
 }
+
+func (c *controllerEventsWrapper) mirrorClusterEvent(ctx context.Context, clusterID strfmt.UUID, name, severity, msg string) {
+	if c.kubeEvents != nil {
+		c.kubeEvents.MirrorClusterEvent(ctx, clusterID, name, severity, msg)
+	}
+}
+
+func (c *controllerEventsWrapper) mirrorHostEvent(ctx context.Context, infraEnvID, hostID strfmt.UUID, name, severity, msg string) {
+	if c.kubeEvents != nil {
+		c.kubeEvents.MirrorHostEvent(ctx, infraEnvID, hostID, name, severity, msg)
+	}
+}
+
+func (c *controllerEventsWrapper) mirrorInfraEnvEvent(ctx context.Context, infraEnvID strfmt.UUID, name, severity, msg string) {
+	if c.kubeEvents != nil {
+		c.kubeEvents.MirrorInfraEnvEvent(ctx, infraEnvID, name, severity, msg)
+	}
+}
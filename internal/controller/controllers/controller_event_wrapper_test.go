@@ -37,7 +37,7 @@ var _ = Describe("Controller events wrapper", func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		theEvents = events.New(db, nil, logrus.WithField("pkg", "events"))
 		mockCRDEventsHandler = NewMockCRDEventsHandler(mockCtrl)
-		cEventsWrapper = NewControllerEventsWrapper(mockCRDEventsHandler, theEvents, db, logrus.New())
+		cEventsWrapper = NewControllerEventsWrapper(mockCRDEventsHandler, nil, theEvents, db, logrus.New())
 		// create simple cluster
 		clusterID1 := strfmt.UUID(uuid.New().String())
 		cluster1 = &common.Cluster{
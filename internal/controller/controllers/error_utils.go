@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"net/http"
+
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/pkg/errors"
 )
@@ -36,6 +38,9 @@ func newKubeAPIError(err error, isClientError bool) *KubeAPIError {
 }
 
 func isClientError(err error) bool {
+	if isConcurrentUpdateError(err) {
+		return false
+	}
 	switch serr := err.(type) {
 	case *KubeAPIError:
 		return serr.IsClientError
@@ -59,7 +64,16 @@ func IsHTTPError(err error, httpErrorCode int) bool {
 	}
 }
 
+// isConcurrentUpdateError returns true if an update failed because the resource was updated concurrently, which is
+// resolved by retrying the update with the updated resource
+func isConcurrentUpdateError(err error) bool {
+	return IsHTTPError(err, http.StatusPreconditionFailed)
+}
+
 func IsUserError(err error) bool {
+	if isConcurrentUpdateError(err) {
+		return false
+	}
 	switch serr := err.(type) {
 	case *common.ApiErrorResponse:
 		if serr.StatusCode() >= 400 && serr.StatusCode() < 500 {
@@ -0,0 +1,269 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/eventsubscriptions"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const EventSubscriptionFinalizerName = "eventsubscription." + aiv1beta1.Group + "/ai-deprovision"
+
+// EventSubscriptionReconciler reconciles a EventSubscription object
+type EventSubscriptionReconciler struct {
+	client.Client
+	APIReader          client.Reader
+	Log                logrus.FieldLogger
+	Installer          bminventory.InstallerInternals
+	EventSubscriptions eventsubscriptions.API
+}
+
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=eventsubscriptions,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=eventsubscriptions/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=eventsubscriptions/finalizers,verbs=update
+
+func (r *EventSubscriptionReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := addRequestIdIfNeeded(origCtx)
+	log := logutil.FromContext(ctx, r.Log).WithFields(
+		logrus.Fields{
+			"event_subscription":           req.Name,
+			"event_subscription_namespace": req.Namespace,
+		})
+
+	defer func() {
+		log.Info("EventSubscription Reconcile ended")
+	}()
+
+	log.Info("EventSubscription Reconcile started")
+
+	subscriptionCR := &aiv1beta1.EventSubscription{}
+	if err := r.Get(ctx, req.NamespacedName, subscriptionCR); err != nil {
+		log.WithError(err).Errorf("Failed to get EventSubscription %s", req.NamespacedName)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !subscriptionCR.DeletionTimestamp.IsZero() {
+		return r.deregister(ctx, log, subscriptionCR)
+	}
+
+	if !funk.ContainsString(subscriptionCR.GetFinalizers(), EventSubscriptionFinalizerName) {
+		controllerutil.AddFinalizer(subscriptionCR, EventSubscriptionFinalizerName)
+		if err := r.Update(ctx, subscriptionCR); err != nil {
+			log.WithError(err).Errorf("failed to add finalizer %s to resource %s %s", EventSubscriptionFinalizerName, subscriptionCR.Name, subscriptionCR.Namespace)
+			return ctrl.Result{}, err
+		}
+	}
+
+	subscription, syncErr := r.sync(ctx, log, subscriptionCR)
+	if subscription != nil {
+		subscriptionCR.Status.SubscriptionID = subscription.ID.String()
+	}
+	setEventSubscriptionSpecSynced(subscriptionCR, syncErr)
+	if err := r.Status().Update(ctx, subscriptionCR); err != nil {
+		log.WithError(err).Error("failed to update event subscription status")
+		return ctrl.Result{}, err
+	}
+	if syncErr != nil {
+		log.WithError(syncErr).Error("failed to sync event subscription")
+		if IsUserError(syncErr) {
+			// The referenced secret or cluster may not exist yet
+			return ctrl.Result{RequeueAfter: longerRequeueAfterOnError}, nil
+		}
+		return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// sync creates or updates the event subscription of the resource
+func (r *EventSubscriptionReconciler) sync(ctx context.Context, log logrus.FieldLogger,
+	subscriptionCR *aiv1beta1.EventSubscription) (*common.EventSubscription, error) {
+	key := types.NamespacedName{Name: subscriptionCR.Name, Namespace: subscriptionCR.Namespace}
+	subscription, err := r.EventSubscriptions.GetEventSubscriptionByKubeKey(key)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		subscription = nil
+	}
+
+	params, err := r.subscriptionParams(ctx, subscriptionCR)
+	if err != nil {
+		return subscription, err
+	}
+	if subscription == nil {
+		log.Infof("Creating event subscription for EventSubscription %s", key)
+		return r.EventSubscriptions.RegisterEventSubscriptionInternal(ctx, key, params)
+	}
+	if params.Secret == "" {
+		// The secret was removed from the spec, while the update keeps the secret when none is set
+		subscription.Secret = ""
+		subscription.Signed = false
+	}
+	return r.EventSubscriptions.UpdateEventSubscriptionInternal(ctx, subscription, params)
+}
+
+func (r *EventSubscriptionReconciler) subscriptionParams(ctx context.Context,
+	subscriptionCR *aiv1beta1.EventSubscription) (*models.EventSubscriptionCreateParams, error) {
+	params := &models.EventSubscriptionCreateParams{
+		Name:       swag.String(subscriptionCR.Name),
+		URL:        swag.String(subscriptionCR.Spec.URL),
+		Enabled:    swag.Bool(!subscriptionCR.Spec.Disabled),
+		Severities: subscriptionCR.Spec.Severities,
+		Categories: subscriptionCR.Spec.Categories,
+		EventNames: subscriptionCR.Spec.EventNames,
+	}
+
+	if subscriptionCR.Spec.SecretRef != nil {
+		secretKey := types.NamespacedName{Namespace: subscriptionCR.Namespace, Name: subscriptionCR.Spec.SecretRef.Name}
+		secret, err := getSecret(ctx, r.Client, r.APIReader, secretKey)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		if err = ensureSecretIsLabelled(ctx, r.Client, secret, secretKey); err != nil {
+			return nil, err
+		}
+		data, ok := secret.Data[aiv1beta1.EventSubscriptionSecretKey]
+		if !ok || len(data) == 0 {
+			return nil, common.NewApiError(http.StatusBadRequest,
+				errors.Errorf("secret %s has no %s key", secretKey, aiv1beta1.EventSubscriptionSecretKey))
+		}
+		params.Secret = string(data)
+	}
+
+	if subscriptionCR.Spec.ClusterRef != nil {
+		clusterKey := types.NamespacedName{Namespace: subscriptionCR.Spec.ClusterRef.Namespace, Name: subscriptionCR.Spec.ClusterRef.Name}
+		if clusterKey.Namespace == "" {
+			clusterKey.Namespace = subscriptionCR.Namespace
+		}
+		// The subscription only gets the events of its own namespace
+		if clusterKey.Namespace != subscriptionCR.Namespace {
+			return nil, common.NewApiError(http.StatusBadRequest,
+				errors.Errorf("ClusterDeployment %s is not in the namespace of the EventSubscription", clusterKey))
+		}
+		cluster, err := r.Installer.GetClusterByKubeKey(clusterKey)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, common.NewApiError(http.StatusBadRequest, errors.Errorf("cluster of ClusterDeployment %s not found", clusterKey))
+			}
+			return nil, err
+		}
+		params.ClusterID = cluster.ID
+	}
+
+	if err := params.Validate(strfmt.Default); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	return params, nil
+}
+
+func (r *EventSubscriptionReconciler) deregister(ctx context.Context, log logrus.FieldLogger,
+	subscriptionCR *aiv1beta1.EventSubscription) (ctrl.Result, error) {
+	if !funk.ContainsString(subscriptionCR.GetFinalizers(), EventSubscriptionFinalizerName) {
+		return ctrl.Result{}, nil
+	}
+	key := types.NamespacedName{Name: subscriptionCR.Name, Namespace: subscriptionCR.Namespace}
+	subscription, err := r.EventSubscriptions.GetEventSubscriptionByKubeKey(key)
+	if err == nil {
+		if err = r.EventSubscriptions.DeregisterEventSubscriptionInternal(ctx, *subscription.ID); err != nil {
+			log.WithError(err).Errorf("failed to delete event subscription %s", subscription.ID)
+			return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, nil
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.WithError(err).Errorf("failed to get event subscription of EventSubscription %s", key)
+		return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, nil
+	}
+
+	controllerutil.RemoveFinalizer(subscriptionCR, EventSubscriptionFinalizerName)
+	if err = r.Update(ctx, subscriptionCR); err != nil {
+		log.WithError(err).Errorf("failed to remove finalizer %s from resource %s %s", EventSubscriptionFinalizerName, subscriptionCR.Name, subscriptionCR.Namespace)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func setEventSubscriptionSpecSynced(subscriptionCR *aiv1beta1.EventSubscription, syncErr error) {
+	condition := conditionsv1.Condition{
+		Type:    aiv1beta1.SpecSyncedCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  aiv1beta1.SyncedOkReason,
+		Message: aiv1beta1.SyncedOkMsg,
+	}
+	if syncErr != nil {
+		condition.Status = corev1.ConditionFalse
+		if IsUserError(syncErr) {
+			condition.Reason = aiv1beta1.InputErrorReason
+			condition.Message = aiv1beta1.InputErrorMsg + " " + syncErr.Error()
+		} else {
+			condition.Reason = aiv1beta1.BackendErrorReason
+			condition.Message = aiv1beta1.BackendErrorMsg + " " + syncErr.Error()
+		}
+	}
+	conditionsv1.SetStatusConditionNoHeartbeat(&subscriptionCR.Status.Conditions, condition)
+}
+
+func (r *EventSubscriptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mapSecretToEventSubscription := func(secret client.Object) []reconcile.Request {
+		log := logutil.FromContext(context.Background(), r.Log).WithFields(
+			logrus.Fields{
+				"secret":           secret.GetName(),
+				"secret_namespace": secret.GetNamespace(),
+			})
+		subscriptions := &aiv1beta1.EventSubscriptionList{}
+		if err := r.List(context.Background(), subscriptions, client.InNamespace(secret.GetNamespace())); err != nil {
+			log.Debugf("failed to list event subscriptions")
+			return []reconcile.Request{}
+		}
+
+		reply := make([]reconcile.Request, 0, len(subscriptions.Items))
+		for _, subscription := range subscriptions.Items {
+			if subscription.Spec.SecretRef != nil && subscription.Spec.SecretRef.Name == secret.GetName() {
+				reply = append(reply, reconcile.Request{NamespacedName: types.NamespacedName{
+					Namespace: subscription.Namespace,
+					Name:      subscription.Name,
+				}})
+			}
+		}
+		return reply
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aiv1beta1.EventSubscription{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(mapSecretToEventSubscription)).
+		Complete(r)
+}
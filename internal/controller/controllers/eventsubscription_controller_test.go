@@ -0,0 +1,236 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/eventsubscriptions"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/leader"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("EventSubscription reconcile", func() {
+	var (
+		c                      client.Client
+		r                      *EventSubscriptionReconciler
+		mockCtrl               *gomock.Controller
+		mockInstallerInternal  *bminventory.MockInstallerInternals
+		mockEventSubscriptions *eventsubscriptions.MockAPI
+		ctx                    = context.Background()
+		key                    = types.NamespacedName{Name: "webhook", Namespace: "test-namespace"}
+		subscriptionID         strfmt.UUID
+	)
+
+	newEventSubscription := func(spec v1beta1.EventSubscriptionSpec) *v1beta1.EventSubscription {
+		return &v1beta1.EventSubscription{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				Finalizers: []string{EventSubscriptionFinalizerName},
+			},
+			Spec: spec,
+		}
+	}
+
+	getEventSubscription := func() *v1beta1.EventSubscription {
+		subscription := &v1beta1.EventSubscription{}
+		Expect(c.Get(ctx, key, subscription)).To(Succeed())
+		return subscription
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockInstallerInternal = bminventory.NewMockInstallerInternals(mockCtrl)
+		mockEventSubscriptions = eventsubscriptions.NewMockAPI(mockCtrl)
+		r = &EventSubscriptionReconciler{
+			Client:             c,
+			APIReader:          c,
+			Log:                common.GetTestLog(),
+			Installer:          mockInstallerInternal,
+			EventSubscriptions: mockEventSubscriptions,
+		}
+		subscriptionID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("creates a subscription with the secret and cluster of the resource", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(c.Create(ctx, newSecret("webhook-secret", key.Namespace, map[string][]byte{"secret": []byte("s3cret")}))).To(Succeed())
+		Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{
+			URL:        "https://example.com/hook",
+			SecretRef:  &corev1.LocalObjectReference{Name: "webhook-secret"},
+			Severities: []string{models.EventSeverityError},
+			ClusterRef: &v1beta1.ClusterReference{Name: "cluster"},
+		}))).To(Succeed())
+
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(types.NamespacedName{Name: "cluster", Namespace: key.Namespace}).
+			Return(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, nil)
+		mockEventSubscriptions.EXPECT().GetEventSubscriptionByKubeKey(key).Return(nil, gorm.ErrRecordNotFound)
+		mockEventSubscriptions.EXPECT().RegisterEventSubscriptionInternal(gomock.Any(), key, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ types.NamespacedName, params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+				Expect(swag.StringValue(params.URL)).To(Equal("https://example.com/hook"))
+				Expect(params.Secret).To(Equal("s3cret"))
+				Expect(params.Severities).To(Equal([]string{models.EventSeverityError}))
+				Expect(*params.ClusterID).To(Equal(clusterID))
+				Expect(swag.BoolValue(params.Enabled)).To(BeTrue())
+				return &common.EventSubscription{EventSubscription: models.EventSubscription{ID: &subscriptionID}}, nil
+			})
+
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+		subscription := getEventSubscription()
+		Expect(subscription.Status.SubscriptionID).To(Equal(subscriptionID.String()))
+		Expect(conditionsv1.FindStatusCondition(subscription.Status.Conditions, v1beta1.SpecSyncedCondition).Reason).To(Equal(v1beta1.SyncedOkReason))
+	})
+
+	It("clears the secret of the subscription when it is removed from the resource", func() {
+		Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{URL: "https://example.com/hook", Disabled: true}))).To(Succeed())
+		existing := &common.EventSubscription{EventSubscription: models.EventSubscription{ID: &subscriptionID, Signed: true}, Secret: "old"}
+		mockEventSubscriptions.EXPECT().GetEventSubscriptionByKubeKey(key).Return(existing, nil)
+		mockEventSubscriptions.EXPECT().UpdateEventSubscriptionInternal(gomock.Any(), existing, gomock.Any()).
+			DoAndReturn(func(_ context.Context, subscription *common.EventSubscription, params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+				Expect(subscription.Secret).To(BeEmpty())
+				Expect(subscription.Signed).To(BeFalse())
+				Expect(swag.BoolValue(params.Enabled)).To(BeFalse())
+				return subscription, nil
+			})
+
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("reports an input error when the secret doesn't exist", func() {
+		Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{
+			URL:       "https://example.com/hook",
+			SecretRef: &corev1.LocalObjectReference{Name: "missing"},
+		}))).To(Succeed())
+		mockEventSubscriptions.EXPECT().GetEventSubscriptionByKubeKey(key).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(longerRequeueAfterOnError))
+		condition := conditionsv1.FindStatusCondition(getEventSubscription().Status.Conditions, v1beta1.SpecSyncedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1beta1.InputErrorReason))
+	})
+
+	It("reports an input error for invalid severities", func() {
+		Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{
+			URL:        "https://example.com/hook",
+			Severities: []string{"fatal"},
+		}))).To(Succeed())
+		mockEventSubscriptions.EXPECT().GetEventSubscriptionByKubeKey(key).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		condition := conditionsv1.FindStatusCondition(getEventSubscription().Status.Conditions, v1beta1.SpecSyncedCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.InputErrorReason))
+	})
+
+	It("reports an input error for a cluster in another namespace", func() {
+		Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{
+			URL:        "https://example.com/hook",
+			ClusterRef: &v1beta1.ClusterReference{Name: "cluster", Namespace: "other-namespace"},
+		}))).To(Succeed())
+		mockEventSubscriptions.EXPECT().GetEventSubscriptionByKubeKey(key).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(longerRequeueAfterOnError))
+		condition := conditionsv1.FindStatusCondition(getEventSubscription().Status.Conditions, v1beta1.SpecSyncedCondition)
+		Expect(condition.Reason).To(Equal(v1beta1.InputErrorReason))
+		Expect(condition.Message).To(ContainSubstring("other-namespace/cluster"))
+	})
+
+	Context("with the event subscriptions manager", func() {
+		var (
+			db     *gorm.DB
+			dbName string
+		)
+
+		BeforeEach(func() {
+			db, dbName = common.PrepareTestDB()
+			authzHandler := auth.NewAuthzHandler(&auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}, nil, logrus.New(), db)
+			r.EventSubscriptions = eventsubscriptions.NewManager(eventsubscriptions.Config{}, db, common.GetTestLog(), authzHandler, &leader.DummyElector{})
+		})
+
+		AfterEach(func() {
+			common.DeleteTestDB(db, dbName)
+		})
+
+		It("reports an input error for a private webhook address", func() {
+			Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{URL: "http://169.254.169.254/latest/meta-data"}))).To(Succeed())
+
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(longerRequeueAfterOnError))
+			condition := conditionsv1.FindStatusCondition(getEventSubscription().Status.Conditions, v1beta1.SpecSyncedCondition)
+			Expect(condition.Reason).To(Equal(v1beta1.InputErrorReason))
+			_, err = r.EventSubscriptions.GetEventSubscriptionByKubeKey(key)
+			Expect(errors.Is(err, gorm.ErrRecordNotFound)).To(BeTrue())
+		})
+
+		It("restricts the subscription to the namespace of the resource", func() {
+			Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{URL: "https://example.com/hook"}))).To(Succeed())
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+			Expect(err).ToNot(HaveOccurred())
+			subscription, err := r.EventSubscriptions.GetEventSubscriptionByKubeKey(key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subscription.CreatedByAdmin).To(BeFalse())
+			Expect(subscription.KubeKeyNamespace).To(Equal(key.Namespace))
+		})
+	})
+
+	It("deletes the subscription with the resource", func() {
+		Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{URL: "https://example.com/hook"}))).To(Succeed())
+		Expect(c.Delete(ctx, getEventSubscription())).To(Succeed())
+		mockEventSubscriptions.EXPECT().GetEventSubscriptionByKubeKey(key).
+			Return(&common.EventSubscription{EventSubscription: models.EventSubscription{ID: &subscriptionID}}, nil)
+		mockEventSubscriptions.EXPECT().DeregisterEventSubscriptionInternal(gomock.Any(), subscriptionID).Return(nil)
+
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.Get(ctx, key, &v1beta1.EventSubscription{})).ToNot(Succeed())
+	})
+
+	It("retries the deletion on backend errors", func() {
+		Expect(c.Create(ctx, newEventSubscription(v1beta1.EventSubscriptionSpec{URL: "https://example.com/hook"}))).To(Succeed())
+		Expect(c.Delete(ctx, getEventSubscription())).To(Succeed())
+		mockEventSubscriptions.EXPECT().GetEventSubscriptionByKubeKey(key).
+			Return(&common.EventSubscription{EventSubscription: models.EventSubscription{ID: &subscriptionID}}, nil)
+		mockEventSubscriptions.EXPECT().DeregisterEventSubscriptionInternal(gomock.Any(), subscriptionID).
+			Return(common.NewApiError(http.StatusInternalServerError, gorm.ErrInvalidDB))
+
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(defaultRequeueAfterOnError))
+		Expect(getEventSubscription().Finalizers).To(ContainElement(EventSubscriptionFinalizerName))
+	})
+})
@@ -115,6 +115,10 @@ func (r *InfraEnvReconciler) Reconcile(origCtx context.Context, req ctrl.Request
 		}
 	} else { // infraEnv is being deleted
 		if funk.ContainsString(infraEnv.GetFinalizers(), InfraEnvFinalizerName) {
+			if isDeletionProtected(infraEnv) {
+				log.Infof("InfraEnv is deletion protected, remove the %s annotation to delete it", DeletionProtected)
+				return ctrl.Result{RequeueAfter: longerRequeueAfterOnError}, nil
+			}
 			// deletion finalizer found, deregister the backend hosts and the infraenv
 			cleanUpErr := r.deregisterInfraEnvWithHosts(ctx, log, req.NamespacedName)
 
@@ -141,6 +145,7 @@ func (r *InfraEnvReconciler) updateInfraEnv(ctx context.Context, log logrus.Fiel
 	updateParams := installer.UpdateInfraEnvParams{
 		InfraEnvID:           *internalInfraEnv.ID,
 		InfraEnvUpdateParams: &models.InfraEnvUpdateParams{},
+		IfMatch:              swag.String(common.ETag(internalInfraEnv.ResourceVersion)),
 	}
 	if infraEnv.Spec.Proxy != nil {
 		proxy := &models.Proxy{}
@@ -164,6 +169,9 @@ func (r *InfraEnvReconciler) updateInfraEnv(ctx context.Context, log logrus.Fiel
 	if infraEnv.Spec.SSHAuthorizedKey != internalInfraEnv.SSHAuthorizedKey {
 		updateParams.InfraEnvUpdateParams.SSHAuthorizedKey = &infraEnv.Spec.SSHAuthorizedKey
 	}
+	if deletionProtected := isDeletionProtected(infraEnv); deletionProtected != swag.BoolValue(internalInfraEnv.DeletionProtected) {
+		updateParams.InfraEnvUpdateParams.DeletionProtected = swag.Bool(deletionProtected)
+	}
 
 	pullSecret, err := r.PullSecretHandler.GetValidPullSecret(ctx, getPullSecretKey(infraEnv.Namespace, infraEnv.Spec.PullSecretRef))
 	if err != nil {
@@ -355,6 +363,7 @@ func CreateInfraEnvParams(infraEnv *aiv1beta1.InfraEnv, imageType models.ImageTy
 			CPUArchitecture:        infraEnv.Spec.CpuArchitecture,
 			ClusterID:              clusterID,
 			OpenshiftVersion:       openshiftVersion,
+			DeletionProtected:      swag.Bool(isDeletionProtected(infraEnv)),
 		},
 	}
 	if infraEnv.Spec.Proxy != nil {
@@ -440,6 +449,17 @@ func (r *InfraEnvReconciler) deregisterInfraEnvWithHosts(ctx context.Context, lo
 	if err != nil {
 		return err
 	}
+
+	// the deletion protection annotation was removed after the deletion was requested
+	if swag.BoolValue(infraEnv.DeletionProtected) {
+		log.Infof("Removing the deletion protection of infraEnv %s", *infraEnv.ID)
+		if _, err = r.Installer.UpdateInfraEnvInternal(ctx, installer.UpdateInfraEnvParams{
+			InfraEnvID:           *infraEnv.ID,
+			InfraEnvUpdateParams: &models.InfraEnvUpdateParams{DeletionProtected: swag.Bool(false)},
+		}, nil); err != nil {
+			return err
+		}
+	}
 	allowedStatuses := []string{
 		models.HostStatusInsufficientUnbound,
 		models.HostStatusDisconnectedUnbound,
@@ -0,0 +1,251 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type KubeEventsConfig struct {
+	Enabled bool `envconfig:"KUBE_EVENTS_ENABLED" default:"true"`
+	// DedupWindow is the time in which an event that was already recorded for a resource is not recorded again
+	DedupWindow time.Duration `envconfig:"KUBE_EVENTS_DEDUP_WINDOW" default:"10m"`
+	// Burst events can be recorded for a resource at once, after which one event is recorded every Interval
+	Burst    int           `envconfig:"KUBE_EVENTS_BURST" default:"10"`
+	Interval time.Duration `envconfig:"KUBE_EVENTS_INTERVAL" default:"30s"`
+}
+
+// mirroredInfoEvents are the info events that are mirrored as Kubernetes events, in addition to all the warning,
+// error and critical events
+var mirroredInfoEvents = map[string]bool{
+	eventgen.ClusterPrepareInstallationStartedEventName: true,
+	eventgen.ClusterStatusUpdatedEventName:              true,
+	eventgen.ClusterInstallationCompletedEventName:      true,
+	eventgen.ClusterInstallationCanceledEventName:       true,
+	eventgen.ClusterInstallationResetEventName:          true,
+	eventgen.ClusterValidationFixedEventName:            true,
+	eventgen.ScheduledInstallStartedEventName:           true,
+	eventgen.HostRegistrationSucceededEventName:         true,
+	eventgen.HostApprovedUpdatedEventName:               true,
+	eventgen.HostStatusUpdatedEventName:                 true,
+	eventgen.HostInstallationStartedEventName:           true,
+	eventgen.HostValidationFixedEventName:               true,
+	eventgen.ImageInfoUpdatedEventName:                  true,
+}
+
+// KubeEvents records the events of the service as Kubernetes events of the resources of the kube-api, so they are
+// shown by `kubectl describe`: the events of the hosts on their Agents, of the infra-envs on their InfraEnvs, and of
+// the clusters on their AgentClusterInstalls.
+// The events are rate limited and deduplicated per resource, as the service may repeat the same events often, for
+// example when a validation flaps.
+type KubeEvents struct {
+	client   client.Reader
+	recorder record.EventRecorder
+	db       *gorm.DB
+	log      logrus.FieldLogger
+	config   KubeEventsConfig
+
+	mu        sync.Mutex
+	objects   map[string]*objectEvents
+	lastPrune time.Time
+}
+
+// objectEvents are the events recently recorded for a resource
+type objectEvents struct {
+	limiter      *rate.Limiter
+	recorded     map[string]time.Time
+	lastRecorded time.Time
+}
+
+var _ record.EventRecorder = &KubeEvents{}
+
+func NewKubeEvents(config KubeEventsConfig, client client.Reader, recorder record.EventRecorder, db *gorm.DB, log logrus.FieldLogger) *KubeEvents {
+	return &KubeEvents{
+		client:   client,
+		recorder: recorder,
+		db:       db,
+		log:      log,
+		config:   config,
+		objects:  make(map[string]*objectEvents),
+	}
+}
+
+func (k *KubeEvents) Event(object runtime.Object, eventType, reason, message string) {
+	if k.allow(object, eventType, reason, message) {
+		k.recorder.Event(object, eventType, reason, message)
+	}
+}
+
+func (k *KubeEvents) Eventf(object runtime.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	k.Event(object, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (k *KubeEvents) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventType, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if k.allow(object, eventType, reason, message) {
+		k.recorder.AnnotatedEventf(object, annotations, eventType, reason, "%s", message)
+	}
+}
+
+// allow returns whether the event should be recorded for the resource: it wasn't recorded within the dedup window,
+// and the rate limit of the resource wasn't reached
+func (k *KubeEvents) allow(object runtime.Object, eventType, reason, message string) bool {
+	if !k.config.Enabled {
+		return false
+	}
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		k.log.WithError(err).Warn("failed to get the resource of a Kubernetes event")
+		return false
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	now := time.Now()
+	k.prune(now)
+	objectKey := fmt.Sprintf("%T/%s/%s/%s", object, accessor.GetNamespace(), accessor.GetName(), accessor.GetUID())
+	events, ok := k.objects[objectKey]
+	if !ok {
+		events = &objectEvents{
+			limiter:  rate.NewLimiter(rate.Every(k.config.Interval), k.config.Burst),
+			recorded: make(map[string]time.Time),
+		}
+		k.objects[objectKey] = events
+	}
+	key := strings.Join([]string{eventType, reason, message}, "/")
+	if recordedAt, ok := events.recorded[key]; ok && now.Sub(recordedAt) < k.config.DedupWindow {
+		return false
+	}
+	if !events.limiter.AllowN(now, 1) {
+		k.log.Debugf("Dropping event %s of %s/%s, too many events were recorded for it", reason,
+			accessor.GetNamespace(), accessor.GetName())
+		return false
+	}
+	events.recorded[key] = now
+	events.lastRecorded = now
+	return true
+}
+
+// prune forgets the events that left the dedup window, and the resources whose rate limit was replenished since
+// their last event
+func (k *KubeEvents) prune(now time.Time) {
+	if now.Sub(k.lastPrune) < k.config.DedupWindow {
+		return
+	}
+	k.lastPrune = now
+	replenished := k.config.Interval * time.Duration(k.config.Burst)
+	for objectKey, events := range k.objects {
+		for key, recordedAt := range events.recorded {
+			if now.Sub(recordedAt) >= k.config.DedupWindow {
+				delete(events.recorded, key)
+			}
+		}
+		if len(events.recorded) == 0 && now.Sub(events.lastRecorded) >= replenished {
+			delete(k.objects, objectKey)
+		}
+	}
+}
+
+func isMirroredEvent(name, severity string) bool {
+	return severity != models.EventSeverityInfo || mirroredInfoEvents[name]
+}
+
+func kubeEventType(severity string) string {
+	if severity == models.EventSeverityInfo {
+		return corev1.EventTypeNormal
+	}
+	return corev1.EventTypeWarning
+}
+
+// kubeEventReason returns the reason of the Kubernetes event of a service event, in the CamelCase of the reasons of
+// Kubernetes events, such as HostValidationFailed for host_validation_failed
+func kubeEventReason(name string) string {
+	var reason strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if word != "" {
+			reason.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return reason.String()
+}
+
+// MirrorHostEvent records the event of a host on its Agent
+func (k *KubeEvents) MirrorHostEvent(ctx context.Context, infraEnvID, hostID strfmt.UUID, name, severity, message string) {
+	if !k.config.Enabled || !isMirroredEvent(name, severity) || infraEnvID == "" || hostID == "" {
+		return
+	}
+	host, err := common.GetHostFromDB(k.db, infraEnvID.String(), hostID.String())
+	if err != nil || host.KubeKeyNamespace == "" {
+		return
+	}
+	agent := &aiv1beta1.Agent{}
+	if err = k.client.Get(ctx, types.NamespacedName{Name: hostID.String(), Namespace: host.KubeKeyNamespace}, agent); err != nil {
+		k.log.WithError(err).Debugf("failed to get Agent %s/%s to record event %s", host.KubeKeyNamespace, hostID, name)
+		return
+	}
+	k.Event(agent, kubeEventType(severity), kubeEventReason(name), message)
+}
+
+// MirrorInfraEnvEvent records the event of an infra-env on its InfraEnv
+func (k *KubeEvents) MirrorInfraEnvEvent(ctx context.Context, infraEnvID strfmt.UUID, name, severity, message string) {
+	if !k.config.Enabled || !isMirroredEvent(name, severity) || infraEnvID == "" {
+		return
+	}
+	ie, err := common.GetInfraEnvFromDB(k.db, infraEnvID)
+	if err != nil || ie.KubeKeyNamespace == "" {
+		return
+	}
+	infraEnv := &aiv1beta1.InfraEnv{}
+	if err = k.client.Get(ctx, types.NamespacedName{Name: swag.StringValue(ie.Name), Namespace: ie.KubeKeyNamespace}, infraEnv); err != nil {
+		k.log.WithError(err).Debugf("failed to get InfraEnv %s/%s to record event %s", ie.KubeKeyNamespace, swag.StringValue(ie.Name), name)
+		return
+	}
+	k.Event(infraEnv, kubeEventType(severity), kubeEventReason(name), message)
+}
+
+// MirrorClusterEvent records the event of a cluster on the AgentClusterInstall of its ClusterDeployment
+func (k *KubeEvents) MirrorClusterEvent(ctx context.Context, clusterID strfmt.UUID, name, severity, message string) {
+	if !k.config.Enabled || !isMirroredEvent(name, severity) || clusterID == "" {
+		return
+	}
+	cluster, err := common.GetClusterFromDB(k.db, clusterID, common.SkipEagerLoading)
+	if err != nil || cluster.KubeKeyNamespace == "" {
+		return
+	}
+	clusterDeployment := &hivev1.ClusterDeployment{}
+	cdKey := types.NamespacedName{Name: cluster.KubeKeyName, Namespace: cluster.KubeKeyNamespace}
+	if err = k.client.Get(ctx, cdKey, clusterDeployment); err != nil {
+		k.log.WithError(err).Debugf("failed to get ClusterDeployment %s to record event %s", cdKey, name)
+		return
+	}
+	if clusterDeployment.Spec.ClusterInstallRef == nil {
+		return
+	}
+	clusterInstall := &hiveext.AgentClusterInstall{}
+	aciKey := types.NamespacedName{Name: clusterDeployment.Spec.ClusterInstallRef.Name, Namespace: cluster.KubeKeyNamespace}
+	if err = k.client.Get(ctx, aciKey, clusterInstall); err != nil {
+		k.log.WithError(err).Debugf("failed to get AgentClusterInstall %s to record event %s", aciKey, name)
+		return
+	}
+	k.Event(clusterInstall, kubeEventType(severity), kubeEventReason(name), message)
+}
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Kubernetes events", func() {
+	var (
+		ctx        = context.Background()
+		c          client.Client
+		recorder   *record.FakeRecorder
+		kubeEvents *KubeEvents
+		config     KubeEventsConfig
+		db         *gorm.DB
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		recorder = record.NewFakeRecorder(10)
+		config = KubeEventsConfig{Enabled: true, DedupWindow: time.Minute, Burst: 3, Interval: time.Hour}
+		kubeEvents = NewKubeEvents(config, c, recorder, db, common.GetTestLog())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("rate limiting and deduplication", func() {
+		var agent *v1beta1.Agent
+
+		BeforeEach(func() {
+			agent = newAgent("agent", testNamespace, v1beta1.AgentSpec{})
+		})
+
+		It("records an event that was already recorded within the dedup window once", func() {
+			kubeEvents.Event(agent, "Warning", "HostValidationFailed", "the message")
+			kubeEvents.Event(agent, "Warning", "HostValidationFailed", "the message")
+			kubeEvents.Event(agent, "Warning", "HostValidationFailed", "another message")
+			Expect(recorder.Events).To(HaveLen(2))
+		})
+
+		It("records an event again once it left the dedup window", func() {
+			kubeEvents.config.DedupWindow = 0
+			kubeEvents.Event(agent, "Warning", "HostValidationFailed", "the message")
+			kubeEvents.Event(agent, "Warning", "HostValidationFailed", "the message")
+			Expect(recorder.Events).To(HaveLen(2))
+		})
+
+		It("drops the events of a resource above the rate limit", func() {
+			for _, message := range []string{"first", "second", "third", "fourth"} {
+				kubeEvents.Eventf(agent, "Normal", "HostStatusUpdated", "%s message", message)
+			}
+			Expect(recorder.Events).To(HaveLen(3))
+
+			otherAgent := newAgent("other-agent", testNamespace, v1beta1.AgentSpec{})
+			kubeEvents.Event(otherAgent, "Normal", "HostStatusUpdated", "fourth message")
+			Expect(recorder.Events).To(HaveLen(4))
+		})
+
+		It("doesn't record events when disabled", func() {
+			kubeEvents.config.Enabled = false
+			kubeEvents.Event(agent, "Warning", "HostValidationFailed", "the message")
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("mirroring the service events", func() {
+		var (
+			clusterID  strfmt.UUID
+			infraEnvID strfmt.UUID
+			hostID     strfmt.UUID
+		)
+
+		BeforeEach(func() {
+			clusterID = strfmt.UUID(uuid.New().String())
+			infraEnvID = strfmt.UUID(uuid.New().String())
+			hostID = strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{
+				Cluster:          models.Cluster{ID: &clusterID},
+				KubeKeyName:      "test-cluster",
+				KubeKeyNamespace: testNamespace,
+			}).Error).ToNot(HaveOccurred())
+			Expect(db.Create(&common.InfraEnv{
+				InfraEnv:         models.InfraEnv{ID: &infraEnvID, Name: swag.String("test-infraenv")},
+				KubeKeyNamespace: testNamespace,
+			}).Error).ToNot(HaveOccurred())
+			Expect(db.Create(&common.Host{
+				Host:             models.Host{ID: &hostID, InfraEnvID: infraEnvID, ClusterID: &clusterID},
+				KubeKeyNamespace: testNamespace,
+			}).Error).ToNot(HaveOccurred())
+
+			clusterDeployment := newClusterDeployment("test-cluster", testNamespace,
+				getDefaultClusterDeploymentSpec("test-cluster", "test-cluster-aci", "pull-secret"))
+			Expect(c.Create(ctx, clusterDeployment)).To(Succeed())
+			Expect(c.Create(ctx, newAgentClusterInstall("test-cluster-aci", testNamespace,
+				getDefaultAgentClusterInstallSpec("test-cluster"), clusterDeployment))).To(Succeed())
+			Expect(c.Create(ctx, newInfraEnvImage("test-infraenv", testNamespace, v1beta1.InfraEnvSpec{}))).To(Succeed())
+			Expect(c.Create(ctx, newAgent(hostID.String(), testNamespace, v1beta1.AgentSpec{}))).To(Succeed())
+		})
+
+		It("records the warning events of a host on its Agent", func() {
+			kubeEvents.MirrorHostEvent(ctx, infraEnvID, hostID, eventgen.HostValidationFailedEventName,
+				models.EventSeverityWarning, "Host validation failed")
+			Expect(recorder.Events).To(Receive(Equal("Warning HostValidationFailed Host validation failed")))
+		})
+
+		It("records the selected info events of a host", func() {
+			kubeEvents.MirrorHostEvent(ctx, infraEnvID, hostID, eventgen.HostInstallationStartedEventName,
+				models.EventSeverityInfo, "Host installation started")
+			Expect(recorder.Events).To(Receive(Equal("Normal HostInstallationStarted Host installation started")))
+		})
+
+		It("doesn't record the other info events", func() {
+			kubeEvents.MirrorHostEvent(ctx, infraEnvID, hostID, eventgen.HostRoleUpdatedEventName,
+				models.EventSeverityInfo, "Host role updated")
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("records the events of a cluster on its AgentClusterInstall", func() {
+			kubeEvents.MirrorClusterEvent(ctx, clusterID, eventgen.ClusterInstallationFailedEventName,
+				models.EventSeverityCritical, "Failed installing cluster")
+			Expect(recorder.Events).To(Receive(Equal("Warning ClusterInstallationFailed Failed installing cluster")))
+		})
+
+		It("records the events of an infra-env on its InfraEnv", func() {
+			kubeEvents.MirrorInfraEnvEvent(ctx, infraEnvID, eventgen.GenerateImageFetchFailedEventName,
+				models.EventSeverityError, "Failed to generate image")
+			Expect(recorder.Events).To(Receive(Equal("Warning GenerateImageFetchFailed Failed to generate image")))
+		})
+
+		It("doesn't record the events of resources that are not in the kube-api", func() {
+			Expect(c.Delete(ctx, newAgent(hostID.String(), testNamespace, v1beta1.AgentSpec{}))).To(Succeed())
+			kubeEvents.MirrorHostEvent(ctx, infraEnvID, hostID, eventgen.HostValidationFailedEventName,
+				models.EventSeverityWarning, "Host validation failed")
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	It("converts the names of the service events to reasons", func() {
+		Expect(kubeEventReason(eventgen.HostValidationFailedEventName)).To(Equal("HostValidationFailed"))
+		Expect(kubeEventReason(eventgen.ClusterDegradedOLMOperatorsFailedEventName)).To(Equal("ClusterDegradedOLMOperatorsFailed"))
+	})
+})
@@ -40,6 +40,47 @@ type Sender interface {
 type Handler interface {
 	Sender
 	V2GetEvents(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, categories ...string) ([]*common.Event, error)
+	// V2GetFilteredEvents returns a page of the events that match the filters of params, together with the number
+	// of events that match the filters on all the pages
+	V2GetFilteredEvents(ctx context.Context, params *V2GetEventsParams) (*V2GetEventsResponse, error)
+}
+
+const (
+	OrderAscending  = "ascending"
+	OrderDescending = "descending"
+)
+
+// V2GetEventsParams are the filters, order and page of the events returned by V2GetFilteredEvents. Unset filters
+// match all the events, except for Categories that default to DefaultEventCategories.
+type V2GetEventsParams struct {
+	ClusterID  *strfmt.UUID
+	HostID     *strfmt.UUID
+	InfraEnvID *strfmt.UUID
+	Categories []string
+	Severities []string
+	Names      []string
+	// Message matches the events whose message contains it, ignoring case
+	Message *string
+	// StartTime and EndTime match the events that occurred at or after StartTime, and before EndTime
+	StartTime *strfmt.DateTime
+	EndTime   *strfmt.DateTime
+	// AfterEventID matches the events saved after the event with this ID. When set, the events are ordered by their
+	// ID rather than by their time, so they can be followed with the ID of the last returned event
+	AfterEventID *int64
+	// CreatedBefore matches the events that were saved before this time
+	CreatedBefore *time.Time
+	// Order is either OrderAscending, the default, or OrderDescending
+	Order  string
+	Limit  *int64
+	Offset *int64
+	// SkipCount skips counting the events that match the filters on all the pages, EventCount is then 0
+	SkipCount bool
+}
+
+type V2GetEventsResponse struct {
+	Events []*common.Event
+	// EventCount is the number of events that match the filters, regardless of the limit and offset
+	EventCount int64
 }
 
 var DefaultEventCategories = []string{
@@ -384,6 +384,21 @@ func (mr *MockHandlerMockRecorder) V2GetEvents(ctx, clusterID, hostID, infraEnvI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetEvents", reflect.TypeOf((*MockHandler)(nil).V2GetEvents), varargs...)
 }
 
+// V2GetFilteredEvents mocks base method.
+func (m *MockHandler) V2GetFilteredEvents(ctx context.Context, params *V2GetEventsParams) (*V2GetEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetFilteredEvents", ctx, params)
+	ret0, _ := ret[0].(*V2GetEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// V2GetFilteredEvents indicates an expected call of V2GetFilteredEvents.
+func (mr *MockHandlerMockRecorder) V2GetFilteredEvents(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetFilteredEvents", reflect.TypeOf((*MockHandler)(nil).V2GetFilteredEvents), ctx, params)
+}
+
 // MockBaseEvent is a mock of BaseEvent interface.
 type MockBaseEvent struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,425 @@
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// RetentionConfig defines which events are pruned from the database. Error and critical events are only pruned by
+// ErrorMaxAge, so they are kept longer than the events of the other severities.
+type RetentionConfig struct {
+	RetentionInterval time.Duration `envconfig:"EVENTS_RETENTION_INTERVAL" default:"1h"`
+	// MaxAge is the age of the info and warning events that are pruned, 0 keeps them
+	MaxAge time.Duration `envconfig:"EVENTS_MAX_AGE" default:"0s"`
+	// ErrorMaxAge is the age of the error and critical events that are pruned, 0 keeps them
+	ErrorMaxAge time.Duration `envconfig:"EVENTS_ERROR_MAX_AGE" default:"0s"`
+	// MaxEventsPerCluster is the number of info and warning events kept for each cluster, 0 keeps all of them
+	MaxEventsPerCluster int  `envconfig:"EVENTS_MAX_PER_CLUSTER" default:"0"`
+	ArchiveEnabled      bool `envconfig:"EVENTS_ARCHIVE_ENABLED" default:"true"`
+	MaxPrunedEvents     int  `envconfig:"EVENTS_MAX_PRUNED_PER_INTERVAL" default:"10000"`
+}
+
+const (
+	archiveFolder    = "events"
+	archiveExtension = ".ndjson.gz"
+	// archiveTimeFormat is the format of the times of the first and the last events in the names of the archives,
+	// which sorts them by time
+	archiveTimeFormat = "20060102T150405.000000000Z"
+)
+
+var (
+	prunedSeverities      = []string{models.EventSeverityInfo, models.EventSeverityWarning}
+	prunedErrorSeverities = []string{models.EventSeverityError, models.EventSeverityCritical}
+)
+
+// archivedEvent is a line of an archive, holding the ID of the event along with its fields
+type archivedEvent struct {
+	ID uint `json:"id"`
+	models.Event
+}
+
+// Archiver prunes the events according to the retention configuration, and archives the pruned events as gzip
+// compressed NDJSON objects in the folder of their cluster, or of their infra-env for events of unbound hosts
+type Archiver struct {
+	RetentionConfig
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	authz         auth.Authorizer
+	objectHandler s3wrapper.API
+	leaderElector leader.Leader
+}
+
+func NewArchiver(cfg RetentionConfig, db *gorm.DB, log logrus.FieldLogger, authz auth.Authorizer, objectHandler s3wrapper.API,
+	leaderElector leader.Leader) *Archiver {
+	return &Archiver{
+		RetentionConfig: cfg,
+		db:              db,
+		log:             log,
+		authz:           authz,
+		objectHandler:   objectHandler,
+		leaderElector:   leaderElector,
+	}
+}
+
+func (a *Archiver) PruneEvents() {
+	if !a.leaderElector.IsLeader() {
+		return
+	}
+	ids, err := a.prunedEventIDs()
+	if err != nil {
+		a.log.WithError(err).Error("Failed to select the events to prune")
+		return
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	var events []*common.Event
+	if err = a.db.Unscoped().Where("id IN (?)", ids).Order("id").Find(&events).Error; err != nil {
+		a.log.WithError(err).Error("Failed to get the events to prune")
+		return
+	}
+	groups := make(map[string][]*common.Event)
+	for _, event := range events {
+		folder := archiveOwner(&event.Event)
+		groups[folder] = append(groups[folder], event)
+	}
+	for owner, group := range groups {
+		if err = a.pruneGroup(owner, group); err != nil {
+			a.log.WithError(err).Errorf("Failed to prune %d events of %s", len(group), owner)
+		}
+	}
+}
+
+// prunedEventIDs returns the IDs of the events that are older than the maximal age of their severity, or that
+// exceed the number of events kept for their cluster
+func (a *Archiver) prunedEventIDs() ([]uint, error) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	if a.MaxAge > 0 {
+		conditions = append(conditions, "(severity IN (?) AND event_time < ?)")
+		args = append(args, prunedSeverities, time.Now().Add(-a.MaxAge))
+	}
+	if a.ErrorMaxAge > 0 {
+		conditions = append(conditions, "(severity IN (?) AND event_time < ?)")
+		args = append(args, prunedErrorSeverities, time.Now().Add(-a.ErrorMaxAge))
+	}
+	if a.MaxEventsPerCluster > 0 {
+		// Only the events of the clusters that exceed the limit are ranked
+		exceeding := a.db.Unscoped().Model(&common.Event{}).Select("cluster_id").
+			Where("cluster_id IS NOT NULL AND severity IN (?)", prunedSeverities).
+			Group("cluster_id").Having("count(*) > ?", a.MaxEventsPerCluster)
+		ranked := a.db.Unscoped().Model(&common.Event{}).
+			Select("id, ROW_NUMBER() OVER (PARTITION BY cluster_id ORDER BY event_time DESC, id DESC) AS position").
+			Where("cluster_id IN (?) AND severity IN (?)", exceeding, prunedSeverities)
+		conditions = append(conditions, "id IN (?)")
+		args = append(args, a.db.Table("(?) AS ranked", ranked).Select("id").Where("position > ?", a.MaxEventsPerCluster))
+	}
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+
+	var ids []uint
+	err := a.db.Unscoped().Model(&common.Event{}).Where(strings.Join(conditions, " OR "), args...).
+		Order("id").Limit(a.MaxPrunedEvents).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// pruneGroup archives the events of a single owner before deleting them, so events are never lost when the
+// archive fails
+func (a *Archiver) pruneGroup(owner string, events []*common.Event) error {
+	if a.ArchiveEnabled && owner != "" {
+		data, err := compressEvents(events)
+		if err != nil {
+			return err
+		}
+		objectName := archiveObjectName(owner, events)
+		if err = a.objectHandler.Upload(context.Background(), data, objectName); err != nil {
+			return errors.Wrapf(err, "failed to upload events archive %s", objectName)
+		}
+	}
+	ids := make([]uint, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	if err := a.db.Unscoped().Where("id IN (?)", ids).Delete(&common.Event{}).Error; err != nil {
+		return err
+	}
+	if owner == "" {
+		owner = "no cluster or infra-env"
+	}
+	a.log.Infof("Pruned %d events of %s", len(events), owner)
+	return nil
+}
+
+// archiveObjectName returns the name of the archive of the events of the owner: the times of its earliest and latest
+// events, so the archives can be filtered by time without downloading them, followed by the IDs of its first and
+// last events, e.g. <cluster_id>/events/<from>_<to>_<first_id>-<last_id>.ndjson.gz
+func archiveObjectName(owner string, events []*common.Event) string {
+	ids := fmt.Sprintf("%d-%d", events[0].ID, events[len(events)-1].ID)
+	var from, to time.Time
+	for _, event := range events {
+		if event.EventTime == nil {
+			continue
+		}
+		eventTime := time.Time(*event.EventTime).UTC()
+		if from.IsZero() || eventTime.Before(from) {
+			from = eventTime
+		}
+		if to.IsZero() || eventTime.After(to) {
+			to = eventTime
+		}
+	}
+	if from.IsZero() {
+		return fmt.Sprintf("%s/%s/%s%s", owner, archiveFolder, ids, archiveExtension)
+	}
+	return fmt.Sprintf("%s/%s/%s_%s_%s%s", owner, archiveFolder, from.Format(archiveTimeFormat), to.Format(archiveTimeFormat),
+		ids, archiveExtension)
+}
+
+// archiveTimeRange returns the times of the earliest and the latest events of the archive from its name. Archives
+// without times in their name may hold events of any time.
+func archiveTimeRange(objectName string) (time.Time, time.Time, bool) {
+	parts := strings.Split(strings.TrimSuffix(objectName[strings.LastIndex(objectName, "/")+1:], archiveExtension), "_")
+	if len(parts) != 3 {
+		return time.Time{}, time.Time{}, false
+	}
+	from, err := time.Parse(archiveTimeFormat, parts[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	to, err := time.Parse(archiveTimeFormat, parts[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+// archiveInTimeRange returns whether the archive may hold events within the time filters of params
+func archiveInTimeRange(objectName string, params *eventsapi.V2GetEventsParams) bool {
+	if params.StartTime == nil && params.EndTime == nil {
+		return true
+	}
+	from, to, ok := archiveTimeRange(objectName)
+	if !ok {
+		return true
+	}
+	if params.StartTime != nil && to.Before(time.Time(*params.StartTime)) {
+		return false
+	}
+	if params.EndTime != nil && !from.Before(time.Time(*params.EndTime)) {
+		return false
+	}
+	return true
+}
+
+// archiveOwner returns the ID of the cluster, or the infra-env, whose folder holds the archive of the event
+func archiveOwner(event *models.Event) string {
+	if event.ClusterID != nil {
+		return event.ClusterID.String()
+	}
+	if event.InfraEnvID != nil {
+		return event.InfraEnvID.String()
+	}
+	return ""
+}
+
+func compressEvents(events []*common.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(writer)
+	for _, event := range events {
+		if err := encoder.Encode(&archivedEvent{ID: event.ID, Event: event.Event}); err != nil {
+			return nil, errors.Wrapf(err, "failed to encode event %d", event.ID)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// V2GetArchivedEvents returns the archived events of the cluster, or of the infra-env, of params that match its
+// filters, with the same order and pagination as V2GetFilteredEvents
+func (a *Archiver) V2GetArchivedEvents(ctx context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
+	if !a.ArchiveEnabled {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("Events archival is not enabled"))
+	}
+	var owner string
+	var err error
+	switch {
+	case params.ClusterID != nil:
+		owner = params.ClusterID.String()
+		err = a.checkAccess(ctx, &common.Cluster{}, owner)
+	case params.InfraEnvID != nil:
+		owner = params.InfraEnvID.String()
+		err = a.checkAccess(ctx, &common.InfraEnv{}, owner)
+	default:
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("Archived events require either a cluster or an infra-env"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	objectNames, err := a.objectHandler.ListObjectsByPrefix(ctx, fmt.Sprintf("%s/%s/", owner, archiveFolder))
+	if err != nil {
+		return nil, err
+	}
+	categories := params.Categories
+	if len(categories) == 0 {
+		categories = eventsapi.DefaultEventCategories
+	}
+	events := make([]*common.Event, 0)
+	for _, objectName := range objectNames {
+		if !strings.HasSuffix(objectName, archiveExtension) || !archiveInTimeRange(objectName, params) {
+			continue
+		}
+		archived, err := a.readArchive(ctx, objectName)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range archived {
+			if matchesArchivedEvent(&event.Event, params, categories) {
+				events = append(events, &common.Event{Model: gorm.Model{ID: event.ID}, Event: event.Event})
+			}
+		}
+	}
+	return paginateArchivedEvents(events, params), nil
+}
+
+// checkAccess returns a not found error when the resource does not exist, or the user is not allowed to access it
+func (a *Archiver) checkAccess(ctx context.Context, model interface{}, id string) error {
+	db := a.db
+	if a.authz != nil {
+		db = a.authz.OwnedBy(ctx, db)
+	}
+	var count int64
+	if err := db.Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("%s was not found", id))
+	}
+	return nil
+}
+
+func (a *Archiver) readArchive(ctx context.Context, objectName string) ([]*archivedEvent, error) {
+	reader, _, err := a.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download events archive %s", objectName)
+	}
+	defer reader.Close()
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read events archive %s", objectName)
+	}
+	defer gzipReader.Close()
+
+	events := make([]*archivedEvent, 0)
+	scanner := bufio.NewScanner(gzipReader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		event := &archivedEvent{}
+		if err = json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode events archive %s", objectName)
+		}
+		events = append(events, event)
+	}
+	return events, errors.Wrapf(scanner.Err(), "failed to read events archive %s", objectName)
+}
+
+func matchesArchivedEvent(event *models.Event, params *eventsapi.V2GetEventsParams, categories []string) bool {
+	if params.ClusterID != nil && (event.ClusterID == nil || *event.ClusterID != *params.ClusterID) {
+		return false
+	}
+	if params.InfraEnvID != nil && (event.InfraEnvID == nil || *event.InfraEnvID != *params.InfraEnvID) {
+		return false
+	}
+	if params.HostID != nil && (event.HostID == nil || *event.HostID != *params.HostID) {
+		return false
+	}
+	if !contains(categories, event.Category) {
+		return false
+	}
+	if len(params.Severities) > 0 && !contains(params.Severities, swag.StringValue(event.Severity)) {
+		return false
+	}
+	if len(params.Names) > 0 && !contains(params.Names, event.Name) {
+		return false
+	}
+	if params.Message != nil && !strings.Contains(strings.ToLower(swag.StringValue(event.Message)), strings.ToLower(*params.Message)) {
+		return false
+	}
+	if event.EventTime == nil {
+		return params.StartTime == nil && params.EndTime == nil
+	}
+	eventTime := time.Time(*event.EventTime)
+	if params.StartTime != nil && eventTime.Before(time.Time(*params.StartTime)) {
+		return false
+	}
+	if params.EndTime != nil && !eventTime.Before(time.Time(*params.EndTime)) {
+		return false
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func paginateArchivedEvents(events []*common.Event, params *eventsapi.V2GetEventsParams) *eventsapi.V2GetEventsResponse {
+	eventTime := func(event *common.Event) time.Time {
+		if event.EventTime == nil {
+			return time.Time{}
+		}
+		return time.Time(*event.EventTime)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		first, second := events[i], events[j]
+		if params.Order == eventsapi.OrderDescending {
+			first, second = second, first
+		}
+		if !eventTime(first).Equal(eventTime(second)) {
+			return eventTime(first).Before(eventTime(second))
+		}
+		return first.ID < second.ID
+	})
+
+	response := &eventsapi.V2GetEventsResponse{EventCount: int64(len(events))}
+	if params.Offset != nil {
+		if *params.Offset >= int64(len(events)) {
+			events = events[:0]
+		} else {
+			events = events[*params.Offset:]
+		}
+	}
+	if params.Limit != nil && *params.Limit < int64(len(events)) {
+		events = events[:*params.Limit]
+	}
+	response.Events = events
+	return response
+}
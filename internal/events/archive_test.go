@@ -0,0 +1,239 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("events retention", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		ctrl       *gomock.Controller
+		mockS3     *s3wrapper.MockAPI
+		archiver   *Archiver
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		archives   map[string][]byte
+		downloads  int
+	)
+
+	archiveName := func(owner strfmt.UUID, first, last *common.Event) types.GomegaMatcher {
+		timePattern := `\d{8}T\d{6}\.\d{9}Z`
+		return MatchRegexp(`^%s/events/%s_%s_%d-%d\.ndjson\.gz$`, owner, timePattern, timePattern, first.ID, last.ID)
+	}
+
+	createEvent := func(severity string, clusterID, infraEnvID *strfmt.UUID, age time.Duration) *common.Event {
+		eventTime := strfmt.DateTime(time.Now().Add(-age))
+		event := &common.Event{Event: models.Event{
+			Name:       "event",
+			Severity:   swag.String(severity),
+			Category:   models.EventCategoryUser,
+			ClusterID:  clusterID,
+			InfraEnvID: infraEnvID,
+			EventTime:  &eventTime,
+			Message:    swag.String(severity),
+		}}
+		Expect(db.Create(event).Error).ToNot(HaveOccurred())
+		return event
+	}
+
+	remainingEventIDs := func() []uint {
+		var ids []uint
+		Expect(db.Unscoped().Model(&common.Event{}).Order("id").Pluck("id", &ids).Error).ToNot(HaveOccurred())
+		return ids
+	}
+
+	expectUploads := func() {
+		mockS3.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, data []byte, objectName string) error {
+				archives[objectName] = data
+				return nil
+			}).AnyTimes()
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockS3 = s3wrapper.NewMockAPI(ctrl)
+		archiver = NewArchiver(RetentionConfig{ArchiveEnabled: true, MaxPrunedEvents: 100}, db, logrus.New(), nil, mockS3,
+			&leader.DummyElector{})
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ToNot(HaveOccurred())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID}}).Error).ToNot(HaveOccurred())
+		archives = make(map[string][]byte)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("prunes the old events and keeps the errors longer", func() {
+		archiver.MaxAge = time.Hour
+		archiver.ErrorMaxAge = 10 * time.Hour
+		oldInfo := createEvent(models.EventSeverityInfo, &clusterID, nil, 2*time.Hour)
+		oldError := createEvent(models.EventSeverityError, &clusterID, nil, 2*time.Hour)
+		newInfo := createEvent(models.EventSeverityInfo, &clusterID, nil, 0)
+		expiredError := createEvent(models.EventSeverityCritical, nil, &infraEnvID, 20*time.Hour)
+		expectUploads()
+
+		archiver.PruneEvents()
+		Expect(remainingEventIDs()).To(Equal([]uint{oldError.ID, newInfo.ID}))
+		Expect(archives).To(HaveLen(2))
+		Expect(archives).To(HaveKey(archiveName(clusterID, oldInfo, oldInfo)))
+		Expect(archives).To(HaveKey(archiveName(infraEnvID, expiredError, expiredError)))
+	})
+
+	It("keeps the latest info and warning events of each cluster", func() {
+		archiver.MaxEventsPerCluster = 2
+		first := createEvent(models.EventSeverityInfo, &clusterID, nil, 4*time.Minute)
+		second := createEvent(models.EventSeverityWarning, &clusterID, nil, 3*time.Minute)
+		errorEvent := createEvent(models.EventSeverityError, &clusterID, nil, 2*time.Minute)
+		third := createEvent(models.EventSeverityInfo, &clusterID, nil, time.Minute)
+		fourth := createEvent(models.EventSeverityInfo, &clusterID, nil, 0)
+		otherClusterID := strfmt.UUID(uuid.New().String())
+		otherFirst := createEvent(models.EventSeverityInfo, &otherClusterID, nil, 5*time.Minute)
+		otherSecond := createEvent(models.EventSeverityInfo, &otherClusterID, nil, 0)
+		expectUploads()
+
+		archiver.PruneEvents()
+		Expect(remainingEventIDs()).To(Equal([]uint{errorEvent.ID, third.ID, fourth.ID, otherFirst.ID, otherSecond.ID}))
+		Expect(archives).To(HaveLen(1))
+		Expect(archives).To(HaveKey(archiveName(clusterID, first, second)))
+	})
+
+	It("keeps the events when they can't be archived", func() {
+		archiver.MaxAge = time.Hour
+		createEvent(models.EventSeverityInfo, &clusterID, nil, 2*time.Hour)
+		mockS3.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("upload failed"))
+
+		archiver.PruneEvents()
+		Expect(remainingEventIDs()).To(HaveLen(1))
+	})
+
+	It("deletes the events without archiving them when archival is disabled", func() {
+		archiver.MaxAge = time.Hour
+		archiver.ArchiveEnabled = false
+		createEvent(models.EventSeverityInfo, &clusterID, nil, 2*time.Hour)
+
+		archiver.PruneEvents()
+		Expect(remainingEventIDs()).To(BeEmpty())
+	})
+
+	Context("archived events", func() {
+		BeforeEach(func() {
+			downloads = 0
+			archiver.MaxAge = time.Hour
+			for i := 0; i < 3; i++ {
+				createEvent(models.EventSeverityInfo, &clusterID, nil, time.Duration(i+2)*time.Hour)
+			}
+			createEvent(models.EventSeverityWarning, &clusterID, nil, 5*time.Hour)
+			expectUploads()
+			archiver.PruneEvents()
+			Expect(remainingEventIDs()).To(BeEmpty())
+
+			mockS3.EXPECT().ListObjectsByPrefix(gomock.Any(), clusterID.String()+"/events/").DoAndReturn(
+				func(_ context.Context, _ string) ([]string, error) {
+					names := make([]string, 0)
+					for name := range archives {
+						names = append(names, name)
+					}
+					return names, nil
+				}).AnyTimes()
+			mockS3.EXPECT().Download(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, objectName string) (io.ReadCloser, int64, error) {
+					downloads++
+					data := archives[objectName]
+					return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+				}).AnyTimes()
+		})
+
+		It("returns the archived events that match the filters", func() {
+			response, err := archiver.V2GetArchivedEvents(context.Background(), &eventsapi.V2GetEventsParams{
+				ClusterID:  &clusterID,
+				Severities: []string{models.EventSeverityInfo},
+				Order:      eventsapi.OrderDescending,
+				Limit:      swag.Int64(2),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.EventCount).To(Equal(int64(3)))
+			Expect(response.Events).To(HaveLen(2))
+			Expect(time.Time(*response.Events[0].EventTime)).To(BeTemporally(">", time.Time(*response.Events[1].EventTime)))
+			Expect(*response.Events[0].ClusterID).To(Equal(clusterID))
+		})
+
+		It("downloads only the archives within the time range", func() {
+			response, err := archiver.V2GetArchivedEvents(context.Background(), &eventsapi.V2GetEventsParams{
+				ClusterID: &clusterID,
+				StartTime: (*strfmt.DateTime)(swag.Time(time.Now().Add(-time.Hour))),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Events).To(BeEmpty())
+			Expect(downloads).To(BeZero())
+
+			response, err = archiver.V2GetArchivedEvents(context.Background(), &eventsapi.V2GetEventsParams{
+				ClusterID: &clusterID,
+				StartTime: (*strfmt.DateTime)(swag.Time(time.Now().Add(-150 * time.Minute))),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Events).To(HaveLen(1))
+			Expect(downloads).To(Equal(1))
+		})
+
+		It("fails when the cluster does not exist", func() {
+			otherClusterID := strfmt.UUID(uuid.New().String())
+			_, err := archiver.V2GetArchivedEvents(context.Background(), &eventsapi.V2GetEventsParams{ClusterID: &otherClusterID})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+		})
+
+		It("requires a cluster or an infra-env", func() {
+			_, err := archiver.V2GetArchivedEvents(context.Background(), &eventsapi.V2GetEventsParams{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		})
+	})
+})
+
+var _ = Describe("archiveInTimeRange", func() {
+	at := func(t time.Time) *strfmt.DateTime {
+		return (*strfmt.DateTime)(&t)
+	}
+	from := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	objectName := fmt.Sprintf("cluster/events/%s_%s_1-10.ndjson.gz", from.Format(archiveTimeFormat), to.Format(archiveTimeFormat))
+
+	It("matches the archives that overlap the time range", func() {
+		Expect(archiveInTimeRange(objectName, &eventsapi.V2GetEventsParams{})).To(BeTrue())
+		Expect(archiveInTimeRange(objectName, &eventsapi.V2GetEventsParams{StartTime: at(to)})).To(BeTrue())
+		Expect(archiveInTimeRange(objectName, &eventsapi.V2GetEventsParams{StartTime: at(to.Add(time.Second))})).To(BeFalse())
+		Expect(archiveInTimeRange(objectName, &eventsapi.V2GetEventsParams{EndTime: at(from)})).To(BeFalse())
+		Expect(archiveInTimeRange(objectName, &eventsapi.V2GetEventsParams{EndTime: at(from.Add(time.Second))})).To(BeTrue())
+	})
+
+	It("matches the archives without times in their name", func() {
+		Expect(archiveInTimeRange("cluster/events/1-10.ndjson.gz", &eventsapi.V2GetEventsParams{StartTime: at(to)})).To(BeTrue())
+	})
+})
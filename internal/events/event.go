@@ -181,7 +181,8 @@ func (e *Events) V2AddMetricsEvent(ctx context.Context, clusterID *strfmt.UUID,
 	e.v2SaveEvent(ctx, clusterID, hostID, infraEnvID, name, models.EventCategoryMetrics, severity, msg, eventTime, requestID, props...)
 }
 
-func (e Events) queryEvents(ctx context.Context, selectedCategories []string, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID) ([]*common.Event, error) {
+func (e Events) queryEvents(ctx context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
+	clusterID, hostID, infraEnvID := params.ClusterID, params.HostID, params.InfraEnvID
 
 	WithIDs := func(db *gorm.DB) *gorm.DB {
 		if clusterID != nil {
@@ -213,7 +214,7 @@ func (e Events) queryEvents(ctx context.Context, selectedCategories []string, cl
 	}
 
 	//prepare the common parts of the query
-	db := e.db.Order("event_time").Where("category IN (?)", selectedCategories)
+	db := withEventFilters(e.db.Where("events.category IN (?)", params.Categories), params)
 	if e.authz != nil {
 		db = e.authz.OwnedBy(ctx, db)
 	}
@@ -250,23 +251,93 @@ func (e Events) queryEvents(ctx context.Context, selectedCategories []string, cl
 	}
 
 	if result == nil { //non supported option
-		return make([]*common.Event, 0), nil
+		return &eventsapi.V2GetEventsResponse{Events: make([]*common.Event, 0)}, nil
 	}
 
-	var events []*common.Event
-	return events, WithIDs(result).Find(&events).Error
+	// The query is used both to count the events and to retrieve the requested page
+	result = WithIDs(result).Session(&gorm.Session{})
+	response := &eventsapi.V2GetEventsResponse{}
+	if !params.SkipCount {
+		if err := result.Select("events.id").Count(&response.EventCount).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// The ID orders the events that occurred at the same time, so pages don't overlap
+	order := "events.event_time, events.id"
+	if params.Order == eventsapi.OrderDescending {
+		order = "events.event_time DESC, events.id DESC"
+	}
+	if params.AfterEventID != nil {
+		order = "events.id"
+		if params.Order == eventsapi.OrderDescending {
+			order = "events.id DESC"
+		}
+	}
+	result = result.Order(order)
+	if params.Limit != nil {
+		result = result.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		result = result.Offset(int(*params.Offset))
+	}
+	return response, result.Find(&response.Events).Error
+}
+
+// withEventFilters filters the events by the optional filters of params
+func withEventFilters(db *gorm.DB, params *eventsapi.V2GetEventsParams) *gorm.DB {
+	if len(params.Severities) > 0 {
+		db = db.Where("events.severity IN (?)", params.Severities)
+	}
+	if len(params.Names) > 0 {
+		db = db.Where("events.name IN (?)", params.Names)
+	}
+	if params.Message != nil && *params.Message != "" {
+		db = db.Where("events.message ILIKE ?", "%"+likeEscaper.Replace(*params.Message)+"%")
+	}
+	if params.StartTime != nil {
+		db = db.Where("events.event_time >= ?", time.Time(*params.StartTime))
+	}
+	if params.EndTime != nil {
+		db = db.Where("events.event_time < ?", time.Time(*params.EndTime))
+	}
+	if params.AfterEventID != nil {
+		db = db.Where("events.id > ?", *params.AfterEventID)
+	}
+	if params.CreatedBefore != nil {
+		db = db.Where("events.created_at < ?", *params.CreatedBefore)
+	}
+	return db
 }
 
+// likeEscaper escapes the wildcards of a LIKE pattern, so text is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func (e Events) V2GetEvents(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, categories ...string) ([]*common.Event, error) {
+	response, err := e.V2GetFilteredEvents(ctx, &eventsapi.V2GetEventsParams{
+		ClusterID:  clusterID,
+		HostID:     hostID,
+		InfraEnvID: infraEnvID,
+		Categories: categories,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Events, nil
+}
+
+func (e Events) V2GetFilteredEvents(ctx context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
 	//initialize the selectedCategories either from the filter, if exists, or from the default values
 	selectedCategories := make([]string, 0)
-	if len(categories) > 0 {
-		selectedCategories = categories[:]
+	if len(params.Categories) > 0 {
+		selectedCategories = params.Categories[:]
 	} else {
-		selectedCategories = append(selectedCategories, DefaultEventCategories...)
+		selectedCategories = append(selectedCategories, eventsapi.DefaultEventCategories...)
 	}
 
-	return e.queryEvents(ctx, selectedCategories, clusterID, hostID, infraEnvID)
+	query := *params
+	query.Categories = selectedCategories
+	return e.queryEvents(ctx, &query)
 }
 
 func toProps(attrs ...interface{}) (result string, err error) {
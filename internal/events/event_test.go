@@ -209,6 +209,68 @@ var _ = Describe("Events library", func() {
 		})
 	})
 
+	Context("filtering and pagination", func() {
+		var start time.Time
+
+		getEvents := func(params *eventsapi.V2GetEventsParams) *eventsapi.V2GetEventsResponse {
+			params.ClusterID = &cluster1
+			response, err := theEvents.V2GetFilteredEvents(context.TODO(), params)
+			Expect(err).ShouldNot(HaveOccurred())
+			return response
+		}
+
+		messages := func(response *eventsapi.V2GetEventsResponse) []string {
+			ret := make([]string, 0, len(response.Events))
+			for _, ev := range response.Events {
+				ret = append(ret, *ev.Message)
+			}
+			return ret
+		}
+
+		BeforeEach(func() {
+			start = time.Now().Add(-time.Hour)
+			theEvents.V2AddEvent(context.TODO(), &cluster1, nil, nil, "first_event", models.EventSeverityInfo, "first message", start)
+			theEvents.V2AddEvent(context.TODO(), &cluster1, nil, nil, "second_event", models.EventSeverityError, "second message", start.Add(time.Minute))
+			theEvents.V2AddEvent(context.TODO(), &cluster1, nil, nil, "third_event", models.EventSeverityWarning, "third 100% message", start.Add(2*time.Minute))
+			theEvents.V2AddEvent(context.TODO(), &cluster2, nil, nil, "first_event", models.EventSeverityInfo, "other cluster", start)
+		})
+
+		It("filters by severity and name", func() {
+			response := getEvents(&eventsapi.V2GetEventsParams{Severities: []string{models.EventSeverityInfo, models.EventSeverityError}})
+			Expect(messages(response)).To(Equal([]string{"first message", "second message"}))
+			response = getEvents(&eventsapi.V2GetEventsParams{Names: []string{"third_event"}})
+			Expect(messages(response)).To(Equal([]string{"third 100% message"}))
+		})
+
+		It("searches the message text", func() {
+			Expect(messages(getEvents(&eventsapi.V2GetEventsParams{Message: swag.String("SECOND")}))).To(Equal([]string{"second message"}))
+			Expect(messages(getEvents(&eventsapi.V2GetEventsParams{Message: swag.String("0%")}))).To(Equal([]string{"third 100% message"}))
+			Expect(getEvents(&eventsapi.V2GetEventsParams{Message: swag.String("d_m")}).Events).To(BeEmpty())
+		})
+
+		It("filters by time range", func() {
+			startTime := strfmt.DateTime(start.Add(time.Minute))
+			endTime := strfmt.DateTime(start.Add(2 * time.Minute))
+			response := getEvents(&eventsapi.V2GetEventsParams{StartTime: &startTime, EndTime: &endTime})
+			Expect(messages(response)).To(Equal([]string{"second message"}))
+		})
+
+		It("orders and paginates the events and counts all the matching events", func() {
+			response := getEvents(&eventsapi.V2GetEventsParams{Order: eventsapi.OrderDescending, Limit: swag.Int64(2)})
+			Expect(messages(response)).To(Equal([]string{"third 100% message", "second message"}))
+			Expect(response.EventCount).To(Equal(int64(3)))
+			response = getEvents(&eventsapi.V2GetEventsParams{Order: eventsapi.OrderDescending, Limit: swag.Int64(2), Offset: swag.Int64(2)})
+			Expect(messages(response)).To(Equal([]string{"first message"}))
+			Expect(response.EventCount).To(Equal(int64(3)))
+		})
+
+		It("skips counting the matching events", func() {
+			response := getEvents(&eventsapi.V2GetEventsParams{Limit: swag.Int64(2), SkipCount: true})
+			Expect(messages(response)).To(Equal([]string{"first message", "second message"}))
+			Expect(response.EventCount).To(BeZero())
+		})
+	})
+
 	Context("authorization", func() {
 		var ctx context.Context
 		var cluster3 strfmt.UUID
@@ -3,8 +3,10 @@ package events
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/models"
@@ -13,36 +15,64 @@ import (
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"gorm.io/gorm"
 )
 
 var _ restapi.EventsAPI = &Api{}
 
 type Api struct {
-	handler eventsapi.Handler
-	log     logrus.FieldLogger
+	handler  eventsapi.Handler
+	archiver *Archiver
+	log      logrus.FieldLogger
+
+	streamPollInterval      time.Duration
+	streamSettleDelay       time.Duration
+	streamHeartbeatInterval time.Duration
 }
 
-func NewApi(handler eventsapi.Handler, log logrus.FieldLogger) *Api {
+func NewApi(handler eventsapi.Handler, archiver *Archiver, log logrus.FieldLogger) *Api {
 	return &Api{
-		handler: handler,
-		log:     log,
+		handler:                 handler,
+		archiver:                archiver,
+		log:                     log,
+		streamPollInterval:      streamPollInterval,
+		streamSettleDelay:       streamSettleDelay,
+		streamHeartbeatInterval: streamHeartbeatInterval,
 	}
 }
 
 func (a *Api) V2ListEvents(ctx context.Context, params events.V2ListEventsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, a.log)
 
-	evs, err := a.handler.V2GetEvents(ctx, params.ClusterID, params.HostID, params.InfraEnvID, params.Categories...)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return common.NewApiError(http.StatusNotFound, err)
+	query := &eventsapi.V2GetEventsParams{
+		ClusterID:  params.ClusterID,
+		HostID:     params.HostID,
+		InfraEnvID: params.InfraEnvID,
+		Categories: params.Categories,
+		Severities: params.Severities,
+		Names:      params.Names,
+		Message:    params.Message,
+		StartTime:  params.StartTime,
+		EndTime:    params.EndTime,
+		Order:      swag.StringValue(params.Order),
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+	}
+	var response *eventsapi.V2GetEventsResponse
+	var err error
+	if swag.BoolValue(params.Archived) {
+		if a.archiver == nil {
+			return common.NewApiError(http.StatusBadRequest, errors.New("Events archival is not enabled"))
 		}
+		response, err = a.archiver.V2GetArchivedEvents(ctx, query)
+	} else {
+		response, err = a.handler.V2GetFilteredEvents(ctx, query)
+	}
+	if err != nil {
 		log.WithError(err).Errorf("failed to get events")
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return common.GenerateErrorResponder(err)
 	}
-	ret := make(models.EventList, len(evs))
-	for i, ev := range evs {
+	ret := make(models.EventList, len(response.Events))
+	for i, ev := range response.Events {
 		ret[i] = &models.Event{
 			Name:       ev.Name,
 			ClusterID:  ev.ClusterID,
@@ -54,5 +84,5 @@ func (a *Api) V2ListEvents(ctx context.Context, params events.V2ListEventsParams
 			Props:      ev.Props,
 		}
 	}
-	return events.NewV2ListEventsOK().WithPayload(ret)
+	return events.NewV2ListEventsOK().WithPayload(ret).WithEventCount(response.EventCount)
 }
@@ -0,0 +1,174 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// streamPollInterval is the interval between the queries for new events. The events table is tailed, rather
+	// than notified in memory, so the events saved by all the replicas are streamed.
+	streamPollInterval = time.Second
+	// streamSettleDelay is the age of the events that are streamed. Events are only streamed once they are older
+	// than the delay, so events with lower IDs that are still being committed are not skipped.
+	streamSettleDelay = 2 * time.Second
+	// streamHeartbeatInterval is the maximal interval between messages, so idle connections are not closed by proxies
+	streamHeartbeatInterval = 15 * time.Second
+	streamBatchSize         = 100
+)
+
+func (a *Api) V2StreamEvents(ctx context.Context, params events.V2StreamEventsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	query := eventsapi.V2GetEventsParams{
+		ClusterID:  params.ClusterID,
+		HostID:     params.HostID,
+		InfraEnvID: params.InfraEnvID,
+		Categories: params.Categories,
+		Severities: params.Severities,
+		Names:      params.Names,
+		Limit:      swag.Int64(streamBatchSize),
+		// The stream polls for the events after the last written one, counting all the matching events on each poll
+		// would scan them over and over
+		SkipCount: true,
+	}
+	if params.LastEventIDHeader != nil {
+		query.AfterEventID = params.LastEventIDHeader
+	}
+	if params.LastEventID != nil {
+		query.AfterEventID = params.LastEventID
+	}
+	if query.AfterEventID == nil {
+		lastEventID, err := a.lastEventID(ctx, query)
+		if err != nil {
+			log.WithError(err).Error("failed to get the last event")
+			return streamError(http.StatusInternalServerError, err)
+		}
+		query.AfterEventID = &lastEventID
+	}
+	return &eventStream{
+		ctx:               ctx,
+		handler:           a.handler,
+		log:               log,
+		query:             query,
+		pollInterval:      a.streamPollInterval,
+		settleDelay:       a.streamSettleDelay,
+		heartbeatInterval: a.streamHeartbeatInterval,
+	}
+}
+
+// lastEventID returns the ID of the latest settled event that matches the query, so only new events are streamed
+func (a *Api) lastEventID(ctx context.Context, query eventsapi.V2GetEventsParams) (int64, error) {
+	createdBefore := time.Now().Add(-a.streamSettleDelay)
+	query.AfterEventID = swag.Int64(0)
+	query.CreatedBefore = &createdBefore
+	query.Order = eventsapi.OrderDescending
+	query.Limit = swag.Int64(1)
+	response, err := a.handler.V2GetFilteredEvents(ctx, &query)
+	if err != nil {
+		return 0, err
+	}
+	if len(response.Events) == 0 {
+		return 0, nil
+	}
+	return int64(response.Events[0].ID), nil
+}
+
+// streamError replies with an error in JSON format, as the event stream producer only streams events
+func streamError(statusCode int32, err error) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+		common.NewApiError(statusCode, err).WriteResponse(rw, runtime.JSONProducer())
+	})
+}
+
+// eventStream writes the events that match the query as server-sent events, until the client disconnects
+type eventStream struct {
+	ctx               context.Context
+	handler           eventsapi.Handler
+	log               logrus.FieldLogger
+	query             eventsapi.V2GetEventsParams
+	pollInterval      time.Duration
+	settleDelay       time.Duration
+	heartbeatInterval time.Duration
+}
+
+func (s *eventStream) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		streamError(http.StatusInternalServerError, errors.New("streaming is not supported")).WriteResponse(rw, producer)
+		return
+	}
+	rw.Header().Set(runtime.HeaderContentType, "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	// Disable the response buffering of nginx based proxies
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	lastWrite := time.Now()
+	for {
+		written, err := s.writeNewEvents(rw)
+		if err != nil {
+			s.log.WithError(err).Debug("event stream closed")
+			return
+		}
+		if written == 0 && time.Since(lastWrite) >= s.heartbeatInterval {
+			if _, err = fmt.Fprint(rw, ": heartbeat\n\n"); err != nil {
+				s.log.WithError(err).Debug("event stream closed")
+				return
+			}
+			written++
+		}
+		if written > 0 {
+			flusher.Flush()
+			lastWrite = time.Now()
+		}
+		// Keep on writing without waiting while there are more events than a single batch
+		if written >= streamBatchSize {
+			continue
+		}
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeNewEvents writes the settled events that were saved after the last written event, and returns their number.
+// Failures to query the events are logged and retried on the next poll, only write failures end the stream.
+func (s *eventStream) writeNewEvents(rw http.ResponseWriter) (int, error) {
+	createdBefore := time.Now().Add(-s.settleDelay)
+	s.query.CreatedBefore = &createdBefore
+	response, err := s.handler.V2GetFilteredEvents(s.ctx, &s.query)
+	if err != nil {
+		s.log.WithError(err).Warn("failed to get the events to stream")
+		return 0, nil
+	}
+	for _, event := range response.Events {
+		data, err := json.Marshal(&event.Event)
+		if err != nil {
+			return 0, err
+		}
+		if _, err = fmt.Fprintf(rw, "id: %d\ndata: %s\n\n", event.ID, data); err != nil {
+			return 0, err
+		}
+		s.query.AfterEventID = swag.Int64(int64(event.ID))
+	}
+	return len(response.Events), nil
+}
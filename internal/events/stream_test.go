@@ -0,0 +1,119 @@
+package events
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("event stream", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockHandler *eventsapi.MockHandler
+		api         *Api
+		ctx         context.Context
+		cancel      context.CancelFunc
+		clusterID   = strfmt.UUID("46a8d745-dfce-4fd8-9df0-549ee8eabb3d")
+	)
+
+	newEvent := func(id uint, name string) *common.Event {
+		return &common.Event{Model: gorm.Model{ID: id}, Event: models.Event{Name: name, ClusterID: &clusterID, Message: swag.String(name)}}
+	}
+
+	stream := func(params events.V2StreamEventsParams) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		api.V2StreamEvents(ctx, params).WriteResponse(recorder, runtime.JSONProducer())
+		return recorder
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockHandler = eventsapi.NewMockHandler(ctrl)
+		api = NewApi(mockHandler, nil, logrus.New())
+		api.streamPollInterval = time.Millisecond
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+		ctrl.Finish()
+	})
+
+	It("streams the events saved after the last event ID", func() {
+		first := mockHandler.EXPECT().V2GetFilteredEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
+				Expect(*params.AfterEventID).To(Equal(int64(3)))
+				Expect(*params.ClusterID).To(Equal(clusterID))
+				Expect(params.CreatedBefore).ToNot(BeNil())
+				Expect(params.SkipCount).To(BeTrue())
+				return &eventsapi.V2GetEventsResponse{Events: []*common.Event{newEvent(4, "first"), newEvent(5, "second")}}, nil
+			})
+		mockHandler.EXPECT().V2GetFilteredEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
+				Expect(*params.AfterEventID).To(Equal(int64(5)))
+				cancel()
+				return &eventsapi.V2GetEventsResponse{}, nil
+			}).After(first)
+
+		recorder := stream(events.V2StreamEventsParams{ClusterID: &clusterID, LastEventIDHeader: swag.Int64(3)})
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("text/event-stream"))
+		body := recorder.Body.String()
+		Expect(body).To(ContainSubstring("id: 4\ndata: {"))
+		Expect(body).To(ContainSubstring(`"name":"first"`))
+		Expect(body).To(ContainSubstring("id: 5\ndata: {"))
+	})
+
+	It("streams only new events when no last event ID is set", func() {
+		first := mockHandler.EXPECT().V2GetFilteredEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
+				Expect(params.Order).To(Equal(eventsapi.OrderDescending))
+				Expect(*params.Limit).To(Equal(int64(1)))
+				return &eventsapi.V2GetEventsResponse{Events: []*common.Event{newEvent(7, "old")}}, nil
+			})
+		mockHandler.EXPECT().V2GetFilteredEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
+				Expect(*params.AfterEventID).To(Equal(int64(7)))
+				cancel()
+				return &eventsapi.V2GetEventsResponse{}, nil
+			}).After(first)
+
+		recorder := stream(events.V2StreamEventsParams{ClusterID: &clusterID})
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(BeEmpty())
+	})
+
+	It("writes a heartbeat while there are no new events", func() {
+		api.streamHeartbeatInterval = 0
+		mockHandler.EXPECT().V2GetFilteredEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, params *eventsapi.V2GetEventsParams) (*eventsapi.V2GetEventsResponse, error) {
+				cancel()
+				return &eventsapi.V2GetEventsResponse{}, nil
+			})
+
+		recorder := stream(events.V2StreamEventsParams{ClusterID: &clusterID, LastEventID: swag.Int64(0)})
+		Expect(recorder.Body.String()).To(Equal(": heartbeat\n\n"))
+	})
+
+	It("replies with an error in JSON format when the last event can't be found", func() {
+		mockHandler.EXPECT().V2GetFilteredEvents(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrInvalidDB)
+
+		recorder := stream(events.V2StreamEventsParams{ClusterID: &clusterID})
+		Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal(runtime.JSONMime))
+	})
+})
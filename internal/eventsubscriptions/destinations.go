@@ -0,0 +1,100 @@
+package eventsubscriptions
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which is not public either
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// destinationValidator rejects the endpoints of the subscriptions that are internal to the network of the service,
+// so users can't use the deliveries, and their response codes, to reach or probe it: loopback, link-local, private
+// and unspecified addresses, unless they are in the allowed networks.
+type destinationValidator struct {
+	allowedNetworks []*net.IPNet
+	lookupIPAddr    func(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+func newDestinationValidator(allowedNetworks []string, log logrus.FieldLogger) *destinationValidator {
+	v := &destinationValidator{lookupIPAddr: net.DefaultResolver.LookupIPAddr}
+	for _, cidr := range allowedNetworks {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.WithError(err).Errorf("Ignoring invalid allowed network %s of the event subscriptions", cidr)
+			continue
+		}
+		v.allowedNetworks = append(v.allowedNetworks, network)
+	}
+	return v
+}
+
+func (v *destinationValidator) validateIP(ip net.IP) error {
+	for _, network := range v.allowedNetworks {
+		if network.Contains(ip) {
+			return nil
+		}
+	}
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() || sharedAddressSpace.Contains(ip) {
+		return errors.Errorf("address %s is not allowed as the destination of event subscriptions", ip)
+	}
+	return nil
+}
+
+// validateHost resolves the host of the endpoint and validates all its addresses
+func (v *destinationValidator) validateHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		return v.validateIP(ip)
+	}
+	addrs, err := v.lookupIPAddr(ctx, host)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve host %s", host)
+	}
+	for _, addr := range addrs {
+		if err = v.validateIP(addr.IP); err != nil {
+			return errors.Wrapf(err, "host %s resolves to a forbidden address", host)
+		}
+	}
+	return nil
+}
+
+// control validates the address that is actually dialed, after it was resolved, so that a host that resolved to a
+// public address when the subscription was created can't later be pointed at an internal one
+func (v *destinationValidator) control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("failed to parse the dialed address %s", address)
+	}
+	return v.validateIP(ip)
+}
+
+// httpClient returns a client that only connects to the allowed destinations. It doesn't use a proxy, as the
+// destination would then be the proxy.
+func (v *destinationValidator) httpClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: v.control}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
@@ -0,0 +1,322 @@
+package eventsubscriptions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/event_subscriptions"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type Config struct {
+	DeliveryInterval    time.Duration `envconfig:"EVENT_SUBSCRIPTIONS_DELIVERY_INTERVAL" default:"5s"`
+	EventSettleDelay    time.Duration `envconfig:"EVENT_SUBSCRIPTIONS_EVENT_SETTLE_DELAY" default:"2s"`
+	MaxEventsPerQuery   int           `envconfig:"EVENT_SUBSCRIPTIONS_MAX_EVENTS_PER_QUERY" default:"500"`
+	MaxDeliveryAttempts int64         `envconfig:"EVENT_SUBSCRIPTIONS_MAX_DELIVERY_ATTEMPTS" default:"8"`
+	RetryBackoff        time.Duration `envconfig:"EVENT_SUBSCRIPTIONS_RETRY_BACKOFF" default:"10s"`
+	MaxRetryBackoff     time.Duration `envconfig:"EVENT_SUBSCRIPTIONS_MAX_RETRY_BACKOFF" default:"1h"`
+	DeliveryTimeout     time.Duration `envconfig:"EVENT_SUBSCRIPTIONS_DELIVERY_TIMEOUT" default:"10s"`
+	DeliveriesRetention time.Duration `envconfig:"EVENT_SUBSCRIPTIONS_DELIVERIES_RETENTION" default:"168h"` // 7d
+	// DeliveryWorkers is the number of subscriptions that events are delivered to concurrently
+	DeliveryWorkers int `envconfig:"EVENT_SUBSCRIPTIONS_DELIVERY_WORKERS" default:"10"`
+	// AllowedNetworks are the CIDRs of internal networks that the subscriptions of users that are not admins may
+	// deliver events to, such as the network of a receiver running next to the service
+	AllowedNetworks []string `envconfig:"EVENT_SUBSCRIPTIONS_ALLOWED_NETWORKS" default:""`
+}
+
+var _ API = &Manager{}
+
+//go:generate mockgen --build_flags=--mod=mod -package=eventsubscriptions -destination=mock_eventsubscriptions_api.go . API
+type API interface {
+	restapi.EventSubscriptionsAPI
+	// GetEventSubscriptionByKubeKey returns the event subscription of the EventSubscription resource
+	GetEventSubscriptionByKubeKey(key types.NamespacedName) (*common.EventSubscription, error)
+	// RegisterEventSubscriptionInternal creates the event subscription of the EventSubscription resource
+	RegisterEventSubscriptionInternal(ctx context.Context, key types.NamespacedName, params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error)
+	// UpdateEventSubscriptionInternal replaces the properties of the event subscription
+	UpdateEventSubscriptionInternal(ctx context.Context, subscription *common.EventSubscription, params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error)
+	// DeregisterEventSubscriptionInternal deletes the event subscription and its delivery log
+	DeregisterEventSubscriptionInternal(ctx context.Context, subscriptionID strfmt.UUID) error
+	// DeliverEvents queues the new events for delivery to the matching subscriptions and sends the pending deliveries
+	DeliverEvents()
+}
+
+// Manager stores the event subscriptions and delivers the events to them. A subscription is owned by the user that
+// created it, or by the organization of the user when tenancy is enabled, and only receives the events of the
+// clusters and infra-envs it owns unless it was created by an admin. The subscriptions of users that are not admins
+// can only deliver events to public addresses, or to the allowed networks.
+type Manager struct {
+	Config
+	db                   *gorm.DB
+	log                  logrus.FieldLogger
+	authzHandler         auth.Authorizer
+	leaderElector        leader.Leader
+	httpClient           *http.Client
+	destinations         *destinationValidator
+	restrictedHTTPClient *http.Client
+}
+
+func NewManager(cfg Config, db *gorm.DB, log logrus.FieldLogger, authzHandler auth.Authorizer, leaderElector leader.Leader) *Manager {
+	destinations := newDestinationValidator(cfg.AllowedNetworks, log)
+	return &Manager{
+		Config:               cfg,
+		db:                   db,
+		log:                  log,
+		authzHandler:         authzHandler,
+		leaderElector:        leaderElector,
+		httpClient:           &http.Client{Timeout: cfg.DeliveryTimeout},
+		destinations:         destinations,
+		restrictedHTTPClient: destinations.httpClient(cfg.DeliveryTimeout),
+	}
+}
+
+func (m *Manager) V2ListEventSubscriptions(ctx context.Context, params operations.V2ListEventSubscriptionsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	var subscriptions []*common.EventSubscription
+	if err := m.authzHandler.OwnedBy(ctx, m.db).Order("created_at").Find(&subscriptions).Error; err != nil {
+		log.WithError(err).Error("Failed to list event subscriptions")
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	reply := models.EventSubscriptionList{}
+	for _, subscription := range subscriptions {
+		reply = append(reply, &subscription.EventSubscription)
+	}
+	return operations.NewV2ListEventSubscriptionsOK().WithPayload(reply)
+}
+
+func (m *Manager) V2RegisterEventSubscription(ctx context.Context, params operations.V2RegisterEventSubscriptionParams) middleware.Responder {
+	if err := m.validateClusterID(ctx, params.NewEventSubscriptionParams.ClusterID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	subscription := &common.EventSubscription{
+		EventSubscription: models.EventSubscription{
+			UserName: ocm.UserNameFromContext(ctx),
+			OrgID:    ocm.OrgIDFromContext(ctx),
+		},
+		CreatedByAdmin: m.authzHandler.IsAdmin(ctx),
+	}
+	if err := m.createSubscription(ctx, subscription, params.NewEventSubscriptionParams); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2RegisterEventSubscriptionCreated().WithPayload(&subscription.EventSubscription)
+}
+
+func (m *Manager) V2GetEventSubscription(ctx context.Context, params operations.V2GetEventSubscriptionParams) middleware.Responder {
+	subscription, err := m.getOwnedSubscription(ctx, params.SubscriptionID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2GetEventSubscriptionOK().WithPayload(&subscription.EventSubscription)
+}
+
+func (m *Manager) V2UpdateEventSubscription(ctx context.Context, params operations.V2UpdateEventSubscriptionParams) middleware.Responder {
+	subscription, err := m.getOwnedSubscription(ctx, params.SubscriptionID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err = m.validateClusterID(ctx, params.EventSubscriptionParams.ClusterID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if subscription, err = m.UpdateEventSubscriptionInternal(ctx, subscription, params.EventSubscriptionParams); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2UpdateEventSubscriptionOK().WithPayload(&subscription.EventSubscription)
+}
+
+func (m *Manager) V2DeregisterEventSubscription(ctx context.Context, params operations.V2DeregisterEventSubscriptionParams) middleware.Responder {
+	if _, err := m.getOwnedSubscription(ctx, params.SubscriptionID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if err := m.DeregisterEventSubscriptionInternal(ctx, params.SubscriptionID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewV2DeregisterEventSubscriptionNoContent()
+}
+
+func (m *Manager) V2ListEventSubscriptionDeliveries(ctx context.Context, params operations.V2ListEventSubscriptionDeliveriesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	if _, err := m.getOwnedSubscription(ctx, params.SubscriptionID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	query := m.db.Where("subscription_id = ?", params.SubscriptionID.String())
+	if params.Status != nil {
+		query = query.Where("status = ?", *params.Status)
+	}
+	deliveries := models.EventSubscriptionDeliveryList{}
+	if err := query.Order("id DESC").Limit(int(swag.Int64Value(params.Limit))).Find(&deliveries).Error; err != nil {
+		log.WithError(err).Errorf("Failed to list the deliveries of event subscription %s", params.SubscriptionID)
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	return operations.NewV2ListEventSubscriptionDeliveriesOK().WithPayload(deliveries)
+}
+
+func (m *Manager) GetEventSubscriptionByKubeKey(key types.NamespacedName) (*common.EventSubscription, error) {
+	var subscription common.EventSubscription
+	if err := m.db.Take(&subscription, "kube_key_name = ? and kube_key_namespace = ?", key.Name, key.Namespace).Error; err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (m *Manager) RegisterEventSubscriptionInternal(ctx context.Context, key types.NamespacedName,
+	params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+	// Anyone that can create the resource in its namespace creates the subscription, so it is restricted like the
+	// subscriptions of the users: it only gets the events of the namespace, and only to the allowed destinations
+	subscription := &common.EventSubscription{
+		KubeKeyName:      key.Name,
+		KubeKeyNamespace: key.Namespace,
+	}
+	if err := m.createSubscription(ctx, subscription, params); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (m *Manager) UpdateEventSubscriptionInternal(ctx context.Context, subscription *common.EventSubscription,
+	params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+	log := logutil.FromContext(ctx, m.log)
+	if err := m.validateSubscriptionParams(ctx, params, isUnrestricted(subscription)); err != nil {
+		return nil, err
+	}
+	wasEnabled := subscription.Enabled
+	// The secret is never returned, so it is kept unless a new one is set
+	setSubscriptionParams(subscription, params)
+	if !wasEnabled && subscription.Enabled {
+		// The events that were emitted while the subscription was disabled are not delivered
+		lastEventID, err := m.lastEventID()
+		if err != nil {
+			log.WithError(err).Errorf("Failed to update event subscription %s", subscription.ID)
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		subscription.LastEventID = lastEventID
+	}
+	if err := m.db.Select("*").Save(subscription).Error; err != nil {
+		log.WithError(err).Errorf("Failed to update event subscription %s", subscription.ID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Updated event subscription %s", subscription.ID)
+	return subscription, nil
+}
+
+func (m *Manager) DeregisterEventSubscriptionInternal(ctx context.Context, subscriptionID strfmt.UUID) error {
+	log := logutil.FromContext(ctx, m.log)
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.EventSubscriptionDelivery{}, "subscription_id = ?", subscriptionID.String()).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&common.EventSubscription{}, "id = ?", subscriptionID.String()).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("Failed to delete event subscription %s", subscriptionID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Deleted event subscription %s", subscriptionID)
+	return nil
+}
+
+func (m *Manager) getOwnedSubscription(ctx context.Context, subscriptionID strfmt.UUID) (*common.EventSubscription, error) {
+	var subscription common.EventSubscription
+	if err := m.authzHandler.OwnedBy(ctx, m.db).Take(&subscription, "id = ?", subscriptionID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("event subscription %s not found", subscriptionID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &subscription, nil
+}
+
+func (m *Manager) createSubscription(ctx context.Context, subscription *common.EventSubscription, params *models.EventSubscriptionCreateParams) error {
+	log := logutil.FromContext(ctx, m.log)
+	if err := m.validateSubscriptionParams(ctx, params, isUnrestricted(subscription)); err != nil {
+		return err
+	}
+	// Only the events emitted after the subscription was created are delivered
+	lastEventID, err := m.lastEventID()
+	if err != nil {
+		log.WithError(err).Error("Failed to create event subscription")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	id := strfmt.UUID(uuid.New().String())
+	subscription.ID = &id
+	subscription.CreatedAt = strfmt.DateTime(time.Now())
+	subscription.LastEventID = lastEventID
+	setSubscriptionParams(subscription, params)
+	if err = m.db.Create(subscription).Error; err != nil {
+		log.WithError(err).Errorf("Failed to create event subscription %s", id)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Created event subscription %s", id)
+	return nil
+}
+
+func (m *Manager) validateClusterID(ctx context.Context, clusterID *strfmt.UUID) error {
+	if clusterID == nil {
+		return nil
+	}
+	if err := m.authzHandler.OwnedBy(ctx, m.db).Take(&common.Cluster{}, "id = ?", clusterID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("cluster %s not found", clusterID))
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+func (m *Manager) lastEventID() (uint, error) {
+	var lastEventID uint
+	err := m.db.Unscoped().Model(&common.Event{}).Select("COALESCE(MAX(id), 0)").Scan(&lastEventID).Error
+	return lastEventID, err
+}
+
+// isUnrestricted returns whether the subscription gets the events of all the clusters and infra-envs, and may send
+// them to any destination. Only the subscriptions that admins created through the REST API are.
+func isUnrestricted(subscription *common.EventSubscription) bool {
+	return subscription.CreatedByAdmin && subscription.KubeKeyNamespace == ""
+}
+
+func (m *Manager) validateSubscriptionParams(ctx context.Context, params *models.EventSubscriptionCreateParams, createdByAdmin bool) error {
+	u, err := url.Parse(swag.StringValue(params.URL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("URL %s of the event subscription is not a valid HTTP or HTTPS URL", swag.StringValue(params.URL)))
+	}
+	if createdByAdmin {
+		return nil
+	}
+	if err = m.destinations.validateHost(ctx, u.Hostname()); err != nil {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Wrapf(err, "URL %s of the event subscription is not allowed", swag.StringValue(params.URL)))
+	}
+	return nil
+}
+
+func setSubscriptionParams(subscription *common.EventSubscription, params *models.EventSubscriptionCreateParams) {
+	subscription.Name = params.Name
+	subscription.URL = params.URL
+	subscription.Enabled = swag.BoolValue(params.Enabled) || params.Enabled == nil
+	subscription.Severities = params.Severities
+	subscription.Categories = params.Categories
+	subscription.EventNames = params.EventNames
+	subscription.ClusterID = params.ClusterID
+	if params.Secret != "" {
+		subscription.Secret = params.Secret
+		subscription.Signed = true
+	}
+	subscription.UpdatedAt = strfmt.DateTime(time.Now())
+}
@@ -0,0 +1,265 @@
+package eventsubscriptions
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/event_subscriptions"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEventSubscriptions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "event subscriptions test")
+}
+
+func userContext(userName, orgID string) context.Context {
+	payload := &ocm.AuthPayload{Username: userName, Organization: orgID, Role: ocm.UserRole}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+func newManager(db *gorm.DB, cfg Config) *Manager {
+	authzHandler := auth.NewAuthzHandler(&auth.Config{AuthType: auth.TypeRHSSO, EnableOrgTenancy: true}, nil, logrus.New(), db)
+	manager := NewManager(cfg, db, common.GetTestLog(), authzHandler, &leader.DummyElector{})
+	// The hosts of the tests are resolved without DNS, those under internal.example to a private address
+	manager.destinations.lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		if strings.HasSuffix(host, ".internal.example") {
+			return []net.IPAddr{{IP: net.ParseIP("10.0.0.1")}}, nil
+		}
+		return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil
+	}
+	return manager
+}
+
+func newSubscriptionParams(name, url string) *models.EventSubscriptionCreateParams {
+	return &models.EventSubscriptionCreateParams{
+		Name:       swag.String(name),
+		URL:        swag.String(url),
+		Secret:     "secret",
+		Severities: []string{models.EventSeverityError, models.EventSeverityCritical},
+	}
+}
+
+func registerSubscription(ctx context.Context, manager *Manager, params *models.EventSubscriptionCreateParams) *models.EventSubscription {
+	reply := manager.V2RegisterEventSubscription(ctx, operations.V2RegisterEventSubscriptionParams{
+		NewEventSubscriptionParams: params,
+	})
+	Expect(reply).To(BeAssignableToTypeOf(operations.NewV2RegisterEventSubscriptionCreated()))
+	return reply.(*operations.V2RegisterEventSubscriptionCreated).Payload
+}
+
+func verifyApiError(reply interface{}, expectedHttpStatus int32) {
+	Expect(reply).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(expectedHttpStatus))
+}
+
+var _ = Describe("event subscriptions", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		manager *Manager
+		ctx     context.Context
+	)
+
+	getSubscription := func(id strfmt.UUID) *common.EventSubscription {
+		var subscription common.EventSubscription
+		Expect(db.Take(&subscription, "id = ?", id.String()).Error).ToNot(HaveOccurred())
+		return &subscription
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		manager = newManager(db, Config{})
+		ctx = userContext("user1", "org1")
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("registers a subscription without returning its secret", func() {
+		Expect(db.Create(&common.Event{Event: models.Event{Name: "old"}}).Error).ToNot(HaveOccurred())
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://example.com/hook"))
+		Expect(subscription.UserName).To(Equal("user1"))
+		Expect(subscription.OrgID).To(Equal("org1"))
+		Expect(subscription.Signed).To(BeTrue())
+		Expect(subscription.Enabled).To(BeTrue())
+
+		stored := getSubscription(*subscription.ID)
+		Expect(stored.Secret).To(Equal("secret"))
+		Expect(stored.CreatedByAdmin).To(BeFalse())
+		Expect(stored.LastEventID).To(BeNumerically(">", 0))
+		Expect(stored.Severities).To(ConsistOf(models.EventSeverityError, models.EventSeverityCritical))
+	})
+
+	It("rejects an invalid URL", func() {
+		for _, url := range []string{"example.com/hook", "ftp://example.com/hook", "https://"} {
+			reply := manager.V2RegisterEventSubscription(ctx, operations.V2RegisterEventSubscriptionParams{
+				NewEventSubscriptionParams: newSubscriptionParams("sub1", url),
+			})
+			verifyApiError(reply, http.StatusBadRequest)
+		}
+	})
+
+	It("rejects the URLs of internal addresses", func() {
+		for _, url := range []string{"http://127.0.0.1:8080/hook", "http://169.254.169.254/latest/meta-data",
+			"https://10.1.2.3/hook", "http://[::1]/hook", "http://0.0.0.0/hook", "https://hook.internal.example/hook"} {
+			reply := manager.V2RegisterEventSubscription(ctx, operations.V2RegisterEventSubscriptionParams{
+				NewEventSubscriptionParams: newSubscriptionParams("sub1", url),
+			})
+			verifyApiError(reply, http.StatusBadRequest)
+		}
+
+		By("rejecting them when a subscription is updated")
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://example.com/hook"))
+		reply := manager.V2UpdateEventSubscription(ctx, operations.V2UpdateEventSubscriptionParams{
+			SubscriptionID:          *subscription.ID,
+			EventSubscriptionParams: newSubscriptionParams("sub1", "http://169.254.169.254/latest/meta-data"),
+		})
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("accepts the URLs of the allowed networks and of the subscriptions of admins", func() {
+		manager = newManager(db, Config{AllowedNetworks: []string{"10.0.0.0/24", "invalid"}})
+		registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://hook.internal.example/hook"))
+
+		_, err := manager.RegisterEventSubscriptionInternal(context.Background(), types.NamespacedName{Name: "sub2", Namespace: "ns"},
+			newSubscriptionParams("sub2", "http://receiver.ns.svc:8080/hook"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects a cluster of another organization", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org2"}}).Error).ToNot(HaveOccurred())
+		params := newSubscriptionParams("sub1", "https://example.com/hook")
+		params.ClusterID = &clusterID
+		reply := manager.V2RegisterEventSubscription(ctx, operations.V2RegisterEventSubscriptionParams{NewEventSubscriptionParams: params})
+		verifyApiError(reply, http.StatusBadRequest)
+	})
+
+	It("lists the subscriptions of the organization", func() {
+		registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://example.com/hook"))
+		registerSubscription(userContext("user2", "org1"), manager, newSubscriptionParams("sub2", "https://example.com/hook"))
+		registerSubscription(userContext("user3", "org2"), manager, newSubscriptionParams("sub3", "https://example.com/hook"))
+
+		reply := manager.V2ListEventSubscriptions(ctx, operations.V2ListEventSubscriptionsParams{})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListEventSubscriptionsOK()))
+		subscriptions := reply.(*operations.V2ListEventSubscriptionsOK).Payload
+		Expect(subscriptions).To(HaveLen(2))
+		Expect(swag.StringValue(subscriptions[0].Name)).To(Equal("sub1"))
+		Expect(swag.StringValue(subscriptions[1].Name)).To(Equal("sub2"))
+	})
+
+	It("updates a subscription and keeps its secret", func() {
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://example.com/hook"))
+		params := newSubscriptionParams("sub1-updated", "https://example.com/other")
+		params.Secret = ""
+		params.Enabled = swag.Bool(false)
+		reply := manager.V2UpdateEventSubscription(ctx, operations.V2UpdateEventSubscriptionParams{
+			SubscriptionID:          *subscription.ID,
+			EventSubscriptionParams: params,
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2UpdateEventSubscriptionOK()))
+
+		stored := getSubscription(*subscription.ID)
+		Expect(swag.StringValue(stored.Name)).To(Equal("sub1-updated"))
+		Expect(swag.StringValue(stored.URL)).To(Equal("https://example.com/other"))
+		Expect(stored.Enabled).To(BeFalse())
+		Expect(stored.Secret).To(Equal("secret"))
+		Expect(stored.Signed).To(BeTrue())
+	})
+
+	It("skips the events emitted while a subscription was disabled", func() {
+		params := newSubscriptionParams("sub1", "https://example.com/hook")
+		params.Enabled = swag.Bool(false)
+		subscription := registerSubscription(ctx, manager, params)
+		Expect(db.Create(&common.Event{Event: models.Event{Name: "missed"}}).Error).ToNot(HaveOccurred())
+		lastEventID, err := manager.lastEventID()
+		Expect(err).ToNot(HaveOccurred())
+
+		params.Enabled = nil
+		_, err = manager.UpdateEventSubscriptionInternal(ctx, getSubscription(*subscription.ID), params)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getSubscription(*subscription.ID).LastEventID).To(Equal(lastEventID))
+	})
+
+	It("deletes a subscription with its deliveries", func() {
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://example.com/hook"))
+		Expect(db.Create(&models.EventSubscriptionDelivery{
+			SubscriptionID: subscription.ID,
+			Status:         swag.String(models.EventSubscriptionDeliveryStatusDelivered),
+		}).Error).ToNot(HaveOccurred())
+
+		reply := manager.V2DeregisterEventSubscription(ctx, operations.V2DeregisterEventSubscriptionParams{SubscriptionID: *subscription.ID})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2DeregisterEventSubscriptionNoContent()))
+		var count int64
+		Expect(db.Model(&models.EventSubscriptionDelivery{}).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+
+		reply = manager.V2GetEventSubscription(ctx, operations.V2GetEventSubscriptionParams{SubscriptionID: *subscription.ID})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("hides the subscriptions of other organizations", func() {
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://example.com/hook"))
+		otherCtx := userContext("user3", "org2")
+
+		reply := manager.V2GetEventSubscription(otherCtx, operations.V2GetEventSubscriptionParams{SubscriptionID: *subscription.ID})
+		verifyApiError(reply, http.StatusNotFound)
+		reply = manager.V2ListEventSubscriptionDeliveries(otherCtx, operations.V2ListEventSubscriptionDeliveriesParams{SubscriptionID: *subscription.ID})
+		verifyApiError(reply, http.StatusNotFound)
+		reply = manager.V2DeregisterEventSubscription(otherCtx, operations.V2DeregisterEventSubscriptionParams{SubscriptionID: *subscription.ID})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("lists the deliveries of a subscription, latest first", func() {
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://example.com/hook"))
+		for _, status := range []string{models.EventSubscriptionDeliveryStatusDelivered, models.EventSubscriptionDeliveryStatusFailed,
+			models.EventSubscriptionDeliveryStatusDelivered} {
+			Expect(db.Create(&models.EventSubscriptionDelivery{
+				SubscriptionID: subscription.ID,
+				Status:         swag.String(status),
+			}).Error).ToNot(HaveOccurred())
+		}
+
+		reply := manager.V2ListEventSubscriptionDeliveries(ctx, operations.V2ListEventSubscriptionDeliveriesParams{
+			SubscriptionID: *subscription.ID,
+			Status:         swag.String(models.EventSubscriptionDeliveryStatusDelivered),
+			Limit:          swag.Int64(100),
+		})
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ListEventSubscriptionDeliveriesOK()))
+		deliveries := reply.(*operations.V2ListEventSubscriptionDeliveriesOK).Payload
+		Expect(deliveries).To(HaveLen(2))
+		Expect(*deliveries[0].ID).To(BeNumerically(">", *deliveries[1].ID))
+	})
+
+	It("registers the subscription of a kube-api resource", func() {
+		key := types.NamespacedName{Name: "sub1", Namespace: "ns"}
+		subscription, err := manager.RegisterEventSubscriptionInternal(context.Background(), key, newSubscriptionParams("sub1", "https://example.com/hook"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(subscription.CreatedByAdmin).To(BeFalse())
+		Expect(subscription.KubeKeyNamespace).To(Equal("ns"))
+
+		subscription, err = manager.GetEventSubscriptionByKubeKey(key)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(swag.StringValue(subscription.Name)).To(Equal("sub1"))
+	})
+})
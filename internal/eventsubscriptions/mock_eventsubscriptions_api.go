@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/eventsubscriptions (interfaces: API)
+
+// Package eventsubscriptions is a generated GoMock package.
+package eventsubscriptions
+
+import (
+	context "context"
+	reflect "reflect"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
+	event_subscriptions "github.com/openshift/assisted-service/restapi/operations/event_subscriptions"
+	types "k8s.io/apimachinery/pkg/types"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// DeliverEvents mocks base method.
+func (m *MockAPI) DeliverEvents() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeliverEvents")
+}
+
+// DeliverEvents indicates an expected call of DeliverEvents.
+func (mr *MockAPIMockRecorder) DeliverEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverEvents", reflect.TypeOf((*MockAPI)(nil).DeliverEvents))
+}
+
+// DeregisterEventSubscriptionInternal mocks base method.
+func (m *MockAPI) DeregisterEventSubscriptionInternal(arg0 context.Context, arg1 strfmt.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEventSubscriptionInternal", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEventSubscriptionInternal indicates an expected call of DeregisterEventSubscriptionInternal.
+func (mr *MockAPIMockRecorder) DeregisterEventSubscriptionInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEventSubscriptionInternal", reflect.TypeOf((*MockAPI)(nil).DeregisterEventSubscriptionInternal), arg0, arg1)
+}
+
+// GetEventSubscriptionByKubeKey mocks base method.
+func (m *MockAPI) GetEventSubscriptionByKubeKey(arg0 types.NamespacedName) (*common.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSubscriptionByKubeKey", arg0)
+	ret0, _ := ret[0].(*common.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventSubscriptionByKubeKey indicates an expected call of GetEventSubscriptionByKubeKey.
+func (mr *MockAPIMockRecorder) GetEventSubscriptionByKubeKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSubscriptionByKubeKey", reflect.TypeOf((*MockAPI)(nil).GetEventSubscriptionByKubeKey), arg0)
+}
+
+// RegisterEventSubscriptionInternal mocks base method.
+func (m *MockAPI) RegisterEventSubscriptionInternal(arg0 context.Context, arg1 types.NamespacedName, arg2 *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEventSubscriptionInternal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*common.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEventSubscriptionInternal indicates an expected call of RegisterEventSubscriptionInternal.
+func (mr *MockAPIMockRecorder) RegisterEventSubscriptionInternal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEventSubscriptionInternal", reflect.TypeOf((*MockAPI)(nil).RegisterEventSubscriptionInternal), arg0, arg1, arg2)
+}
+
+// UpdateEventSubscriptionInternal mocks base method.
+func (m *MockAPI) UpdateEventSubscriptionInternal(arg0 context.Context, arg1 *common.EventSubscription, arg2 *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventSubscriptionInternal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*common.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventSubscriptionInternal indicates an expected call of UpdateEventSubscriptionInternal.
+func (mr *MockAPIMockRecorder) UpdateEventSubscriptionInternal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventSubscriptionInternal", reflect.TypeOf((*MockAPI)(nil).UpdateEventSubscriptionInternal), arg0, arg1, arg2)
+}
+
+// V2DeregisterEventSubscription mocks base method.
+func (m *MockAPI) V2DeregisterEventSubscription(arg0 context.Context, arg1 event_subscriptions.V2DeregisterEventSubscriptionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DeregisterEventSubscription", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DeregisterEventSubscription indicates an expected call of V2DeregisterEventSubscription.
+func (mr *MockAPIMockRecorder) V2DeregisterEventSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DeregisterEventSubscription", reflect.TypeOf((*MockAPI)(nil).V2DeregisterEventSubscription), arg0, arg1)
+}
+
+// V2GetEventSubscription mocks base method.
+func (m *MockAPI) V2GetEventSubscription(arg0 context.Context, arg1 event_subscriptions.V2GetEventSubscriptionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetEventSubscription", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetEventSubscription indicates an expected call of V2GetEventSubscription.
+func (mr *MockAPIMockRecorder) V2GetEventSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetEventSubscription", reflect.TypeOf((*MockAPI)(nil).V2GetEventSubscription), arg0, arg1)
+}
+
+// V2ListEventSubscriptionDeliveries mocks base method.
+func (m *MockAPI) V2ListEventSubscriptionDeliveries(arg0 context.Context, arg1 event_subscriptions.V2ListEventSubscriptionDeliveriesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListEventSubscriptionDeliveries", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListEventSubscriptionDeliveries indicates an expected call of V2ListEventSubscriptionDeliveries.
+func (mr *MockAPIMockRecorder) V2ListEventSubscriptionDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListEventSubscriptionDeliveries", reflect.TypeOf((*MockAPI)(nil).V2ListEventSubscriptionDeliveries), arg0, arg1)
+}
+
+// V2ListEventSubscriptions mocks base method.
+func (m *MockAPI) V2ListEventSubscriptions(arg0 context.Context, arg1 event_subscriptions.V2ListEventSubscriptionsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListEventSubscriptions", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListEventSubscriptions indicates an expected call of V2ListEventSubscriptions.
+func (mr *MockAPIMockRecorder) V2ListEventSubscriptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListEventSubscriptions", reflect.TypeOf((*MockAPI)(nil).V2ListEventSubscriptions), arg0, arg1)
+}
+
+// V2RegisterEventSubscription mocks base method.
+func (m *MockAPI) V2RegisterEventSubscription(arg0 context.Context, arg1 event_subscriptions.V2RegisterEventSubscriptionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2RegisterEventSubscription", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2RegisterEventSubscription indicates an expected call of V2RegisterEventSubscription.
+func (mr *MockAPIMockRecorder) V2RegisterEventSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RegisterEventSubscription", reflect.TypeOf((*MockAPI)(nil).V2RegisterEventSubscription), arg0, arg1)
+}
+
+// V2UpdateEventSubscription mocks base method.
+func (m *MockAPI) V2UpdateEventSubscription(arg0 context.Context, arg1 event_subscriptions.V2UpdateEventSubscriptionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2UpdateEventSubscription", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2UpdateEventSubscription indicates an expected call of V2UpdateEventSubscription.
+func (mr *MockAPIMockRecorder) V2UpdateEventSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UpdateEventSubscription", reflect.TypeOf((*MockAPI)(nil).V2UpdateEventSubscription), arg0, arg1)
+}
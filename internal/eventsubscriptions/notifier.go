@@ -0,0 +1,338 @@
+package eventsubscriptions
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	CloudEventsSpecVersion = "1.0"
+	CloudEventsSource      = "assisted-service"
+	CloudEventsTypePrefix  = "io.openshift.assisted-service."
+	CloudEventsContentType = "application/cloudevents+json"
+
+	// SignatureHeader holds the HMAC-SHA256 of the delivered body, keyed with the secret of the subscription
+	SignatureHeader = "X-Assisted-Signature"
+	signaturePrefix = "sha256="
+
+	maxDeliveryErrorLength = 1024
+)
+
+// CloudEvent is the structured mode JSON format of a delivered event, as defined by the CloudEvents specification
+type CloudEvent struct {
+	SpecVersion     string        `json:"specversion"`
+	ID              string        `json:"id"`
+	Source          string        `json:"source"`
+	Type            string        `json:"type"`
+	Subject         string        `json:"subject,omitempty"`
+	Time            string        `json:"time,omitempty"`
+	DataContentType string        `json:"datacontenttype"`
+	Data            *models.Event `json:"data"`
+}
+
+// Sign returns the value of the signature header of a body delivered to a subscription with the given secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (m *Manager) DeliverEvents() {
+	if !m.leaderElector.IsLeader() {
+		return
+	}
+	if err := m.queueEvents(); err != nil {
+		m.log.WithError(err).Error("Failed to queue events for delivery to the event subscriptions")
+	}
+	if err := m.sendPendingDeliveries(); err != nil {
+		m.log.WithError(err).Error("Failed to deliver events to the event subscriptions")
+	}
+	olderThan := time.Now().Add(-m.DeliveriesRetention)
+	if err := m.db.Where("status <> ? and created_at < ?", models.EventSubscriptionDeliveryStatusPending, olderThan).
+		Delete(&models.EventSubscriptionDelivery{}).Error; err != nil {
+		m.log.WithError(err).Error("Failed to delete old event subscription deliveries")
+	}
+}
+
+// queueEvents creates a pending delivery for each new event that matches the filters of an enabled subscription.
+// Events are only queued once they are older than the settle delay, so events with lower IDs that are still being
+// committed are not skipped.
+func (m *Manager) queueEvents() error {
+	var settledEventID uint
+	if err := m.db.Unscoped().Model(&common.Event{}).Select("COALESCE(MAX(id), 0)").
+		Where("created_at < ?", time.Now().Add(-m.EventSettleDelay)).Scan(&settledEventID).Error; err != nil {
+		return err
+	}
+
+	var subscriptions []*common.EventSubscription
+	if err := m.db.Where("enabled = ? and last_event_id < ?", true, settledEventID).Find(&subscriptions).Error; err != nil {
+		return err
+	}
+	for _, subscription := range subscriptions {
+		if err := m.queueSubscriptionEvents(subscription, settledEventID); err != nil {
+			m.log.WithError(err).Errorf("Failed to queue events for delivery to event subscription %s", subscription.ID)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) queueSubscriptionEvents(subscription *common.EventSubscription, settledEventID uint) error {
+	var events []*common.Event
+	if err := m.subscriptionEventsQuery(subscription).
+		Where("events.id > ? and events.id <= ?", subscription.LastEventID, settledEventID).
+		Order("events.id").Limit(m.MaxEventsPerQuery).Find(&events).Error; err != nil {
+		return err
+	}
+	lastEventID := settledEventID
+	if len(events) == m.MaxEventsPerQuery {
+		lastEventID = events[len(events)-1].ID
+	}
+
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		for _, event := range events {
+			delivery, err := newDelivery(subscription, event)
+			if err != nil {
+				return err
+			}
+			if err = tx.Create(delivery).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(subscription).UpdateColumn("last_event_id", lastEventID).Error
+	})
+}
+
+// subscriptionEventsQuery selects the events that match the filters of the subscription and are visible to its owner
+func (m *Manager) subscriptionEventsQuery(subscription *common.EventSubscription) *gorm.DB {
+	query := m.db.Model(&common.Event{})
+	if len(subscription.Severities) > 0 {
+		query = query.Where("events.severity IN (?)", subscription.Severities)
+	}
+	categories := subscription.Categories
+	if len(categories) == 0 {
+		categories = []string{models.EventCategoryUser}
+	}
+	query = query.Where("events.category IN (?)", categories)
+	if len(subscription.EventNames) > 0 {
+		query = query.Where("events.name IN (?)", subscription.EventNames)
+	}
+	if subscription.ClusterID != nil {
+		query = query.Where("events.cluster_id = ?", subscription.ClusterID.String())
+	}
+	switch {
+	case subscription.KubeKeyNamespace != "":
+		// The subscriptions of kube-api resources get the events of the resources in their namespace
+		clusters := m.db.Unscoped().Model(&common.Cluster{}).Select("id").Where("kube_key_namespace = ?", subscription.KubeKeyNamespace)
+		infraEnvs := m.db.Unscoped().Model(&common.InfraEnv{}).Select("id").Where("kube_key_namespace = ?", subscription.KubeKeyNamespace)
+		query = query.Where("events.cluster_id IN (?) OR events.infra_env_id IN (?)", clusters, infraEnvs)
+	case !subscription.CreatedByAdmin:
+		ctx := context.WithValue(context.Background(), restapi.AuthKey, &ocm.AuthPayload{
+			Username:     subscription.UserName,
+			Organization: subscription.OrgID,
+			Role:         ocm.UserRole,
+		})
+		clusters := m.authzHandler.OwnedBy(ctx, m.db.Unscoped().Model(&common.Cluster{}).Select("id"))
+		infraEnvs := m.authzHandler.OwnedBy(ctx, m.db.Unscoped().Model(&common.InfraEnv{}).Select("id"))
+		query = query.Where("events.cluster_id IN (?) OR events.infra_env_id IN (?)", clusters, infraEnvs)
+	}
+	return query
+}
+
+func newDelivery(subscription *common.EventSubscription, event *common.Event) (*models.EventSubscriptionDelivery, error) {
+	cloudEvent := CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              fmt.Sprint(event.ID),
+		Source:          CloudEventsSource,
+		Type:            CloudEventsTypePrefix + event.Name,
+		Subject:         eventSubject(&event.Event),
+		DataContentType: "application/json",
+		Data:            &event.Event,
+	}
+	if event.EventTime != nil {
+		cloudEvent.Time = event.EventTime.String()
+	}
+	payload, err := json.Marshal(&cloudEvent)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal event %d", event.ID)
+	}
+	now := strfmt.DateTime(time.Now())
+	return &models.EventSubscriptionDelivery{
+		SubscriptionID: subscription.ID,
+		EventID:        int64(event.ID),
+		EventName:      event.Name,
+		ClusterID:      event.ClusterID,
+		Status:         swag.String(models.EventSubscriptionDeliveryStatusPending),
+		Payload:        string(payload),
+		CreatedAt:      now,
+		NextAttemptAt:  now,
+	}, nil
+}
+
+func eventSubject(event *models.Event) string {
+	switch {
+	case event.HostID != nil:
+		return "hosts/" + event.HostID.String()
+	case event.ClusterID != nil:
+		return "clusters/" + event.ClusterID.String()
+	case event.InfraEnvID != nil:
+		return "infra-envs/" + event.InfraEnvID.String()
+	}
+	return ""
+}
+
+func (m *Manager) sendPendingDeliveries() error {
+	// The deliveries of disabled subscriptions stay pending until the subscription is enabled again
+	enabled := m.db.Model(&common.EventSubscription{}).Select("id").Where("enabled = ?", true)
+	var deliveries []*models.EventSubscriptionDelivery
+	if err := m.db.Where("status = ? and next_attempt_at <= ? and subscription_id IN (?)",
+		models.EventSubscriptionDeliveryStatusPending, time.Now(), enabled).
+		Order("id").Limit(m.MaxEventsPerQuery).Find(&deliveries).Error; err != nil {
+		return err
+	}
+
+	// The deliveries of each subscription are sent in order, and concurrently with those of the other subscriptions,
+	// so that an endpoint that doesn't respond only delays its own deliveries
+	var subscriptionIDs []strfmt.UUID
+	subscriptionDeliveries := make(map[strfmt.UUID][]*models.EventSubscriptionDelivery)
+	for _, delivery := range deliveries {
+		if _, ok := subscriptionDeliveries[*delivery.SubscriptionID]; !ok {
+			subscriptionIDs = append(subscriptionIDs, *delivery.SubscriptionID)
+		}
+		subscriptionDeliveries[*delivery.SubscriptionID] = append(subscriptionDeliveries[*delivery.SubscriptionID], delivery)
+	}
+
+	workers := m.DeliveryWorkers
+	if workers < 1 {
+		workers = 1
+	}
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		firstErr error
+	)
+	subscriptionIDsCh := make(chan strfmt.UUID)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for subscriptionID := range subscriptionIDsCh {
+				if err := m.sendSubscriptionDeliveries(subscriptionID, subscriptionDeliveries[subscriptionID]); err != nil {
+					mutex.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for _, subscriptionID := range subscriptionIDs {
+		subscriptionIDsCh <- subscriptionID
+	}
+	close(subscriptionIDsCh)
+	wg.Wait()
+	return firstErr
+}
+
+// sendSubscriptionDeliveries sends the deliveries of the subscription in order. Once a delivery fails the following
+// ones are left pending, to be sent after it is retried, rather than waiting again for an endpoint that is down.
+func (m *Manager) sendSubscriptionDeliveries(subscriptionID strfmt.UUID, deliveries []*models.EventSubscriptionDelivery) error {
+	subscription := &common.EventSubscription{}
+	if err := m.db.Take(subscription, "id = ?", subscriptionID.String()).Error; err != nil {
+		// The subscription may have been deleted since the deliveries were selected
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	for _, delivery := range deliveries {
+		m.attemptDelivery(subscription, delivery)
+		if err := m.db.Select("*").Save(delivery).Error; err != nil {
+			return err
+		}
+		if swag.StringValue(delivery.Status) != models.EventSubscriptionDeliveryStatusDelivered {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *Manager) attemptDelivery(subscription *common.EventSubscription, delivery *models.EventSubscriptionDelivery) {
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = strfmt.DateTime(now)
+	delivery.ResponseCode = 0
+	delivery.Error = ""
+
+	err := m.send(subscription, delivery)
+	if err == nil {
+		delivery.Status = swag.String(models.EventSubscriptionDeliveryStatusDelivered)
+		return
+	}
+	delivery.Error = err.Error()
+	if len(delivery.Error) > maxDeliveryErrorLength {
+		delivery.Error = delivery.Error[:maxDeliveryErrorLength]
+	}
+	if delivery.Attempts >= m.MaxDeliveryAttempts {
+		m.log.WithError(err).Warnf("Failed to deliver event %d to event subscription %s after %d attempts",
+			delivery.EventID, subscription.ID, delivery.Attempts)
+		delivery.Status = swag.String(models.EventSubscriptionDeliveryStatusFailed)
+		return
+	}
+	delivery.NextAttemptAt = strfmt.DateTime(now.Add(m.retryBackoff(delivery.Attempts)))
+}
+
+// retryBackoff returns the delay before the next attempt, doubled after each failed attempt
+func (m *Manager) retryBackoff(attempts int64) time.Duration {
+	backoff := m.RetryBackoff
+	for i := int64(1); i < attempts && backoff < m.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > m.MaxRetryBackoff {
+		backoff = m.MaxRetryBackoff
+	}
+	return backoff
+}
+
+func (m *Manager) send(subscription *common.EventSubscription, delivery *models.EventSubscriptionDelivery) error {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, swag.StringValue(subscription.URL), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", CloudEventsContentType)
+	if subscription.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(subscription.Secret, body))
+	}
+	httpClient := m.restrictedHTTPClient
+	if isUnrestricted(subscription) {
+		httpClient = m.httpClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	delivery.ResponseCode = int64(resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("endpoint replied with status %s", resp.Status)
+	}
+	return nil
+}
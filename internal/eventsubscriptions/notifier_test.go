@@ -0,0 +1,262 @@
+package eventsubscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type receivedEvent struct {
+	header http.Header
+	body   []byte
+}
+
+var _ = Describe("event delivery", func() {
+	var (
+		db           *gorm.DB
+		dbName       string
+		manager      *Manager
+		ctx          context.Context
+		server       *httptest.Server
+		received     chan receivedEvent
+		responseCode int
+		clusterID    strfmt.UUID
+	)
+
+	createEvent := func(name, severity string, clusterID strfmt.UUID) *common.Event {
+		eventTime := strfmt.DateTime(time.Now())
+		event := &common.Event{Event: models.Event{
+			Name:      name,
+			Severity:  swag.String(severity),
+			Category:  models.EventCategoryUser,
+			ClusterID: &clusterID,
+			EventTime: &eventTime,
+			Message:   swag.String(name),
+		}}
+		Expect(db.Create(event).Error).ToNot(HaveOccurred())
+		return event
+	}
+
+	getDeliveries := func() []*models.EventSubscriptionDelivery {
+		var deliveries []*models.EventSubscriptionDelivery
+		Expect(db.Order("id").Find(&deliveries).Error).ToNot(HaveOccurred())
+		return deliveries
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		manager = newManager(db, Config{
+			MaxEventsPerQuery:   100,
+			MaxDeliveryAttempts: 2,
+			MaxRetryBackoff:     time.Hour,
+			DeliveryTimeout:     5 * time.Second,
+			DeliveriesRetention: time.Hour,
+			DeliveryWorkers:     2,
+			AllowedNetworks:     []string{"127.0.0.0/8"},
+		})
+		ctx = userContext("user1", "org1")
+		received = make(chan receivedEvent, 10)
+		responseCode = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received <- receivedEvent{header: r.Header, body: body}
+			w.WriteHeader(responseCode)
+		}))
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org1"}}).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("delivers the matching events as signed CloudEvents", func() {
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", server.URL))
+		otherClusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherClusterID, OrgID: "org2"}}).Error).ToNot(HaveOccurred())
+
+		event := createEvent("cluster_installation_failed", models.EventSeverityError, clusterID)
+		createEvent("cluster_status_updated", models.EventSeverityInfo, clusterID)
+		createEvent("cluster_installation_failed", models.EventSeverityError, otherClusterID)
+
+		manager.DeliverEvents()
+
+		var r receivedEvent
+		Eventually(received).Should(Receive(&r))
+		Expect(r.header.Get("Content-Type")).To(Equal(CloudEventsContentType))
+		Expect(r.header.Get(SignatureHeader)).To(Equal(Sign("secret", r.body)))
+		var cloudEvent CloudEvent
+		Expect(json.Unmarshal(r.body, &cloudEvent)).To(Succeed())
+		Expect(cloudEvent.SpecVersion).To(Equal(CloudEventsSpecVersion))
+		Expect(cloudEvent.ID).To(Equal(swag.FormatUint64(uint64(event.ID))))
+		Expect(cloudEvent.Type).To(Equal(CloudEventsTypePrefix + "cluster_installation_failed"))
+		Expect(cloudEvent.Subject).To(Equal("clusters/" + clusterID.String()))
+		Expect(cloudEvent.Data.Name).To(Equal("cluster_installation_failed"))
+		Consistently(received).ShouldNot(Receive())
+
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(*deliveries[0].SubscriptionID).To(Equal(*subscription.ID))
+		Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.EventSubscriptionDeliveryStatusDelivered))
+		Expect(deliveries[0].ResponseCode).To(Equal(int64(http.StatusOK)))
+		Expect(deliveries[0].Attempts).To(Equal(int64(1)))
+
+		By("not delivering the events again")
+		manager.DeliverEvents()
+		Expect(getDeliveries()).To(HaveLen(1))
+	})
+
+	It("delivers the events of all organizations to subscriptions of admins", func() {
+		otherClusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherClusterID, OrgID: "org2"}}).Error).ToNot(HaveOccurred())
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", server.URL))
+		Expect(db.Model(&common.EventSubscription{}).Where("id = ?", subscription.ID.String()).
+			Update("created_by_admin", true).Error).ToNot(HaveOccurred())
+
+		createEvent("cluster_installation_failed", models.EventSeverityError, otherClusterID)
+		manager.DeliverEvents()
+		Eventually(received).Should(Receive())
+	})
+
+	It("delivers only the events of its namespace to the subscription of a kube-api resource", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).
+			Update("kube_key_namespace", "ns").Error).ToNot(HaveOccurred())
+		otherClusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherClusterID}, KubeKeyNamespace: "other-ns"}).Error).ToNot(HaveOccurred())
+		_, err := manager.RegisterEventSubscriptionInternal(context.Background(), types.NamespacedName{Name: "sub1", Namespace: "ns"},
+			newSubscriptionParams("sub1", server.URL))
+		Expect(err).ToNot(HaveOccurred())
+
+		event := createEvent("cluster_installation_failed", models.EventSeverityError, clusterID)
+		createEvent("cluster_installation_failed", models.EventSeverityError, otherClusterID)
+		manager.DeliverEvents()
+
+		var r receivedEvent
+		Eventually(received).Should(Receive(&r))
+		var cloudEvent CloudEvent
+		Expect(json.Unmarshal(r.body, &cloudEvent)).To(Succeed())
+		Expect(cloudEvent.ID).To(Equal(swag.FormatUint64(uint64(event.ID))))
+		Consistently(received).ShouldNot(Receive())
+	})
+
+	It("retries failed deliveries until the maximal number of attempts", func() {
+		responseCode = http.StatusServiceUnavailable
+		registerSubscription(ctx, manager, newSubscriptionParams("sub1", server.URL))
+		createEvent("cluster_installation_failed", models.EventSeverityError, clusterID)
+
+		manager.DeliverEvents()
+		Eventually(received).Should(Receive())
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.EventSubscriptionDeliveryStatusPending))
+		Expect(deliveries[0].ResponseCode).To(Equal(int64(http.StatusServiceUnavailable)))
+		Expect(deliveries[0].Error).ToNot(BeEmpty())
+
+		manager.DeliverEvents()
+		Eventually(received).Should(Receive())
+		deliveries = getDeliveries()
+		Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.EventSubscriptionDeliveryStatusFailed))
+		Expect(deliveries[0].Attempts).To(Equal(int64(2)))
+	})
+
+	It("doesn't connect to internal addresses", func() {
+		manager = newManager(db, Config{MaxEventsPerQuery: 100, MaxDeliveryAttempts: 2, MaxRetryBackoff: time.Hour,
+			DeliveryTimeout: 5 * time.Second, DeliveryWorkers: 1})
+		// The host of the subscription resolved to a public address when it was created
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", "https://example.com/hook"))
+		Expect(db.Model(&common.EventSubscription{}).Where("id = ?", subscription.ID.String()).
+			Update("url", server.URL).Error).ToNot(HaveOccurred())
+		createEvent("cluster_installation_failed", models.EventSeverityError, clusterID)
+
+		manager.DeliverEvents()
+		Consistently(received).ShouldNot(Receive())
+		deliveries := getDeliveries()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(swag.StringValue(deliveries[0].Status)).To(Equal(models.EventSubscriptionDeliveryStatusPending))
+		Expect(deliveries[0].Error).To(ContainSubstring("not allowed"))
+	})
+
+	It("delivers to the other subscriptions while an endpoint doesn't respond", func() {
+		unblock := make(chan struct{})
+		slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-unblock
+		}))
+		defer slowServer.Close()
+		defer close(unblock)
+		registerSubscription(ctx, manager, newSubscriptionParams("slow", slowServer.URL))
+		registerSubscription(ctx, manager, newSubscriptionParams("fast", server.URL))
+		createEvent("cluster_installation_failed", models.EventSeverityError, clusterID)
+		createEvent("cluster_installation_failed", models.EventSeverityError, clusterID)
+
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			manager.DeliverEvents()
+			close(done)
+		}()
+		Eventually(received, 2*time.Second).Should(Receive())
+		Eventually(received, 2*time.Second).Should(Receive())
+		Eventually(done, 10*time.Second).Should(BeClosed())
+
+		By("leaving the following deliveries of the endpoint pending once one failed")
+		var slowDeliveries []*models.EventSubscriptionDelivery
+		for _, delivery := range getDeliveries() {
+			if delivery.ResponseCode != int64(http.StatusOK) {
+				slowDeliveries = append(slowDeliveries, delivery)
+			}
+		}
+		Expect(slowDeliveries).To(HaveLen(2))
+		Expect(slowDeliveries[0].Attempts).To(Equal(int64(1)))
+		Expect(slowDeliveries[1].Attempts).To(BeZero())
+	})
+
+	It("doesn't send the deliveries of disabled subscriptions", func() {
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", server.URL))
+		createEvent("cluster_installation_failed", models.EventSeverityError, clusterID)
+		Expect(manager.queueEvents()).To(Succeed())
+		Expect(db.Model(&common.EventSubscription{}).Where("id = ?", subscription.ID.String()).
+			Update("enabled", false).Error).ToNot(HaveOccurred())
+
+		manager.DeliverEvents()
+		Consistently(received).ShouldNot(Receive())
+		Expect(swag.StringValue(getDeliveries()[0].Status)).To(Equal(models.EventSubscriptionDeliveryStatusPending))
+	})
+
+	It("deletes the old deliveries", func() {
+		subscription := registerSubscription(ctx, manager, newSubscriptionParams("sub1", server.URL))
+		for _, createdAt := range []time.Time{time.Now().Add(-2 * time.Hour), time.Now()} {
+			Expect(db.Create(&models.EventSubscriptionDelivery{
+				SubscriptionID: subscription.ID,
+				Status:         swag.String(models.EventSubscriptionDeliveryStatusDelivered),
+				CreatedAt:      strfmt.DateTime(createdAt),
+			}).Error).ToNot(HaveOccurred())
+		}
+
+		manager.DeliverEvents()
+		Expect(getDeliveries()).To(HaveLen(1))
+	})
+
+	It("doubles the retry backoff up to the maximal backoff", func() {
+		manager.RetryBackoff = 10 * time.Second
+		manager.MaxRetryBackoff = time.Minute
+		Expect(manager.retryBackoff(1)).To(Equal(10 * time.Second))
+		Expect(manager.retryBackoff(2)).To(Equal(20 * time.Second))
+		Expect(manager.retryBackoff(3)).To(Equal(40 * time.Second))
+		Expect(manager.retryBackoff(4)).To(Equal(time.Minute))
+		Expect(manager.retryBackoff(100)).To(Equal(time.Minute))
+	})
+})
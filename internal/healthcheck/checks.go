@@ -0,0 +1,126 @@
+package healthcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/thread"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// DatabaseCheck pings the database, and reports the usage of the connection pool
+func DatabaseCheck(db *gorm.DB, metricsAPI metrics.API) Check {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		stats := sqlDB.Stats()
+		metricsAPI.DBConnections(stats.InUse, stats.Idle, stats.MaxOpenConnections)
+		details := map[string]interface{}{
+			"open_connections":      stats.OpenConnections,
+			"in_use":                stats.InUse,
+			"idle":                  stats.Idle,
+			"max_open_connections":  stats.MaxOpenConnections,
+			"wait_count":            stats.WaitCount,
+			"wait_duration_seconds": stats.WaitDuration.Seconds(),
+		}
+		if err = sqlDB.PingContext(ctx); err != nil {
+			return details, errors.Wrap(err, "failed to ping the database")
+		}
+		return details, nil
+	}
+}
+
+// ObjectStorageCheck checks that the objects can be stored: that the bucket can be accessed, or that the usage of the
+// filesystem is below the threshold
+func ObjectStorageCheck(objectHandler s3wrapper.API) Check {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		health, err := objectHandler.CheckHealth(ctx)
+		if health == nil || health.FilesystemUsagePercentage == nil {
+			return nil, err
+		}
+		return map[string]interface{}{"filesystem_usage_percentage": *health.FilesystemUsagePercentage}, err
+	}
+}
+
+// LeaderCheck reports whether the service is the leader. It doesn't fail, as only one of the replicas of the service
+// is the leader.
+func LeaderCheck(lead leader.Leader, metricsAPI metrics.API) Check {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		isLeader := lead.IsLeader()
+		metricsAPI.Leader(isLeader)
+		return map[string]interface{}{"is_leader": isLeader}, nil
+	}
+}
+
+// ThreadsCheck fails when one of the threads didn't run within the timeout, as their loop is stuck
+func ThreadsCheck(threads []*thread.Thread, timeout time.Duration) Check {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		details := make(map[string]interface{}, len(threads))
+		var stale []string
+		for _, th := range threads {
+			lastRunStartedAt := th.LastRunStartedAt()
+			details[th.Name()] = lastRunStartedAt
+			if time.Since(lastRunStartedAt) > timeout {
+				stale = append(stale, th.Name())
+			}
+		}
+		if len(stale) > 0 {
+			return details, errors.Errorf("%s did not run in the last %s", strings.Join(stale, ", "), timeout)
+		}
+		return details, nil
+	}
+}
+
+// ImageServiceCheck checks that the health endpoint of the image service responds
+func ImageServiceCheck(baseURL string) Check {
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			// Only the availability of the image service is checked, regardless of the certificate it serves
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	healthURL := strings.TrimSuffix(baseURL, "/") + "/health"
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		if baseURL == "" {
+			return nil, ErrDisabled
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to reach the image service")
+		}
+		defer resp.Body.Close()
+		details := map[string]interface{}{"status_code": resp.StatusCode}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return details, errors.Errorf("the image service responded with status %d", resp.StatusCode)
+		}
+		return details, nil
+	}
+}
+
+// OCMCheck checks that the service can authenticate with OCM. It is disabled when the service doesn't use OCM.
+func OCMCheck(client *ocm.Client) Check {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		if client == nil {
+			return nil, ErrDisabled
+		}
+		if err := client.CheckHealth(ctx); err != nil {
+			return nil, errors.Wrap(err, "failed to authenticate with OCM")
+		}
+		return nil, nil
+	}
+}
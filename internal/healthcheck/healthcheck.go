@@ -0,0 +1,218 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/pkg/thread"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const componentsHealthPath = "/health/components"
+
+type Config struct {
+	Interval time.Duration `envconfig:"HEALTH_CHECK_INTERVAL" default:"30s"`
+	Timeout  time.Duration `envconfig:"HEALTH_CHECK_TIMEOUT" default:"5s"`
+}
+
+// The statuses of the components
+const (
+	StatusOK       = "ok"
+	StatusFailed   = "failed"
+	StatusDisabled = "disabled"
+)
+
+// StatusDegraded is the status of the service when only components that are not critical failed
+const StatusDegraded = "degraded"
+
+// ErrDisabled is returned by the checks of the components that are not used by the service
+var ErrDisabled = errors.New("the component is disabled")
+
+// Check checks a component. It returns details about the status of the component, and an error when the component is
+// not healthy.
+type Check func(ctx context.Context) (map[string]interface{}, error)
+
+type ComponentReport struct {
+	Name string `json:"name"`
+	// Status is one of StatusOK, StatusFailed and StatusDisabled
+	Status string `json:"status"`
+	// Critical components are required for the service to function, the service fails when they fail
+	Critical        bool                   `json:"critical"`
+	Message         string                 `json:"message,omitempty"`
+	Details         map[string]interface{} `json:"details,omitempty"`
+	DurationSeconds float64                `json:"duration_seconds"`
+}
+
+type Report struct {
+	// Status is StatusFailed when a critical component failed, StatusDegraded when another component failed, and
+	// StatusOK otherwise
+	Status     string             `json:"status"`
+	CheckedAt  time.Time          `json:"checked_at"`
+	Components []*ComponentReport `json:"components"`
+}
+
+type component struct {
+	name     string
+	critical bool
+	check    Check
+}
+
+// Checker checks the components that the service depends on periodically, and reports their status both as metrics
+// and through the components health endpoint
+type Checker struct {
+	config     Config
+	log        logrus.FieldLogger
+	metricsAPI metrics.API
+	components []component
+	thread     *thread.Thread
+
+	mu     sync.RWMutex
+	report *Report
+}
+
+func NewChecker(config Config, log logrus.FieldLogger, metricsAPI metrics.API) *Checker {
+	return &Checker{
+		config:     config,
+		log:        log,
+		metricsAPI: metricsAPI,
+	}
+}
+
+// AddComponent adds a component to check. It must be called before the checker is started.
+func (c *Checker) AddComponent(name string, critical bool, check Check) {
+	c.components = append(c.components, component{name: name, critical: critical, check: check})
+}
+
+// Start checks the components now and then every interval
+func (c *Checker) Start() {
+	c.thread = thread.New(c.log, "Health Check", c.config.Interval, c.Run)
+	go c.Run()
+	c.thread.Start()
+}
+
+func (c *Checker) Stop() {
+	if c.thread != nil {
+		c.thread.Stop()
+	}
+}
+
+// Run checks all the components concurrently, each within the timeout
+func (c *Checker) Run() {
+	reports := make([]*ComponentReport, len(c.components))
+	var wg sync.WaitGroup
+	for i := range c.components {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			reports[i] = c.checkComponent(c.components[i])
+		}(i)
+	}
+	wg.Wait()
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Name < reports[j].Name })
+
+	report := &Report{Status: StatusOK, CheckedAt: time.Now(), Components: reports}
+	for _, r := range reports {
+		if r.Status != StatusFailed {
+			continue
+		}
+		if r.Critical {
+			report.Status = StatusFailed
+		} else if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+
+	c.mu.Lock()
+	previous := c.report
+	c.report = report
+	c.mu.Unlock()
+	c.logChanges(previous, report)
+}
+
+func (c *Checker) checkComponent(comp component) *ComponentReport {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+	defer cancel()
+	start := time.Now()
+	details, err := comp.check(ctx)
+	duration := time.Since(start)
+
+	report := &ComponentReport{
+		Name:            comp.name,
+		Status:          StatusOK,
+		Critical:        comp.critical,
+		Details:         details,
+		DurationSeconds: duration.Seconds(),
+	}
+	switch {
+	case errors.Is(err, ErrDisabled):
+		report.Status = StatusDisabled
+		return report
+	case err != nil:
+		report.Status = StatusFailed
+		report.Message = err.Error()
+	}
+	c.metricsAPI.ComponentHealth(comp.name, err == nil, duration)
+	return report
+}
+
+// logChanges logs the components whose status changed, rather than the failures on every check
+func (c *Checker) logChanges(previous, current *Report) {
+	previousStatus := make(map[string]string)
+	if previous != nil {
+		for _, r := range previous.Components {
+			previousStatus[r.Name] = r.Status
+		}
+	}
+	for _, r := range current.Components {
+		if r.Status == previousStatus[r.Name] {
+			continue
+		}
+		if r.Status == StatusFailed {
+			c.log.Errorf("Health check of component %s failed: %s", r.Name, r.Message)
+		} else if previousStatus[r.Name] == StatusFailed {
+			c.log.Infof("Health check of component %s succeeded", r.Name)
+		}
+	}
+}
+
+// Report returns the report of the last check of the components. The components are checked when they weren't checked
+// yet.
+func (c *Checker) Report() *Report {
+	c.mu.RLock()
+	report := c.report
+	c.mu.RUnlock()
+	if report == nil {
+		c.Run()
+		c.mu.RLock()
+		report = c.report
+		c.mu.RUnlock()
+	}
+	return report
+}
+
+// Middleware responds to the components health endpoint with the report of the last check of the components. The
+// status code is 503 when a critical component failed.
+func (c *Checker) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != componentsHealthPath {
+			next.ServeHTTP(w, r)
+			return
+		}
+		report := c.Report()
+		status := http.StatusOK
+		if report.Status == StatusFailed {
+			status = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			c.log.WithError(err).Warn("failed to write the health report")
+		}
+	})
+}
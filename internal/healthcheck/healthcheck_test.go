@@ -0,0 +1,238 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/thread"
+	"github.com/pkg/errors"
+)
+
+func TestHealthcheck(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "healthcheck tests")
+}
+
+func succeeding(ctx context.Context) (map[string]interface{}, error) {
+	return map[string]interface{}{"key": "value"}, nil
+}
+
+func failing(ctx context.Context) (map[string]interface{}, error) {
+	return nil, errors.New("the component is down")
+}
+
+func disabled(ctx context.Context) (map[string]interface{}, error) {
+	return nil, ErrDisabled
+}
+
+var _ = Describe("Checker", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockMetrics *metrics.MockAPI
+		checker     *Checker
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetrics = metrics.NewMockAPI(ctrl)
+		checker = NewChecker(Config{Interval: time.Minute, Timeout: time.Second}, common.GetTestLog(), mockMetrics)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	getReport := func() (int, *Report) {
+		handler := checker.Middleware(http.NotFoundHandler())
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, componentsHealthPath, nil))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+		var report Report
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &report)).To(Succeed())
+		return recorder.Code, &report
+	}
+
+	It("reports ok when all the components succeed", func() {
+		mockMetrics.EXPECT().ComponentHealth("database", true, gomock.Any()).Times(1)
+		mockMetrics.EXPECT().ComponentHealth("ocm", true, gomock.Any()).Times(1)
+		checker.AddComponent("ocm", false, succeeding)
+		checker.AddComponent("database", true, succeeding)
+
+		code, report := getReport()
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(report.Status).To(Equal(StatusOK))
+		Expect(report.Components).To(HaveLen(2))
+		Expect(report.Components[0].Name).To(Equal("database"))
+		Expect(report.Components[0].Status).To(Equal(StatusOK))
+		Expect(report.Components[0].Critical).To(BeTrue())
+		Expect(report.Components[0].Details).To(HaveKeyWithValue("key", "value"))
+		Expect(report.Components[1].Name).To(Equal("ocm"))
+	})
+
+	It("reports degraded when a component that is not critical fails", func() {
+		mockMetrics.EXPECT().ComponentHealth("database", true, gomock.Any()).Times(1)
+		mockMetrics.EXPECT().ComponentHealth("ocm", false, gomock.Any()).Times(1)
+		checker.AddComponent("database", true, succeeding)
+		checker.AddComponent("ocm", false, failing)
+
+		code, report := getReport()
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(report.Status).To(Equal(StatusDegraded))
+		Expect(report.Components[1].Status).To(Equal(StatusFailed))
+		Expect(report.Components[1].Message).To(Equal("the component is down"))
+	})
+
+	It("reports failed when a critical component fails", func() {
+		mockMetrics.EXPECT().ComponentHealth("database", false, gomock.Any()).Times(1)
+		mockMetrics.EXPECT().ComponentHealth("ocm", false, gomock.Any()).Times(1)
+		checker.AddComponent("database", true, failing)
+		checker.AddComponent("ocm", false, failing)
+
+		code, report := getReport()
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(report.Status).To(Equal(StatusFailed))
+	})
+
+	It("reports disabled components without failing or reporting their metrics", func() {
+		mockMetrics.EXPECT().ComponentHealth("database", true, gomock.Any()).Times(1)
+		checker.AddComponent("database", true, succeeding)
+		checker.AddComponent("image-service", false, disabled)
+
+		code, report := getReport()
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(report.Status).To(Equal(StatusOK))
+		Expect(report.Components[1].Status).To(Equal(StatusDisabled))
+	})
+
+	It("fails the components that don't respond within the timeout", func() {
+		checker.config.Timeout = 10 * time.Millisecond
+		mockMetrics.EXPECT().ComponentHealth("database", false, gomock.Any()).Times(1)
+		checker.AddComponent("database", true, func(ctx context.Context) (map[string]interface{}, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		code, report := getReport()
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(report.Components[0].Message).To(ContainSubstring("deadline exceeded"))
+	})
+
+	It("responds with the report of the last check", func() {
+		mockMetrics.EXPECT().ComponentHealth("database", true, gomock.Any()).Times(1)
+		checker.AddComponent("database", true, succeeding)
+		checker.Run()
+
+		_, first := getReport()
+		_, second := getReport()
+		Expect(second.CheckedAt).To(BeTemporally("==", first.CheckedAt))
+	})
+
+	It("passes the other requests through", func() {
+		handler := checker.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		}))
+		for _, req := range []*http.Request{
+			httptest.NewRequest(http.MethodGet, "/health", nil),
+			httptest.NewRequest(http.MethodPost, componentsHealthPath, nil),
+		} {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			Expect(recorder.Code).To(Equal(http.StatusTeapot))
+		}
+	})
+})
+
+var _ = Describe("Checks", func() {
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("ObjectStorageCheck", func() {
+		var mockS3 *s3wrapper.MockAPI
+
+		BeforeEach(func() {
+			mockS3 = s3wrapper.NewMockAPI(ctrl)
+		})
+
+		It("reports the usage of the filesystem", func() {
+			mockS3.EXPECT().CheckHealth(ctx).Return(&s3wrapper.Health{FilesystemUsagePercentage: swag.Float64(42)}, nil)
+			details, err := ObjectStorageCheck(mockS3)(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(details).To(HaveKeyWithValue("filesystem_usage_percentage", 42.0))
+		})
+
+		It("fails when the object storage is not healthy", func() {
+			mockS3.EXPECT().CheckHealth(ctx).Return(nil, errors.New("bucket not found"))
+			_, err := ObjectStorageCheck(mockS3)(ctx)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ThreadsCheck", func() {
+		It("fails when a thread didn't run within the timeout", func() {
+			running := thread.New(common.GetTestLog(), "Running Monitor", time.Hour, func() {})
+			running.Start()
+			defer running.Stop()
+			stuck := thread.New(common.GetTestLog(), "Stuck Monitor", time.Hour, func() {})
+
+			details, err := ThreadsCheck([]*thread.Thread{running}, time.Minute)(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(details).To(HaveKey("Running Monitor"))
+
+			_, err = ThreadsCheck([]*thread.Thread{running, stuck}, time.Minute)(ctx)
+			Expect(err).To(MatchError(ContainSubstring("Stuck Monitor did not run")))
+		})
+	})
+
+	Context("ImageServiceCheck", func() {
+		It("succeeds when the image service is healthy", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/health"))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+			details, err := ImageServiceCheck(server.URL + "/")(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(details).To(HaveKeyWithValue("status_code", http.StatusOK))
+		})
+
+		It("fails when the image service is not healthy", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+			_, err := ImageServiceCheck(server.URL)(ctx)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("is disabled when the image service is not configured", func() {
+			_, err := ImageServiceCheck("")(ctx)
+			Expect(err).To(Equal(ErrDisabled))
+		})
+	})
+
+	It("disables the OCM check when the service doesn't use OCM", func() {
+		_, err := OCMCheck(nil)(ctx)
+		Expect(err).To(Equal(ErrDisabled))
+	})
+})
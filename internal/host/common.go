@@ -92,6 +92,13 @@ var hostStatusesBeforeInstallationOrUnbound = [...]string{
 	models.HostStatusBinding,
 }
 
+// hostStatusesAcceptingDiagnostics are the statuses in which the instruction manager delivers diagnostics to the agent
+var hostStatusesAcceptingDiagnostics = [...]string{
+	models.HostStatusDiscovering, models.HostStatusKnown, models.HostStatusInsufficient, models.HostStatusPendingForInput,
+	models.HostStatusError, models.HostStatusCancelled,
+	models.HostStatusDiscoveringUnbound, models.HostStatusInsufficientUnbound, models.HostStatusKnownUnbound,
+}
+
 type UpdateReply struct {
 	State     string
 	IsChanged bool
@@ -0,0 +1,126 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/hostcommands"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// MaxDiagnosticOutputLength is the maximal number of bytes that are kept from the output and the error of a diagnostic
+const MaxDiagnosticOutputLength = 1024 * 1024
+
+func (m *Manager) CreateDiagnostic(ctx context.Context, h *models.Host, action models.HostDiagnosticAction, requestedBy string) (*models.HostDiagnostic, error) {
+	log := logutil.FromContext(ctx, m.log)
+
+	if !hostcommands.IsDiagnosticActionAllowed(action) {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Errorf("Diagnostic action %s is not allowed", action))
+	}
+	hostStatus := swag.StringValue(h.Status)
+	if !funk.ContainsString(hostStatusesAcceptingDiagnostics[:], hostStatus) {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Host is in %s state, diagnostics can be run only in one of %s states",
+				hostStatus, hostStatusesAcceptingDiagnostics[:]))
+	}
+
+	diagnostic := &models.HostDiagnostic{
+		ID:          strfmt.UUID(uuid.New().String()),
+		HostID:      *h.ID,
+		InfraEnvID:  h.InfraEnvID,
+		Action:      action,
+		Status:      models.HostDiagnosticStatusPending,
+		RequestedBy: requestedBy,
+		RequestedAt: strfmt.DateTime(time.Now()),
+	}
+	if err := m.db.Create(diagnostic).Error; err != nil {
+		log.WithError(err).Errorf("failed to create diagnostic %s for host %s", action, h.ID.String())
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	eventgen.SendHostDiagnosticRequestedEvent(ctx, m.eventsHandler, *h.ID, hostutil.GetHostnameForMsg(h), h.InfraEnvID,
+		h.ClusterID, string(action), requestedBy)
+	return diagnostic, nil
+}
+
+func (m *Manager) UpdateDiagnosticResult(ctx context.Context, h *models.Host, reply *models.StepReply) error {
+	var diagnostic models.HostDiagnostic
+	if err := m.db.Take(&diagnostic, "host_id = ? and infra_env_id = ? and step_id = ?",
+		h.ID.String(), h.InfraEnvID.String(), reply.StepID).Error; err != nil {
+		return errors.Wrapf(err, "failed to find diagnostic of host %s for step %s", h.ID.String(), reply.StepID)
+	}
+
+	status := models.HostDiagnosticStatusCompleted
+	if reply.ExitCode != 0 {
+		status = models.HostDiagnosticStatusFailed
+	}
+	updates := map[string]interface{}{
+		"status":       status,
+		"exit_code":    reply.ExitCode,
+		"output":       truncateDiagnosticOutput(reply.Output),
+		"error":        truncateDiagnosticOutput(reply.Error),
+		"completed_at": strfmt.DateTime(time.Now()),
+	}
+	// A diagnostic that already expired keeps its status, the result came too late
+	result := m.db.Model(&models.HostDiagnostic{}).Where("id = ? and status = ?", diagnostic.ID.String(), models.HostDiagnosticStatusSent).
+		Updates(updates)
+	if result.Error != nil {
+		return errors.Wrapf(result.Error, "failed to update diagnostic %s of host %s", diagnostic.ID.String(), h.ID.String())
+	}
+	if result.RowsAffected == 0 {
+		logutil.FromContext(ctx, m.log).Warnf("Ignoring the result of diagnostic %s of host %s in status %s",
+			diagnostic.ID.String(), h.ID.String(), diagnostic.Status)
+		return nil
+	}
+
+	if status == models.HostDiagnosticStatusFailed {
+		eventgen.SendHostDiagnosticFailedEvent(ctx, m.eventsHandler, *h.ID, hostutil.GetHostnameForMsg(h), h.InfraEnvID,
+			h.ClusterID, string(diagnostic.Action), reply.ExitCode)
+	} else {
+		eventgen.SendHostDiagnosticCompletedEvent(ctx, m.eventsHandler, *h.ID, hostutil.GetHostnameForMsg(h), h.InfraEnvID,
+			h.ClusterID, string(diagnostic.Action))
+	}
+	return nil
+}
+
+// expireDiagnostics fails the diagnostics that didn't complete within the timeout, e.g. because the host stopped
+// asking for instructions before the diagnostic was sent, or because the agent never replied to it
+func (m *Manager) expireDiagnostics() {
+	if m.Config.DiagnosticTimeout <= 0 {
+		return
+	}
+	now := time.Now()
+	result := m.db.Model(&models.HostDiagnostic{}).
+		Where("status in (?) and requested_at < ?",
+			[]string{models.HostDiagnosticStatusPending, models.HostDiagnosticStatusSent}, now.Add(-m.Config.DiagnosticTimeout)).
+		Updates(map[string]interface{}{
+			"status":       models.HostDiagnosticStatusFailed,
+			"error":        fmt.Sprintf("the diagnostic did not complete within %s", m.Config.DiagnosticTimeout),
+			"completed_at": strfmt.DateTime(now),
+		})
+	if result.Error != nil {
+		m.log.WithError(result.Error).Error("failed to expire the diagnostics of the hosts")
+		return
+	}
+	if result.RowsAffected > 0 {
+		m.log.Infof("Expired %d diagnostics that did not complete within %s", result.RowsAffected, m.Config.DiagnosticTimeout)
+	}
+}
+
+func truncateDiagnosticOutput(output string) string {
+	if len(output) <= MaxDiagnosticOutputLength {
+		return output
+	}
+	return output[:MaxDiagnosticOutputLength]
+}
@@ -0,0 +1,171 @@
+package host
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Diagnostics", func() {
+	var (
+		ctx        = context.Background()
+		db         *gorm.DB
+		dbName     string
+		ctrl       *gomock.Controller
+		mockEvents *eventsapi.MockHandler
+		m          *Manager
+		host       models.Host
+	)
+
+	getDiagnostic := func(diagnosticID strfmt.UUID) *models.HostDiagnostic {
+		var diagnostic models.HostDiagnostic
+		Expect(db.Take(&diagnostic, "id = ?", diagnosticID.String()).Error).ToNot(HaveOccurred())
+		return &diagnostic
+	}
+
+	createDiagnostic := func(status string, requestedAt time.Time) *models.HostDiagnostic {
+		diagnostic := &models.HostDiagnostic{
+			ID:          strfmt.UUID(uuid.New().String()),
+			HostID:      *host.ID,
+			InfraEnvID:  host.InfraEnvID,
+			Action:      models.HostDiagnosticActionJournal,
+			Status:      status,
+			StepID:      "execute-" + uuid.New().String()[:8],
+			RequestedAt: strfmt.DateTime(requestedAt),
+		}
+		Expect(db.Create(diagnostic).Error).ToNot(HaveOccurred())
+		return diagnostic
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		cfg := *defaultConfig
+		cfg.DiagnosticTimeout = 30 * time.Minute
+		m = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, &cfg, &leader.DummyElector{}, nil, nil, false, nil)
+		host = hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()),
+			strfmt.UUID(uuid.New().String()), models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	Context("CreateDiagnostic", func() {
+		It("creates a pending diagnostic", func() {
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostDiagnosticRequestedEventName),
+				eventstest.WithHostIdMatcher(host.ID.String()))).Times(1)
+			diagnostic, err := m.CreateDiagnostic(ctx, &host, models.HostDiagnosticActionIPRoutes, "alice")
+			Expect(err).ToNot(HaveOccurred())
+
+			created := getDiagnostic(diagnostic.ID)
+			Expect(created.Status).To(Equal(models.HostDiagnosticStatusPending))
+			Expect(created.Action).To(Equal(models.HostDiagnosticActionIPRoutes))
+			Expect(created.RequestedBy).To(Equal("alice"))
+		})
+
+		It("rejects an action outside of the allowlist", func() {
+			_, err := m.CreateDiagnostic(ctx, &host, models.HostDiagnosticAction("rm-rf"), "alice")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		})
+
+		It("rejects a host that is installing", func() {
+			host.Status = swag.String(models.HostStatusInstallingInProgress)
+			_, err := m.CreateDiagnostic(ctx, &host, models.HostDiagnosticActionIPRoutes, "alice")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+		})
+	})
+
+	Context("UpdateDiagnosticResult", func() {
+		It("completes the diagnostic with its truncated output", func() {
+			diagnostic := createDiagnostic(models.HostDiagnosticStatusSent, time.Now())
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostDiagnosticCompletedEventName),
+				eventstest.WithHostIdMatcher(host.ID.String()))).Times(1)
+			Expect(m.UpdateDiagnosticResult(ctx, &host, &models.StepReply{
+				StepID: diagnostic.StepID,
+				Output: strings.Repeat("a", MaxDiagnosticOutputLength+1),
+			})).To(Succeed())
+
+			updated := getDiagnostic(diagnostic.ID)
+			Expect(updated.Status).To(Equal(models.HostDiagnosticStatusCompleted))
+			Expect(updated.Output).To(HaveLen(MaxDiagnosticOutputLength))
+			Expect(time.Time(updated.CompletedAt)).ToNot(BeZero())
+		})
+
+		It("fails the diagnostic when the command failed", func() {
+			diagnostic := createDiagnostic(models.HostDiagnosticStatusSent, time.Now())
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostDiagnosticFailedEventName),
+				eventstest.WithHostIdMatcher(host.ID.String()))).Times(1)
+			Expect(m.UpdateDiagnosticResult(ctx, &host, &models.StepReply{
+				StepID:   diagnostic.StepID,
+				ExitCode: 1,
+				Error:    "command not found",
+			})).To(Succeed())
+
+			updated := getDiagnostic(diagnostic.ID)
+			Expect(updated.Status).To(Equal(models.HostDiagnosticStatusFailed))
+			Expect(updated.ExitCode).To(Equal(int64(1)))
+			Expect(updated.Error).To(Equal("command not found"))
+		})
+
+		It("ignores the result of an expired diagnostic", func() {
+			diagnostic := createDiagnostic(models.HostDiagnosticStatusFailed, time.Now().Add(-time.Hour))
+			Expect(m.UpdateDiagnosticResult(ctx, &host, &models.StepReply{StepID: diagnostic.StepID, Output: "late"})).To(Succeed())
+			Expect(getDiagnostic(diagnostic.ID).Output).To(BeEmpty())
+		})
+
+		It("fails for an unknown step", func() {
+			Expect(m.UpdateDiagnosticResult(ctx, &host, &models.StepReply{StepID: "execute-unknown"})).ToNot(Succeed())
+		})
+	})
+
+	Context("expiry", func() {
+		It("fails the diagnostics that didn't complete within the timeout", func() {
+			sent := createDiagnostic(models.HostDiagnosticStatusSent, time.Now().Add(-time.Hour))
+			pending := createDiagnostic(models.HostDiagnosticStatusPending, time.Now().Add(-time.Hour))
+			recent := createDiagnostic(models.HostDiagnosticStatusSent, time.Now())
+			completed := createDiagnostic(models.HostDiagnosticStatusCompleted, time.Now().Add(-time.Hour))
+
+			m.expireDiagnostics()
+
+			for _, id := range []strfmt.UUID{sent.ID, pending.ID} {
+				expired := getDiagnostic(id)
+				Expect(expired.Status).To(Equal(models.HostDiagnosticStatusFailed))
+				Expect(expired.Error).To(ContainSubstring("did not complete within 30m0s"))
+			}
+			Expect(getDiagnostic(recent.ID).Status).To(Equal(models.HostDiagnosticStatusSent))
+			Expect(getDiagnostic(completed.ID).Status).To(Equal(models.HostDiagnosticStatusCompleted))
+		})
+
+		It("doesn't expire the diagnostics without a timeout", func() {
+			m.Config.DiagnosticTimeout = 0
+			sent := createDiagnostic(models.HostDiagnosticStatusSent, time.Now().Add(-time.Hour))
+			m.expireDiagnostics()
+			Expect(getDiagnostic(sent.ID).Status).To(Equal(models.HostDiagnosticStatusSent))
+		})
+	})
+})
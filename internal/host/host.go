@@ -85,6 +85,12 @@ type Config struct {
 	BootstrapHostMAC         string                  `envconfig:"BOOTSTRAP_HOST_MAC" default:""`        // For ephemeral installer to ensure the bootstrap for the (single) cluster lands on the same host as assisted-service
 	MaxHostDisconnectionTime time.Duration           `envconfig:"HOST_MAX_DISCONNECTION_TIME" default:"3m"`
 	EnableVirtualInterfaces  bool                    `envconfig:"ENABLE_VIRTUAL_INTERFACES" default:"false"`
+	HostStageTimeouts        HostStageTimeouts       `envconfig:"HOST_STAGE_TIMEOUTS" default:""` // JSON-formatted list of models.HostStageTimeout
+	// A validation that changes between success and failure more than the threshold within the window is flapping
+	ValidationFlappingThreshold int           `envconfig:"HOST_VALIDATION_FLAPPING_THRESHOLD" default:"4"`
+	ValidationFlappingWindow    time.Duration `envconfig:"HOST_VALIDATION_FLAPPING_WINDOW" default:"30m"`
+	// A diagnostic that didn't complete within the timeout since it was requested fails
+	DiagnosticTimeout time.Duration `envconfig:"HOST_DIAGNOSTIC_TIMEOUT" default:"30m"`
 }
 
 //go:generate mockgen --build_flags=--mod=mod -package=host -aux_files=github.com/openshift/assisted-service/internal/host/hostcommands=instruction_manager.go -destination=mock_host_api.go . API
@@ -140,6 +146,9 @@ type API interface {
 	UpdateImageStatus(ctx context.Context, h *models.Host, imageStatus *models.ContainerImageAvailability, db *gorm.DB) error
 	SetDiskSpeed(ctx context.Context, h *models.Host, path string, speedMs int64, exitCode int64, db *gorm.DB) error
 	ResetHostValidation(ctx context.Context, hostID, infraEnvID strfmt.UUID, validationID string, db *gorm.DB) error
+	GetValidationStats(ctx context.Context, h *models.Host) ([]*models.HostValidationStats, error)
+	CreateDiagnostic(ctx context.Context, h *models.Host, action models.HostDiagnosticAction, requestedBy string) (*models.HostDiagnostic, error)
+	UpdateDiagnosticResult(ctx context.Context, h *models.Host, reply *models.StepReply) error
 	GetHostByKubeKey(key types.NamespacedName) (*common.Host, error)
 	UpdateDomainNameResolution(ctx context.Context, h *models.Host, domainResolutionResponse models.DomainResolutionResponse, db *gorm.DB) error
 	BindHost(ctx context.Context, h *models.Host, clusterID strfmt.UUID, db *gorm.DB) error
@@ -176,6 +185,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Han
 	}
 	sm := NewHostStateMachine(stateswitch.NewStateMachine(), th)
 	sm = NewPoolHostStateMachine(sm, th)
+	sm = newTransitionRecorder(sm, log, db, metricApi)
 	return &Manager{
 		log:            log,
 		db:             db,
@@ -227,6 +237,12 @@ func (m *Manager) RegisterHost(ctx context.Context, h *models.Host, db *gorm.DB)
 		}
 	}
 
+	// The agent starts from scratch when it registers, so all of its steps are sent again
+	if err := hostcommands.ResetStepSchedules(db, *host.ID, host.InfraEnvID); err != nil {
+		return errors.Wrapf(err, "failed to reset the step schedules of host %s in infra env %s",
+			host.ID.String(), host.InfraEnvID.String())
+	}
+
 	return m.sm.Run(TransitionTypeRegisterHost, newStateHost(host), &TransitionArgsRegisterHost{
 		ctx:                   ctx,
 		discoveryAgentVersion: h.DiscoveryAgentVersion,
@@ -499,6 +515,7 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, h *models.Host, c *
 		// For changes to be detected and reported correctly, the comparison needs to be
 		// performed before the new validations are updated to the DB.
 		m.reportValidationStatusChanged(ctx, vc, h, newValidationRes, currentValidationRes)
+		m.updateValidationStats(ctx, db, h, newValidationRes, currentValidationRes)
 		_, err = m.updateValidationsInDB(ctx, db, h, newValidationRes)
 		if err != nil {
 			return err
@@ -511,6 +528,7 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, h *models.Host, c *
 		eventHandler:      m.eventsHandler,
 		conditions:        conditions,
 		validationResults: newValidationRes,
+		cluster:           vc.cluster,
 	})
 	if err != nil {
 		return common.NewApiError(http.StatusConflict, err)
@@ -618,10 +636,11 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 
 	statusInfo := string(progress.CurrentStage)
 
+	var updatedHost *common.Host
 	var err error
 	switch progress.CurrentStage {
 	case models.HostStageDone:
-		_, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.InfraEnvID, *h.ID,
+		updatedHost, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.InfraEnvID, *h.ID,
 			swag.StringValue(h.Status), models.HostStatusInstalled, statusInfo,
 			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo, extra...)
 	case models.HostStageFailed:
@@ -631,7 +650,7 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 			statusInfo += fmt.Sprintf(" - %s", progress.ProgressInfo)
 		}
 
-		_, err = hostutil.UpdateHostStatus(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.InfraEnvID, *h.ID,
+		updatedHost, err = hostutil.UpdateHostStatus(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.InfraEnvID, *h.ID,
 			swag.StringValue(h.Status), models.HostStatusError, statusInfo)
 	case models.HostStageRebooting:
 		if swag.StringValue(h.Kind) == models.HostKindAddToExistingClusterHost {
@@ -639,21 +658,46 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 			if !m.kubeApiEnabled {
 				infoMessage = statusInfoRebootingDay2
 			}
-			_, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.InfraEnvID, *h.ID,
+			updatedHost, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.InfraEnvID, *h.ID,
 				swag.StringValue(h.Status), models.HostStatusAddedToExistingCluster, infoMessage,
 				h.Progress.CurrentStage, models.HostStageDone, progress.ProgressInfo, extra...)
 			break
 		}
 		fallthrough
 	default:
-		_, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.InfraEnvID, *h.ID,
+		updatedHost, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.InfraEnvID, *h.ID,
 			swag.StringValue(h.Status), models.HostStatusInstallingInProgress, statusInfo,
 			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo, extra...)
 	}
+	// The host is not returned when its status was changed concurrently
+	if err == nil && updatedHost != nil {
+		recordStateTransition(logutil.FromContext(ctx, m.log), m.db, m.metricApi, &updatedHost.Host, swag.StringValue(h.Status),
+			h.StatusUpdatedAt, triggerUpdateInstallProgress)
+		if previousProgress == nil || previousProgress.CurrentStage != progress.CurrentStage {
+			m.recordStageTransition(ctx, h, progress.CurrentStage)
+		}
+	}
 	m.reportInstallationMetrics(ctx, h, previousProgress, progress.CurrentStage)
 	return err
 }
 
+func (m *Manager) recordStageTransition(ctx context.Context, h *models.Host, stage models.HostStage) {
+	if h.ClusterID == nil {
+		return
+	}
+	transition := &common.HostStageTransition{
+		ClusterID:  *h.ClusterID,
+		HostID:     *h.ID,
+		InfraEnvID: h.InfraEnvID,
+		Stage:      stage,
+		StartedAt:  time.Now(),
+	}
+	if err := m.db.Create(transition).Error; err != nil {
+		// Failure to record the transition only affects the installation timeline, so it isn't a failure to update the progress
+		logutil.FromContext(ctx, m.log).WithError(err).Errorf("failed to record stage %s of host %s", stage, h.ID)
+	}
+}
+
 func (m *Manager) SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool, db *gorm.DB) error {
 	if h.Bootstrap != isbootstrap {
 		err := db.Model(h).Update("bootstrap", isbootstrap).Error
@@ -1337,7 +1381,19 @@ func (m Manager) PermanentHostsDeletion(olderThan strfmt.DateTime) error {
 	} else if reply.RowsAffected > 0 {
 		m.log.Debugf("Deleted %s hosts from db", reply.RowsAffected)
 	}
-	return nil
+	hostIDs := m.db.Unscoped().Model(&models.Host{}).Select("id")
+	if reply := m.db.Unscoped().Where("host_id not in (?)", hostIDs).Delete(&models.HostDiagnostic{}); reply.Error != nil {
+		return reply.Error
+	} else if reply.RowsAffected > 0 {
+		m.log.Debugf("Deleted %d diagnostics of deleted hosts from db", reply.RowsAffected)
+	}
+	hostIDs = m.db.Unscoped().Model(&models.Host{}).Select("id")
+	if reply := m.db.Where("host_id not in (?)", hostIDs).Delete(&common.HostStepSchedule{}); reply.Error != nil {
+		return reply.Error
+	} else if reply.RowsAffected > 0 {
+		m.log.Debugf("Deleted %d step schedules of deleted hosts from db", reply.RowsAffected)
+	}
+	return m.deleteValidationStatsOfDeletedHosts()
 }
 
 type DisabledHostValidations map[string]struct{}
@@ -310,6 +310,10 @@ var _ = Describe("update_progress", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		dummy := &leader.DummyElector{}
 		state = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, dummy, nil, nil, false, nil)
 		id := strfmt.UUID(uuid.New().String())
@@ -332,6 +336,10 @@ var _ = Describe("update_progress", func() {
 		BeforeEach(func() {
 			ctrl = gomock.NewController(GinkgoT())
 			mockMetric = metrics.NewMockAPI(ctrl)
+			mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+			mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+			mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 			setDefaultReportHostInstallationMetrics(mockMetric)
 			host.Status = swag.String(models.HostStatusInstalling)
 			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
@@ -369,6 +377,35 @@ var _ = Describe("update_progress", func() {
 				hostFromDB = hostutil.GetHostFromDB(*hostFromDB.ID, host.InfraEnvID, db)
 				Expect(*hostFromDB.Status).Should(Equal(models.HostStatusInstallingInProgress))
 				Expect(hostFromDB.StageUpdatedAt.String()).Should(Equal(updatedAt))
+
+				By("recording the stage transition once")
+				var transitions []*common.HostStageTransition
+				Expect(db.Where("host_id = ?", host.ID.String()).Find(&transitions).Error).ShouldNot(HaveOccurred())
+				Expect(transitions).To(HaveLen(1))
+				Expect(transitions[0].ClusterID).To(Equal(*host.ClusterID))
+				Expect(transitions[0].Stage).To(Equal(progress.CurrentStage))
+
+				By("recording the status transition once")
+				var statusTransitions []*models.StateTransition
+				Expect(db.Where("object_id = ?", host.ID.String()).Find(&statusTransitions).Error).ShouldNot(HaveOccurred())
+				Expect(statusTransitions).To(HaveLen(1))
+				Expect(statusTransitions[0].ObjectKind).To(Equal(models.StateTransitionObjectKindHost))
+				Expect(statusTransitions[0].FromState).To(Equal(models.HostStatusInstalling))
+				Expect(statusTransitions[0].ToState).To(Equal(models.HostStatusInstallingInProgress))
+				Expect(statusTransitions[0].Trigger).To(Equal(triggerUpdateInstallProgress))
+			})
+
+			It("status changed concurrently", func() {
+				progress.CurrentStage = common.TestDefaultConfig.HostProgressStage
+				Expect(db.Model(&common.Host{}).Where("id = ?", host.ID.String()).
+					Update("status", models.HostStatusCancelled).Error).ShouldNot(HaveOccurred())
+				Expect(state.UpdateInstallProgress(ctx, &host, &progress)).ShouldNot(HaveOccurred())
+				hostFromDB = hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db)
+				Expect(*hostFromDB.Status).Should(Equal(models.HostStatusCancelled))
+
+				var statusTransitions []*models.StateTransition
+				Expect(db.Where("object_id = ?", host.ID.String()).Find(&statusTransitions).Error).ShouldNot(HaveOccurred())
+				Expect(statusTransitions).To(BeEmpty())
 			})
 
 			It("writing to disk", func() {
@@ -570,6 +607,10 @@ var _ = Describe("update progress special cases", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		dummy := &leader.DummyElector{}
 		setDefaultReportHostInstallationMetrics(mockMetric)
 		state = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, dummy, nil, nil, false, nil)
@@ -936,6 +977,34 @@ var _ = Describe("register host", func() {
 
 	})
 
+	It("records the status transition of a new host", func() {
+		h.Status = nil
+		eventsHandler.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
+		Expect(state.RegisterHost(ctx, &h, db)).ShouldNot(HaveOccurred())
+
+		var transitions []*models.StateTransition
+		Expect(db.Where("object_id = ?", h.ID.String()).Find(&transitions).Error).ShouldNot(HaveOccurred())
+		Expect(transitions).To(HaveLen(1))
+		Expect(*transitions[0].ClusterID).To(Equal(*h.ClusterID))
+		Expect(*transitions[0].InfraEnvID).To(Equal(h.InfraEnvID))
+		Expect(transitions[0].FromState).To(BeEmpty())
+		Expect(transitions[0].ToState).To(Equal(models.HostStatusDiscovering))
+		Expect(transitions[0].Trigger).To(Equal(TransitionTypeRegisterHost))
+		Expect(transitions[0].TimeInStateSeconds).To(BeZero())
+	})
+
+	It("doesn't record the status transition of a host registered in a transaction that is rolled back", func() {
+		h.Status = nil
+		eventsHandler.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
+		tx := db.Begin()
+		Expect(state.RegisterHost(ctx, &h, tx)).ShouldNot(HaveOccurred())
+		Expect(tx.Rollback().Error).ShouldNot(HaveOccurred())
+
+		var transitions []*models.StateTransition
+		Expect(db.Where("object_id = ?", h.ID.String()).Find(&transitions).Error).ShouldNot(HaveOccurred())
+		Expect(transitions).To(BeEmpty())
+	})
+
 	AfterEach(func() {
 		ctrl.Finish()
 		common.DeleteTestDB(db, dbName)
@@ -2462,6 +2531,10 @@ var _ = Describe("UpdateImageStatus", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		dummy := &leader.DummyElector{}
 		hapi = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, dummy, nil, nil, false, nil)
 		hostId = strfmt.UUID(uuid.New().String())
@@ -3030,6 +3103,10 @@ var _ = Describe("Validation metrics and events", func() {
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockHwValidator = hardware.NewMockValidator(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		validatorCfg = createValidatorCfg()
 		m = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, mockMetric, defaultConfig, nil, nil, nil, false, nil)
 		h = registerTestHostWithValidations(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()))
@@ -3249,6 +3326,10 @@ var _ = Describe("ResetHostValidation", func() {
 		mockHwValidator = hardware.NewMockValidator(ctrl)
 		validatorCfg = createValidatorCfg()
 		mockMetric := metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		clusterId := strfmt.UUID(uuid.New().String())
 		m = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, mockMetric, defaultConfig, nil, nil, nil, false, nil)
 		h = registerTestHost(strfmt.UUID(uuid.New().String()), &clusterId)
@@ -3383,6 +3464,10 @@ var _ = Describe("Get host by Kube key", func() {
 		mockHwValidator = hardware.NewMockValidator(ctrl)
 		validatorCfg = createValidatorCfg()
 		mockMetric := metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		db, dbName = common.PrepareTestDB()
 		state = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, mockMetric, defaultConfig, nil, nil, nil, false, nil)
 		id = strfmt.UUID(uuid.New().String())
@@ -0,0 +1,81 @@
+package hostcommands
+
+import (
+	"context"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// diagnosticCommands is the server-side allowlist of diagnostic actions. Every action maps to a fixed,
+// read-only command that the agent runs in the namespaces of the host. Nothing supplied by the user
+// is ever passed to the agent as part of the command.
+var diagnosticCommands = map[models.HostDiagnosticAction][]string{
+	models.HostDiagnosticActionIPAddresses:      {"ip", "address", "show"},
+	models.HostDiagnosticActionIPRoutes:         {"ip", "route", "show", "table", "all"},
+	models.HostDiagnosticActionBlockDevices:     {"lsblk", "--all", "--bytes", "--output-all"},
+	models.HostDiagnosticActionJournal:          {"journalctl", "--no-pager", "--boot", "--lines", "2000"},
+	models.HostDiagnosticActionKernelRingBuffer: {"dmesg", "--ctime"},
+	models.HostDiagnosticActionTimeSources:      {"chronyc", "-n", "sources", "-v"},
+}
+
+// hostNamespacesCommand runs a command in the namespaces of PID 1 so the agent container sees the host
+// as it is, and not as it is seen from within the container
+var hostNamespacesCommand = []string{"nsenter", "--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", "--"}
+
+// IsDiagnosticActionAllowed returns whether the action has a command in the diagnostics allowlist
+func IsDiagnosticActionAllowed(action models.HostDiagnosticAction) bool {
+	_, ok := diagnosticCommands[action]
+	return ok
+}
+
+type diagnosticCmd struct {
+	baseCmd
+	db *gorm.DB
+}
+
+func NewDiagnosticCmd(log logrus.FieldLogger, db *gorm.DB) *diagnosticCmd {
+	return &diagnosticCmd{
+		baseCmd: baseCmd{log: log},
+		db:      db,
+	}
+}
+
+func (d *diagnosticCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	var diagnostics []*models.HostDiagnostic
+	if err := d.db.Where("host_id = ? and infra_env_id = ? and status = ?", host.ID.String(), host.InfraEnvID.String(), models.HostDiagnosticStatusPending).
+		Order("requested_at").Find(&diagnostics).Error; err != nil {
+		d.log.WithError(err).Errorf("failed to get pending diagnostics of host %s", host.ID.String())
+		return nil, err
+	}
+
+	var steps []*models.Step
+	for _, diagnostic := range diagnostics {
+		command, ok := diagnosticCommands[diagnostic.Action]
+		if !ok {
+			d.log.Warnf("Diagnostic %s of host %s has action %s which is not in the allowlist, skipping it",
+				diagnostic.ID.String(), host.ID.String(), diagnostic.Action)
+			continue
+		}
+		stepID := createStepID(models.StepTypeExecute)
+		// The status condition guarantees that a diagnostic is delivered once, even when two
+		// service replicas serve the instructions of the same host at the same time
+		reply := d.db.Model(&models.HostDiagnostic{}).
+			Where("id = ? and status = ?", diagnostic.ID.String(), models.HostDiagnosticStatusPending).
+			Updates(map[string]interface{}{"status": models.HostDiagnosticStatusSent, "step_id": stepID})
+		if reply.Error != nil {
+			d.log.WithError(reply.Error).Errorf("failed to mark diagnostic %s of host %s as sent", diagnostic.ID.String(), host.ID.String())
+			return nil, reply.Error
+		}
+		if reply.RowsAffected == 0 {
+			continue
+		}
+		steps = append(steps, &models.Step{
+			StepType: models.StepTypeExecute,
+			StepID:   stepID,
+			Args:     append(append([]string{}, hostNamespacesCommand...), command...),
+		})
+	}
+	return steps, nil
+}
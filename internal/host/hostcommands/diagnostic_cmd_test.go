@@ -0,0 +1,104 @@
+package hostcommands
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("diagnostic", func() {
+	ctx := context.Background()
+	var host models.Host
+	var db *gorm.DB
+	var dCmd *diagnosticCmd
+	var id, clusterId, infraEnvId strfmt.UUID
+	var dbName string
+
+	createDiagnostic := func(action models.HostDiagnosticAction, status string) *models.HostDiagnostic {
+		diagnostic := &models.HostDiagnostic{
+			ID:          strfmt.UUID(uuid.New().String()),
+			HostID:      id,
+			InfraEnvID:  infraEnvId,
+			Action:      action,
+			Status:      status,
+			RequestedAt: strfmt.DateTime(time.Now()),
+		}
+		Expect(db.Create(diagnostic).Error).ShouldNot(HaveOccurred())
+		return diagnostic
+	}
+
+	getDiagnostic := func(diagnosticID strfmt.UUID) *models.HostDiagnostic {
+		var diagnostic models.HostDiagnostic
+		Expect(db.Take(&diagnostic, "id = ?", diagnosticID.String()).Error).ShouldNot(HaveOccurred())
+		return &diagnostic
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		dCmd = NewDiagnosticCmd(common.GetTestLog(), db)
+
+		id = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(id, infraEnvId, clusterId, models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("no pending diagnostics", func() {
+		createDiagnostic(models.HostDiagnosticActionJournal, models.HostDiagnosticStatusCompleted)
+		stepReply, stepErr := dCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeEmpty())
+	})
+
+	It("pending diagnostic is sent once", func() {
+		diagnostic := createDiagnostic(models.HostDiagnosticActionIPRoutes, models.HostDiagnosticStatusPending)
+		stepReply, stepErr := dCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		Expect(stepReply[0].StepType).To(Equal(models.StepTypeExecute))
+		Expect(stepReply[0].Args).To(Equal(append(append([]string{}, hostNamespacesCommand...), "ip", "route", "show", "table", "all")))
+
+		updated := getDiagnostic(diagnostic.ID)
+		Expect(updated.Status).To(Equal(models.HostDiagnosticStatusSent))
+		Expect(updated.StepID).To(Equal(stepReply[0].StepID))
+
+		stepReply, stepErr = dCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeEmpty())
+	})
+
+	It("action outside of the allowlist is skipped", func() {
+		diagnostic := createDiagnostic(models.HostDiagnosticAction("rm-rf"), models.HostDiagnosticStatusPending)
+		stepReply, stepErr := dCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeEmpty())
+		Expect(getDiagnostic(diagnostic.ID).Status).To(Equal(models.HostDiagnosticStatusPending))
+	})
+
+	It("allowlist", func() {
+		for _, action := range []models.HostDiagnosticAction{
+			models.HostDiagnosticActionIPAddresses,
+			models.HostDiagnosticActionIPRoutes,
+			models.HostDiagnosticActionBlockDevices,
+			models.HostDiagnosticActionJournal,
+			models.HostDiagnosticActionKernelRingBuffer,
+			models.HostDiagnosticActionTimeSources,
+		} {
+			Expect(IsDiagnosticActionAllowed(action)).To(BeTrue())
+		}
+		Expect(IsDiagnosticActionAllowed(models.HostDiagnosticAction("cat /etc/shadow"))).To(BeFalse())
+	})
+})
@@ -0,0 +1,136 @@
+package hostcommands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// stepScheduler filters out adaptive steps whose last result is still valid. An adaptive step is sent
+// again only when its arguments change (the arguments carry the inputs of the step, e.g. the addresses
+// of the other hosts for the connectivity check), when its last run failed, or when its last run is
+// older than the staleness interval.
+type stepScheduler struct {
+	log               logrus.FieldLogger
+	db                *gorm.DB
+	metricApi         metrics.API
+	adaptiveSteps     map[models.StepType]bool
+	stalenessInterval time.Duration
+}
+
+func newStepScheduler(log logrus.FieldLogger, db *gorm.DB, metricApi metrics.API, adaptiveSteps []models.StepType,
+	stalenessInterval time.Duration) *stepScheduler {
+	adaptiveStepsMap := make(map[models.StepType]bool)
+	for _, stepType := range adaptiveSteps {
+		adaptiveStepsMap[stepType] = true
+	}
+	return &stepScheduler{
+		log:               log,
+		db:                db,
+		metricApi:         metricApi,
+		adaptiveSteps:     adaptiveStepsMap,
+		stalenessInterval: stalenessInterval,
+	}
+}
+
+func (s *stepScheduler) decide(schedule *common.HostStepSchedule, argsHash string, now time.Time) string {
+	switch {
+	case schedule == nil:
+		return metrics.StepSchedulingFirstRun
+	case schedule.ArgsHash != argsHash:
+		return metrics.StepSchedulingInputsChanged
+	case now.Sub(schedule.SentAt) >= s.stalenessInterval:
+		return metrics.StepSchedulingStale
+	case schedule.RepliedAt != nil && schedule.ExitCode != 0:
+		return metrics.StepSchedulingRetry
+	default:
+		// Either the last run succeeded or it is still running
+		return metrics.StepSchedulingUnchanged
+	}
+}
+
+func (s *stepScheduler) schedule(ctx context.Context, host *models.Host, steps []*models.Step) []*models.Step {
+	log := logutil.FromContext(ctx, s.log)
+	if len(s.adaptiveSteps) == 0 || len(steps) == 0 {
+		return steps
+	}
+
+	var schedules []*common.HostStepSchedule
+	if err := s.db.Where("host_id = ? and infra_env_id = ?", host.ID.String(), host.InfraEnvID.String()).
+		Find(&schedules).Error; err != nil {
+		log.WithError(err).Warnf("failed to get the step schedules of host %s, sending all steps", host.ID.String())
+		return steps
+	}
+	schedulesByType := make(map[models.StepType]*common.HostStepSchedule)
+	for _, schedule := range schedules {
+		schedulesByType[schedule.StepType] = schedule
+	}
+
+	now := time.Now()
+	ret := make([]*models.Step, 0, len(steps))
+	for _, step := range steps {
+		if !s.adaptiveSteps[step.StepType] {
+			s.metricApi.HostStepScheduled(step.StepType, metrics.StepSchedulingAlways)
+			ret = append(ret, step)
+			continue
+		}
+		argsHash, err := hashStepArgs(step.Args)
+		if err != nil {
+			log.WithError(err).Warnf("failed to hash the arguments of step %s of host %s", step.StepType, host.ID.String())
+			ret = append(ret, step)
+			continue
+		}
+		decision := s.decide(schedulesByType[step.StepType], argsHash, now)
+		s.metricApi.HostStepScheduled(step.StepType, decision)
+		if decision == metrics.StepSchedulingUnchanged {
+			log.Debugf("Skipping step %s of host %s, its inputs did not change since it was sent at %s",
+				step.StepType, host.ID.String(), schedulesByType[step.StepType].SentAt)
+			continue
+		}
+		schedule := &common.HostStepSchedule{
+			HostID:     *host.ID,
+			InfraEnvID: host.InfraEnvID,
+			StepType:   step.StepType,
+			StepID:     step.StepID,
+			ArgsHash:   argsHash,
+			SentAt:     now,
+		}
+		if err = s.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(schedule).Error; err != nil {
+			log.WithError(err).Warnf("failed to update the schedule of step %s of host %s", step.StepType, host.ID.String())
+		}
+		ret = append(ret, step)
+	}
+	return ret
+}
+
+func hashStepArgs(args []string) (string, error) {
+	b, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
+
+// RecordStepReply stores the result of a step, so an adaptive step is sent again if it failed
+func RecordStepReply(db *gorm.DB, host *models.Host, reply *models.StepReply) error {
+	return db.Model(&common.HostStepSchedule{}).
+		Where("host_id = ? and infra_env_id = ? and step_id = ?", host.ID.String(), host.InfraEnvID.String(), reply.StepID).
+		Updates(map[string]interface{}{"replied_at": time.Now(), "exit_code": reply.ExitCode}).Error
+}
+
+// ResetStepSchedules makes all the steps of a host to be sent on its next request for instructions
+func ResetStepSchedules(db *gorm.DB, hostID, infraEnvID strfmt.UUID) error {
+	return db.Where("host_id = ? and infra_env_id = ?", hostID.String(), infraEnvID.String()).
+		Delete(&common.HostStepSchedule{}).Error
+}
@@ -0,0 +1,123 @@
+package hostcommands
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("step scheduler", func() {
+	ctx := context.Background()
+	var (
+		host       models.Host
+		db         *gorm.DB
+		dbName     string
+		ctrl       *gomock.Controller
+		mockMetric *metrics.MockAPI
+		scheduler  *stepScheduler
+	)
+
+	newStep := func(stepType models.StepType, args ...string) *models.Step {
+		return &models.Step{StepType: stepType, StepID: createStepID(stepType), Args: args}
+	}
+
+	stepTypes := func(steps []*models.Step) []models.StepType {
+		ret := make([]models.StepType, 0, len(steps))
+		for _, step := range steps {
+			ret = append(ret, step.StepType)
+		}
+		return ret
+	}
+
+	getSchedule := func(stepType models.StepType) *common.HostStepSchedule {
+		var schedule common.HostStepSchedule
+		Expect(db.Take(&schedule, "host_id = ? and step_type = ?", host.ID.String(), stepType).Error).ShouldNot(HaveOccurred())
+		return &schedule
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetric = metrics.NewMockAPI(ctrl)
+		scheduler = newStepScheduler(common.GetTestLog(), db, mockMetric,
+			[]models.StepType{models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses}, 10*time.Minute)
+
+		hostID := strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(hostID, strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("sends adaptive steps once until their inputs change", func() {
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeInventory, metrics.StepSchedulingAlways).Times(3)
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeConnectivityCheck, metrics.StepSchedulingFirstRun).Times(1)
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeConnectivityCheck, metrics.StepSchedulingUnchanged).Times(1)
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeConnectivityCheck, metrics.StepSchedulingInputsChanged).Times(1)
+
+		steps := scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeInventory), newStep(models.StepTypeConnectivityCheck, "a")})
+		Expect(stepTypes(steps)).To(Equal([]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck}))
+
+		steps = scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeInventory), newStep(models.StepTypeConnectivityCheck, "a")})
+		Expect(stepTypes(steps)).To(Equal([]models.StepType{models.StepTypeInventory}))
+
+		steps = scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeInventory), newStep(models.StepTypeConnectivityCheck, "b")})
+		Expect(stepTypes(steps)).To(Equal([]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck}))
+	})
+
+	It("sends stale steps again", func() {
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeFreeNetworkAddresses, metrics.StepSchedulingFirstRun).Times(1)
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeFreeNetworkAddresses, metrics.StepSchedulingStale).Times(1)
+
+		Expect(scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeFreeNetworkAddresses, "a")})).To(HaveLen(1))
+		Expect(db.Model(&common.HostStepSchedule{}).Where("host_id = ?", host.ID.String()).
+			Update("sent_at", time.Now().Add(-time.Hour)).Error).ShouldNot(HaveOccurred())
+		Expect(scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeFreeNetworkAddresses, "a")})).To(HaveLen(1))
+	})
+
+	It("retries failed steps and skips succeeded ones", func() {
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeConnectivityCheck, metrics.StepSchedulingFirstRun).Times(1)
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeConnectivityCheck, metrics.StepSchedulingRetry).Times(1)
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeConnectivityCheck, metrics.StepSchedulingUnchanged).Times(1)
+
+		steps := scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeConnectivityCheck, "a")})
+		Expect(steps).To(HaveLen(1))
+		Expect(RecordStepReply(db, &host, &models.StepReply{StepID: steps[0].StepID, ExitCode: 1})).To(Succeed())
+		Expect(getSchedule(models.StepTypeConnectivityCheck).ExitCode).To(Equal(int64(1)))
+
+		steps = scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeConnectivityCheck, "a")})
+		Expect(steps).To(HaveLen(1))
+		Expect(RecordStepReply(db, &host, &models.StepReply{StepID: steps[0].StepID, ExitCode: 0})).To(Succeed())
+		Expect(getSchedule(models.StepTypeConnectivityCheck).RepliedAt).ToNot(BeNil())
+
+		Expect(scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeConnectivityCheck, "a")})).To(BeEmpty())
+	})
+
+	It("sends all steps after the schedules are reset", func() {
+		mockMetric.EXPECT().HostStepScheduled(models.StepTypeConnectivityCheck, metrics.StepSchedulingFirstRun).Times(2)
+
+		Expect(scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeConnectivityCheck, "a")})).To(HaveLen(1))
+		Expect(ResetStepSchedules(db, *host.ID, host.InfraEnvID)).To(Succeed())
+		Expect(scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeConnectivityCheck, "a")})).To(HaveLen(1))
+	})
+
+	It("sends all steps when no step is adaptive", func() {
+		scheduler = newStepScheduler(common.GetTestLog(), db, mockMetric, nil, 10*time.Minute)
+		for i := 0; i < 2; i++ {
+			Expect(scheduler.schedule(ctx, &host, []*models.Step{newStep(models.StepTypeConnectivityCheck, "a")})).To(HaveLen(1))
+		}
+	})
+})
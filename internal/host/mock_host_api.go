@@ -83,6 +83,21 @@ func (mr *MockAPIMockRecorder) CancelInstallation(arg0, arg1, arg2, arg3 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelInstallation", reflect.TypeOf((*MockAPI)(nil).CancelInstallation), arg0, arg1, arg2, arg3)
 }
 
+// CreateDiagnostic mocks base method.
+func (m *MockAPI) CreateDiagnostic(arg0 context.Context, arg1 *models.Host, arg2 models.HostDiagnosticAction, arg3 string) (*models.HostDiagnostic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDiagnostic", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.HostDiagnostic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDiagnostic indicates an expected call of CreateDiagnostic.
+func (mr *MockAPIMockRecorder) CreateDiagnostic(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiagnostic", reflect.TypeOf((*MockAPI)(nil).CreateDiagnostic), arg0, arg1, arg2, arg3)
+}
+
 // GetHostByKubeKey mocks base method.
 func (m *MockAPI) GetHostByKubeKey(arg0 types.NamespacedName) (*common.Host, error) {
 	m.ctrl.T.Helper()
@@ -173,6 +188,21 @@ func (mr *MockAPIMockRecorder) GetStagesByRole(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStagesByRole", reflect.TypeOf((*MockAPI)(nil).GetStagesByRole), arg0, arg1)
 }
 
+// GetValidationStats mocks base method.
+func (m *MockAPI) GetValidationStats(arg0 context.Context, arg1 *models.Host) ([]*models.HostValidationStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidationStats", arg0, arg1)
+	ret0, _ := ret[0].([]*models.HostValidationStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationStats indicates an expected call of GetValidationStats.
+func (mr *MockAPIMockRecorder) GetValidationStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationStats", reflect.TypeOf((*MockAPI)(nil).GetValidationStats), arg0, arg1)
+}
+
 // HandleInstallationFailure mocks base method.
 func (m *MockAPI) HandleInstallationFailure(arg0 context.Context, arg1 *models.Host) error {
 	m.ctrl.T.Helper()
@@ -565,6 +595,20 @@ func (mr *MockAPIMockRecorder) UpdateConnectivityReport(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateConnectivityReport), arg0, arg1, arg2)
 }
 
+// UpdateDiagnosticResult mocks base method.
+func (m *MockAPI) UpdateDiagnosticResult(arg0 context.Context, arg1 *models.Host, arg2 *models.StepReply) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDiagnosticResult", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDiagnosticResult indicates an expected call of UpdateDiagnosticResult.
+func (mr *MockAPIMockRecorder) UpdateDiagnosticResult(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDiagnosticResult", reflect.TypeOf((*MockAPI)(nil).UpdateDiagnosticResult), arg0, arg1, arg2)
+}
+
 // UpdateDomainNameResolution mocks base method.
 func (m *MockAPI) UpdateDomainNameResolution(arg0 context.Context, arg1 *models.Host, arg2 models.DomainResolutionResponse, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -241,4 +241,5 @@ func (m *Manager) HostMonitoring() {
 	monitored += m.clusterHostMonitoring()
 	monitored += m.infraEnvHostMonitoring()
 	m.metricApi.MonitoredHostsCount(monitored)
+	m.expireDiagnostics()
 }
@@ -45,6 +45,10 @@ var _ = Describe("monitor_disconnection", func() {
 		dummy := &leader.DummyElector{}
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		mockMetricApi = metrics.NewMockAPI(ctrl)
+		mockMetricApi.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		mockHwValidator.EXPECT().ListEligibleDisks(gomock.Any()).AnyTimes()
 		mockHwValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&models.ClusterHostRequirements{
 			Total: &models.ClusterHostRequirementsDetails{},
@@ -169,6 +173,10 @@ var _ = Describe("TestHostMonitoring - with cluster", func() {
 
 		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).ShouldNot(HaveOccurred())
 		mockMetricApi = metrics.NewMockAPI(ctrl)
+		mockMetricApi.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		mockHwValidator.EXPECT().ListEligibleDisks(gomock.Any()).AnyTimes()
 		mockHwValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&models.ClusterHostRequirements{
@@ -264,6 +272,10 @@ var _ = Describe("HostMonitoring - with infra-env", func() {
 		mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
 		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).ShouldNot(HaveOccurred())
 		mockMetricApi = metrics.NewMockAPI(ctrl)
+		mockMetricApi.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		mockHwValidator.EXPECT().ListEligibleDisks(gomock.Any()).AnyTimes()
 		mockHwValidator.EXPECT().GetInfraEnvHostRequirements(gomock.Any(), gomock.Any()).AnyTimes().Return(&models.ClusterHostRequirements{
@@ -0,0 +1,100 @@
+package host
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// HostStageTimeouts overrides the maximum time a host may spend in an installation stage, optionally only for the
+// hosts of a role. It is set as a JSON-formatted list of models.HostStageTimeout.
+type HostStageTimeouts []*models.HostStageTimeout
+
+func (t *HostStageTimeouts) Decode(value string) error {
+	timeouts, err := ParseHostStageTimeouts(value)
+	if err != nil {
+		return err
+	}
+	*t = timeouts
+	return nil
+}
+
+// ParseHostStageTimeouts parses and validates a JSON-formatted list of models.HostStageTimeout
+func ParseHostStageTimeouts(value string) (HostStageTimeouts, error) {
+	timeouts := HostStageTimeouts{}
+	if strings.TrimSpace(value) == "" {
+		return timeouts, nil
+	}
+	if err := json.Unmarshal([]byte(value), &timeouts); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse host stage timeouts")
+	}
+	set := make(map[string]struct{})
+	for _, timeout := range timeouts {
+		if timeout == nil {
+			return nil, errors.New("empty host stage timeout")
+		}
+		if err := timeout.Validate(strfmt.Default); err != nil {
+			return nil, errors.Wrapf(err, "invalid host stage timeout")
+		}
+		key := string(*timeout.Stage) + "/" + string(timeout.Role)
+		if _, ok := set[key]; ok {
+			return nil, errors.Errorf("host stage timeout of stage %s and role '%s' is set more than once", *timeout.Stage, timeout.Role)
+		}
+		set[key] = struct{}{}
+	}
+	return timeouts, nil
+}
+
+// find returns the timeout of the stage for the first of the roles that has one, falling back to the timeout of the
+// stage for all the roles
+func (t HostStageTimeouts) find(stage models.HostStage, roles ...models.HostRole) (time.Duration, bool) {
+	for _, role := range append(roles, "") {
+		for _, timeout := range t {
+			if *timeout.Stage == stage && timeout.Role == role {
+				return time.Duration(swag.Int64Value(timeout.Timeout)) * time.Second, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// installationStageTimeout returns the maximum time the host may spend in its current installation stage. The timeouts
+// of the cluster take precedence over the timeouts of the service, that take precedence over the built-in timeouts.
+// The bootstrap host uses the timeouts of the bootstrap role before the timeouts of its own role.
+func (th *transitionHandler) installationStageTimeout(h *models.Host, c *common.Cluster) time.Duration {
+	stage := h.Progress.CurrentStage
+	roles := []models.HostRole{h.Role}
+	if h.Bootstrap {
+		roles = []models.HostRole{models.HostRoleBootstrap, h.Role}
+	}
+	if c != nil && c.HostStageTimeouts != "" {
+		timeouts, err := ParseHostStageTimeouts(c.HostStageTimeouts)
+		if err != nil {
+			th.log.WithError(err).Warnf("ignoring the host stage timeouts of cluster %s", c.ID)
+		} else if timeout, ok := timeouts.find(stage, roles...); ok {
+			return timeout
+		}
+	}
+	if th.config != nil {
+		if timeout, ok := th.config.HostStageTimeouts.find(stage, roles...); ok {
+			return timeout
+		}
+	}
+
+	maxDuration, ok := InstallationProgressTimeout[stage]
+	if !ok {
+		maxDuration = InstallationProgressTimeout["DEFAULT"]
+	}
+	if stage == models.HostStageRebooting && hostutil.IsSingleNode(th.log, th.db, h) {
+		// use extended reboot timeout for SNO
+		maxDuration = singleNodeRebootTimeout
+	}
+	return maxDuration
+}
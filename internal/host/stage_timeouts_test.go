@@ -0,0 +1,48 @@
+package host
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("HostStageTimeouts", func() {
+	It("parses an empty value", func() {
+		timeouts, err := ParseHostStageTimeouts("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeouts).To(BeEmpty())
+	})
+
+	It("finds the timeout of the role before the timeout of all the roles", func() {
+		timeouts, err := ParseHostStageTimeouts(`[
+			{"stage": "Rebooting", "timeout": 600},
+			{"stage": "Rebooting", "role": "master", "timeout": 1200},
+			{"stage": "Rebooting", "role": "bootstrap", "timeout": 1800}]`)
+		Expect(err).ToNot(HaveOccurred())
+
+		timeout, ok := timeouts.find(models.HostStageRebooting, models.HostRoleBootstrap, models.HostRoleMaster)
+		Expect(ok).To(BeTrue())
+		Expect(timeout).To(Equal(30 * time.Minute))
+		timeout, _ = timeouts.find(models.HostStageRebooting, models.HostRoleMaster)
+		Expect(timeout).To(Equal(20 * time.Minute))
+		timeout, _ = timeouts.find(models.HostStageRebooting, models.HostRoleWorker)
+		Expect(timeout).To(Equal(10 * time.Minute))
+		_, ok = timeouts.find(models.HostStageConfiguring, models.HostRoleWorker)
+		Expect(ok).To(BeFalse())
+	})
+
+	DescribeTable("rejects invalid timeouts", func(value string) {
+		_, err := ParseHostStageTimeouts(value)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("malformed", `{"stage": "Rebooting"`),
+		Entry("unknown stage", `[{"stage": "Sleeping", "timeout": 600}]`),
+		Entry("unknown role", `[{"stage": "Rebooting", "role": "arbiter", "timeout": 600}]`),
+		Entry("missing timeout", `[{"stage": "Rebooting"}]`),
+		Entry("zero timeout", `[{"stage": "Rebooting", "timeout": 0}]`),
+		Entry("duplicate", `[{"stage": "Rebooting", "timeout": 600}, {"stage": "Rebooting", "timeout": 1200}]`),
+	)
+})
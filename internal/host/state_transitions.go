@@ -0,0 +1,115 @@
+package host
+
+import (
+	"time"
+
+	"github.com/filanov/stateswitch"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// triggerUpdateInstallProgress is the trigger of the status transitions that the installation progress of the host
+// makes without the state machine
+const triggerUpdateInstallProgress = "UpdateInstallProgress"
+
+// transitionRecorder records the status transitions that the state machine makes
+type transitionRecorder struct {
+	stateswitch.StateMachine
+	log       logrus.FieldLogger
+	db        *gorm.DB
+	metricApi metrics.API
+}
+
+func newTransitionRecorder(sm stateswitch.StateMachine, log logrus.FieldLogger, db *gorm.DB, metricApi metrics.API) stateswitch.StateMachine {
+	return &transitionRecorder{StateMachine: sm, log: log, db: db, metricApi: metricApi}
+}
+
+func (r *transitionRecorder) Run(transitionType stateswitch.TransitionType, stateSwitch stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sHost, ok := stateSwitch.(*stateHost)
+	if !ok {
+		return r.StateMachine.Run(transitionType, stateSwitch, args)
+	}
+	srcStatus := swag.StringValue(sHost.host.Status)
+	statusUpdatedAt := sHost.host.StatusUpdatedAt
+	if err := r.StateMachine.Run(transitionType, stateSwitch, args); err != nil {
+		return err
+	}
+	db := r.db
+	if argsWithDB, ok := args.(transitionArgsWithDB); ok && argsWithDB.transitionDB() != nil {
+		db = argsWithDB.transitionDB()
+	}
+	recordStateTransition(r.log, db, r.metricApi, sHost.host, srcStatus, statusUpdatedAt, string(transitionType))
+	return nil
+}
+
+// transitionArgsWithDB are the arguments of the transitions that update the database through the db of the caller.
+// The transitions are recorded with the same db, so that they are rolled back when it is a transaction that is rolled
+// back.
+type transitionArgsWithDB interface {
+	transitionDB() *gorm.DB
+}
+
+func (a *TransitionArgsRegisterHost) transitionDB() *gorm.DB           { return a.db }
+func (a *TransitionArgsRegisterInstalledHost) transitionDB() *gorm.DB  { return a.db }
+func (a *TransitionArgsMediaDisconnected) transitionDB() *gorm.DB      { return a.db }
+func (a *TransitionArgsCancelInstallation) transitionDB() *gorm.DB     { return a.db }
+func (a *TransitionArgsResetHost) transitionDB() *gorm.DB              { return a.db }
+func (a *TransitionArgsInstallHost) transitionDB() *gorm.DB            { return a.db }
+func (a *TransitionArgsBindHost) transitionDB() *gorm.DB               { return a.db }
+func (a *TransitionArgsUnbindHost) transitionDB() *gorm.DB             { return a.db }
+func (a *TransitionArgsReclaimHost) transitionDB() *gorm.DB            { return a.db }
+func (a *TransitionResettingPendingUserAction) transitionDB() *gorm.DB { return a.db }
+func (a *TransitionArgsRefreshHost) transitionDB() *gorm.DB            { return a.db }
+
+// recordStateTransition records the transition of the host from srcStatus to its current status, if it changed, and
+// reports the time that the host spent in srcStatus since statusUpdatedAt
+func recordStateTransition(log logrus.FieldLogger, db *gorm.DB, metricApi metrics.API, h *models.Host, srcStatus string,
+	statusUpdatedAt strfmt.DateTime, trigger string) {
+	dstStatus := swag.StringValue(h.Status)
+	if dstStatus == srcStatus || h.ID == nil {
+		return
+	}
+	now := time.Now()
+	var timeInState time.Duration
+	if srcStatus != "" && !time.Time(statusUpdatedAt).IsZero() {
+		timeInState = now.Sub(time.Time(statusUpdatedAt))
+	}
+	infraEnvID := h.InfraEnvID
+	transition := &models.StateTransition{
+		ObjectKind:         models.StateTransitionObjectKindHost,
+		ObjectID:           *h.ID,
+		ClusterID:          h.ClusterID,
+		InfraEnvID:         &infraEnvID,
+		FromState:          srcStatus,
+		ToState:            dstStatus,
+		Trigger:            trigger,
+		TimeInStateSeconds: timeInState.Seconds(),
+		TransitionedAt:     strfmt.DateTime(now),
+	}
+	if err := db.Create(transition).Error; err != nil {
+		// The status already changed, failing to record the transition only affects its history
+		log.WithError(err).Errorf("failed to record the transition of host %s from %s to %s", h.ID, srcStatus, dstStatus)
+	}
+
+	if metricApi == nil {
+		return
+	}
+	var openshiftVersion, platform string
+	if h.ClusterID != nil {
+		var cluster common.Cluster
+		if err := db.Select("openshift_version", "platform_type").Take(&cluster, "id = ?", h.ClusterID.String()).Error; err != nil {
+			log.WithError(err).Warnf("failed to get the cluster %s of host %s for its transition metrics", h.ClusterID, h.ID)
+		} else {
+			openshiftVersion = cluster.OpenshiftVersion
+			if cluster.Platform != nil && cluster.Platform.Type != nil {
+				platform = string(*cluster.Platform.Type)
+			}
+		}
+	}
+	metricApi.HostStateTransition(srcStatus, dstStatus, trigger, openshiftVersion, platform, timeInState)
+}
@@ -560,7 +560,7 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th *transitionHandler) sta
 			stateswitch.Not(th.IsHostInReboot),
 			stateswitch.Not(shouldIgnoreInstallationProgressTimeout)),
 		DestinationState: stateswitch.State(models.HostStatusError),
-		PostTransition:   th.PostRefreshHost(statusInfoInstallationInProgressTimedOut),
+		PostTransition:   th.PostRefreshInstallationStageTimedOut,
 	})
 
 	// Time out while host is rebooting
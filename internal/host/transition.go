@@ -32,7 +32,8 @@ type transitionHandler struct {
 
 var resetLogsField = []interface{}{"logs_info", "", "logs_started_at", strfmt.DateTime(time.Time{}), "logs_collected_at", strfmt.DateTime(time.Time{})}
 var resetProgressFields = []interface{}{"progress_current_stage", "", "progress_installation_percentage", 0,
-	"progress_progress_info", "", "progress_stage_started_at", strfmt.DateTime(time.Time{}), "progress_stage_updated_at", strfmt.DateTime(time.Time{})}
+	"progress_progress_info", "", "progress_stage_started_at", strfmt.DateTime(time.Time{}), "progress_stage_updated_at", strfmt.DateTime(time.Time{}),
+	"progress_estimated_completion_at", nil}
 
 var resetFields = append(resetProgressFields, "inventory", "", "bootstrap", false, "images_status", "")
 var restFieldsOnUnbind = append(append(resetProgressFields, resetLogsField...), "cluster_id", nil, "kind", swag.String(models.HostKindHost), "connectivity", "", "domain_name_resolutions", "",
@@ -512,6 +513,7 @@ type TransitionArgsRefreshHost struct {
 	conditions        map[string]bool
 	validationResults ValidationsStatus
 	db                *gorm.DB
+	cluster           *common.Cluster
 }
 
 func If(id stringer) stateswitch.Condition {
@@ -608,22 +610,16 @@ func (th *transitionHandler) HasStatusTimedOut(timeout time.Duration) stateswitc
 	}
 }
 
-func (th *transitionHandler) HasInstallationInProgressTimedOut(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+func (th *transitionHandler) HasInstallationInProgressTimedOut(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
 	sHost, ok := sw.(*stateHost)
 	if !ok {
 		return false, errors.New("HasInstallationInProgressTimedOut incompatible type of StateSwitch")
 	}
-	maxDuration, ok := InstallationProgressTimeout[sHost.host.Progress.CurrentStage]
+	params, ok := args.(*TransitionArgsRefreshHost)
 	if !ok {
-		maxDuration = InstallationProgressTimeout["DEFAULT"]
-	}
-	if sHost.host.Progress.CurrentStage == models.HostStageRebooting {
-		if hostutil.IsSingleNode(th.log, th.db, sHost.host) {
-			// use extended reboot timeout for SNO
-			maxDuration = singleNodeRebootTimeout
-		}
+		return false, errors.New("HasInstallationInProgressTimedOut invalid argument")
 	}
-
+	maxDuration := th.installationStageTimeout(sHost.host, params.cluster)
 	return time.Since(time.Time(sHost.host.Progress.StageUpdatedAt)) > maxDuration, nil
 }
 
@@ -648,7 +644,9 @@ func (th *transitionHandler) PostRefreshHost(reason string) stateswitch.PostTran
 			template = statusInfoInstallationInProgressWritingImageToDiskTimedOut
 		}
 		template = strings.Replace(template, "$STAGE", string(sHost.host.Progress.CurrentStage), 1)
-		template = strings.Replace(template, "$MAX_TIME", InstallationProgressTimeout[sHost.host.Progress.CurrentStage].String(), 1)
+		if strings.Contains(template, "$MAX_TIME") {
+			template = strings.Replace(template, "$MAX_TIME", th.installationStageTimeout(sHost.host, params.cluster).String(), 1)
+		}
 		if strings.Contains(template, "$INSTALLATION_DISK") {
 			var installationDisk *models.Disk
 			installationDisk, err = hostutil.GetHostInstallationDisk(sHost.host)
@@ -676,6 +674,26 @@ func (th *transitionHandler) PostRefreshHost(reason string) stateswitch.PostTran
 	return ret
 }
 
+// PostRefreshInstallationStageTimedOut fails the host with the timed out stage and requests the host logs, so the
+// logs of the stuck stage are gathered
+func (th *transitionHandler) PostRefreshInstallationStageTimedOut(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	if err := th.PostRefreshHost(statusInfoInstallationInProgressTimedOut)(sw, args); err != nil {
+		return err
+	}
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return errors.New("PostRefreshInstallationStageTimedOut incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsRefreshHost)
+	if !ok {
+		return errors.New("PostRefreshInstallationStageTimedOut invalid argument")
+	}
+	_, err := hostutil.UpdateLogsProgress(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, th.eventsHandler,
+		sHost.host.InfraEnvID, *sHost.host.ID, swag.StringValue(sHost.host.Status), string(models.LogsStateRequested),
+		"logs_collected_at", strfmt.DateTime(time.Time{}))
+	return err
+}
+
 func (th *transitionHandler) IsDay2Host(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
 	sHost, ok := sw.(*stateHost)
 	if !ok {
@@ -610,6 +610,10 @@ var _ = Describe("HostInstallationFailed", func() {
 		db, dbName = common.PrepareTestDB()
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil, nil)
@@ -658,6 +662,10 @@ var _ = Describe("RegisterInstalledOCPHost", func() {
 		db, dbName = common.PrepareTestDB()
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockHwValidator := hardware.NewMockValidator(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil, nil)
@@ -1960,6 +1968,65 @@ var _ = Describe("Refresh Host", func() {
 			Expect(swag.StringValue(resultHost.StatusInfo)).To(Equal(info))
 		})
 
+		Context("configured stage timeouts", func() {
+			createInstallingHost := func(stage models.HostStage, passedTime time.Duration, clusterTimeouts string) {
+				cluster = hostutil.GenerateTestCluster(clusterId)
+				cluster.HostStageTimeouts = clusterTimeouts
+				Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+				host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusInstallingInProgress)
+				host.Inventory = hostutil.GenerateMasterInventory()
+				host.Role = models.HostRoleWorker
+				host.CheckedInAt = strfmt.DateTime(time.Now())
+				host.Progress = &models.HostProgressInfo{
+					CurrentStage:   stage,
+					StageStartedAt: strfmt.DateTime(time.Now().Add(-passedTime)),
+					StageUpdatedAt: strfmt.DateTime(time.Now().Add(-passedTime)),
+				}
+				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			}
+
+			refreshedHost := func() *models.Host {
+				Expect(hapi.RefreshStatus(ctx, &host, db)).To(Succeed())
+				return &hostutil.GetHostFromDB(hostId, infraEnvId, db).Host
+			}
+
+			expectTimedOut := func(stage models.HostStage, maxTime string) {
+				mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.HostStatusUpdatedEventName),
+					eventstest.WithHostIdMatcher(hostId.String()))).Times(1)
+				h := refreshedHost()
+				Expect(swag.StringValue(h.Status)).To(Equal(models.HostStatusError))
+				statusInfo := statusInfoInstallationInProgressTimedOut
+				if stage == models.HostStageWritingImageToDisk {
+					statusInfo = statusInfoInstallationInProgressWritingImageToDiskTimedOut
+				}
+				statusInfo = strings.Replace(statusInfo, "$STAGE", string(stage), 1)
+				Expect(swag.StringValue(h.StatusInfo)).To(Equal(strings.Replace(statusInfo, "$MAX_TIME", maxTime, 1)))
+				Expect(h.LogsInfo).To(Equal(models.LogsStateRequested))
+			}
+
+			It("times out with the service timeout of the stage", func() {
+				config := *defaultConfig
+				Expect(config.HostStageTimeouts.Decode(`[{"stage": "Writing image to disk", "timeout": 600}]`)).To(Succeed())
+				hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, &config, nil, operatorsManager, pr, false, nil)
+				createInstallingHost(models.HostStageWritingImageToDisk, 15*time.Minute, "")
+				expectTimedOut(models.HostStageWritingImageToDisk, "10m0s")
+			})
+
+			It("times out with the cluster timeout of the role", func() {
+				createInstallingHost(models.HostStageWaitingForControlPlane, 15*time.Minute,
+					`[{"stage": "Waiting for control plane", "timeout": 7200}, {"stage": "Waiting for control plane", "role": "worker", "timeout": 600}]`)
+				expectTimedOut(models.HostStageWaitingForControlPlane, "10m0s")
+			})
+
+			It("prefers the cluster timeout over the service timeout", func() {
+				config := *defaultConfig
+				Expect(config.HostStageTimeouts.Decode(`[{"stage": "Configuring", "timeout": 600}]`)).To(Succeed())
+				hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, &config, nil, operatorsManager, pr, false, nil)
+				createInstallingHost(models.HostStageConfiguring, 15*time.Minute, `[{"stage": "Configuring", "timeout": 7200}]`)
+				Expect(swag.StringValue(refreshedHost().Status)).To(Equal(models.HostStatusInstallingInProgress))
+			})
+		})
 	})
 
 	Context("Validate host", func() {
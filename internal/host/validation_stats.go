@@ -0,0 +1,151 @@
+package host
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// updateValidationStats records the validations of the host whose status changed: when they succeeded for the first
+// time, and when they changed between success and failure. A validation that changed between success and failure
+// more than the flapping threshold within the flapping window is flagged with a warning event.
+func (m *Manager) updateValidationStats(ctx context.Context, db *gorm.DB, h *models.Host, newValidationRes, currentValidationRes ValidationsStatus) {
+	log := logutil.FromContext(ctx, m.log)
+	var existing []*models.HostValidationStats
+	if err := db.Where("infra_env_id = ? and host_id = ?", h.InfraEnvID.String(), h.ID.String()).Find(&existing).Error; err != nil {
+		// The statistics only affect the reporting of the validations, not the host
+		log.WithError(err).Warnf("failed to get the validation statistics of host %s", h.ID)
+		return
+	}
+	statsByID := make(map[string]*models.HostValidationStats, len(existing))
+	for _, stats := range existing {
+		statsByID[stats.ValidationID] = stats
+	}
+
+	now := time.Now()
+	var changed []*models.HostValidationStats
+	for vCategory, vRes := range newValidationRes {
+		for _, v := range vRes {
+			previousStatus, _ := m.getValidationStatus(currentValidationRes, vCategory, v.ID)
+			stats, ok := statsByID[v.ID.String()]
+			if !ok {
+				stats = &models.HostValidationStats{InfraEnvID: h.InfraEnvID, HostID: *h.ID, ValidationID: v.ID.String()}
+			} else if stats.Status == string(v.Status) {
+				continue
+			}
+			stats.Status = string(v.Status)
+			// The time of the first success of validations that already succeeded before they were tracked is unknown
+			if v.Status == ValidationSuccess && previousStatus != ValidationSuccess && stats.FirstSucceededAt == nil {
+				m.recordValidationSucceeded(h, v.ID, stats, now)
+			}
+			if isValidationFlip(previousStatus, v.Status) {
+				m.recordValidationFlip(ctx, h, v.ID, stats, now)
+			}
+			changed = append(changed, stats)
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+	if err := db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&changed).Error; err != nil {
+		log.WithError(err).Warnf("failed to update the validation statistics of host %s", h.ID)
+	}
+}
+
+func isValidationFlip(previousStatus, status ValidationStatus) bool {
+	return previousStatus == ValidationSuccess && status == ValidationFailure ||
+		previousStatus == ValidationFailure && status == ValidationSuccess
+}
+
+// recordValidationSucceeded records the first success of the validation, and the time it took since the host was
+// discovered
+func (m *Manager) recordValidationSucceeded(h *models.Host, id validationID, stats *models.HostValidationStats, now time.Time) {
+	succeededAt := strfmt.DateTime(now)
+	stats.FirstSucceededAt = &succeededAt
+	discoveredAt := time.Time(h.RegisteredAt)
+	if discoveredAt.IsZero() {
+		discoveredAt = time.Time(h.CreatedAt)
+	}
+	if discoveredAt.IsZero() || discoveredAt.After(now) {
+		return
+	}
+	stats.TimeToSuccessSeconds = now.Sub(discoveredAt).Seconds()
+	if m.metricApi != nil {
+		m.metricApi.HostValidationSucceeded(models.HostValidationID(id), now.Sub(discoveredAt))
+	}
+}
+
+// recordValidationFlip records the change of the validation between success and failure, and flags the validation
+// once it flipped more times than the threshold within the window
+func (m *Manager) recordValidationFlip(ctx context.Context, h *models.Host, id validationID, stats *models.HostValidationStats, now time.Time) {
+	flippedAt := strfmt.DateTime(now)
+	stats.FlipCount++
+	stats.LastFlippedAt = &flippedAt
+	stats.RecentFlips = append(recentFlips(stats.RecentFlips, now, m.Config.ValidationFlappingWindow), flippedAt)
+	if m.metricApi != nil {
+		m.metricApi.HostValidationFlipped(models.HostValidationID(id))
+	}
+
+	flapping := m.isFlapping(stats.RecentFlips)
+	if flapping && !stats.Flapping {
+		logutil.FromContext(ctx, m.log).Warnf("Host %s: validation '%s' is flapping, it changed %d times in the last %s",
+			hostutil.GetHostnameForMsg(h), id, len(stats.RecentFlips), m.Config.ValidationFlappingWindow)
+		eventgen.SendHostValidationFlappingEvent(ctx, m.eventsHandler, *h.ID, h.InfraEnvID, h.ClusterID,
+			hostutil.GetHostnameForMsg(h), id.String(), int64(len(stats.RecentFlips)), m.Config.ValidationFlappingWindow.String())
+		if m.metricApi != nil {
+			m.metricApi.HostValidationFlapping(models.HostValidationID(id))
+		}
+	}
+	stats.Flapping = flapping
+}
+
+func (m *Manager) isFlapping(flips []strfmt.DateTime) bool {
+	return m.Config.ValidationFlappingThreshold > 0 && len(flips) > m.Config.ValidationFlappingThreshold
+}
+
+// recentFlips returns the flips that are within the window before now
+func recentFlips(flips []strfmt.DateTime, now time.Time, window time.Duration) []strfmt.DateTime {
+	ret := make([]strfmt.DateTime, 0, len(flips)+1)
+	for _, flip := range flips {
+		if now.Sub(time.Time(flip)) < window {
+			ret = append(ret, flip)
+		}
+	}
+	return ret
+}
+
+// GetValidationStats returns the statistics of the validations of the host. The flips that left the flapping window
+// since the validations last changed are left out, so validations that stopped flapping are no longer flagged.
+func (m *Manager) GetValidationStats(ctx context.Context, h *models.Host) ([]*models.HostValidationStats, error) {
+	var stats []*models.HostValidationStats
+	if err := m.db.Where("infra_env_id = ? and host_id = ?", h.InfraEnvID.String(), h.ID.String()).
+		Order("validation_id").Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, s := range stats {
+		s.RecentFlips = recentFlips(s.RecentFlips, now, m.Config.ValidationFlappingWindow)
+		s.Flapping = s.Flapping && m.isFlapping(s.RecentFlips)
+	}
+	return stats, nil
+}
+
+// deleteValidationStatsOfDeletedHosts deletes the validation statistics of the hosts that were permanently deleted
+func (m *Manager) deleteValidationStatsOfDeletedHosts() error {
+	hostIDs := m.db.Unscoped().Model(&models.Host{}).Select("id")
+	reply := m.db.Where("host_id not in (?)", hostIDs).Delete(&models.HostValidationStats{})
+	if reply.Error != nil {
+		return reply.Error
+	}
+	if reply.RowsAffected > 0 {
+		m.log.Debugf("Deleted %d validation statistics of deleted hosts from db", reply.RowsAffected)
+	}
+	return nil
+}
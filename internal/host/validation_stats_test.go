@@ -0,0 +1,143 @@
+package host
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Validation statistics", func() {
+	var (
+		ctx        = context.Background()
+		db         *gorm.DB
+		dbName     string
+		ctrl       *gomock.Controller
+		mockEvents *eventsapi.MockHandler
+		mockMetric *metrics.MockAPI
+		m          *Manager
+		host       models.Host
+	)
+
+	validations := func(status ValidationStatus) ValidationsStatus {
+		return ValidationsStatus{
+			"hardware": ValidationResults{
+				{ID: HasMinCPUCores, Status: ValidationSuccess},
+				{ID: HasMinMemory, Status: status},
+			},
+		}
+	}
+
+	getStats := func() map[string]*models.HostValidationStats {
+		stats, err := m.GetValidationStats(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		ret := make(map[string]*models.HostValidationStats)
+		for _, s := range stats {
+			ret[s.ValidationID] = s
+		}
+		return ret
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockMetric = metrics.NewMockAPI(ctrl)
+		cfg := *defaultConfig
+		cfg.ValidationFlappingThreshold = 2
+		cfg.ValidationFlappingWindow = time.Hour
+		m = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, &cfg, &leader.DummyElector{}, nil, nil, false, nil)
+		host = hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()),
+			strfmt.UUID(uuid.New().String()), models.HostStatusKnown)
+		host.RegisteredAt = strfmt.DateTime(time.Now().Add(-time.Minute))
+		Expect(db.Create(&host).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("records the time the validations took to succeed since the host was discovered", func() {
+		mockMetric.EXPECT().HostValidationSucceeded(models.HostValidationID(HasMinCPUCores), gomock.Any()).Times(1)
+		m.updateValidationStats(ctx, db, &host, validations(ValidationFailure), ValidationsStatus{})
+		mockMetric.EXPECT().HostValidationSucceeded(models.HostValidationID(HasMinMemory), gomock.Any()).Times(1)
+		mockMetric.EXPECT().HostValidationFlipped(models.HostValidationID(HasMinMemory)).Times(1)
+		m.updateValidationStats(ctx, db, &host, validations(ValidationSuccess), validations(ValidationFailure))
+
+		stats := getStats()
+		Expect(stats).To(HaveLen(2))
+		Expect(stats[HasMinCPUCores.String()].FirstSucceededAt).ToNot(BeNil())
+		Expect(stats[HasMinCPUCores.String()].TimeToSuccessSeconds).To(BeNumerically("~", 60, 5))
+		Expect(stats[HasMinCPUCores.String()].FlipCount).To(BeZero())
+		Expect(stats[HasMinMemory.String()].Status).To(Equal(string(ValidationSuccess)))
+		Expect(stats[HasMinMemory.String()].FirstSucceededAt).ToNot(BeNil())
+		Expect(stats[HasMinMemory.String()].FlipCount).To(Equal(int64(1)))
+	})
+
+	It("does not record the first success of validations that already succeeded", func() {
+		m.updateValidationStats(ctx, db, &host, validations(ValidationPending), validations(ValidationSuccess))
+		Expect(getStats()[HasMinCPUCores.String()].FirstSucceededAt).To(BeNil())
+	})
+
+	It("flags the validations that flip more than the threshold within the window", func() {
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(models.HostValidationID(HasMinMemory)).Times(3)
+		mockMetric.EXPECT().HostValidationFlapping(models.HostValidationID(HasMinMemory)).Times(1)
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostValidationFlappingEventName),
+			eventstest.WithHostIdMatcher(host.ID.String()),
+			eventstest.WithSeverityMatcher(models.EventSeverityWarning))).Times(1)
+
+		m.updateValidationStats(ctx, db, &host, validations(ValidationSuccess), validations(ValidationFailure))
+		m.updateValidationStats(ctx, db, &host, validations(ValidationFailure), validations(ValidationSuccess))
+		Expect(getStats()[HasMinMemory.String()].Flapping).To(BeFalse())
+		m.updateValidationStats(ctx, db, &host, validations(ValidationSuccess), validations(ValidationFailure))
+
+		stats := getStats()[HasMinMemory.String()]
+		Expect(stats.Flapping).To(BeTrue())
+		Expect(stats.FlipCount).To(Equal(int64(3)))
+		Expect(stats.RecentFlips).To(HaveLen(3))
+		Expect(stats.LastFlippedAt).ToNot(BeNil())
+	})
+
+	It("stops flagging the validations once their flips left the window", func() {
+		oldFlip := strfmt.DateTime(time.Now().Add(-2 * time.Hour))
+		Expect(db.Create(&models.HostValidationStats{
+			InfraEnvID:   host.InfraEnvID,
+			HostID:       *host.ID,
+			ValidationID: HasMinMemory.String(),
+			Status:       string(ValidationSuccess),
+			FlipCount:    3,
+			RecentFlips:  []strfmt.DateTime{oldFlip, oldFlip, oldFlip},
+			Flapping:     true,
+		}).Error).ToNot(HaveOccurred())
+
+		stats := getStats()[HasMinMemory.String()]
+		Expect(stats.Flapping).To(BeFalse())
+		Expect(stats.RecentFlips).To(BeEmpty())
+		Expect(stats.FlipCount).To(Equal(int64(3)))
+	})
+
+	It("deletes the statistics of the permanently deleted hosts", func() {
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		m.updateValidationStats(ctx, db, &host, validations(ValidationSuccess), ValidationsStatus{})
+		Expect(getStats()).To(HaveLen(2))
+		Expect(db.Delete(&host).Error).ToNot(HaveOccurred())
+		Expect(m.PermanentHostsDeletion(strfmt.DateTime(time.Now().Add(time.Minute)))).To(Succeed())
+		Expect(getStats()).To(BeEmpty())
+	})
+})
@@ -60,6 +60,10 @@ var _ = Describe("Validations test", func() {
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		mockHwValidator = hardware.NewMockValidator(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().HostStateTransition(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationSucceeded(gomock.Any(), gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlipped(gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().HostValidationFlapping(gomock.Any()).AnyTimes()
 		mockOperators = operators.NewMockAPI(ctrl)
 		pr := registry.NewMockProviderRegistry(ctrl)
 		pr.EXPECT().IsHostSupported(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
@@ -0,0 +1,154 @@
+package hostchanges
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Channel is the Postgres notification channel on which the database publishes the ID of a host whenever
+// its status changes or a new command is queued for it. The notifications are sent by triggers, so every
+// replica of the service is notified, no matter which replica (or monitor) made the change.
+const Channel = "host_changes"
+
+var _ Waiter = &Listener{}
+
+//go:generate mockgen -source=listener.go -package=hostchanges -destination=mock_waiter.go
+type Waiter interface {
+	// Watch starts watching the changes of the host. The returned channel is closed when the host changes, and
+	// is nil when the changes can't be watched, in which case the caller should not wait for them. The returned
+	// function stops watching and must always be called.
+	Watch(hostID strfmt.UUID) (<-chan struct{}, func())
+}
+
+type Config struct {
+	ListenerRetryInterval time.Duration `envconfig:"HOST_CHANGES_LISTENER_RETRY_INTERVAL" default:"10s"`
+}
+
+// Listener holds a dedicated database connection listening on Channel and wakes up the requests waiting
+// for changes of the notified hosts. While the connection is down changes can't be watched, and the requests
+// fall back to the regular polling.
+type Listener struct {
+	log        logrus.FieldLogger
+	connString string
+	config     Config
+
+	mutex     sync.Mutex
+	listening bool
+	waiters   map[string]map[chan struct{}]struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewListener(log logrus.FieldLogger, connString string, config Config) *Listener {
+	return &Listener{
+		log:        log,
+		connString: connString,
+		config:     config,
+		waiters:    make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+func (l *Listener) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	l.done = make(chan struct{})
+	go l.run(ctx)
+}
+
+func (l *Listener) Stop() {
+	l.cancel()
+	<-l.done
+}
+
+func (l *Listener) run(ctx context.Context) {
+	defer close(l.done)
+	for {
+		if err := l.listen(ctx); err != nil && ctx.Err() == nil {
+			l.log.WithError(err).Warnf("Stopped listening on %s, retrying in %s", Channel, l.config.ListenerRetryInterval)
+		}
+		l.setListening(false)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(l.config.ListenerRetryInterval):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.connString)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to the database")
+	}
+	defer conn.Close(context.Background())
+
+	if _, err = conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return errors.Wrapf(err, "failed to listen on %s", Channel)
+	}
+	l.setListening(true)
+	l.log.Infof("Listening on %s", Channel)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to wait for notifications on %s", Channel)
+		}
+		l.notify(notification.Payload)
+	}
+}
+
+func (l *Listener) setListening(listening bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.listening = listening
+}
+
+func (l *Listener) notify(hostID string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	for ch := range l.waiters[hostID] {
+		close(ch)
+	}
+	delete(l.waiters, hostID)
+}
+
+// register returns a channel that is closed when the host changes, or nil if changes can't be watched
+func (l *Listener) register(hostID string) chan struct{} {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if !l.listening {
+		return nil
+	}
+	ch := make(chan struct{})
+	if l.waiters[hostID] == nil {
+		l.waiters[hostID] = make(map[chan struct{}]struct{})
+	}
+	l.waiters[hostID][ch] = struct{}{}
+	return ch
+}
+
+func (l *Listener) unregister(hostID string, ch chan struct{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if hostWaiters, ok := l.waiters[hostID]; ok {
+		delete(hostWaiters, ch)
+		if len(hostWaiters) == 0 {
+			delete(l.waiters, hostID)
+		}
+	}
+}
+
+func (l *Listener) Watch(hostID strfmt.UUID) (<-chan struct{}, func()) {
+	ch := l.register(hostID.String())
+	if ch == nil {
+		return nil, func() {}
+	}
+	return ch, func() { l.unregister(hostID.String(), ch) }
+}
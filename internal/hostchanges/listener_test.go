@@ -0,0 +1,59 @@
+package hostchanges
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestHostChanges(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Host changes test Suite")
+}
+
+var _ = Describe("Watch", func() {
+	var (
+		listener *Listener
+		hostID   strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		listener = NewListener(logrus.New(), "", Config{ListenerRetryInterval: time.Second})
+		hostID = strfmt.UUID(uuid.New().String())
+	})
+
+	It("closes the channel when the host is notified", func() {
+		listener.setListening(true)
+		changed, stop := listener.Watch(hostID)
+		defer stop()
+		Expect(changed).ToNot(BeNil())
+
+		listener.notify(hostID.String())
+		Expect(changed).To(BeClosed())
+		Expect(listener.waiters).To(BeEmpty())
+	})
+
+	It("ignores notifications of other hosts", func() {
+		listener.setListening(true)
+		changed, stop := listener.Watch(hostID)
+
+		listener.notify(uuid.New().String())
+		Expect(changed).ToNot(BeClosed())
+		Expect(listener.waiters).To(HaveLen(1))
+
+		stop()
+		Expect(listener.waiters).To(BeEmpty())
+	})
+
+	It("can't watch when not listening", func() {
+		changed, stop := listener.Watch(hostID)
+		defer stop()
+		Expect(changed).To(BeNil())
+		Expect(listener.waiters).To(BeEmpty())
+	})
+})
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: listener.go
+
+// Package hostchanges is a generated GoMock package.
+package hostchanges
+
+import (
+	reflect "reflect"
+
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWaiter is a mock of Waiter interface.
+type MockWaiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaiterMockRecorder
+}
+
+// MockWaiterMockRecorder is the mock recorder for MockWaiter.
+type MockWaiterMockRecorder struct {
+	mock *MockWaiter
+}
+
+// NewMockWaiter creates a new mock instance.
+func NewMockWaiter(ctrl *gomock.Controller) *MockWaiter {
+	mock := &MockWaiter{ctrl: ctrl}
+	mock.recorder = &MockWaiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaiter) EXPECT() *MockWaiterMockRecorder {
+	return m.recorder
+}
+
+// Watch mocks base method.
+func (m *MockWaiter) Watch(hostID strfmt.UUID) (<-chan struct{}, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", hostID)
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockWaiterMockRecorder) Watch(hostID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockWaiter)(nil).Watch), hostID)
+}
@@ -7,7 +7,6 @@ import (
 	"path/filepath"
 
 	"github.com/openshift/assisted-service/internal/common"
-	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
@@ -38,12 +37,7 @@ func (g *dummyGenerator) Generate(_ context.Context, installConfig []byte, platf
 		return err
 	}
 
-	toUpload := fileNames[:]
-	for _, host := range g.cluster.Hosts {
-		toUpload = append(toUpload, hostutil.IgnitionFileName(host))
-	}
-
-	for _, fileName := range toUpload {
+	for _, fileName := range GeneratedFileNames(g.cluster) {
 		f, err := os.Create(filepath.Join(g.workDir, fileName))
 		if err != nil {
 			return err
@@ -73,6 +67,9 @@ func (g *dummyGenerator) UpdateEtcHosts(serviceIPs string) error {
 	return nil
 }
 
+func (g *dummyGenerator) AddManifests(map[string][]byte) {
+}
+
 const kubeconfig string = `
 clusters:
 - cluster:
@@ -44,6 +44,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -192,11 +193,22 @@ var fileNames = [...]string{
 	"install-config.yaml",
 }
 
+// GeneratedFileNames returns the names of the files that a Generator creates in its working directory
+func GeneratedFileNames(cluster *common.Cluster) []string {
+	names := fileNames[:]
+	for _, host := range cluster.Hosts {
+		names = append(names, hostutil.IgnitionFileName(host))
+	}
+	return names
+}
+
 // Generator can generate ignition files and upload them to an S3-like service
 type Generator interface {
 	Generate(ctx context.Context, installConfig []byte, platformType models.PlatformType) error
 	UploadToS3(ctx context.Context) error
 	UpdateEtcHosts(string) error
+	// AddManifests adds manifests, by path relative to the working directory, to the ones stored for the cluster
+	AddManifests(manifests map[string][]byte)
 }
 
 // IgnitionBuilder defines the ignition formatting methods for the various images
@@ -222,6 +234,7 @@ type installerGenerator struct {
 	providerRegistry              registry.ProviderRegistry
 	installerReleaseImageOverride string
 	clusterTLSCertOverrideDir     string
+	extraManifests                map[string][]byte
 }
 
 // IgnitionConfig contains the attributes required to build the discovery ignition file
@@ -286,11 +299,30 @@ func NewGenerator(workDir string, installerDir string, cluster *common.Cluster,
 // UploadToS3 uploads generated ignition and related files to the configured
 // S3-compatible storage
 func (g *installerGenerator) UploadToS3(ctx context.Context) error {
-	return uploadToS3(ctx, g.workDir, g.cluster, g.s3Client, g.log)
+	ctx, span := tracing.StartSpan(ctx, "ignition.UploadToS3", tracing.ClusterIDKey.String(g.cluster.ID.String()))
+	err := uploadToS3(ctx, g.workDir, g.cluster, g.s3Client, g.log)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (g *installerGenerator) AddManifests(manifests map[string][]byte) {
+	if g.extraManifests == nil {
+		g.extraManifests = make(map[string][]byte)
+	}
+	for name, content := range manifests {
+		g.extraManifests[name] = content
+	}
 }
 
 // Generate generates ignition files and applies modifications.
 func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte, platformType models.PlatformType) error {
+	ctx, span := tracing.StartSpan(ctx, "ignition.Generate", tracing.ClusterIDKey.String(g.cluster.ID.String()))
+	err := g.generate(ctx, installConfig, platformType)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (g *installerGenerator) generate(ctx context.Context, installConfig []byte, platformType models.PlatformType) error {
 	var icspFile string
 	log := logutil.FromContext(ctx, g.log)
 
@@ -306,8 +338,10 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 	}
 	defer removeIcspFile(icspFile)
 
-	installerPath, err := installercache.Get(g.installerReleaseImageOverride, g.releaseImageMirror, g.installerDir,
+	extractCtx, extractSpan := tracing.StartSpan(ctx, "ignition.GetInstaller")
+	installerPath, err := installercache.Get(extractCtx, g.installerReleaseImageOverride, g.releaseImageMirror, g.installerDir,
 		g.cluster.PullSecret, platformType, icspFile, log)
+	tracing.EndSpan(extractSpan, err)
 	if err != nil {
 		return errors.Wrap(err, "failed to get installer path")
 	}
@@ -389,6 +423,14 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 			return err
 		}
 	}
+	for name, content := range g.extraManifests {
+		log.Infof("adding manifest %s to working dir for cluster %s", name, g.cluster.ID)
+		err = ioutil.WriteFile(filepath.Join(g.workDir, name), content, 0600)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to write manifest %s to working dir for cluster %s", name, g.cluster.ID)
+			return err
+		}
+	}
 
 	if swag.StringValue(g.cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
 		err = g.bootstrapInPlaceIgnitionsCreate(ctx, installerPath, envVars)
@@ -964,12 +1006,7 @@ func sortHosts(hosts []*models.Host) ([]*models.Host, []*models.Host) {
 
 // UploadToS3 uploads the generated files to S3
 func uploadToS3(ctx context.Context, workDir string, cluster *common.Cluster, s3Client s3wrapper.API, log logrus.FieldLogger) error {
-	toUpload := fileNames[:]
-	for _, host := range cluster.Hosts {
-		toUpload = append(toUpload, hostutil.IgnitionFileName(host))
-	}
-
-	for _, fileName := range toUpload {
+	for _, fileName := range GeneratedFileNames(cluster) {
 		fullPath := filepath.Join(workDir, fileName)
 		key := filepath.Join(cluster.ID.String(), fileName)
 		err := s3Client.UploadFile(ctx, fullPath, key)
@@ -1239,12 +1276,14 @@ func firstN(s string, n int) string {
 
 func (g *installerGenerator) runCreateCommand(ctx context.Context, installerPath, command string, envVars []string) error {
 	log := logutil.FromContext(ctx, g.log)
+	_, span := tracing.StartSpan(ctx, "openshift-install create "+command)
 	cmd := exec.Command(installerPath, "create", command, "--dir", g.workDir)
 	var out bytes.Buffer
 	cmd.Stdout = &out
 	cmd.Stderr = &out
 	cmd.Env = envVars
 	err := cmd.Run()
+	tracing.EndSpan(span, err)
 	if err != nil {
 		log.WithError(err).
 			Errorf("error running openshift-install create %s, stdout: %s", command, out.String())
@@ -37,6 +37,18 @@ func (m *MockGenerator) EXPECT() *MockGeneratorMockRecorder {
 	return m.recorder
 }
 
+// AddManifests mocks base method.
+func (m *MockGenerator) AddManifests(manifests map[string][]byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddManifests", manifests)
+}
+
+// AddManifests indicates an expected call of AddManifests.
+func (mr *MockGeneratorMockRecorder) AddManifests(manifests interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddManifests", reflect.TypeOf((*MockGenerator)(nil).AddManifests), manifests)
+}
+
 // Generate mocks base method.
 func (m *MockGenerator) Generate(ctx context.Context, installConfig []byte, platformType models.PlatformType) error {
 	m.ctrl.T.Helper()
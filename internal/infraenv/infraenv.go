@@ -36,14 +36,15 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, objectHandler s3wrapper.API
 func (m Manager) DeleteOrphanInfraEnvs(ctx context.Context, maxDeletePerInterval int, inactiveSince strfmt.DateTime) error {
 	log := logutil.FromContext(ctx, m.log)
 	var infraEnvs []*models.InfraEnv
-	if err := m.db.Limit(maxDeletePerInterval).Where("updated_at < ?", inactiveSince).Find(&infraEnvs).Error; err != nil {
+	// Deletion protected infra-envs are never deleted as orphans
+	if err := m.db.Limit(maxDeletePerInterval).Where("updated_at < ? AND deletion_protected = ?", inactiveSince, false).Find(&infraEnvs).Error; err != nil {
 		return err
 	}
 	for _, infraEnv := range infraEnvs {
 		if infraEnv.ClusterID != "" {
-			// Dereigster InfraEnv only if Cluster has been deleted
+			// Dereigster InfraEnv only if Cluster has been permanently deleted, so deregistered clusters can be restored with it
 			_, err := common.GetClusterFromDBWhere(m.db, common.SkipEagerLoading,
-				common.SkipDeletedRecords, "id = ?", infraEnv.ClusterID)
+				common.IncludeDeletedRecords, "id = ?", infraEnv.ClusterID)
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				err = m.DeregisterInfraEnvAndHosts(ctx, *infraEnv.ID)
 				if err != nil {
@@ -156,6 +156,26 @@ var _ = Describe("Delete inactive infraenvs", func() {
 		Expect(wasDeleted(db, *infraEnv.ID)).To(BeTrue())
 	})
 
+	It("Do nothing, inactive infraEnv with deregistered cluster", func() {
+		clusterId := strfmt.UUID(uuid.New().String())
+		infraEnv2 := registerInfraEnv(clusterId)
+		cluster := common.Cluster{Cluster: models.Cluster{
+			ID: &clusterId,
+		}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		Expect(db.Delete(&cluster).Error).ShouldNot(HaveOccurred())
+		Expect(state.DeleteOrphanInfraEnvs(ctx, 10, strfmt.DateTime(time.Now()))).ShouldNot(HaveOccurred())
+		Expect(wasDeleted(db, *infraEnv2.ID)).To(BeFalse())
+		Expect(wasDeleted(db, *infraEnv.ID)).To(BeTrue())
+	})
+
+	It("Do nothing, deletion protected inactive infraEnv", func() {
+		Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnv.ID.String()).
+			UpdateColumn("deletion_protected", true).Error).ShouldNot(HaveOccurred())
+		Expect(state.DeleteOrphanInfraEnvs(ctx, 10, strfmt.DateTime(time.Now()))).ShouldNot(HaveOccurred())
+		Expect(wasDeleted(db, *infraEnv.ID)).To(BeFalse())
+	})
+
 	It("Do nothing, active infraEnv", func() {
 		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Times(0)
 		lastActive := strfmt.DateTime(time.Now().Add(-time.Hour))
@@ -70,18 +70,34 @@ func (m *Manifests) CreateClusterManifestInternal(ctx context.Context, params op
 		return nil, common.NewApiError(http.StatusNotFound, fmt.Errorf("Object Not Found"))
 	}
 
-	if strings.ContainsRune(*params.CreateManifestParams.FileName, os.PathSeparator) {
-		log.Errorf("Cluster manifest %s for cluster %s should not include a directory in its name.", *params.CreateManifestParams.FileName, params.ClusterID)
-		return nil, common.NewApiError(http.StatusBadRequest, errors.New("Manifest should not include a directory in its name"))
+	manifestContent, err := DecodeManifest(params.CreateManifestParams)
+	if err != nil {
+		log.WithError(err).Errorf("Cluster manifest %s for cluster %s is invalid", *params.CreateManifestParams.FileName, params.ClusterID)
+		return nil, err
 	}
 	fileName := filepath.Join(*params.CreateManifestParams.Folder, *params.CreateManifestParams.FileName)
-	manifestContent, err := base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+
+	objectName := GetManifestObjectName(params.ClusterID, fileName)
+	if err := m.objectHandler.Upload(ctx, manifestContent, objectName); err != nil {
+		log.WithError(err).Errorf("Failed to upload %s", objectName)
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Errorf("failed to upload %s", objectName))
+	}
+
+	log.Infof("Done creating manifest %s for cluster %s", fileName, params.ClusterID.String())
+	manifest := models.Manifest{FileName: *params.CreateManifestParams.FileName, Folder: *params.CreateManifestParams.Folder}
+	return &manifest, nil
+}
+
+// DecodeManifest validates the name and the content of the manifest and returns its decoded content
+func DecodeManifest(params *models.CreateManifestParams) ([]byte, error) {
+	if strings.ContainsRune(*params.FileName, os.PathSeparator) {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("Manifest should not include a directory in its name"))
+	}
+	manifestContent, err := base64.StdEncoding.DecodeString(*params.Content)
 	if err != nil {
-		log.WithError(err).Errorf("Cluster manifest %s for cluster %s failed to base64 decode: [%s]",
-			fileName, params.ClusterID.String(), *params.CreateManifestParams.Content)
 		return nil, common.NewApiError(http.StatusBadRequest, errors.New("failed to base64-decode cluster manifest content"))
 	}
-	extension := filepath.Ext(fileName)
+	extension := filepath.Ext(*params.FileName)
 	if extension == ".yaml" || extension == ".yml" {
 		var s map[interface{}]interface{}
 		if yaml.Unmarshal(manifestContent, &s) != nil {
@@ -94,16 +110,7 @@ func (m *Manifests) CreateClusterManifestInternal(ctx context.Context, params op
 	} else {
 		return nil, common.NewApiError(http.StatusBadRequest, errors.New("Unsupported manifest extension. Only json, yaml and yml extensions are supported"))
 	}
-
-	objectName := GetManifestObjectName(params.ClusterID, fileName)
-	if err := m.objectHandler.Upload(ctx, manifestContent, objectName); err != nil {
-		log.WithError(err).Errorf("Failed to upload %s", objectName)
-		return nil, common.NewApiError(http.StatusInternalServerError, errors.Errorf("failed to upload %s", objectName))
-	}
-
-	log.Infof("Done creating manifest %s for cluster %s", fileName, params.ClusterID.String())
-	manifest := models.Manifest{FileName: *params.CreateManifestParams.FileName, Folder: *params.CreateManifestParams.Folder}
-	return &manifest, nil
+	return manifestContent, nil
 }
 
 func (m *Manifests) ListClusterManifestsInternal(ctx context.Context, params operations.V2ListClusterManifestsParams) (models.ListManifests, error) {
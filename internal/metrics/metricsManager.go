@@ -39,6 +39,18 @@ const (
 	counterFilesystemUsagePercentage              = "assisted_installer_filesystem_usage_percentage"
 	counterMonitoredHosts                         = "assisted_installer_monitored_hosts"
 	counterMonitoredClusters                      = "assisted_installer_monitored_clusters"
+	counterHostStepScheduling                     = "assisted_installer_host_step_scheduling"
+	counterHostTimeInStateSeconds                 = "assisted_installer_host_time_in_state_seconds"
+	counterClusterTimeInStateSeconds              = "assisted_installer_cluster_time_in_state_seconds"
+	counterHostStateTransitions                   = "assisted_installer_host_state_transitions"
+	counterClusterStateTransitions                = "assisted_installer_cluster_state_transitions"
+	counterHostValidationTimeToSuccessSeconds     = "assisted_installer_host_validation_time_to_success_seconds"
+	counterHostValidationFlips                    = "assisted_installer_host_validation_flips"
+	counterHostValidationFlapping                 = "assisted_installer_host_validation_flapping"
+	counterComponentHealthy                       = "assisted_installer_component_healthy"
+	counterComponentCheckDurationSeconds          = "assisted_installer_component_check_duration_seconds"
+	counterDBConnections                          = "assisted_installer_db_connections"
+	counterLeader                                 = "assisted_installer_leader"
 )
 
 const (
@@ -61,6 +73,18 @@ const (
 	counterDescriptionFilesystemUsagePercentage              = "The percentage of the filesystem usage by the service"
 	counterDescriptionMonitoredHosts                         = "Number of hosts monitored by host monitor"
 	counterDescriptionMonitoredClusters                      = "Number of clusters monitored by cluster monitor"
+	counterDescriptionHostStepScheduling                     = "Number of scheduling decisions of host steps, by step type, decision"
+	counterDescriptionHostTimeInStateSeconds                 = "Histogram/sum/count of time hosts spent in a status before leaving it, by status, OpenShift version, platform"
+	counterDescriptionClusterTimeInStateSeconds              = "Histogram/sum/count of time clusters spent in a status before leaving it, by status, OpenShift version, platform"
+	counterDescriptionHostStateTransitions                   = "Number of host status transitions, by source status, destination status, trigger, OpenShift version, platform"
+	counterDescriptionClusterStateTransitions                = "Number of cluster status transitions, by source status, destination status, trigger, OpenShift version, platform"
+	counterDescriptionHostValidationTimeToSuccessSeconds     = "Histogram/sum/count of time host validations took to succeed for the first time after the host was discovered"
+	counterDescriptionHostValidationFlips                    = "Number of times host validations changed between success and failure"
+	counterDescriptionHostValidationFlapping                 = "Number of times host validations were detected as flapping between success and failure"
+	counterDescriptionComponentHealthy                       = "Whether the last health check of a component of the service succeeded (1) or failed (0), by component"
+	counterDescriptionComponentCheckDurationSeconds          = "Duration of the last health check of a component of the service, by component"
+	counterDescriptionDBConnections                          = "Number of connections of the database connection pool, by state (in_use, idle, max_open)"
+	counterDescriptionLeader                                 = "Whether the service is the leader (1) or not (0)"
 )
 
 const (
@@ -77,6 +101,31 @@ const (
 	imageLabel                 = "imageName"
 	hosts                      = "hosts"
 	clusters                   = "clusters"
+	stepTypeLabel              = "stepType"
+	decisionLabel              = "decision"
+	stateLabel                 = "state"
+	fromStateLabel             = "fromState"
+	toStateLabel               = "toState"
+	triggerLabel               = "trigger"
+	openshiftVersionLabel      = "openshiftVersion"
+	platformLabel              = "platform"
+	componentLabel             = "component"
+)
+
+// Scheduling decisions of host steps
+const (
+	// StepSchedulingAlways is the decision for steps that are sent on every request for instructions
+	StepSchedulingAlways = "always"
+	// StepSchedulingFirstRun is the decision for adaptive steps that were never sent to the host
+	StepSchedulingFirstRun = "first-run"
+	// StepSchedulingInputsChanged is the decision for adaptive steps whose arguments changed since they were sent
+	StepSchedulingInputsChanged = "inputs-changed"
+	// StepSchedulingStale is the decision for adaptive steps whose last result is older than the staleness interval
+	StepSchedulingStale = "stale"
+	// StepSchedulingRetry is the decision for adaptive steps whose last run failed
+	StepSchedulingRetry = "retry"
+	// StepSchedulingUnchanged is the decision for adaptive steps that are skipped since their last result is still valid
+	StepSchedulingUnchanged = "unchanged"
 )
 
 type API interface {
@@ -94,6 +143,15 @@ type API interface {
 	FileSystemUsage(usageInPercentage float64)
 	MonitoredHostsCount(monitoredHosts int64)
 	MonitoredClusterCount(monitoredClusters int64)
+	HostStepScheduled(stepType models.StepType, decision string)
+	HostStateTransition(fromState, toState, trigger, openshiftVersion, platform string, timeInState time.Duration)
+	ClusterStateTransition(fromState, toState, trigger, openshiftVersion, platform string, timeInState time.Duration)
+	HostValidationSucceeded(hostValidationType models.HostValidationID, timeToSuccess time.Duration)
+	HostValidationFlipped(hostValidationType models.HostValidationID)
+	HostValidationFlapping(hostValidationType models.HostValidationID)
+	ComponentHealth(component string, healthy bool, checkDuration time.Duration)
+	DBConnections(inUse, idle, maxOpen int)
+	Leader(isLeader bool)
 }
 
 type MetricsManager struct {
@@ -119,6 +177,18 @@ type MetricsManager struct {
 	serviceLogicFilesystemUsagePercentage              *prometheus.GaugeVec
 	serviceLogicMonitoredHosts                         *prometheus.GaugeVec
 	serviceLogicMonitoredClusters                      *prometheus.GaugeVec
+	serviceLogicHostStepScheduling                     *prometheus.CounterVec
+	serviceLogicHostTimeInStateSeconds                 *prometheus.HistogramVec
+	serviceLogicClusterTimeInStateSeconds              *prometheus.HistogramVec
+	serviceLogicHostStateTransitions                   *prometheus.CounterVec
+	serviceLogicClusterStateTransitions                *prometheus.CounterVec
+	serviceLogicHostValidationTimeToSuccessSeconds     *prometheus.HistogramVec
+	serviceLogicHostValidationFlips                    *prometheus.CounterVec
+	serviceLogicHostValidationFlapping                 *prometheus.CounterVec
+	serviceLogicComponentHealthy                       *prometheus.GaugeVec
+	serviceLogicComponentCheckDurationSeconds          *prometheus.GaugeVec
+	serviceLogicDBConnections                          *prometheus.GaugeVec
+	serviceLogicLeader                                 *prometheus.GaugeVec
 }
 
 var _ API = &MetricsManager{}
@@ -280,6 +350,98 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 			Name:      counterMonitoredClusters,
 			Help:      counterDescriptionMonitoredClusters,
 		}, []string{hosts}),
+
+		serviceLogicHostStepScheduling: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterHostStepScheduling,
+				Help:      counterDescriptionHostStepScheduling,
+			}, []string{stepTypeLabel, decisionLabel}),
+
+		serviceLogicHostTimeInStateSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterHostTimeInStateSeconds,
+			Help:      counterDescriptionHostTimeInStateSeconds,
+			Buckets:   []float64{1, 10, 30, 60, 300, 600, 1800, 3600, 7200, 14400, 43200, 86400, 259200},
+		}, []string{stateLabel, openshiftVersionLabel, platformLabel}),
+
+		serviceLogicClusterTimeInStateSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterClusterTimeInStateSeconds,
+			Help:      counterDescriptionClusterTimeInStateSeconds,
+			Buckets:   []float64{1, 10, 30, 60, 300, 600, 1800, 3600, 7200, 14400, 43200, 86400, 259200},
+		}, []string{stateLabel, openshiftVersionLabel, platformLabel}),
+
+		serviceLogicHostStateTransitions: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterHostStateTransitions,
+				Help:      counterDescriptionHostStateTransitions,
+			}, []string{fromStateLabel, toStateLabel, triggerLabel, openshiftVersionLabel, platformLabel}),
+
+		serviceLogicClusterStateTransitions: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterClusterStateTransitions,
+				Help:      counterDescriptionClusterStateTransitions,
+			}, []string{fromStateLabel, toStateLabel, triggerLabel, openshiftVersionLabel, platformLabel}),
+
+		serviceLogicHostValidationTimeToSuccessSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterHostValidationTimeToSuccessSeconds,
+			Help:      counterDescriptionHostValidationTimeToSuccessSeconds,
+			Buckets:   []float64{1, 10, 30, 60, 120, 300, 600, 1800, 3600, 7200, 14400, 86400},
+		}, []string{hostValidationTypeLabel}),
+
+		serviceLogicHostValidationFlips: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterHostValidationFlips,
+				Help:      counterDescriptionHostValidationFlips,
+			}, []string{hostValidationTypeLabel}),
+
+		serviceLogicHostValidationFlapping: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterHostValidationFlapping,
+				Help:      counterDescriptionHostValidationFlapping,
+			}, []string{hostValidationTypeLabel}),
+
+		serviceLogicComponentHealthy: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterComponentHealthy,
+			Help:      counterDescriptionComponentHealthy,
+		}, []string{componentLabel}),
+
+		serviceLogicComponentCheckDurationSeconds: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterComponentCheckDurationSeconds,
+			Help:      counterDescriptionComponentCheckDurationSeconds,
+		}, []string{componentLabel}),
+
+		serviceLogicDBConnections: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterDBConnections,
+			Help:      counterDescriptionDBConnections,
+		}, []string{stateLabel}),
+
+		serviceLogicLeader: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterLeader,
+			Help:      counterDescriptionLeader,
+		}, []string{}),
 	}
 
 	registry.MustRegister(
@@ -302,6 +464,18 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 		m.serviceLogicFilesystemUsagePercentage,
 		m.serviceLogicMonitoredHosts,
 		m.serviceLogicMonitoredClusters,
+		m.serviceLogicHostStepScheduling,
+		m.serviceLogicHostTimeInStateSeconds,
+		m.serviceLogicClusterTimeInStateSeconds,
+		m.serviceLogicHostStateTransitions,
+		m.serviceLogicClusterStateTransitions,
+		m.serviceLogicHostValidationTimeToSuccessSeconds,
+		m.serviceLogicHostValidationFlips,
+		m.serviceLogicHostValidationFlapping,
+		m.serviceLogicComponentHealthy,
+		m.serviceLogicComponentCheckDurationSeconds,
+		m.serviceLogicDBConnections,
+		m.serviceLogicLeader,
 	)
 	return m
 }
@@ -468,6 +642,69 @@ func (m *MetricsManager) MonitoredClusterCount(monitoredClusters int64) {
 	m.serviceLogicMonitoredClusters.WithLabelValues(clusters).Set(float64(monitoredClusters))
 }
 
+func (m *MetricsManager) HostStepScheduled(stepType models.StepType, decision string) {
+	m.serviceLogicHostStepScheduling.WithLabelValues(string(stepType), decision).Inc()
+}
+
+// HostStateTransition counts the status transition of a host and observes the time that the host spent in the status
+// that it left. The time isn't observed when the host was created by the transition.
+func (m *MetricsManager) HostStateTransition(fromState, toState, trigger, openshiftVersion, platform string, timeInState time.Duration) {
+	m.serviceLogicHostStateTransitions.WithLabelValues(fromState, toState, trigger, openshiftVersion, platform).Inc()
+	if fromState != "" {
+		m.serviceLogicHostTimeInStateSeconds.WithLabelValues(fromState, openshiftVersion, platform).Observe(timeInState.Seconds())
+	}
+}
+
+// ClusterStateTransition counts the status transition of a cluster and observes the time that the cluster spent in
+// the status that it left. The time isn't observed when the cluster was created by the transition.
+func (m *MetricsManager) ClusterStateTransition(fromState, toState, trigger, openshiftVersion, platform string, timeInState time.Duration) {
+	m.serviceLogicClusterStateTransitions.WithLabelValues(fromState, toState, trigger, openshiftVersion, platform).Inc()
+	if fromState != "" {
+		m.serviceLogicClusterTimeInStateSeconds.WithLabelValues(fromState, openshiftVersion, platform).Observe(timeInState.Seconds())
+	}
+}
+
+// HostValidationSucceeded observes the time that a host validation took to succeed for the first time after the host
+// was discovered
+func (m *MetricsManager) HostValidationSucceeded(hostValidationType models.HostValidationID, timeToSuccess time.Duration) {
+	m.serviceLogicHostValidationTimeToSuccessSeconds.WithLabelValues(string(hostValidationType)).Observe(timeToSuccess.Seconds())
+}
+
+// HostValidationFlipped counts a change of a host validation between success and failure
+func (m *MetricsManager) HostValidationFlipped(hostValidationType models.HostValidationID) {
+	m.serviceLogicHostValidationFlips.WithLabelValues(string(hostValidationType)).Inc()
+}
+
+// HostValidationFlapping counts a host validation that started flapping between success and failure
+func (m *MetricsManager) HostValidationFlapping(hostValidationType models.HostValidationID) {
+	m.serviceLogicHostValidationFlapping.WithLabelValues(string(hostValidationType)).Inc()
+}
+
 func bytesToGib(bytes int64) int64 {
 	return bytes / int64(units.GiB)
 }
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// ComponentHealth sets the result and the duration of the last health check of a component of the service
+func (m *MetricsManager) ComponentHealth(component string, healthy bool, checkDuration time.Duration) {
+	m.serviceLogicComponentHealthy.WithLabelValues(component).Set(boolToFloat(healthy))
+	m.serviceLogicComponentCheckDurationSeconds.WithLabelValues(component).Set(checkDuration.Seconds())
+}
+
+// DBConnections sets the number of connections of the database connection pool
+func (m *MetricsManager) DBConnections(inUse, idle, maxOpen int) {
+	m.serviceLogicDBConnections.WithLabelValues("in_use").Set(float64(inUse))
+	m.serviceLogicDBConnections.WithLabelValues("idle").Set(float64(idle))
+	m.serviceLogicDBConnections.WithLabelValues("max_open").Set(float64(maxOpen))
+}
+
+// Leader sets whether the service is the leader
+func (m *MetricsManager) Leader(isLeader bool) {
+	m.serviceLogicLeader.WithLabelValues().Set(boolToFloat(isLeader))
+}
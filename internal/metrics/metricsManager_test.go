@@ -81,3 +81,105 @@ var _ = DescribeTable(
 		`^service_assisted_installer_host_installation_phase_seconds_count\{.*phase="Configuring".*\} .*$`,
 	),
 )
+
+var _ = Describe("State transition metrics", func() {
+	var (
+		server  *MetricsServer
+		ctrl    *gomock.Controller
+		manager *MetricsManager
+	)
+
+	BeforeEach(func() {
+		server = NewMetricsServer()
+		ctrl = gomock.NewController(GinkgoT())
+		manager = NewMetricsManager(server.Registry(), eventsapi.NewMockHandler(ctrl))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		server.Close()
+	})
+
+	It("counts the host transitions and observes the time in the status that was left", func() {
+		manager.HostStateTransition(models.HostStatusKnown, models.HostStatusInstalling, "InstallHost", "4.10.18",
+			string(models.PlatformTypeBaremetal), 90*time.Second)
+
+		metrics := server.Metrics()
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_host_state_transitions\{fromState="known",openshiftVersion="4.10.18",platform="baremetal",toState="installing",trigger="InstallHost"\} 1$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_host_time_in_state_seconds_sum\{openshiftVersion="4.10.18",platform="baremetal",state="known"\} 90$`))
+	})
+
+	It("doesn't observe the time in status of created clusters", func() {
+		manager.ClusterStateTransition("", models.ClusterStatusPendingForInput, "RefreshStatus", "4.10.18", "", 0)
+
+		metrics := server.Metrics()
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_cluster_state_transitions\{fromState="",.*toState="pending-for-input".*\} 1$`))
+		Expect(metrics).ToNot(MatchLine(`^service_assisted_installer_cluster_time_in_state_seconds_count`))
+	})
+})
+
+var _ = Describe("Host validation metrics", func() {
+	var (
+		server  *MetricsServer
+		ctrl    *gomock.Controller
+		manager *MetricsManager
+	)
+
+	BeforeEach(func() {
+		server = NewMetricsServer()
+		ctrl = gomock.NewController(GinkgoT())
+		manager = NewMetricsManager(server.Registry(), eventsapi.NewMockHandler(ctrl))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		server.Close()
+	})
+
+	It("observes the time to success and counts the flips of the validations", func() {
+		manager.HostValidationSucceeded(models.HostValidationIDHasMinMemory, 2*time.Minute)
+		manager.HostValidationFlipped(models.HostValidationIDHasMinMemory)
+		manager.HostValidationFlipped(models.HostValidationIDHasMinMemory)
+		manager.HostValidationFlapping(models.HostValidationIDHasMinMemory)
+
+		metrics := server.Metrics()
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_host_validation_time_to_success_seconds_sum\{hostValidationType="has-min-memory"\} 120$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_host_validation_flips\{hostValidationType="has-min-memory"\} 2$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_host_validation_flapping\{hostValidationType="has-min-memory"\} 1$`))
+	})
+})
+
+var _ = Describe("Component health metrics", func() {
+	var (
+		server  *MetricsServer
+		ctrl    *gomock.Controller
+		manager *MetricsManager
+	)
+
+	BeforeEach(func() {
+		server = NewMetricsServer()
+		ctrl = gomock.NewController(GinkgoT())
+		manager = NewMetricsManager(server.Registry(), eventsapi.NewMockHandler(ctrl))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		server.Close()
+	})
+
+	It("reports the health of the components, the database connections and the leadership", func() {
+		manager.ComponentHealth("database", true, 2*time.Second)
+		manager.ComponentHealth("ocm", false, time.Second)
+		manager.DBConnections(3, 2, 10)
+		manager.Leader(true)
+
+		metrics := server.Metrics()
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_component_healthy\{component="database"\} 1$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_component_healthy\{component="ocm"\} 0$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_component_check_duration_seconds\{component="database"\} 2$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_db_connections\{state="in_use"\} 3$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_db_connections\{state="idle"\} 2$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_db_connections\{state="max_open"\} 10$`))
+		Expect(metrics).To(MatchLine(`^service_assisted_installer_leader 1$`))
+	})
+})
@@ -61,6 +61,18 @@ func (mr *MockAPIMockRecorder) ClusterRegistered() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterRegistered", reflect.TypeOf((*MockAPI)(nil).ClusterRegistered))
 }
 
+// ClusterStateTransition mocks base method.
+func (m *MockAPI) ClusterStateTransition(fromState, toState, trigger, openshiftVersion, platform string, timeInState time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClusterStateTransition", fromState, toState, trigger, openshiftVersion, platform, timeInState)
+}
+
+// ClusterStateTransition indicates an expected call of ClusterStateTransition.
+func (mr *MockAPIMockRecorder) ClusterStateTransition(fromState, toState, trigger, openshiftVersion, platform, timeInState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterStateTransition", reflect.TypeOf((*MockAPI)(nil).ClusterStateTransition), fromState, toState, trigger, openshiftVersion, platform, timeInState)
+}
+
 // ClusterValidationChanged mocks base method.
 func (m *MockAPI) ClusterValidationChanged(clusterValidationType models.ClusterValidationID) {
 	m.ctrl.T.Helper()
@@ -85,6 +97,30 @@ func (mr *MockAPIMockRecorder) ClusterValidationFailed(clusterValidationType int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterValidationFailed", reflect.TypeOf((*MockAPI)(nil).ClusterValidationFailed), clusterValidationType)
 }
 
+// ComponentHealth mocks base method.
+func (m *MockAPI) ComponentHealth(component string, healthy bool, checkDuration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ComponentHealth", component, healthy, checkDuration)
+}
+
+// ComponentHealth indicates an expected call of ComponentHealth.
+func (mr *MockAPIMockRecorder) ComponentHealth(component, healthy, checkDuration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComponentHealth", reflect.TypeOf((*MockAPI)(nil).ComponentHealth), component, healthy, checkDuration)
+}
+
+// DBConnections mocks base method.
+func (m *MockAPI) DBConnections(inUse, idle, maxOpen int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DBConnections", inUse, idle, maxOpen)
+}
+
+// DBConnections indicates an expected call of DBConnections.
+func (mr *MockAPIMockRecorder) DBConnections(inUse, idle, maxOpen interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DBConnections", reflect.TypeOf((*MockAPI)(nil).DBConnections), inUse, idle, maxOpen)
+}
+
 // DiskSyncDuration mocks base method.
 func (m *MockAPI) DiskSyncDuration(syncDuration int64) {
 	m.ctrl.T.Helper()
@@ -121,6 +157,30 @@ func (mr *MockAPIMockRecorder) FileSystemUsage(usageInPercentage interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSystemUsage", reflect.TypeOf((*MockAPI)(nil).FileSystemUsage), usageInPercentage)
 }
 
+// HostStateTransition mocks base method.
+func (m *MockAPI) HostStateTransition(fromState, toState, trigger, openshiftVersion, platform string, timeInState time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HostStateTransition", fromState, toState, trigger, openshiftVersion, platform, timeInState)
+}
+
+// HostStateTransition indicates an expected call of HostStateTransition.
+func (mr *MockAPIMockRecorder) HostStateTransition(fromState, toState, trigger, openshiftVersion, platform, timeInState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostStateTransition", reflect.TypeOf((*MockAPI)(nil).HostStateTransition), fromState, toState, trigger, openshiftVersion, platform, timeInState)
+}
+
+// HostStepScheduled mocks base method.
+func (m *MockAPI) HostStepScheduled(stepType models.StepType, decision string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HostStepScheduled", stepType, decision)
+}
+
+// HostStepScheduled indicates an expected call of HostStepScheduled.
+func (mr *MockAPIMockRecorder) HostStepScheduled(stepType, decision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostStepScheduled", reflect.TypeOf((*MockAPI)(nil).HostStepScheduled), stepType, decision)
+}
+
 // HostValidationChanged mocks base method.
 func (m *MockAPI) HostValidationChanged(hostValidationType models.HostValidationID) {
 	m.ctrl.T.Helper()
@@ -145,6 +205,42 @@ func (mr *MockAPIMockRecorder) HostValidationFailed(hostValidationType interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostValidationFailed", reflect.TypeOf((*MockAPI)(nil).HostValidationFailed), hostValidationType)
 }
 
+// HostValidationFlapping mocks base method.
+func (m *MockAPI) HostValidationFlapping(hostValidationType models.HostValidationID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HostValidationFlapping", hostValidationType)
+}
+
+// HostValidationFlapping indicates an expected call of HostValidationFlapping.
+func (mr *MockAPIMockRecorder) HostValidationFlapping(hostValidationType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostValidationFlapping", reflect.TypeOf((*MockAPI)(nil).HostValidationFlapping), hostValidationType)
+}
+
+// HostValidationFlipped mocks base method.
+func (m *MockAPI) HostValidationFlipped(hostValidationType models.HostValidationID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HostValidationFlipped", hostValidationType)
+}
+
+// HostValidationFlipped indicates an expected call of HostValidationFlipped.
+func (mr *MockAPIMockRecorder) HostValidationFlipped(hostValidationType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostValidationFlipped", reflect.TypeOf((*MockAPI)(nil).HostValidationFlipped), hostValidationType)
+}
+
+// HostValidationSucceeded mocks base method.
+func (m *MockAPI) HostValidationSucceeded(hostValidationType models.HostValidationID, timeToSuccess time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HostValidationSucceeded", hostValidationType, timeToSuccess)
+}
+
+// HostValidationSucceeded indicates an expected call of HostValidationSucceeded.
+func (mr *MockAPIMockRecorder) HostValidationSucceeded(hostValidationType, timeToSuccess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostValidationSucceeded", reflect.TypeOf((*MockAPI)(nil).HostValidationSucceeded), hostValidationType, timeToSuccess)
+}
+
 // ImagePullStatus mocks base method.
 func (m *MockAPI) ImagePullStatus(imageName, resultStatus string, downloadRate float64) {
 	m.ctrl.T.Helper()
@@ -169,6 +265,18 @@ func (mr *MockAPIMockRecorder) InstallationStarted() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallationStarted", reflect.TypeOf((*MockAPI)(nil).InstallationStarted))
 }
 
+// Leader mocks base method.
+func (m *MockAPI) Leader(isLeader bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Leader", isLeader)
+}
+
+// Leader indicates an expected call of Leader.
+func (mr *MockAPIMockRecorder) Leader(isLeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leader", reflect.TypeOf((*MockAPI)(nil).Leader), isLeader)
+}
+
 // MonitoredClusterCount mocks base method.
 func (m *MockAPI) MonitoredClusterCount(monitoredClusters int64) {
 	m.ctrl.T.Helper()
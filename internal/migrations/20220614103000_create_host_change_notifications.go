@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// createHostChangeNotifications publishes the ID of a host on the host_changes channel whenever its status
+// changes or a diagnostic is queued for it, so agents waiting for their next steps on any replica are woken up
+func createHostChangeNotifications() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		statements := []string{
+			`CREATE OR REPLACE FUNCTION notify_host_change() RETURNS trigger AS $$
+			BEGIN
+				IF TG_TABLE_NAME = 'host_diagnostics' THEN
+					PERFORM pg_notify('host_changes', NEW.host_id::text);
+				ELSE
+					PERFORM pg_notify('host_changes', NEW.id::text);
+				END IF;
+				RETURN NULL;
+			END;
+			$$ LANGUAGE plpgsql`,
+			"DROP TRIGGER IF EXISTS host_status_changed ON hosts",
+			`CREATE TRIGGER host_status_changed AFTER UPDATE OF status ON hosts
+			FOR EACH ROW WHEN (OLD.status IS DISTINCT FROM NEW.status) EXECUTE PROCEDURE notify_host_change()`,
+			"DROP TRIGGER IF EXISTS host_diagnostic_created ON host_diagnostics",
+			`CREATE TRIGGER host_diagnostic_created AFTER INSERT ON host_diagnostics
+			FOR EACH ROW EXECUTE PROCEDURE notify_host_change()`,
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		statements := []string{
+			"DROP TRIGGER IF EXISTS host_status_changed ON hosts",
+			"DROP TRIGGER IF EXISTS host_diagnostic_created ON host_diagnostics",
+			"DROP FUNCTION IF EXISTS notify_host_change()",
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return &gormigrate.Migration{
+		ID:       "20220614103000",
+		Migrate:  gormigrate.MigrateFunc(migrate),
+		Rollback: gormigrate.RollbackFunc(rollback),
+	}
+}
@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("createHostChangeNotifications", func() {
+	var (
+		db     *gorm.DB
+		dbName string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	countTriggers := func() int64 {
+		var count int64
+		Expect(db.Raw("SELECT count(*) FROM pg_trigger WHERE tgname IN ('host_status_changed', 'host_diagnostic_created')").
+			Scan(&count).Error).ToNot(HaveOccurred())
+		return count
+	}
+
+	It("creates the triggers", func() {
+		Expect(migrateToBefore(db, "20220614103000")).To(Succeed())
+		Expect(countTriggers()).To(BeZero())
+
+		Expect(migrateTo(db, "20220614103000")).To(Succeed())
+		Expect(countTriggers()).To(Equal(int64(2)))
+	})
+
+	It("is idempotent", func() {
+		Expect(migrateTo(db, "20220614103000")).To(Succeed())
+		Expect(createHostChangeNotifications().Migrate(db)).To(Succeed())
+		Expect(countTriggers()).To(Equal(int64(2)))
+	})
+
+	It("rolls back", func() {
+		Expect(migrateTo(db, "20220614103000")).To(Succeed())
+
+		gm := gormigrate.New(db, gormigrate.DefaultOptions, post())
+		Expect(gm.RollbackMigration(createHostChangeNotifications())).To(Succeed())
+		Expect(countTriggers()).To(BeZero())
+	})
+})
@@ -39,6 +39,7 @@ func post() []*gormigrate.Migration {
 		changeStaticConfigFormat(),
 		multipleNetworksCleanup(),
 		dropClusterIgnitionOverrides(),
+		createHostChangeNotifications(),
 	}
 
 	sort.SliceStable(postMigrations, func(i, j int) bool { return postMigrations[i].ID < postMigrations[j].ID })
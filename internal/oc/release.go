@@ -1,6 +1,7 @@
 package oc
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -40,7 +41,7 @@ type Release interface {
 	GetOpenshiftVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
 	GetMajorMinorVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
 	GetReleaseArchitecture(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error)
-	Extract(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, platformType models.PlatformType, icspFile string) (string, error)
+	Extract(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, platformType models.PlatformType, icspFile string) (string, error)
 }
 
 type imageValue struct {
@@ -263,7 +264,7 @@ func (r *release) getOpenshiftVersionFromRelease(log logrus.FieldLogger, release
 
 // Extract openshift-baremetal-install binary from releaseImageMirror if provided.
 // Else extract from the source releaseImage
-func (r *release) Extract(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, platformType models.PlatformType, icspFile string) (string, error) {
+func (r *release) Extract(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, platformType models.PlatformType, icspFile string) (string, error) {
 	var path string
 	var err error
 	if releaseImage == "" && releaseImageMirror == "" {
@@ -271,13 +272,13 @@ func (r *release) Extract(log logrus.FieldLogger, releaseImage string, releaseIm
 	}
 	if releaseImageMirror != "" {
 		//TODO: Get mirror registry certificate from install-config
-		path, err = r.extractFromRelease(log, releaseImageMirror, cacheDir, pullSecret, true, platformType, icspFile)
+		path, err = r.extractFromRelease(ctx, log, releaseImageMirror, cacheDir, pullSecret, true, platformType, icspFile)
 		if err != nil {
 			log.WithError(err).Errorf("failed to extract openshift-baremetal-install from mirror release image %s", releaseImageMirror)
 			return "", err
 		}
 	} else {
-		path, err = r.extractFromRelease(log, releaseImage, cacheDir, pullSecret, false, platformType, icspFile)
+		path, err = r.extractFromRelease(ctx, log, releaseImage, cacheDir, pullSecret, false, platformType, icspFile)
 		if err != nil {
 			log.WithError(err).Errorf("failed to extract openshift-baremetal-install from release image %s", releaseImage)
 			return "", err
@@ -288,7 +289,7 @@ func (r *release) Extract(log logrus.FieldLogger, releaseImage string, releaseIm
 
 // extractFromRelease returns the path to an openshift-baremetal-install binary extracted from
 // the referenced release image.
-func (r *release) extractFromRelease(log logrus.FieldLogger, releaseImage, cacheDir, pullSecret string, insecure bool, platformType models.PlatformType, icspFile string) (string, error) {
+func (r *release) extractFromRelease(ctx context.Context, log logrus.FieldLogger, releaseImage, cacheDir, pullSecret string, insecure bool, platformType models.PlatformType, icspFile string) (string, error) {
 	// Using platform type as an indication for which openshift install binary to use
 	// (e.g. as non-x86_64 clusters should use the openshift-install binary).
 	var binary string
@@ -312,7 +313,7 @@ func (r *release) extractFromRelease(log logrus.FieldLogger, releaseImage, cache
 		cmd = fmt.Sprintf(templateExtractWithIcsp, binary, workdir, insecure, icspFile, releaseImage)
 	}
 
-	_, err = retry.Do(r.config.MaxTries, r.config.RetryDelay, execute, log, r.executer, pullSecret, cmd, ocAuthArgument)
+	_, err = retry.Do(r.config.MaxTries, r.config.RetryDelay, executeWithContext, ctx, log, r.executer, pullSecret, cmd, ocAuthArgument)
 	if err != nil {
 		return "", err
 	}
@@ -323,6 +324,19 @@ func (r *release) extractFromRelease(log logrus.FieldLogger, releaseImage, cache
 }
 
 func execute(log logrus.FieldLogger, executer executer.Executer, pullSecret string, command string, authArgument string) (string, error) {
+	return executeWithPullSecret(log, executer, pullSecret, command, authArgument, executer.Execute)
+}
+
+// executeWithContext executes the command with the context of the caller, so that it is traced as part of its request
+func executeWithContext(ctx context.Context, log logrus.FieldLogger, executer executer.Executer, pullSecret string, command string, authArgument string) (string, error) {
+	return executeWithPullSecret(log, executer, pullSecret, command, authArgument,
+		func(command string, args ...string) (string, string, int) {
+			return executer.ExecuteWithContext(ctx, command, args...)
+		})
+}
+
+func executeWithPullSecret(log logrus.FieldLogger, executer executer.Executer, pullSecret string, command string, authArgument string,
+	run func(command string, args ...string) (string, string, int)) (string, error) {
 	// write pull secret to a temp file
 	ps, err := executer.TempFile("", "registry-config")
 	if err != nil {
@@ -341,7 +355,7 @@ func execute(log logrus.FieldLogger, executer executer.Executer, pullSecret stri
 	executeCommand := command[:] + authArgument + ps.Name()
 	args := strings.Split(executeCommand, " ")
 
-	stdout, stderr, exitCode := executer.Execute(args[0], args[1:]...)
+	stdout, stderr, exitCode := run(args[0], args[1:]...)
 
 	if exitCode == 0 {
 		return strings.TrimSpace(stdout), nil
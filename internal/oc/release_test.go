@@ -1,6 +1,7 @@
 package oc
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	os "os"
@@ -372,9 +373,9 @@ var _ = Describe("oc", func() {
 			command := fmt.Sprintf(templateExtract+" --registry-config=%s",
 				baremetalInstallBinary, filepath.Join(cacheDir, releaseImage), false, releaseImage, tempFilePath)
 			args := splitStringToInterfacesArray(command)
-			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "", 0).Times(1)
+			mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), args[0], args[1:]...).Return("", "", 0).Times(1)
 
-			path, err := oc.Extract(log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			filePath := filepath.Join(cacheDir+"/"+releaseImage, baremetalInstallBinary)
 			Expect(path).To(Equal(filePath))
 			Expect(err).ShouldNot(HaveOccurred())
@@ -384,16 +385,16 @@ var _ = Describe("oc", func() {
 			command := fmt.Sprintf(templateExtract+" --registry-config=%s",
 				baremetalInstallBinary, filepath.Join(cacheDir, releaseImageMirror), true, releaseImageMirror, tempFilePath)
 			args := splitStringToInterfacesArray(command)
-			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "", 0).Times(1)
+			mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), args[0], args[1:]...).Return("", "", 0).Times(1)
 
-			path, err := oc.Extract(log, releaseImage, releaseImageMirror, cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, releaseImageMirror, cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			filePath := filepath.Join(cacheDir+"/"+releaseImageMirror, baremetalInstallBinary)
 			Expect(path).To(Equal(filePath))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 
 		It("extract baremetal-install with no release image or mirror", func() {
-			path, err := oc.Extract(log, "", "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, "", "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			Expect(path).Should(BeEmpty())
 			Expect(err).Should(HaveOccurred())
 		})
@@ -401,10 +402,10 @@ var _ = Describe("oc", func() {
 			command := fmt.Sprintf(templateExtract+" --registry-config=%s",
 				baremetalInstallBinary, filepath.Join(cacheDir, releaseImage), false, releaseImage, tempFilePath)
 			args := splitStringToInterfacesArray(command)
-			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "Failed to extract the installer", 1).Times(1)
-			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "", 0).Times(1)
+			mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), args[0], args[1:]...).Return("", "Failed to extract the installer", 1).Times(1)
+			mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), args[0], args[1:]...).Return("", "", 0).Times(1)
 
-			path, err := oc.Extract(log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			filePath := filepath.Join(cacheDir+"/"+releaseImage, baremetalInstallBinary)
 			Expect(path).To(Equal(filePath))
 			Expect(err).ShouldNot(HaveOccurred())
@@ -414,9 +415,9 @@ var _ = Describe("oc", func() {
 			command := fmt.Sprintf(templateExtract+" --registry-config=%s",
 				baremetalInstallBinary, filepath.Join(cacheDir, releaseImage), false, releaseImage, tempFilePath)
 			args := splitStringToInterfacesArray(command)
-			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "Failed to extract the installer", 1).Times(5)
+			mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), args[0], args[1:]...).Return("", "Failed to extract the installer", 1).Times(5)
 
-			path, err := oc.Extract(log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			Expect(path).To(Equal(""))
 			Expect(err).Should(HaveOccurred())
 		})
@@ -425,9 +426,9 @@ var _ = Describe("oc", func() {
 			command := fmt.Sprintf(templateExtract+" --registry-config=%s",
 				installBinary, filepath.Join(cacheDir, releaseImage), false, releaseImage, tempFilePath)
 			args := splitStringToInterfacesArray(command)
-			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "", 0).Times(1)
+			mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), args[0], args[1:]...).Return("", "", 0).Times(1)
 
-			path, err := oc.Extract(log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeNone, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeNone, "")
 			filePath := filepath.Join(cacheDir+"/"+releaseImage, installBinary)
 			Expect(path).To(Equal(filePath))
 			Expect(err).ShouldNot(HaveOccurred())
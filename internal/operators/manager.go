@@ -21,7 +21,9 @@ import (
 	"github.com/thoas/go-funk"
 )
 
-const customManifestFile = "custom_manifests.json"
+// CustomManifestFile is the name of the file holding the manifests that the assisted-installer-controller
+// applies once OLM is deployed
+const CustomManifestFile = "custom_manifests.json"
 
 // Manifest store the operator manifest used by assisted-installer to create CRs of the OLM.
 type Manifest struct {
@@ -50,6 +52,9 @@ type API interface {
 	// GenerateManifests generates manifests for all enabled operators.
 	// Returns map assigning manifest content to its desired file name
 	GenerateManifests(ctx context.Context, cluster *common.Cluster) error
+	// RenderManifests renders the manifests of all enabled operators without storing them.
+	// Returns the openshift manifests by file name and the content of the custom manifests file
+	RenderManifests(ctx context.Context, cluster *common.Cluster) (map[string][]byte, []byte, error)
 	// AnyOLMOperatorEnabled checks whether any OLM operator has been enabled for the given cluster
 	AnyOLMOperatorEnabled(cluster *common.Cluster) bool
 	// ResolveDependencies amends the list of requested additional operators with any missing dependencies
@@ -111,6 +116,31 @@ func (mgr *Manager) GetRequirementsBreakdownForHostInCluster(ctx context.Context
 // GenerateManifests generates manifests for all enabled operators.
 // Returns map assigning manifest content to its desired file name
 func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Cluster) error {
+	openshiftManifests, customManifest, err := mgr.RenderManifests(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	for k, v := range openshiftManifests {
+		err = mgr.createManifests(ctx, cluster, k, v, models.ManifestFolderOpenshift)
+		if err != nil {
+			return err
+		}
+	}
+
+	if customManifest != nil {
+		if err := mgr.createCustomManifest(ctx, cluster, string(customManifest)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RenderManifests renders the manifests of all enabled operators without storing them.
+// Returns the openshift manifests by file name and the content of the custom manifests file,
+// which is nil when no operator has custom manifests
+func (mgr *Manager) RenderManifests(_ context.Context, cluster *common.Cluster) (map[string][]byte, []byte, error) {
+	openshiftManifests := make(map[string][]byte)
 	var customManifests []Manifest
 	// Generate manifests for all the generic operators
 	for _, clusterOperator := range cluster.MonitoredOperators {
@@ -120,40 +150,34 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 
 		operator := mgr.olmOperators[clusterOperator.Name]
 		if operator != nil {
-			openshiftManifests, manifest, err := operator.GenerateManifests(cluster)
+			operatorManifests, manifest, err := operator.GenerateManifests(cluster)
 			if err != nil {
 				mgr.log.Error(fmt.Sprintf("Cannot generate %s manifests due to ", clusterOperator.Name), err)
-				return err
+				return nil, nil, err
 			}
-			for k, v := range openshiftManifests {
-				err = mgr.createManifests(ctx, cluster, k, v, models.ManifestFolderOpenshift)
-				if err != nil {
-					return err
-				}
+			for k, v := range operatorManifests {
+				openshiftManifests[k] = v
 			}
 
 			customManifests = append(customManifests, Manifest{Name: clusterOperator.Name, Content: base64.StdEncoding.EncodeToString(manifest)})
 		}
 	}
 
-	if len(customManifests) > 0 {
-		content, err := json.Marshal(customManifests)
-		if err != nil {
-			return err
-		}
-		if err := mgr.createCustomManifest(ctx, cluster, string(content)); err != nil {
-			return err
-		}
+	if len(customManifests) == 0 {
+		return openshiftManifests, nil, nil
 	}
-
-	return nil
+	content, err := json.Marshal(customManifests)
+	if err != nil {
+		return nil, nil, err
+	}
+	return openshiftManifests, content, nil
 }
 
 // createCustomManifest create a file called custom_manifests.json, which is later obtained by the
 // assisted-installer-controller, which apply this manifest file after the OLM is deployed,
 // so user can provide here even CRs provisioned by the OLM.
 func (mgr *Manager) createCustomManifest(ctx context.Context, cluster *common.Cluster, content string) error {
-	objectFileName := path.Join(string(*cluster.ID), customManifestFile)
+	objectFileName := path.Join(string(*cluster.ID), CustomManifestFile)
 	if err := mgr.objectHandler.Upload(ctx, []byte(content), objectFileName); err != nil {
 		return errors.Errorf("Failed to upload custom manifests for cluster %s", cluster.ID)
 	}
@@ -3,6 +3,7 @@ package operators_test
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 
 	"github.com/go-openapi/strfmt"
@@ -136,6 +137,32 @@ var _ = Describe("Operators manager", func() {
 		})
 	})
 
+	Context("RenderManifests", func() {
+		It("renders the manifests without storing them", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				&lso.Operator,
+			}
+			openshiftManifests, customManifest, err := manager.RenderManifests(ctx, cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(openshiftManifests).To(HaveLen(3))
+			for _, content := range openshiftManifests {
+				_, err = yaml.YAMLToJSON(content)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			var manifests []operators.Manifest
+			Expect(json.Unmarshal(customManifest, &manifests)).To(Succeed())
+			Expect(manifests).To(HaveLen(1))
+			Expect(manifests[0].Name).To(Equal(lso.Operator.Name))
+		})
+
+		It("renders nothing without OLM operators", func() {
+			openshiftManifests, customManifest, err := manager.RenderManifests(ctx, cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(openshiftManifests).To(BeEmpty())
+			Expect(customManifest).To(BeNil())
+		})
+	})
+
 	Context("AnyOLMOperatorEnabled", func() {
 		table.DescribeTable("should report any operator enabled", func(operators []*models.MonitoredOperator, expected bool) {
 			cluster.MonitoredOperators = operators
@@ -167,6 +167,22 @@ func (mr *MockAPIMockRecorder) GetSupportedOperatorsByType(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedOperatorsByType", reflect.TypeOf((*MockAPI)(nil).GetSupportedOperatorsByType), arg0)
 }
 
+// RenderManifests mocks base method.
+func (m *MockAPI) RenderManifests(arg0 context.Context, arg1 *common.Cluster) (map[string][]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderManifests", arg0, arg1)
+	ret0, _ := ret[0].(map[string][]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RenderManifests indicates an expected call of RenderManifests.
+func (mr *MockAPIMockRecorder) RenderManifests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderManifests", reflect.TypeOf((*MockAPI)(nil).RenderManifests), arg0, arg1)
+}
+
 // ResolveDependencies mocks base method.
 func (m *MockAPI) ResolveDependencies(arg0 []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
 	m.ctrl.T.Helper()
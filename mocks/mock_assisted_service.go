@@ -232,6 +232,20 @@ func (mr *MockInstallerAPIMockRecorder) UpdateInfraEnv(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfraEnv", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateInfraEnv), arg0, arg1)
 }
 
+// V2CancelInstallSchedule mocks base method.
+func (m *MockInstallerAPI) V2CancelInstallSchedule(arg0 context.Context, arg1 installer.V2CancelInstallScheduleParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2CancelInstallSchedule", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2CancelInstallSchedule indicates an expected call of V2CancelInstallSchedule.
+func (mr *MockInstallerAPIMockRecorder) V2CancelInstallSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CancelInstallSchedule", reflect.TypeOf((*MockInstallerAPI)(nil).V2CancelInstallSchedule), arg0, arg1)
+}
+
 // V2CancelInstallation mocks base method.
 func (m *MockInstallerAPI) V2CancelInstallation(arg0 context.Context, arg1 installer.V2CancelInstallationParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -260,6 +274,20 @@ func (mr *MockInstallerAPIMockRecorder) V2CompleteInstallation(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CompleteInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).V2CompleteInstallation), arg0, arg1)
 }
 
+// V2CreateHostDiagnostic mocks base method.
+func (m *MockInstallerAPI) V2CreateHostDiagnostic(arg0 context.Context, arg1 installer.V2CreateHostDiagnosticParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2CreateHostDiagnostic", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2CreateHostDiagnostic indicates an expected call of V2CreateHostDiagnostic.
+func (mr *MockInstallerAPIMockRecorder) V2CreateHostDiagnostic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CreateHostDiagnostic", reflect.TypeOf((*MockInstallerAPI)(nil).V2CreateHostDiagnostic), arg0, arg1)
+}
+
 // V2DeregisterCluster mocks base method.
 func (m *MockInstallerAPI) V2DeregisterCluster(arg0 context.Context, arg1 installer.V2DeregisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -358,6 +386,20 @@ func (mr *MockInstallerAPIMockRecorder) V2DownloadInfraEnvFiles(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadInfraEnvFiles", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadInfraEnvFiles), arg0, arg1)
 }
 
+// V2DryRunInstallation mocks base method.
+func (m *MockInstallerAPI) V2DryRunInstallation(arg0 context.Context, arg1 installer.V2DryRunInstallationParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DryRunInstallation", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DryRunInstallation indicates an expected call of V2DryRunInstallation.
+func (mr *MockInstallerAPIMockRecorder) V2DryRunInstallation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DryRunInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).V2DryRunInstallation), arg0, arg1)
+}
+
 // V2GetCluster mocks base method.
 func (m *MockInstallerAPI) V2GetCluster(arg0 context.Context, arg1 installer.V2GetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -400,6 +442,34 @@ func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallConfig(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallConfig), arg0, arg1)
 }
 
+// V2GetClusterInstallConfigDiff mocks base method.
+func (m *MockInstallerAPI) V2GetClusterInstallConfigDiff(arg0 context.Context, arg1 installer.V2GetClusterInstallConfigDiffParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterInstallConfigDiff", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterInstallConfigDiff indicates an expected call of V2GetClusterInstallConfigDiff.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallConfigDiff(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallConfigDiff", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallConfigDiff), arg0, arg1)
+}
+
+// V2GetClusterInstallationTimeline mocks base method.
+func (m *MockInstallerAPI) V2GetClusterInstallationTimeline(arg0 context.Context, arg1 installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterInstallationTimeline", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterInstallationTimeline indicates an expected call of V2GetClusterInstallationTimeline.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallationTimeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallationTimeline", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallationTimeline), arg0, arg1)
+}
+
 // V2GetCredentials mocks base method.
 func (m *MockInstallerAPI) V2GetCredentials(arg0 context.Context, arg1 installer.V2GetCredentialsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -428,6 +498,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetHost(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetHost), arg0, arg1)
 }
 
+// V2GetHostDiagnostic mocks base method.
+func (m *MockInstallerAPI) V2GetHostDiagnostic(arg0 context.Context, arg1 installer.V2GetHostDiagnosticParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetHostDiagnostic", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetHostDiagnostic indicates an expected call of V2GetHostDiagnostic.
+func (mr *MockInstallerAPIMockRecorder) V2GetHostDiagnostic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetHostDiagnostic", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetHostDiagnostic), arg0, arg1)
+}
+
 // V2GetHostIgnition mocks base method.
 func (m *MockInstallerAPI) V2GetHostIgnition(arg0 context.Context, arg1 installer.V2GetHostIgnitionParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -540,6 +624,34 @@ func (mr *MockInstallerAPIMockRecorder) V2InstallHost(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2InstallHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2InstallHost), arg0, arg1)
 }
 
+// V2ListClusterInstallConfigHistory mocks base method.
+func (m *MockInstallerAPI) V2ListClusterInstallConfigHistory(arg0 context.Context, arg1 installer.V2ListClusterInstallConfigHistoryParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListClusterInstallConfigHistory", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListClusterInstallConfigHistory indicates an expected call of V2ListClusterInstallConfigHistory.
+func (mr *MockInstallerAPIMockRecorder) V2ListClusterInstallConfigHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusterInstallConfigHistory", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusterInstallConfigHistory), arg0, arg1)
+}
+
+// V2ListClusterStateTransitions mocks base method.
+func (m *MockInstallerAPI) V2ListClusterStateTransitions(arg0 context.Context, arg1 installer.V2ListClusterStateTransitionsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListClusterStateTransitions", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListClusterStateTransitions indicates an expected call of V2ListClusterStateTransitions.
+func (mr *MockInstallerAPIMockRecorder) V2ListClusterStateTransitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusterStateTransitions", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusterStateTransitions), arg0, arg1)
+}
+
 // V2ListClusters mocks base method.
 func (m *MockInstallerAPI) V2ListClusters(arg0 context.Context, arg1 installer.V2ListClustersParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -554,6 +666,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ListClusters(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusters", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusters), arg0, arg1)
 }
 
+// V2ListDeletedClusters mocks base method.
+func (m *MockInstallerAPI) V2ListDeletedClusters(arg0 context.Context, arg1 installer.V2ListDeletedClustersParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListDeletedClusters", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListDeletedClusters indicates an expected call of V2ListDeletedClusters.
+func (mr *MockInstallerAPIMockRecorder) V2ListDeletedClusters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListDeletedClusters", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListDeletedClusters), arg0, arg1)
+}
+
 // V2ListFeatureSupportLevels mocks base method.
 func (m *MockInstallerAPI) V2ListFeatureSupportLevels(arg0 context.Context, arg1 installer.V2ListFeatureSupportLevelsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -568,6 +694,48 @@ func (mr *MockInstallerAPIMockRecorder) V2ListFeatureSupportLevels(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListFeatureSupportLevels", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListFeatureSupportLevels), arg0, arg1)
 }
 
+// V2ListHostDiagnostics mocks base method.
+func (m *MockInstallerAPI) V2ListHostDiagnostics(arg0 context.Context, arg1 installer.V2ListHostDiagnosticsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListHostDiagnostics", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListHostDiagnostics indicates an expected call of V2ListHostDiagnostics.
+func (mr *MockInstallerAPIMockRecorder) V2ListHostDiagnostics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListHostDiagnostics", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListHostDiagnostics), arg0, arg1)
+}
+
+// V2ListHostStateTransitions mocks base method.
+func (m *MockInstallerAPI) V2ListHostStateTransitions(arg0 context.Context, arg1 installer.V2ListHostStateTransitionsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListHostStateTransitions", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListHostStateTransitions indicates an expected call of V2ListHostStateTransitions.
+func (mr *MockInstallerAPIMockRecorder) V2ListHostStateTransitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListHostStateTransitions", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListHostStateTransitions), arg0, arg1)
+}
+
+// V2ListHostValidationStats mocks base method.
+func (m *MockInstallerAPI) V2ListHostValidationStats(arg0 context.Context, arg1 installer.V2ListHostValidationStatsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListHostValidationStats", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListHostValidationStats indicates an expected call of V2ListHostValidationStats.
+func (mr *MockInstallerAPIMockRecorder) V2ListHostValidationStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListHostValidationStats", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListHostValidationStats), arg0, arg1)
+}
+
 // V2ListHosts mocks base method.
 func (m *MockInstallerAPI) V2ListHosts(arg0 context.Context, arg1 installer.V2ListHostsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -624,6 +792,20 @@ func (mr *MockInstallerAPIMockRecorder) V2RegisterHost(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RegisterHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2RegisterHost), arg0, arg1)
 }
 
+// V2ReinstallFailedHosts mocks base method.
+func (m *MockInstallerAPI) V2ReinstallFailedHosts(arg0 context.Context, arg1 installer.V2ReinstallFailedHostsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ReinstallFailedHosts", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ReinstallFailedHosts indicates an expected call of V2ReinstallFailedHosts.
+func (mr *MockInstallerAPIMockRecorder) V2ReinstallFailedHosts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ReinstallFailedHosts", reflect.TypeOf((*MockInstallerAPI)(nil).V2ReinstallFailedHosts), arg0, arg1)
+}
+
 // V2ResetCluster mocks base method.
 func (m *MockInstallerAPI) V2ResetCluster(arg0 context.Context, arg1 installer.V2ResetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -666,6 +848,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ResetHostValidation(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ResetHostValidation", reflect.TypeOf((*MockInstallerAPI)(nil).V2ResetHostValidation), arg0, arg1)
 }
 
+// V2RestoreDeletedCluster mocks base method.
+func (m *MockInstallerAPI) V2RestoreDeletedCluster(arg0 context.Context, arg1 installer.V2RestoreDeletedClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2RestoreDeletedCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2RestoreDeletedCluster indicates an expected call of V2RestoreDeletedCluster.
+func (mr *MockInstallerAPIMockRecorder) V2RestoreDeletedCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RestoreDeletedCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2RestoreDeletedCluster), arg0, arg1)
+}
+
 // V2UpdateCluster mocks base method.
 func (m *MockInstallerAPI) V2UpdateCluster(arg0 context.Context, arg1 installer.V2UpdateClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
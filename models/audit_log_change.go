@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AuditLogChange audit log change
+//
+// swagger:model audit-log-change
+type AuditLogChange struct {
+
+	// The path of the field, such as platform.type.
+	Field string `json:"field,omitempty"`
+
+	// The value of the field before the change.
+	From string `json:"from,omitempty"`
+
+	// The value of the field after the change.
+	To string `json:"to,omitempty"`
+}
+
+// Validate validates this audit log change
+func (m *AuditLogChange) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this audit log change based on context it is used
+func (m *AuditLogChange) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AuditLogChange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AuditLogChange) UnmarshalBinary(b []byte) error {
+	var res AuditLogChange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,282 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AuditLogEntry audit log entry
+//
+// swagger:model audit-log-entry
+type AuditLogEntry struct {
+
+	// The operation that made the change, such as v2UpdateCluster.
+	Action string `json:"action,omitempty"`
+
+	// The user that made the change.
+	Actor string `json:"actor,omitempty" gorm:"index"`
+
+	// The fields of the resource that changed. The values of secret fields are redacted.
+	Changes []*AuditLogChange `json:"changes" gorm:"type:text;serializer:json"`
+
+	// The time at which the change was made.
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone;index"`
+
+	// Unique identifier of the entry.
+	ID int64 `json:"id,omitempty" gorm:"primaryKey"`
+
+	// The organization of the user that made the change.
+	OrgID string `json:"org_id,omitempty" gorm:"index"`
+
+	// The ID of the request that made the change, as written to the logs.
+	RequestID string `json:"request_id,omitempty"`
+
+	// The changed cluster, host or infra-env.
+	// Format: uuid
+	ResourceID *strfmt.UUID `json:"resource_id,omitempty" gorm:"index"`
+
+	// The kind of the changed resource, empty when the change is not made to a cluster, a host or an infra-env.
+	// Enum: [cluster host infra-env]
+	ResourceKind string `json:"resource_kind,omitempty"`
+
+	// The interface through which the change was made.
+	// Enum: [rest-api kube-api]
+	Source string `json:"source,omitempty"`
+
+	// The HTTP status code of the response, for changes made through the REST API.
+	StatusCode int64 `json:"status_code,omitempty"`
+
+	// Whether the operation succeeded.
+	Succeeded bool `json:"succeeded,omitempty"`
+}
+
+// Validate validates this audit log entry
+func (m *AuditLogEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResourceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResourceKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) validateChanges(formats strfmt.Registry) error {
+	if swag.IsZero(m.Changes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Changes); i++ {
+		if swag.IsZero(m.Changes[i]) { // not required
+			continue
+		}
+
+		if m.Changes[i] != nil {
+			if err := m.Changes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("changes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *AuditLogEntry) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditLogEntry) validateResourceID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ResourceID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("resource_id", "body", "uuid", m.ResourceID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var auditLogEntryTypeResourceKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["cluster","host","infra-env"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		auditLogEntryTypeResourceKindPropEnum = append(auditLogEntryTypeResourceKindPropEnum, v)
+	}
+}
+
+const (
+
+	// AuditLogEntryResourceKindCluster captures enum value "cluster"
+	AuditLogEntryResourceKindCluster string = "cluster"
+
+	// AuditLogEntryResourceKindHost captures enum value "host"
+	AuditLogEntryResourceKindHost string = "host"
+
+	// AuditLogEntryResourceKindInfraEnv captures enum value "infra-env"
+	AuditLogEntryResourceKindInfraEnv string = "infra-env"
+)
+
+// prop value enum
+func (m *AuditLogEntry) validateResourceKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, auditLogEntryTypeResourceKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) validateResourceKind(formats strfmt.Registry) error {
+	if swag.IsZero(m.ResourceKind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateResourceKindEnum("resource_kind", "body", m.ResourceKind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var auditLogEntryTypeSourcePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["rest-api","kube-api"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		auditLogEntryTypeSourcePropEnum = append(auditLogEntryTypeSourcePropEnum, v)
+	}
+}
+
+const (
+
+	// AuditLogEntrySourceRestAPI captures enum value "rest-api"
+	AuditLogEntrySourceRestAPI string = "rest-api"
+
+	// AuditLogEntrySourceKubeAPI captures enum value "kube-api"
+	AuditLogEntrySourceKubeAPI string = "kube-api"
+)
+
+// prop value enum
+func (m *AuditLogEntry) validateSourceEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, auditLogEntryTypeSourcePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) validateSource(formats strfmt.Registry) error {
+	if swag.IsZero(m.Source) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSourceEnum("source", "body", m.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this audit log entry based on the context it is used
+func (m *AuditLogEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateChanges(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) contextValidateChanges(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Changes); i++ {
+
+		if m.Changes[i] != nil {
+			if err := m.Changes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("changes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AuditLogEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AuditLogEntry) UnmarshalBinary(b []byte) error {
+	var res AuditLogEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -73,6 +73,9 @@ type Cluster struct {
 	// swagger:ignore
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamp with time zone;index"`
 
+	// Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.
+	DeletionProtected *bool `json:"deletion_protected,omitempty" gorm:"default:false"`
+
 	// Information regarding hosts' installation disks encryption.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
@@ -94,6 +97,9 @@ type Cluster struct {
 	// List of host networks to be filled during query.
 	HostNetworks []*HostNetwork `json:"host_networks" gorm:"-"`
 
+	// JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.
+	HostStageTimeouts string `json:"host_stage_timeouts,omitempty" gorm:"type:text"`
+
 	// Hosts that are associated with this cluster.
 	Hosts []*Host `json:"hosts" gorm:"foreignkey:ClusterID;references:ID"`
 
@@ -149,6 +155,14 @@ type Cluster struct {
 	// Example: {\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}
 	InstallConfigOverrides string `json:"install_config_overrides,omitempty" gorm:"type:text"`
 
+	// The time after which the scheduled installation of this cluster expires if it did not start.
+	// Format: date-time
+	InstallScheduleEndAt *strfmt.DateTime `json:"install_schedule_end_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The time from which the scheduled installation of this cluster is allowed to start.
+	// Format: date-time
+	InstallScheduleStartAt *strfmt.DateTime `json:"install_schedule_start_at,omitempty" gorm:"type:timestamp with time zone"`
+
 	// The time that this cluster started installation.
 	// Format: date-time
 	InstallStartedAt strfmt.DateTime `json:"install_started_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -208,6 +222,9 @@ type Cluster struct {
 	// hosts associated to this cluster that are in 'known' state.
 	ReadyHostCount int64 `json:"ready_host_count,omitempty" gorm:"-"`
 
+	// Incremented on each update of the cluster through its update API, and returned as its ETag.
+	ResourceVersion int64 `json:"resource_version,omitempty" gorm:"default:1"`
+
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
 
@@ -342,6 +359,14 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateInstallScheduleEndAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstallScheduleStartAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInstallStartedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -772,6 +797,30 @@ func (m *Cluster) validateInstallCompletedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateInstallScheduleEndAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallScheduleEndAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("install_schedule_end_at", "body", "date-time", m.InstallScheduleEndAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateInstallScheduleStartAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallScheduleStartAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("install_schedule_start_at", "body", "date-time", m.InstallScheduleStartAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateInstallStartedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.InstallStartedAt) { // not required
 		return nil
@@ -46,6 +46,9 @@ type ClusterCreateParams struct {
 	// The CPU architecture of the image (x86_64/arm64/etc).
 	CPUArchitecture string `json:"cpu_architecture,omitempty"`
 
+	// Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.
+	DeletionProtected *bool `json:"deletion_protected,omitempty"`
+
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
@@ -55,6 +58,10 @@ type ClusterCreateParams struct {
 	// Enum: [Full None]
 	HighAvailabilityMode *string `json:"high_availability_mode,omitempty"`
 
+	// JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.
+	// Example: [{\"stage\": \"Writing image to disk\", \"timeout\": 600}, {\"stage\": \"Waiting for control plane\", \"role\": \"master\", \"timeout\": 7200}]
+	HostStageTimeouts *string `json:"host_stage_timeouts,omitempty"`
+
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
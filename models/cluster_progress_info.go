@@ -8,8 +8,10 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ClusterProgressInfo cluster progress info
@@ -17,6 +19,10 @@ import (
 // swagger:model cluster-progress-info
 type ClusterProgressInfo struct {
 
+	// Estimated time at which the installation completes, based on the stage durations of previous installations with the same OpenShift version and topology.
+	// Format: date-time
+	EstimatedCompletionAt *strfmt.DateTime `json:"estimated_completion_at,omitempty" gorm:"type:timestamp with time zone"`
+
 	// finalizing stage percentage
 	FinalizingStagePercentage int64 `json:"finalizing_stage_percentage,omitempty"`
 
@@ -32,6 +38,27 @@ type ClusterProgressInfo struct {
 
 // Validate validates this cluster progress info
 func (m *ClusterProgressInfo) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEstimatedCompletionAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterProgressInfo) validateEstimatedCompletionAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EstimatedCompletionAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("estimated_completion_at", "body", "date-time", m.EstimatedCompletionAt.String(), formats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
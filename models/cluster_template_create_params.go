@@ -0,0 +1,200 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterTemplateCreateParams cluster template create params
+//
+// swagger:model cluster-template-create-params
+type ClusterTemplateCreateParams struct {
+
+	// The properties of the clusters created from the template. The name and the pull secret of every cluster
+	// are given when it is registered, so the ones of the template are not used and the pull secret is not stored.
+	//
+	// Required: true
+	ClusterParams *ClusterCreateParams `json:"cluster_params" gorm:"type:text;serializer:json"`
+
+	// A description of the cluster template.
+	Description string `json:"description,omitempty"`
+
+	// JSON-formatted string containing the install config overrides of the clusters created from the template.
+	InstallConfigOverrides string `json:"install_config_overrides,omitempty"`
+
+	// Manifests to add to the clusters created from the template.
+	Manifests []*CreateManifestParams `json:"manifests"`
+
+	// Name of the cluster template.
+	// Required: true
+	// Max Length: 256
+	// Min Length: 1
+	Name *string `json:"name"`
+}
+
+// Validate validates this cluster template create params
+func (m *ClusterTemplateCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateManifests(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateClusterParams(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_params", "body", m.ClusterParams); err != nil {
+		return err
+	}
+
+	if m.ClusterParams != nil {
+		if err := m.ClusterParams.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateManifests(formats strfmt.Registry) error {
+	if swag.IsZero(m.Manifests) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Manifests); i++ {
+		if swag.IsZero(m.Manifests[i]) { // not required
+			continue
+		}
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("name", "body", *m.Name, 256); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster template create params based on the context it is used
+func (m *ClusterTemplateCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateClusterParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateManifests(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) contextValidateClusterParams(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ClusterParams != nil {
+		if err := m.ClusterParams.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterTemplateCreateParams) contextValidateManifests(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Manifests); i++ {
+
+		if m.Manifests[i] != nil {
+			if err := m.Manifests[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("manifests" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("manifests" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterTemplateCreateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterTemplateCreateParams) UnmarshalBinary(b []byte) error {
+	var res ClusterTemplateCreateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DeletedCluster deleted cluster
+//
+// swagger:model deleted-cluster
+type DeletedCluster struct {
+
+	// The time the cluster was deregistered.
+	// Required: true
+	// Format: date-time
+	DeletedAt *strfmt.DateTime `json:"deleted_at"`
+
+	// Unique identifier of the deregistered cluster.
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id"`
+
+	// Name of the deregistered cluster.
+	Name string `json:"name,omitempty"`
+
+	// Version of the OpenShift cluster.
+	OpenshiftVersion string `json:"openshift_version,omitempty"`
+
+	// org id
+	OrgID string `json:"org_id,omitempty"`
+
+	// The time after which the cluster is permanently deleted and can no longer be restored.
+	// Required: true
+	// Format: date-time
+	RestorableUntil *strfmt.DateTime `json:"restorable_until"`
+
+	// Status of the cluster when it was deregistered.
+	Status string `json:"status,omitempty"`
+
+	// user name
+	UserName string `json:"user_name,omitempty"`
+}
+
+// Validate validates this deleted cluster
+func (m *DeletedCluster) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRestorableUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DeletedCluster) validateDeletedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("deleted_at", "body", m.DeletedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("deleted_at", "body", "date-time", m.DeletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DeletedCluster) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DeletedCluster) validateRestorableUntil(formats strfmt.Registry) error {
+
+	if err := validate.Required("restorable_until", "body", m.RestorableUntil); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("restorable_until", "body", "date-time", m.RestorableUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this deleted cluster based on context it is used
+func (m *DeletedCluster) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DeletedCluster) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DeletedCluster) UnmarshalBinary(b []byte) error {
+	var res DeletedCluster
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
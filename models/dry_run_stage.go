@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DryRunStage dry run stage
+//
+// swagger:model dry-run-stage
+type DryRunStage struct {
+
+	// The error that failed the stage.
+	Error string `json:"error,omitempty"`
+
+	// name
+	// Required: true
+	Name *DryRunStageName `json:"name"`
+
+	// The outcome of the stage. A stage is skipped when a stage it depends on failed.
+	// Required: true
+	// Enum: [succeeded failed skipped]
+	Status *string `json:"status"`
+}
+
+// Validate validates this dry run stage
+func (m *DryRunStage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DryRunStage) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if m.Name != nil {
+		if err := m.Name.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("name")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("name")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var dryRunStageTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["succeeded","failed","skipped"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dryRunStageTypeStatusPropEnum = append(dryRunStageTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// DryRunStageStatusSucceeded captures enum value "succeeded"
+	DryRunStageStatusSucceeded string = "succeeded"
+
+	// DryRunStageStatusFailed captures enum value "failed"
+	DryRunStageStatusFailed string = "failed"
+
+	// DryRunStageStatusSkipped captures enum value "skipped"
+	DryRunStageStatusSkipped string = "skipped"
+)
+
+// prop value enum
+func (m *DryRunStage) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dryRunStageTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DryRunStage) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dry run stage based on the context it is used
+func (m *DryRunStage) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateName(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DryRunStage) contextValidateName(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Name != nil {
+		if err := m.Name.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("name")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("name")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DryRunStage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DryRunStage) UnmarshalBinary(b []byte) error {
+	var res DryRunStage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// DryRunStageName A stage of the generation of the installation files.
+//
+// swagger:model dry-run-stage-name
+type DryRunStageName string
+
+func NewDryRunStageName(value DryRunStageName) *DryRunStageName {
+	v := value
+	return &v
+}
+
+const (
+
+	// DryRunStageNameInstallConfig captures enum value "install-config"
+	DryRunStageNameInstallConfig DryRunStageName = "install-config"
+
+	// DryRunStageNameOperatorManifests captures enum value "operator-manifests"
+	DryRunStageNameOperatorManifests DryRunStageName = "operator-manifests"
+
+	// DryRunStageNameIgnition captures enum value "ignition"
+	DryRunStageNameIgnition DryRunStageName = "ignition"
+)
+
+// for schema
+var dryRunStageNameEnum []interface{}
+
+func init() {
+	var res []DryRunStageName
+	if err := json.Unmarshal([]byte(`["install-config","operator-manifests","ignition"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dryRunStageNameEnum = append(dryRunStageNameEnum, v)
+	}
+}
+
+func (m DryRunStageName) validateDryRunStageNameEnum(path, location string, value DryRunStageName) error {
+	if err := validate.EnumCase(path, location, value, dryRunStageNameEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this dry run stage name
+func (m DryRunStageName) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateDryRunStageNameEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this dry run stage name based on context it is used
+func (m DryRunStageName) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
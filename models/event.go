@@ -26,20 +26,20 @@ type Event struct {
 
 	// Unique identifier of the cluster this event relates to.
 	// Format: uuid
-	ClusterID *strfmt.UUID `json:"cluster_id,omitempty" gorm:"index"`
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty" gorm:"index;index:events_cluster_id_event_time_idx,priority:1"`
 
 	// event time
 	// Required: true
 	// Format: date-time
-	EventTime *strfmt.DateTime `json:"event_time" gorm:"type:timestamp with time zone"`
+	EventTime *strfmt.DateTime `json:"event_time" gorm:"type:timestamp with time zone;index:events_cluster_id_event_time_idx,priority:2;index:events_host_id_event_time_idx,priority:2;index:events_infra_env_id_event_time_idx,priority:2"`
 
 	// Unique identifier of the host this event relates to.
 	// Format: uuid
-	HostID *strfmt.UUID `json:"host_id,omitempty" gorm:"index"`
+	HostID *strfmt.UUID `json:"host_id,omitempty" gorm:"index;index:events_host_id_event_time_idx,priority:1"`
 
 	// Unique identifier of the infra-env this event relates to.
 	// Format: uuid
-	InfraEnvID *strfmt.UUID `json:"infra_env_id,omitempty" gorm:"index"`
+	InfraEnvID *strfmt.UUID `json:"infra_env_id,omitempty" gorm:"index;index:events_infra_env_id_event_time_idx,priority:1"`
 
 	// message
 	// Required: true
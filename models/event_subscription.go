@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EventSubscription event subscription
+//
+// swagger:model event-subscription
+type EventSubscription struct {
+
+	// Only deliver the events of one of these categories. Only user events are delivered when empty.
+	Categories []string `json:"categories" gorm:"type:text;serializer:json"`
+
+	// Only deliver the events of this cluster, its hosts and infra-envs.
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// The time that the event subscription was created.
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// Whether events are delivered to the subscription.
+	Enabled bool `json:"enabled"`
+
+	// Only deliver the events with one of these names. All events are delivered when empty.
+	EventNames []string `json:"event_names" gorm:"type:text;serializer:json"`
+
+	// Unique identifier of the event subscription.
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id" gorm:"primaryKey"`
+
+	// Name of the event subscription.
+	// Required: true
+	Name *string `json:"name"`
+
+	// The organization of the user that created the event subscription. Only the events of this organization are delivered, unless the subscription was created by an admin.
+	OrgID string `json:"org_id,omitempty" gorm:"index"`
+
+	// Only deliver the events with one of these severities. All severities are delivered when empty.
+	Severities []string `json:"severities" gorm:"type:text;serializer:json"`
+
+	// Whether the delivered events are signed with the secret of the subscription.
+	Signed bool `json:"signed"`
+
+	// The last time that the event subscription was updated.
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The HTTP or HTTPS endpoint that the events are delivered to.
+	// Required: true
+	URL *string `json:"url"`
+
+	// The user that created the event subscription.
+	UserName string `json:"user_name,omitempty" gorm:"index"`
+}
+
+// Validate validates this event subscription
+func (m *EventSubscription) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EventSubscription) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscription) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscription) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscription) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscription) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscription) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this event subscription based on context it is used
+func (m *EventSubscription) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EventSubscription) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EventSubscription) UnmarshalBinary(b []byte) error {
+	var res EventSubscription
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
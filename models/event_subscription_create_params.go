@@ -0,0 +1,205 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EventSubscriptionCreateParams event subscription create params
+//
+// swagger:model event-subscription-create-params
+type EventSubscriptionCreateParams struct {
+
+	// Only deliver the events of one of these categories. Only user events are delivered when empty.
+	Categories []string `json:"categories"`
+
+	// Only deliver the events of this cluster, its hosts and infra-envs.
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// Whether events are delivered to the subscription.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Only deliver the events with one of these names. All events are delivered when empty.
+	EventNames []string `json:"event_names"`
+
+	// Name of the event subscription.
+	// Required: true
+	Name *string `json:"name"`
+
+	// A secret that signs the delivered events with HMAC-SHA256, in the X-Assisted-Signature header.
+	Secret string `json:"secret,omitempty"`
+
+	// Only deliver the events with one of these severities. All severities are delivered when empty.
+	Severities []string `json:"severities"`
+
+	// The HTTP or HTTPS endpoint that the events are delivered to.
+	// Required: true
+	URL *string `json:"url"`
+}
+
+// Validate validates this event subscription create params
+func (m *EventSubscriptionCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCategories(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSeverities(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var eventSubscriptionCreateParamsCategoriesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["user","metrics"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		eventSubscriptionCreateParamsCategoriesItemsEnum = append(eventSubscriptionCreateParamsCategoriesItemsEnum, v)
+	}
+}
+
+func (m *EventSubscriptionCreateParams) validateCategoriesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, eventSubscriptionCreateParamsCategoriesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EventSubscriptionCreateParams) validateCategories(formats strfmt.Registry) error {
+	if swag.IsZero(m.Categories) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Categories); i++ {
+
+		// value enum
+		if err := m.validateCategoriesItemsEnum("categories"+"."+strconv.Itoa(i), "body", m.Categories[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EventSubscriptionCreateParams) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscriptionCreateParams) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var eventSubscriptionCreateParamsSeveritiesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["info","warning","error","critical"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		eventSubscriptionCreateParamsSeveritiesItemsEnum = append(eventSubscriptionCreateParamsSeveritiesItemsEnum, v)
+	}
+}
+
+func (m *EventSubscriptionCreateParams) validateSeveritiesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, eventSubscriptionCreateParamsSeveritiesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EventSubscriptionCreateParams) validateSeverities(formats strfmt.Registry) error {
+	if swag.IsZero(m.Severities) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Severities); i++ {
+
+		// value enum
+		if err := m.validateSeveritiesItemsEnum("severities"+"."+strconv.Itoa(i), "body", m.Severities[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EventSubscriptionCreateParams) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this event subscription create params based on context it is used
+func (m *EventSubscriptionCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EventSubscriptionCreateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EventSubscriptionCreateParams) UnmarshalBinary(b []byte) error {
+	var res EventSubscriptionCreateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
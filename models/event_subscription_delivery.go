@@ -0,0 +1,247 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EventSubscriptionDelivery event subscription delivery
+//
+// swagger:model event-subscription-delivery
+type EventSubscriptionDelivery struct {
+
+	// The number of delivery attempts.
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// The cluster of the delivered event.
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// The time that the event was queued for delivery.
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone;index"`
+
+	// The error of the last attempt.
+	Error string `json:"error,omitempty" gorm:"type:text"`
+
+	// The identifier of the delivered event, which is also the id of the CloudEvent.
+	EventID int64 `json:"event_id,omitempty"`
+
+	// The name of the delivered event.
+	EventName string `json:"event_name,omitempty"`
+
+	// Unique identifier of the delivery.
+	// Required: true
+	ID *int64 `json:"id" gorm:"primaryKey;autoIncrement"`
+
+	// The time of the last delivery attempt.
+	// Format: date-time
+	LastAttemptAt strfmt.DateTime `json:"last_attempt_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The time of the next delivery attempt of a pending delivery.
+	// Format: date-time
+	NextAttemptAt strfmt.DateTime `json:"next_attempt_at,omitempty" gorm:"type:timestamp with time zone;index"`
+
+	// The CloudEvent that is delivered, in JSON format.
+	Payload string `json:"payload,omitempty" gorm:"type:text"`
+
+	// The HTTP status code returned by the endpoint on the last attempt.
+	ResponseCode int64 `json:"response_code,omitempty"`
+
+	// Pending deliveries are retried with a backoff until they are delivered, or fail after too many attempts.
+	// Required: true
+	// Enum: [pending delivered failed]
+	Status *string `json:"status" gorm:"index"`
+
+	// The event subscription of the delivery.
+	// Required: true
+	// Format: uuid
+	SubscriptionID *strfmt.UUID `json:"subscription_id" gorm:"index"`
+}
+
+// Validate validates this event subscription delivery
+func (m *EventSubscriptionDelivery) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastAttemptAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextAttemptAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSubscriptionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EventSubscriptionDelivery) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscriptionDelivery) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscriptionDelivery) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscriptionDelivery) validateLastAttemptAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastAttemptAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_attempt_at", "body", "date-time", m.LastAttemptAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscriptionDelivery) validateNextAttemptAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextAttemptAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_attempt_at", "body", "date-time", m.NextAttemptAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var eventSubscriptionDeliveryTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","delivered","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		eventSubscriptionDeliveryTypeStatusPropEnum = append(eventSubscriptionDeliveryTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// EventSubscriptionDeliveryStatusPending captures enum value "pending"
+	EventSubscriptionDeliveryStatusPending string = "pending"
+
+	// EventSubscriptionDeliveryStatusDelivered captures enum value "delivered"
+	EventSubscriptionDeliveryStatusDelivered string = "delivered"
+
+	// EventSubscriptionDeliveryStatusFailed captures enum value "failed"
+	EventSubscriptionDeliveryStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *EventSubscriptionDelivery) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, eventSubscriptionDeliveryTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EventSubscriptionDelivery) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EventSubscriptionDelivery) validateSubscriptionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("subscription_id", "body", m.SubscriptionID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("subscription_id", "body", "uuid", m.SubscriptionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this event subscription delivery based on context it is used
+func (m *EventSubscriptionDelivery) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EventSubscriptionDelivery) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EventSubscriptionDelivery) UnmarshalBinary(b []byte) error {
+	var res EventSubscriptionDelivery
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -149,6 +149,9 @@ type Host struct {
 	// requested hostname
 	RequestedHostname string `json:"requested_hostname,omitempty"`
 
+	// Incremented on each update of the host through its update API, and returned as its ETag.
+	ResourceVersion int64 `json:"resource_version,omitempty" gorm:"default:1"`
+
 	// role
 	Role HostRole `json:"role,omitempty"`
 
@@ -0,0 +1,273 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostDiagnostic host diagnostic
+//
+// swagger:model host-diagnostic
+type HostDiagnostic struct {
+
+	// action
+	Action HostDiagnosticAction `json:"action,omitempty"`
+
+	// The time at which the agent uploaded the diagnostic result.
+	// Format: date-time
+	CompletedAt strfmt.DateTime `json:"completed_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The standard error of the diagnostic command, truncated to a size limit.
+	Error string `json:"error,omitempty" gorm:"type:text"`
+
+	// The exit code of the diagnostic command.
+	ExitCode int64 `json:"exit_code,omitempty"`
+
+	// The host that the diagnostic runs on.
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty" gorm:"index"`
+
+	// Unique identifier of the diagnostic.
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty" gorm:"primaryKey"`
+
+	// The infra-env of the host.
+	// Format: uuid
+	InfraEnvID strfmt.UUID `json:"infra_env_id,omitempty"`
+
+	// The standard output of the diagnostic command, truncated to a size limit.
+	Output string `json:"output,omitempty" gorm:"type:text"`
+
+	// The time at which the diagnostic was requested.
+	// Format: date-time
+	RequestedAt strfmt.DateTime `json:"requested_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The user that requested the diagnostic.
+	RequestedBy string `json:"requested_by,omitempty"`
+
+	// The state of the diagnostic.
+	// Enum: [pending sent completed failed]
+	Status string `json:"status,omitempty"`
+
+	// The identifier of the step that delivered the diagnostic to the agent.
+	StepID string `json:"step_id,omitempty"`
+}
+
+// Validate validates this host diagnostic
+func (m *HostDiagnostic) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCompletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequestedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostDiagnostic) validateAction(formats strfmt.Registry) error {
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	if err := m.Action.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("action")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("action")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostDiagnostic) validateCompletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("completed_at", "body", "date-time", m.CompletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostDiagnostic) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostDiagnostic) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostDiagnostic) validateInfraEnvID(formats strfmt.Registry) error {
+	if swag.IsZero(m.InfraEnvID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("infra_env_id", "body", "uuid", m.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostDiagnostic) validateRequestedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.RequestedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("requested_at", "body", "date-time", m.RequestedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var hostDiagnosticTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","sent","completed","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostDiagnosticTypeStatusPropEnum = append(hostDiagnosticTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// HostDiagnosticStatusPending captures enum value "pending"
+	HostDiagnosticStatusPending string = "pending"
+
+	// HostDiagnosticStatusSent captures enum value "sent"
+	HostDiagnosticStatusSent string = "sent"
+
+	// HostDiagnosticStatusCompleted captures enum value "completed"
+	HostDiagnosticStatusCompleted string = "completed"
+
+	// HostDiagnosticStatusFailed captures enum value "failed"
+	HostDiagnosticStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *HostDiagnostic) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, hostDiagnosticTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *HostDiagnostic) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this host diagnostic based on the context it is used
+func (m *HostDiagnostic) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAction(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostDiagnostic) contextValidateAction(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Action.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("action")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("action")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostDiagnostic) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostDiagnostic) UnmarshalBinary(b []byte) error {
+	var res HostDiagnostic
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// HostDiagnosticAction A diagnostic action from the service allowlist. Each action maps to a fixed, read-only command
+// that the agent runs on the host.
+//
+//
+// swagger:model host-diagnostic-action
+type HostDiagnosticAction string
+
+func NewHostDiagnosticAction(value HostDiagnosticAction) *HostDiagnosticAction {
+	v := value
+	return &v
+}
+
+const (
+
+	// HostDiagnosticActionIPAddresses captures enum value "ip-addresses"
+	HostDiagnosticActionIPAddresses HostDiagnosticAction = "ip-addresses"
+
+	// HostDiagnosticActionIPRoutes captures enum value "ip-routes"
+	HostDiagnosticActionIPRoutes HostDiagnosticAction = "ip-routes"
+
+	// HostDiagnosticActionBlockDevices captures enum value "block-devices"
+	HostDiagnosticActionBlockDevices HostDiagnosticAction = "block-devices"
+
+	// HostDiagnosticActionJournal captures enum value "journal"
+	HostDiagnosticActionJournal HostDiagnosticAction = "journal"
+
+	// HostDiagnosticActionKernelRingBuffer captures enum value "kernel-ring-buffer"
+	HostDiagnosticActionKernelRingBuffer HostDiagnosticAction = "kernel-ring-buffer"
+
+	// HostDiagnosticActionTimeSources captures enum value "time-sources"
+	HostDiagnosticActionTimeSources HostDiagnosticAction = "time-sources"
+)
+
+// for schema
+var hostDiagnosticActionEnum []interface{}
+
+func init() {
+	var res []HostDiagnosticAction
+	if err := json.Unmarshal([]byte(`["ip-addresses","ip-routes","block-devices","journal","kernel-ring-buffer","time-sources"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		hostDiagnosticActionEnum = append(hostDiagnosticActionEnum, v)
+	}
+}
+
+func (m HostDiagnosticAction) validateHostDiagnosticActionEnum(path, location string, value HostDiagnosticAction) error {
+	if err := validate.EnumCase(path, location, value, hostDiagnosticActionEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this host diagnostic action
+func (m HostDiagnosticAction) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateHostDiagnosticActionEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this host diagnostic action based on context it is used
+func (m HostDiagnosticAction) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
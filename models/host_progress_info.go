@@ -22,6 +22,10 @@ type HostProgressInfo struct {
 	// current stage
 	CurrentStage HostStage `json:"current_stage,omitempty"`
 
+	// Estimated time at which the host completes its installation, based on the stage durations of previous installations with the same OpenShift version, host role and topology.
+	// Format: date-time
+	EstimatedCompletionAt *strfmt.DateTime `json:"estimated_completion_at,omitempty" gorm:"type:timestamp with time zone"`
+
 	// installation percentage
 	InstallationPercentage int64 `json:"installation_percentage,omitempty"`
 
@@ -45,6 +49,10 @@ func (m *HostProgressInfo) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateEstimatedCompletionAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStageStartedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -76,6 +84,18 @@ func (m *HostProgressInfo) validateCurrentStage(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostProgressInfo) validateEstimatedCompletionAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EstimatedCompletionAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("estimated_completion_at", "body", "date-time", m.EstimatedCompletionAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *HostProgressInfo) validateStageStartedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.StageStartedAt) { // not required
 		return nil
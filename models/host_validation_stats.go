@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HostValidationStats host validation stats
+//
+// swagger:model host-validation-stats
+type HostValidationStats struct {
+
+	// The time at which the validation succeeded for the first time, if it did.
+	// Format: date-time
+	FirstSucceededAt *strfmt.DateTime `json:"first_succeeded_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// Whether the validation is flapping, changing between success and failure more times than the threshold
+	// within the flapping window.
+	//
+	Flapping bool `json:"flapping,omitempty"`
+
+	// The number of times the validation changed between success and failure.
+	FlipCount int64 `json:"flip_count,omitempty"`
+
+	// The host of the validation.
+	// Format: uuid
+	HostID strfmt.UUID `json:"host_id,omitempty" gorm:"primaryKey"`
+
+	// The infra-env of the host.
+	// Format: uuid
+	InfraEnvID strfmt.UUID `json:"infra_env_id,omitempty" gorm:"primaryKey"`
+
+	// The last time at which the validation changed between success and failure.
+	// Format: date-time
+	LastFlippedAt *strfmt.DateTime `json:"last_flipped_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The times at which the validation changed between success and failure within the flapping window.
+	RecentFlips []strfmt.DateTime `json:"recent_flips" gorm:"type:text;serializer:json"`
+
+	// The last status of the validation.
+	Status string `json:"status,omitempty"`
+
+	// How long the validation took to succeed for the first time after the host was discovered.
+	TimeToSuccessSeconds float64 `json:"time_to_success_seconds,omitempty"`
+
+	// The validation, such as has-min-cpu-cores.
+	ValidationID string `json:"validation_id,omitempty" gorm:"primaryKey"`
+}
+
+// Validate validates this host validation stats
+func (m *HostValidationStats) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFirstSucceededAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastFlippedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRecentFlips(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HostValidationStats) validateFirstSucceededAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FirstSucceededAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("first_succeeded_at", "body", "date-time", m.FirstSucceededAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostValidationStats) validateHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("host_id", "body", "uuid", m.HostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostValidationStats) validateInfraEnvID(formats strfmt.Registry) error {
+	if swag.IsZero(m.InfraEnvID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("infra_env_id", "body", "uuid", m.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostValidationStats) validateLastFlippedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastFlippedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_flipped_at", "body", "date-time", m.LastFlippedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HostValidationStats) validateRecentFlips(formats strfmt.Registry) error {
+	if swag.IsZero(m.RecentFlips) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RecentFlips); i++ {
+
+		if err := validate.FormatOf("recent_flips"+"."+strconv.Itoa(i), "body", "date-time", m.RecentFlips[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this host validation stats based on context it is used
+func (m *HostValidationStats) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostValidationStats) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostValidationStats) UnmarshalBinary(b []byte) error {
+	var res HostValidationStats
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
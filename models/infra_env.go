@@ -36,6 +36,9 @@ type InfraEnv struct {
 	// Format: date-time
 	CreatedAt *timeext.Time `json:"created_at" gorm:"type:timestamp with time zone"`
 
+	// Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.
+	DeletionProtected *bool `json:"deletion_protected,omitempty" gorm:"default:false"`
+
 	// download url
 	DownloadURL string `json:"download_url,omitempty"`
 
@@ -82,6 +85,9 @@ type InfraEnv struct {
 	// True if the pull secret has been added to the cluster.
 	PullSecretSet bool `json:"pull_secret_set,omitempty"`
 
+	// Incremented on each update of the infra-env through its update API, and returned as its ETag.
+	ResourceVersion int64 `json:"resource_version,omitempty" gorm:"default:1"`
+
 	// size bytes
 	// Minimum: 0
 	SizeBytes *int64 `json:"size_bytes,omitempty"`
@@ -30,6 +30,9 @@ type InfraEnvCreateParams struct {
 	// The CPU architecture of the image (x86_64/arm64/etc).
 	CPUArchitecture string `json:"cpu_architecture,omitempty"`
 
+	// Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.
+	DeletionProtected *bool `json:"deletion_protected,omitempty"`
+
 	// JSON formatted string containing the user overrides for the initial ignition config.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
@@ -22,6 +22,9 @@ type InfraEnvUpdateParams struct {
 	// A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.
 	AdditionalNtpSources *string `json:"additional_ntp_sources,omitempty"`
 
+	// Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.
+	DeletionProtected *bool `json:"deletion_protected,omitempty"`
+
 	// JSON formatted string containing the user overrides for the initial ignition config.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallSchedule install schedule
+//
+// swagger:model install-schedule
+type InstallSchedule struct {
+
+	// The time after which the installation is no longer allowed to start. If the cluster is not ready to be
+	// installed before this time, the scheduled installation expires. When not set, the window never ends.
+	//
+	// Format: date-time
+	EndAt *strfmt.DateTime `json:"end_at,omitempty"`
+
+	// The time from which the installation is allowed to start.
+	// Required: true
+	// Format: date-time
+	StartAt *strfmt.DateTime `json:"start_at"`
+}
+
+// Validate validates this install schedule
+func (m *InstallSchedule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallSchedule) validateEndAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EndAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("end_at", "body", "date-time", m.EndAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallSchedule) validateStartAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("start_at", "body", m.StartAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("start_at", "body", "date-time", m.StartAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this install schedule based on context it is used
+func (m *InstallSchedule) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallSchedule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallSchedule) UnmarshalBinary(b []byte) error {
+	var res InstallSchedule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,293 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// InstallationTimeline installation timeline
+//
+// swagger:model installation-timeline
+type InstallationTimeline struct {
+
+	// cluster id
+	// Format: uuid
+	ClusterID strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// The stages that determined the installation duration, starting with the stages of the bootstrap host,
+	// followed by the stages of the last host to finish after the bootstrap host and the cluster finalization.
+	//
+	CriticalPath []*InstallationTimelineSegment `json:"critical_path"`
+
+	// The installation stages of each host, in the order the hosts started installing.
+	Hosts []*HostInstallationTimeline `json:"hosts"`
+
+	// The time that the cluster completed installation, if it did.
+	// Format: date-time
+	InstallCompletedAt *strfmt.DateTime `json:"install_completed_at,omitempty"`
+
+	// The time that the cluster started installation.
+	// Format: date-time
+	InstallStartedAt strfmt.DateTime `json:"install_started_at,omitempty"`
+
+	// The slowest host of each installation stage.
+	SlowestHosts []*InstallationTimelineSegment `json:"slowest_hosts"`
+}
+
+// Validate validates this installation timeline
+func (m *InstallationTimeline) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCriticalPath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstallCompletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstallStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSlowestHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimeline) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateCriticalPath(formats strfmt.Registry) error {
+	if swag.IsZero(m.CriticalPath) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CriticalPath); i++ {
+		if swag.IsZero(m.CriticalPath[i]) { // not required
+			continue
+		}
+
+		if m.CriticalPath[i] != nil {
+			if err := m.CriticalPath[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("critical_path" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("critical_path" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateHosts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Hosts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Hosts); i++ {
+		if swag.IsZero(m.Hosts[i]) { // not required
+			continue
+		}
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateInstallCompletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallCompletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("install_completed_at", "body", "date-time", m.InstallCompletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateInstallStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallStartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("install_started_at", "body", "date-time", m.InstallStartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) validateSlowestHosts(formats strfmt.Registry) error {
+	if swag.IsZero(m.SlowestHosts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.SlowestHosts); i++ {
+		if swag.IsZero(m.SlowestHosts[i]) { // not required
+			continue
+		}
+
+		if m.SlowestHosts[i] != nil {
+			if err := m.SlowestHosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("slowest_hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("slowest_hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this installation timeline based on the context it is used
+func (m *InstallationTimeline) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCriticalPath(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSlowestHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InstallationTimeline) contextValidateCriticalPath(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CriticalPath); i++ {
+
+		if m.CriticalPath[i] != nil {
+			if err := m.CriticalPath[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("critical_path" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("critical_path" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) contextValidateHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Hosts); i++ {
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *InstallationTimeline) contextValidateSlowestHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.SlowestHosts); i++ {
+
+		if m.SlowestHosts[i] != nil {
+			if err := m.SlowestHosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("slowest_hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("slowest_hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstallationTimeline) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstallationTimeline) UnmarshalBinary(b []byte) error {
+	var res InstallationTimeline
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
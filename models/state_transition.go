@@ -0,0 +1,200 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StateTransition state transition
+//
+// swagger:model state-transition
+type StateTransition struct {
+
+	// The cluster of the object, if any.
+	// Format: uuid
+	ClusterID *strfmt.UUID `json:"cluster_id,omitempty" gorm:"index"`
+
+	// The status that the object left, empty when the object was created by the transition.
+	FromState string `json:"from_state,omitempty"`
+
+	// Unique identifier of the transition.
+	ID int64 `json:"id,omitempty" gorm:"primaryKey"`
+
+	// The infra-env of the host.
+	// Format: uuid
+	InfraEnvID *strfmt.UUID `json:"infra_env_id,omitempty"`
+
+	// The host or cluster whose status changed.
+	// Format: uuid
+	ObjectID strfmt.UUID `json:"object_id,omitempty" gorm:"index"`
+
+	// The kind of the object whose status changed.
+	// Enum: [host cluster]
+	ObjectKind string `json:"object_kind,omitempty"`
+
+	// How long the object was in the status that it left.
+	TimeInStateSeconds float64 `json:"time_in_state_seconds,omitempty"`
+
+	// The status that the object reached.
+	ToState string `json:"to_state,omitempty"`
+
+	// The time at which the status changed.
+	// Format: date-time
+	TransitionedAt strfmt.DateTime `json:"transitioned_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The state machine transition, or the operation, that changed the status.
+	Trigger string `json:"trigger,omitempty"`
+}
+
+// Validate validates this state transition
+func (m *StateTransition) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateObjectID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateObjectKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransitionedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StateTransition) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StateTransition) validateInfraEnvID(formats strfmt.Registry) error {
+	if swag.IsZero(m.InfraEnvID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("infra_env_id", "body", "uuid", m.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StateTransition) validateObjectID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("object_id", "body", "uuid", m.ObjectID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var stateTransitionTypeObjectKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["host","cluster"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		stateTransitionTypeObjectKindPropEnum = append(stateTransitionTypeObjectKindPropEnum, v)
+	}
+}
+
+const (
+
+	// StateTransitionObjectKindHost captures enum value "host"
+	StateTransitionObjectKindHost string = "host"
+
+	// StateTransitionObjectKindCluster captures enum value "cluster"
+	StateTransitionObjectKindCluster string = "cluster"
+)
+
+// prop value enum
+func (m *StateTransition) validateObjectKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, stateTransitionTypeObjectKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *StateTransition) validateObjectKind(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectKind) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateObjectKindEnum("object_kind", "body", m.ObjectKind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StateTransition) validateTransitionedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.TransitionedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("transitioned_at", "body", "date-time", m.TransitionedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this state transition based on context it is used
+func (m *StateTransition) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StateTransition) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StateTransition) UnmarshalBinary(b []byte) error {
+	var res StateTransition
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -46,9 +46,16 @@ type V2ClusterUpdateParams struct {
 	// Cluster networks that are associated with this cluster.
 	ClusterNetworks []*ClusterNetwork `json:"cluster_networks"`
 
+	// Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.
+	DeletionProtected *bool `json:"deletion_protected,omitempty"`
+
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.
+	// Example: [{\"stage\": \"Writing image to disk\", \"timeout\": 600}, {\"stage\": \"Waiting for control plane\", \"role\": \"master\", \"timeout\": 7200}]
+	HostStageTimeouts *string `json:"host_stage_timeouts,omitempty"`
+
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
@@ -89,6 +89,14 @@ func (f fakeInventory) V2ResetCluster(ctx context.Context, params installer.V2Re
 	return installer.NewV2ResetClusterAccepted()
 }
 
+func (f fakeInventory) V2DryRunInstallation(ctx context.Context, params installer.V2DryRunInstallationParams) middleware.Responder {
+	return installer.NewV2DryRunInstallationOK()
+}
+
+func (f fakeInventory) V2ReinstallFailedHosts(ctx context.Context, params installer.V2ReinstallFailedHostsParams) middleware.Responder {
+	return installer.NewV2ReinstallFailedHostsAccepted()
+}
+
 func (f fakeInventory) UpdateCluster(ctx context.Context, params installer.V2UpdateClusterParams) middleware.Responder {
 	return common.NewApiError(http.StatusNotFound, errors.New(common.APINotFound))
 }
@@ -105,6 +113,42 @@ func (f fakeInventory) V2GetClusterInstallConfig(ctx context.Context, params ins
 	return installer.NewV2GetClusterInstallConfigOK()
 }
 
+func (f fakeInventory) V2ListClusterInstallConfigHistory(ctx context.Context, params installer.V2ListClusterInstallConfigHistoryParams) middleware.Responder {
+	return installer.NewV2ListClusterInstallConfigHistoryOK()
+}
+
+func (f fakeInventory) V2ListDeletedClusters(ctx context.Context, params installer.V2ListDeletedClustersParams) middleware.Responder {
+	return installer.NewV2ListDeletedClustersOK()
+}
+
+func (f fakeInventory) V2RestoreDeletedCluster(ctx context.Context, params installer.V2RestoreDeletedClusterParams) middleware.Responder {
+	return installer.NewV2RestoreDeletedClusterCreated()
+}
+
+func (f fakeInventory) V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	return installer.NewV2GetClusterInstallationTimelineOK()
+}
+
+func (f fakeInventory) V2ListClusterStateTransitions(ctx context.Context, params installer.V2ListClusterStateTransitionsParams) middleware.Responder {
+	return installer.NewV2ListClusterStateTransitionsOK()
+}
+
+func (f fakeInventory) V2ListHostStateTransitions(ctx context.Context, params installer.V2ListHostStateTransitionsParams) middleware.Responder {
+	return installer.NewV2ListHostStateTransitionsOK()
+}
+
+func (f fakeInventory) V2ListHostValidationStats(ctx context.Context, params installer.V2ListHostValidationStatsParams) middleware.Responder {
+	return installer.NewV2ListHostValidationStatsOK()
+}
+
+func (f fakeInventory) V2CancelInstallSchedule(ctx context.Context, params installer.V2CancelInstallScheduleParams) middleware.Responder {
+	return installer.NewV2CancelInstallScheduleAccepted()
+}
+
+func (f fakeInventory) V2GetClusterInstallConfigDiff(ctx context.Context, params installer.V2GetClusterInstallConfigDiffParams) middleware.Responder {
+	return installer.NewV2GetClusterInstallConfigDiffOK()
+}
+
 func (f fakeInventory) V2UpdateClusterInstallConfig(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) middleware.Responder {
 	return installer.NewV2UpdateClusterInstallConfigCreated()
 }
@@ -209,6 +253,18 @@ func (f fakeInventory) V2InstallHost(ctx context.Context, params installer.V2Ins
 	return installer.NewV2InstallHostAccepted()
 }
 
+func (f fakeInventory) V2CreateHostDiagnostic(ctx context.Context, params installer.V2CreateHostDiagnosticParams) middleware.Responder {
+	return installer.NewV2CreateHostDiagnosticCreated()
+}
+
+func (f fakeInventory) V2ListHostDiagnostics(ctx context.Context, params installer.V2ListHostDiagnosticsParams) middleware.Responder {
+	return installer.NewV2ListHostDiagnosticsOK()
+}
+
+func (f fakeInventory) V2GetHostDiagnostic(ctx context.Context, params installer.V2GetHostDiagnosticParams) middleware.Responder {
+	return installer.NewV2GetHostDiagnosticOK()
+}
+
 func (f fakeInventory) V2DownloadClusterCredentials(ctx context.Context, params installer.V2DownloadClusterCredentialsParams) middleware.Responder {
 	file, err := ioutil.TempFile("/tmp", "test.file")
 	if err != nil {
@@ -278,6 +334,10 @@ func (f fakeEventsAPI) V2ListEvents(ctx context.Context, params eventsapi.V2List
 	return eventsapi.NewV2ListEventsOK()
 }
 
+func (f fakeEventsAPI) V2StreamEvents(ctx context.Context, params eventsapi.V2StreamEventsParams) middleware.Responder {
+	return eventsapi.NewV2StreamEventsOK()
+}
+
 type fakeVersionsAPI struct{}
 
 func (f fakeVersionsAPI) V2ListComponentVersions(
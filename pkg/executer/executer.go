@@ -3,9 +3,15 @@ package executer
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
+
+	"github.com/openshift/assisted-service/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:generate mockgen --build_flags=--mod=mod -package executer -destination mock_executer.go . Executer
@@ -21,14 +27,39 @@ func (e *CommonExecuter) TempFile(dir, pattern string) (f *os.File, err error) {
 	return ioutil.TempFile(dir, pattern)
 }
 
+// Execute traces the command in a trace of its own, as it is not given the context of the request that invoked it
 func (e *CommonExecuter) Execute(command string, args ...string) (stdout string, stderr string, exitCode int) {
+	_, span := tracing.StartSpan(context.Background(), "exec "+filepath.Base(command), commandAttributes(command, args)...)
 	cmd := exec.Command(command, args...)
-	return e.execute(cmd)
+	stdout, stderr, exitCode = e.execute(cmd)
+	endCommandSpan(span, exitCode)
+	return stdout, stderr, exitCode
 }
 
 func (e *CommonExecuter) ExecuteWithContext(ctx context.Context, command string, args ...string) (stdout string, stderr string, exitCode int) {
+	ctx, span := tracing.StartChildSpan(ctx, "exec "+filepath.Base(command), commandAttributes(command, args)...)
 	cmd := exec.CommandContext(ctx, command, args...)
-	return e.execute(cmd)
+	stdout, stderr, exitCode = e.execute(cmd)
+	endCommandSpan(span, exitCode)
+	return stdout, stderr, exitCode
+}
+
+// commandAttributes describe a command without its arguments, as they may hold secrets
+func commandAttributes(command string, args []string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{attribute.String("exec.command", filepath.Base(command))}
+	if len(args) > 0 {
+		attributes = append(attributes, attribute.String("exec.subcommand", args[0]))
+	}
+	return attributes
+}
+
+func endCommandSpan(span trace.Span, exitCode int) {
+	span.SetAttributes(attribute.Int("exec.exit_code", exitCode))
+	var err error
+	if exitCode != 0 {
+		err = fmt.Errorf("command exited with code %d", exitCode)
+	}
+	tracing.EndSpan(span, err)
 }
 
 func (e *CommonExecuter) execute(cmd *exec.Cmd) (stdout string, stderr string, exitCode int) {
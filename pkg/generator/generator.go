@@ -15,6 +15,7 @@ import (
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
 )
 
 type InstallConfigGenerator interface {
@@ -24,8 +25,15 @@ type InstallConfigGenerator interface {
 //go:generate mockgen --build_flags=--mod=mod -package generator -destination mock_install_config.go . ISOInstallConfigGenerator
 type ISOInstallConfigGenerator interface {
 	InstallConfigGenerator
+	// DryRunInstallConfig creates the install config and ignition files in a scratch directory, without uploading
+	// them, and returns them by file name. The credentials of the generated cluster are not returned.
+	DryRunInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage, installerReleaseImageOverride string,
+		manifests map[string][]byte) (map[string][]byte, error)
 }
 
+// dryRunSkippedFiles are the generated files that hold credentials
+var dryRunSkippedFiles = []string{"kubeconfig-noingress", "kubeadmin-password"}
+
 type Config struct {
 	ServiceCACertPath  string `envconfig:"SERVICE_CA_CERT_PATH" default:""`
 	ServiceIPs         string `envconfig:"SERVICE_IPS" default:""`
@@ -68,7 +76,6 @@ func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster co
 	if err != nil {
 		return err
 	}
-	installerCacheDir := filepath.Join(k.workDir, "installercache")
 	defer func() {
 		// keep results in case of failure so a human can debug
 		if err != nil {
@@ -92,32 +99,78 @@ func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster co
 		}
 	}()
 
-	// runs openshift-install to generate ignition files, then modifies them as necessary
-	var generator ignition.Generator
-	if k.Config.DummyIgnition {
-		generator = ignition.NewDummyGenerator(clusterWorkDir, &cluster, k.s3Client, log)
-	} else {
-		generator = ignition.NewGenerator(clusterWorkDir, installerCacheDir, &cluster, releaseImage, k.Config.ReleaseImageMirror,
-			k.Config.ServiceCACertPath, k.Config.InstallInvoker, k.s3Client, log, k.operatorsApi, k.providerRegistry, installerReleaseImageOverride, k.clusterTLSCertOverrideDir)
+	generator := k.newIgnitionGenerator(log, clusterWorkDir, &cluster, releaseImage, installerReleaseImageOverride)
+	err = k.generate(ctx, generator, cluster, cfg)
+	if err != nil {
+		return err
 	}
-	err = generator.Generate(ctx, cfg, k.getClusterPlatformType(cluster))
+
+	// upload files to S3
+	err = generator.UploadToS3(ctx)
 	if err != nil {
 		return err
 	}
 
-	if k.Config.ServiceIPs != "" {
-		err = generator.UpdateEtcHosts(k.Config.ServiceIPs)
+	return nil
+}
+
+// DryRunInstallConfig creates install config and ignition files in a scratch directory and returns them
+func (k *installGenerator) DryRunInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage, installerReleaseImageOverride string,
+	manifests map[string][]byte) (map[string][]byte, error) {
+	log := logutil.FromContext(ctx, k.log)
+	err := os.MkdirAll(k.workDir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	clusterWorkDir, err := ioutil.TempDir(k.workDir, cluster.ID.String()+".dry-run.")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err2 := os.RemoveAll(clusterWorkDir); err2 != nil {
+			log.WithError(err2).Error("Failed to clean up dry run ignition directory")
+		}
+	}()
+
+	generator := k.newIgnitionGenerator(log, clusterWorkDir, &cluster, releaseImage, installerReleaseImageOverride)
+	generator.AddManifests(manifests)
+	if err = k.generate(ctx, generator, cluster, cfg); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	for _, fileName := range ignition.GeneratedFileNames(&cluster) {
+		if funk.ContainsString(dryRunSkippedFiles, fileName) {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(clusterWorkDir, fileName))
 		if err != nil {
-			return err
+			return nil, err
 		}
+		files[fileName] = content
 	}
+	return files, nil
+}
 
-	// upload files to S3
-	err = generator.UploadToS3(ctx)
-	if err != nil {
-		return err
+// newIgnitionGenerator returns a generator that runs openshift-install to generate ignition files,
+// then modifies them as necessary
+func (k *installGenerator) newIgnitionGenerator(log logrus.FieldLogger, clusterWorkDir string, cluster *common.Cluster,
+	releaseImage, installerReleaseImageOverride string) ignition.Generator {
+	if k.Config.DummyIgnition {
+		return ignition.NewDummyGenerator(clusterWorkDir, cluster, k.s3Client, log)
 	}
+	installerCacheDir := filepath.Join(k.workDir, "installercache")
+	return ignition.NewGenerator(clusterWorkDir, installerCacheDir, cluster, releaseImage, k.Config.ReleaseImageMirror,
+		k.Config.ServiceCACertPath, k.Config.InstallInvoker, k.s3Client, log, k.operatorsApi, k.providerRegistry, installerReleaseImageOverride, k.clusterTLSCertOverrideDir)
+}
 
+func (k *installGenerator) generate(ctx context.Context, generator ignition.Generator, cluster common.Cluster, cfg []byte) error {
+	if err := generator.Generate(ctx, cfg, k.getClusterPlatformType(cluster)); err != nil {
+		return err
+	}
+	if k.Config.ServiceIPs != "" {
+		return generator.UpdateEtcHosts(k.Config.ServiceIPs)
+	}
 	return nil
 }
 
@@ -35,6 +35,21 @@ func (m *MockISOInstallConfigGenerator) EXPECT() *MockISOInstallConfigGeneratorM
 	return m.recorder
 }
 
+// DryRunInstallConfig mocks base method.
+func (m *MockISOInstallConfigGenerator) DryRunInstallConfig(arg0 context.Context, arg1 common.Cluster, arg2 []byte, arg3, arg4 string, arg5 map[string][]byte) (map[string][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunInstallConfig", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(map[string][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunInstallConfig indicates an expected call of DryRunInstallConfig.
+func (mr *MockISOInstallConfigGeneratorMockRecorder) DryRunInstallConfig(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunInstallConfig", reflect.TypeOf((*MockISOInstallConfigGenerator)(nil).DryRunInstallConfig), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // GenerateInstallConfig mocks base method.
 func (m *MockISOInstallConfigGenerator) GenerateInstallConfig(arg0 context.Context, arg1 common.Cluster, arg2 []byte, arg3, arg4 string) error {
 	m.ctrl.T.Helper()
@@ -107,6 +107,12 @@ func (c *Client) SetMetrics(handler metrics.API) {
 	c.metricsApi = handler
 }
 
+// CheckHealth checks that the service can get the tokens of its connection to OCM
+func (c *Client) CheckHealth(ctx context.Context) error {
+	_, _, err := c.connection.TokensContext(ctx)
+	return err
+}
+
 func (c *Client) newConnection() error {
 	builder := sdkClient.NewConnectionBuilder().
 		Logger(c.logger).
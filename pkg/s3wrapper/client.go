@@ -50,6 +50,15 @@ type API interface {
 	UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error)
 	ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string))
 	ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error)
+	// CheckHealth checks that the objects can be stored, and returns the status of the storage
+	CheckHealth(ctx context.Context) (*Health, error)
+}
+
+// Health is the status of the storage of the objects
+type Health struct {
+	// FilesystemUsagePercentage is the usage of the filesystem in which the objects are stored, when they are stored
+	// in a filesystem
+	FilesystemUsagePercentage *float64
 }
 
 var _ API = &S3Client{}
@@ -409,3 +418,11 @@ func (c *S3Client) ListObjectsByPrefix(ctx context.Context, prefix string) ([]st
 	}
 	return objects, nil
 }
+
+// CheckHealth checks that the bucket can be accessed
+func (c *S3Client) CheckHealth(ctx context.Context) (*Health, error) {
+	if _, err := c.client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{Bucket: swag.String(c.cfg.S3Bucket)}); err != nil {
+		return nil, errors.Wrapf(err, "failed to access bucket %s", c.cfg.S3Bucket)
+	}
+	return &Health{}, nil
+}
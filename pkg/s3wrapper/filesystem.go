@@ -277,6 +277,15 @@ func (f *FSClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]st
 	return matches, nil
 }
 
+// CheckHealth returns the usage of the filesystem of the objects
+func (f *FSClient) CheckHealth(ctx context.Context) (*Health, error) {
+	usage, err := filesystemUsage(f.basedir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to collect filesystem stats for %s", f.basedir)
+	}
+	return &Health{FilesystemUsagePercentage: &usage}, nil
+}
+
 type FSClientDecorator struct {
 	log                           logrus.FieldLogger
 	fsClient                      FSClient
@@ -313,16 +322,23 @@ func (d *FSClientDecorator) conditionalLog(msg string, logLevel logrus.Level, fi
 	}
 }
 
+// filesystemUsage returns the usage of the filesystem of the directory, in percents rounded down to one decimal
+func filesystemUsage(dir string) (float64, error) {
+	stat := syscall.Statfs_t{}
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	percentage := (float64(stat.Blocks-stat.Bfree) / float64(stat.Blocks)) * 100
+	return math.Floor(percentage*10) / 10, nil
+}
+
 func (d *FSClientDecorator) reportFilesystemUsageMetrics() {
 	basedir := d.fsClient.basedir
-	stat := syscall.Statfs_t{}
-	err := syscall.Statfs(basedir, &stat)
+	fixedPercentage, err := filesystemUsage(basedir)
 	if err != nil {
 		d.fsClient.log.WithError(err).Errorf("Failed to collect filesystem stats for %s", basedir)
 		return
 	}
-	percentage := (float64(stat.Blocks-stat.Bfree) / float64(stat.Blocks)) * 100
-	fixedPercentage := math.Floor(percentage*10) / 10
 	if fixedPercentage >= float64(d.fsUsageThreshold) {
 		msg := fmt.Sprintf("Filesystem '%s' usage is %.1f%% which exceeds threshold %d%%", basedir, fixedPercentage, d.fsUsageThreshold)
 		d.conditionalLog(msg, logrus.WarnLevel, fixedPercentage)
@@ -401,3 +417,16 @@ func (d *FSClientDecorator) ExpireObjects(ctx context.Context, prefix string, de
 func (d *FSClientDecorator) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
 	return d.fsClient.ListObjectsByPrefix(ctx, prefix)
 }
+
+// CheckHealth fails when the usage of the filesystem of the objects exceeds the threshold
+func (d *FSClientDecorator) CheckHealth(ctx context.Context) (*Health, error) {
+	health, err := d.fsClient.CheckHealth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if *health.FilesystemUsagePercentage >= float64(d.fsUsageThreshold) {
+		return health, errors.Errorf("filesystem '%s' usage is %.1f%% which exceeds threshold %d%%",
+			d.fsClient.basedir, *health.FilesystemUsagePercentage, d.fsUsageThreshold)
+	}
+	return health, nil
+}
@@ -199,6 +199,21 @@ var _ = Describe("s3filesystem", func() {
 		Expect(containsObj("dir/file")).To(BeTrue(), "file list %v does not contain \"dir/file\"", objects)
 		Expect(containsObj("dir2/file")).To(BeTrue(), "file list %v does not contain \"dir2/file\"", objects)
 	})
+	It("CheckHealth reports the usage of the filesystem", func() {
+		health, err := client.CheckHealth(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(health.FilesystemUsagePercentage).NotTo(BeNil())
+		Expect(*health.FilesystemUsagePercentage).To(BeNumerically(">", 0))
+
+		decorator := &FSClientDecorator{log: log, fsClient: *client, metricsAPI: mockMetricsAPI, fsUsageThreshold: 100}
+		_, err = decorator.CheckHealth(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		decorator.fsUsageThreshold = 0
+		health, err = decorator.CheckHealth(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(health.FilesystemUsagePercentage).NotTo(BeNil())
+	})
 
 	AfterEach(func() {
 		os.RemoveAll(baseDir)
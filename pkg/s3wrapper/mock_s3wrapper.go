@@ -37,6 +37,21 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 	return m.recorder
 }
 
+// CheckHealth mocks base method.
+func (m *MockAPI) CheckHealth(arg0 context.Context) (*Health, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckHealth", arg0)
+	ret0, _ := ret[0].(*Health)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckHealth indicates an expected call of CheckHealth.
+func (mr *MockAPIMockRecorder) CheckHealth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockAPI)(nil).CheckHealth), arg0)
+}
+
 // CreateBucket mocks base method.
 func (m *MockAPI) CreateBucket() error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,110 @@
+package s3wrapper
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/openshift/assisted-service/pkg/tracing"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const objectNameKey = attribute.Key("s3.object_name")
+
+// tracedClient creates a span for each storage operation that is made with the context of a traced request
+type tracedClient struct {
+	API
+}
+
+var _ API = &tracedClient{}
+
+// NewTracedClient returns a client that traces the operations of the given client
+func NewTracedClient(api API) API {
+	return &tracedClient{API: api}
+}
+
+func startSpan(ctx context.Context, operation, objectName string) (context.Context, trace.Span) {
+	return tracing.StartChildSpan(ctx, "s3."+operation, objectNameKey.String(objectName))
+}
+
+func (c *tracedClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	ctx, span := startSpan(ctx, "Upload", objectName)
+	span.SetAttributes(attribute.Int("s3.size_bytes", len(data)))
+	err := c.API.Upload(ctx, data, objectName)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (c *tracedClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	ctx, span := startSpan(ctx, "UploadStream", objectName)
+	err := c.API.UploadStream(ctx, reader, objectName)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (c *tracedClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	ctx, span := startSpan(ctx, "UploadFile", objectName)
+	err := c.API.UploadFile(ctx, filePath, objectName)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+// Download traces the request of the object, rather than the time its content is read
+func (c *tracedClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	ctx, span := startSpan(ctx, "Download", objectName)
+	reader, size, err := c.API.Download(ctx, objectName)
+	tracing.EndSpan(span, err)
+	return reader, size, err
+}
+
+func (c *tracedClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	ctx, span := startSpan(ctx, "DoesObjectExist", objectName)
+	exists, err := c.API.DoesObjectExist(ctx, objectName)
+	tracing.EndSpan(span, err)
+	return exists, err
+}
+
+func (c *tracedClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	ctx, span := startSpan(ctx, "DeleteObject", objectName)
+	deleted, err := c.API.DeleteObject(ctx, objectName)
+	tracing.EndSpan(span, err)
+	return deleted, err
+}
+
+func (c *tracedClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	ctx, span := startSpan(ctx, "GetObjectSizeBytes", objectName)
+	size, err := c.API.GetObjectSizeBytes(ctx, objectName)
+	tracing.EndSpan(span, err)
+	return size, err
+}
+
+func (c *tracedClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string,
+	duration time.Duration) (string, error) {
+	ctx, span := startSpan(ctx, "GeneratePresignedDownloadURL", objectName)
+	url, err := c.API.GeneratePresignedDownloadURL(ctx, objectName, downloadFilename, duration)
+	tracing.EndSpan(span, err)
+	return url, err
+}
+
+func (c *tracedClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	ctx, span := startSpan(ctx, "UpdateObjectTimestamp", objectName)
+	updated, err := c.API.UpdateObjectTimestamp(ctx, objectName)
+	tracing.EndSpan(span, err)
+	return updated, err
+}
+
+func (c *tracedClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	ctx, span := startSpan(ctx, "ExpireObjects", prefix)
+	c.API.ExpireObjects(ctx, prefix, deleteTime, callback)
+	span.End()
+}
+
+func (c *tracedClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	ctx, span := startSpan(ctx, "ListObjectsByPrefix", prefix)
+	objects, err := c.API.ListObjectsByPrefix(ctx, prefix)
+	tracing.EndSpan(span, err)
+	return objects, err
+}
@@ -0,0 +1,147 @@
+package secretdump
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const redacted = "<SECRET>"
+
+// FieldChange is a field whose value differs between two versions of a struct
+type FieldChange struct {
+	// The path of the field, built from the json names of the fields, such as "platform.type" or "disks[0].name"
+	Field string
+	From  string
+	To    string
+}
+
+type fieldValue struct {
+	value  string
+	secret bool
+}
+
+// DiffSecretStructs returns the fields whose values differ between before and after, sorted by their path.
+// Either version may be nil, e.g. for a created or a deleted object, in which case all the fields of the other
+// version are returned. The values of `secret:"true"` tagged fields, or of any field nested in them, are redacted,
+// only the fact that they changed is returned.
+// Recurses into embedded and nested structs, pointers, slices and maps. Values implementing encoding.TextMarshaler,
+// such as timestamps, are compared by their text.
+func DiffSecretStructs(before, after interface{}) []FieldChange {
+	beforeFields := map[string]fieldValue{}
+	afterFields := map[string]fieldValue{}
+	flatten(reflect.ValueOf(before), "", false, beforeFields)
+	flatten(reflect.ValueOf(after), "", false, afterFields)
+
+	paths := map[string]bool{}
+	for path := range beforeFields {
+		paths[path] = true
+	}
+	for path := range afterFields {
+		paths[path] = true
+	}
+
+	changes := make([]FieldChange, 0)
+	for path := range paths {
+		from, to := beforeFields[path], afterFields[path]
+		if from.value == to.value {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: path, From: from.redacted(), To: to.redacted()})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+func (f fieldValue) redacted() string {
+	if f.secret && f.value != "" {
+		return redacted
+	}
+	return f.value
+}
+
+func flatten(v reflect.Value, path string, secret bool, fields map[string]fieldValue) {
+	if !v.IsValid() {
+		return
+	}
+	if text, ok := marshalText(v); ok {
+		fields[path] = fieldValue{value: text, secret: secret}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			flatten(v.Elem(), path, secret, fields)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			fieldSecret := secret || field.Tag.Get("secret") == "true"
+			if field.Anonymous {
+				flatten(v.Field(i), path, fieldSecret, fields)
+				continue
+			}
+			flatten(v.Field(i), joinPath(path, fieldName(field)), fieldSecret, fields)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			fields[path] = fieldValue{value: fmt.Sprintf("%s", v.Interface()), secret: secret}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			flatten(v.Index(i), fmt.Sprintf("%s[%d]", path, i), secret, fields)
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			flatten(v.MapIndex(key), fmt.Sprintf("%s[%v]", path, key.Interface()), secret, fields)
+		}
+	default:
+		if v.CanInterface() {
+			fields[path] = fieldValue{value: fmt.Sprintf("%v", v.Interface()), secret: secret}
+		}
+	}
+}
+
+// marshalText returns the text of values, or of addressable values with pointer receivers, that implement
+// encoding.TextMarshaler
+func marshalText(v reflect.Value) (string, bool) {
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return "", false
+	}
+	if !v.CanInterface() {
+		return "", false
+	}
+	marshaler, ok := v.Interface().(encoding.TextMarshaler)
+	if !ok && v.CanAddr() {
+		marshaler, ok = v.Addr().Interface().(encoding.TextMarshaler)
+	}
+	if !ok {
+		return "", false
+	}
+	text, err := marshaler.MarshalText()
+	if err != nil {
+		return "", false
+	}
+	return string(text), true
+}
+
+func fieldName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -3,6 +3,7 @@ package secretdump
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -82,4 +83,76 @@ struct Example {
 			Expect(actual).To(Equal(expected))
 		})
 	})
+
+	Context("Diff secret structs", func() {
+		type Nested struct {
+			Name   string `json:"name"`
+			Secret string `json:"secret" secret:"true"`
+		}
+
+		type Embedded struct {
+			Kind string `json:"kind"`
+		}
+
+		type Example struct {
+			Embedded
+			A       string            `json:"a"`
+			B       *int              `json:"b,omitempty"`
+			C       string            `json:"c" secret:"true"`
+			At      time.Time         `json:"at"`
+			N       *Nested           `json:"n"`
+			List    []Nested          `json:"list"`
+			Labels  map[string]string `json:"labels"`
+			NoTag   string
+			private int
+		}
+
+		It("returns no changes for equal structs", func() {
+			example := Example{A: "Hello", C: "ThisIsASecret", N: &Nested{Name: "World"}}
+			Expect(DiffSecretStructs(example, example)).To(BeEmpty())
+		})
+
+		It("returns the changed fields by their json path, with secrets redacted", func() {
+			one, two := 1, 2
+			at := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+			before := &Example{
+				Embedded: Embedded{Kind: "Example"},
+				A:        "Hello",
+				B:        &one,
+				C:        "ThisIsASecret",
+				N:        &Nested{Name: "World", Secret: "ThisIsAnotherSecret"},
+				List:     []Nested{{Name: "first"}},
+				private:  1,
+			}
+			after := &Example{
+				Embedded: Embedded{Kind: "Other"},
+				A:        "Hello",
+				B:        &two,
+				C:        "ThisIsANewSecret",
+				At:       at,
+				N:        &Nested{Name: "World", Secret: "ThisIsAnotherSecret"},
+				List:     []Nested{{Name: "first"}, {Name: "second", Secret: "ThisIsAListSecret"}},
+				Labels:   map[string]string{"key": "value"},
+				NoTag:    "set",
+				private:  2,
+			}
+			Expect(DiffSecretStructs(before, after)).To(Equal([]FieldChange{
+				{Field: "NoTag", From: "", To: "set"},
+				{Field: "at", From: time.Time{}.Format(time.RFC3339), To: "2022-01-02T03:04:05Z"},
+				{Field: "b", From: "1", To: "2"},
+				{Field: "c", From: "<SECRET>", To: "<SECRET>"},
+				{Field: "kind", From: "Example", To: "Other"},
+				{Field: "labels[key]", From: "", To: "value"},
+				{Field: "list[1].name", From: "", To: "second"},
+				{Field: "list[1].secret", From: "", To: "<SECRET>"},
+			}))
+		})
+
+		It("returns all the fields of a created struct", func() {
+			Expect(DiffSecretStructs(nil, &Nested{Name: "World", Secret: "ThisIsASecret"})).To(Equal([]FieldChange{
+				{Field: "name", From: "", To: "World"},
+				{Field: "secret", From: "", To: "<SECRET>"},
+			}))
+		})
+	})
 })
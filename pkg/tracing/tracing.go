@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"TRACING_ENABLED" default:"false"`
+	// OTLPEndpoint is the host:port of the OTLP gRPC receiver of the collector that the spans are exported to
+	OTLPEndpoint string  `envconfig:"TRACING_OTLP_ENDPOINT" default:"localhost:4317"`
+	OTLPInsecure bool    `envconfig:"TRACING_OTLP_INSECURE" default:"true"`
+	ServiceName  string  `envconfig:"TRACING_SERVICE_NAME" default:"assisted-service"`
+	SampleRatio  float64 `envconfig:"TRACING_SAMPLE_RATIO" default:"1"`
+}
+
+const (
+	instrumentationName = "github.com/openshift/assisted-service"
+
+	RequestIDKey = attribute.Key("request_id")
+	ClusterIDKey = attribute.Key("cluster_id")
+)
+
+// Init sets the global tracer provider to export the spans to the OTLP collector of the configuration, and returns a
+// function that flushes the spans and stops the export. While tracing is disabled, the spans are not recorded.
+func Init(ctx context.Context, cfg Config, log logrus.FieldLogger) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlpgrpc.Option{otlpgrpc.WithEndpoint(cfg.OTLPEndpoint)}
+	if cfg.OTLPInsecure {
+		options = append(options, otlpgrpc.WithInsecure())
+	}
+	exporter, err := otlp.NewExporter(ctx, otlpgrpc.NewDriver(options...))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the OTLP exporter of %s", cfg.OTLPEndpoint)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(sdkresource.NewWithAttributes(semconv.ServiceNameKey.String(cfg.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	log.Infof("Exporting traces to %s", cfg.OTLPEndpoint)
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span that is a child of the span of ctx, if any, and tags it with the request ID of ctx
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if id := requestid.FromContext(ctx); id != "" {
+		attributes = append(attributes, RequestIDKey.String(id))
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// StartChildSpan starts a span like StartSpan, but only when ctx already holds a span, so background operations
+// such as the monitors do not create a trace for each of their calls
+func StartChildSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	return StartSpan(ctx, name, attributes...)
+}
+
+// EndSpan records the error, if any, in the span before ending it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Middleware starts a server span for each API request, named after its operation ID. It must be an inner
+// middleware of the API, as it requires the matched route of the request.
+func Middleware(h http.Handler) http.Handler {
+	tagged := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id := requestid.FromContext(r.Context()); id != "" {
+			trace.SpanFromContext(r.Context()).SetAttributes(RequestIDKey.String(id))
+		}
+		h.ServeHTTP(w, r)
+	})
+	return otelhttp.NewHandler(tagged, "assisted-service", otelhttp.WithSpanNameFormatter(
+		func(_ string, r *http.Request) string {
+			if route := middleware.MatchedRouteFrom(r); route != nil {
+				return route.Operation.ID
+			}
+			return r.Method + " " + r.URL.Path
+		}))
+}
@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTracing(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tracing tests")
+}
+
+// recorder keeps the spans that ended
+type recorder struct {
+	mu    sync.Mutex
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (r *recorder) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+func (r *recorder) OnEnd(s sdktrace.ReadOnlySpan) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, s)
+}
+
+func (r *recorder) Shutdown(context.Context) error   { return nil }
+func (r *recorder) ForceFlush(context.Context) error { return nil }
+
+func (r *recorder) ended() []sdktrace.ReadOnlySpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.spans
+}
+
+var _ = Describe("spans", func() {
+	var spans *recorder
+
+	BeforeEach(func() {
+		spans = &recorder{}
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	})
+
+	It("does not start a child span without a parent", func() {
+		ctx, span := StartChildSpan(context.Background(), "child")
+		EndSpan(span, nil)
+		Expect(ctx).To(Equal(context.Background()))
+		Expect(spans.ended()).To(BeEmpty())
+	})
+
+	It("starts a child span tagged with the request ID", func() {
+		ctx, parent := StartSpan(requestid.ToContext(context.Background(), "1234"), "parent")
+		_, child := StartChildSpan(ctx, "child")
+		EndSpan(child, nil)
+		EndSpan(parent, nil)
+
+		ended := spans.ended()
+		Expect(ended).To(HaveLen(2))
+		Expect(ended[0].Name()).To(Equal("child"))
+		Expect(ended[0].Parent().SpanID()).To(Equal(ended[1].SpanContext().SpanID()))
+		Expect(ended[0].Attributes()).To(ContainElement(RequestIDKey.String("1234")))
+	})
+
+	It("records the error of the span", func() {
+		_, span := StartSpan(context.Background(), "failed")
+		EndSpan(span, errors.New("failure"))
+
+		ended := spans.ended()
+		Expect(ended).To(HaveLen(1))
+		Expect(ended[0].StatusCode()).To(Equal(codes.Error))
+		Expect(ended[0].StatusMessage()).To(Equal("failure"))
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -15,6 +16,9 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/openshift/assisted-service/restapi/operations"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/openshift/assisted-service/restapi/operations/event_subscriptions"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
@@ -27,12 +31,71 @@ type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name AuditAPI -inpkg
+
+/* AuditAPI  */
+type AuditAPI interface {
+	/* V2ListAuditLog Lists the audit log entries of the changes made through the REST API and through the kube-api, from the
+	   latest.
+	*/
+	V2ListAuditLog(ctx context.Context, params audit.V2ListAuditLogParams) middleware.Responder
+}
+
+//go:generate mockery -name ClusterTemplatesAPI -inpkg
+
+/* ClusterTemplatesAPI  */
+type ClusterTemplatesAPI interface {
+	/* V2DeregisterClusterTemplate Deletes the cluster template. Clusters created from the template are not affected. */
+	V2DeregisterClusterTemplate(ctx context.Context, params cluster_templates.V2DeregisterClusterTemplateParams) middleware.Responder
+
+	/* V2GetClusterTemplate Retrieves the details of the cluster template. */
+	V2GetClusterTemplate(ctx context.Context, params cluster_templates.V2GetClusterTemplateParams) middleware.Responder
+
+	/* V2ListClusterTemplates Retrieves the list of cluster templates. */
+	V2ListClusterTemplates(ctx context.Context, params cluster_templates.V2ListClusterTemplatesParams) middleware.Responder
+
+	/* V2RegisterClusterTemplate Creates a new cluster template. */
+	V2RegisterClusterTemplate(ctx context.Context, params cluster_templates.V2RegisterClusterTemplateParams) middleware.Responder
+
+	/* V2UpdateClusterTemplate Replaces the properties of the cluster template. Clusters created from the template are not affected. */
+	V2UpdateClusterTemplate(ctx context.Context, params cluster_templates.V2UpdateClusterTemplateParams) middleware.Responder
+}
+
+//go:generate mockery -name EventSubscriptionsAPI -inpkg
+
+/* EventSubscriptionsAPI  */
+type EventSubscriptionsAPI interface {
+	/* V2DeregisterEventSubscription Deletes the event subscription and its delivery log. */
+	V2DeregisterEventSubscription(ctx context.Context, params event_subscriptions.V2DeregisterEventSubscriptionParams) middleware.Responder
+
+	/* V2GetEventSubscription Retrieves the details of the event subscription. */
+	V2GetEventSubscription(ctx context.Context, params event_subscriptions.V2GetEventSubscriptionParams) middleware.Responder
+
+	/* V2ListEventSubscriptionDeliveries Retrieves the delivery log of the event subscription, latest deliveries first. */
+	V2ListEventSubscriptionDeliveries(ctx context.Context, params event_subscriptions.V2ListEventSubscriptionDeliveriesParams) middleware.Responder
+
+	/* V2ListEventSubscriptions Retrieves the list of event subscriptions. */
+	V2ListEventSubscriptions(ctx context.Context, params event_subscriptions.V2ListEventSubscriptionsParams) middleware.Responder
+
+	/* V2RegisterEventSubscription Creates a subscription that delivers events to an HTTP endpoint as CloudEvents. */
+	V2RegisterEventSubscription(ctx context.Context, params event_subscriptions.V2RegisterEventSubscriptionParams) middleware.Responder
+
+	/* V2UpdateEventSubscription Replaces the properties of the event subscription. The secret of the subscription is kept when it is not set. */
+	V2UpdateEventSubscription(ctx context.Context, params event_subscriptions.V2UpdateEventSubscriptionParams) middleware.Responder
+}
+
 //go:generate mockery -name EventsAPI -inpkg
 
 /* EventsAPI  */
 type EventsAPI interface {
 	/* V2ListEvents Lists events for a cluster. */
 	V2ListEvents(ctx context.Context, params events.V2ListEventsParams) middleware.Responder
+
+	/* V2StreamEvents Streams the events of a cluster, host or infra-env as server-sent events while they are saved.
+	   Each message holds an event in JSON format and its ID, which can be sent back in the Last-Event-ID header
+	   (or the last_event_id parameter) to resume the stream after the last received event.
+	*/
+	V2StreamEvents(ctx context.Context, params events.V2StreamEventsParams) middleware.Responder
 }
 
 //go:generate mockery -name InstallerAPI -inpkg
@@ -112,9 +175,15 @@ type InstallerAPI interface {
 	/* V2UploadLogs Agent API to upload logs. */
 	V2UploadLogs(ctx context.Context, params installer.V2UploadLogsParams) middleware.Responder
 
+	/* V2CancelInstallSchedule Cancels the scheduled installation of the cluster. */
+	V2CancelInstallSchedule(ctx context.Context, params installer.V2CancelInstallScheduleParams) middleware.Responder
+
 	/* V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%. */
 	V2CompleteInstallation(ctx context.Context, params installer.V2CompleteInstallationParams) middleware.Responder
 
+	/* V2CreateHostDiagnostic Queues a diagnostic action from the service allowlist to be run on the host by its agent. */
+	V2CreateHostDiagnostic(ctx context.Context, params installer.V2CreateHostDiagnosticParams) middleware.Responder
+
 	/* V2DeregisterCluster Deletes an OpenShift cluster definition. */
 	V2DeregisterCluster(ctx context.Context, params installer.V2DeregisterClusterParams) middleware.Responder
 
@@ -127,15 +196,32 @@ type InstallerAPI interface {
 	/* V2DownloadInfraEnvFiles Downloads the customized ignition file for this host */
 	V2DownloadInfraEnvFiles(ctx context.Context, params installer.V2DownloadInfraEnvFilesParams) middleware.Responder
 
+	/* V2DryRunInstallation Generates the install-config, the operator manifests and the ignition files of the cluster as the installation
+	   would, in a scratch working directory, and returns the generated artifacts together with the errors of every
+	   generation stage. The cluster, its hosts and its stored files are not modified.
+	*/
+	V2DryRunInstallation(ctx context.Context, params installer.V2DryRunInstallationParams) middleware.Responder
+
 	/* V2GetCluster Retrieves the details of the OpenShift cluster. */
 	V2GetCluster(ctx context.Context, params installer.V2GetClusterParams) middleware.Responder
 
 	/* V2GetClusterInstallConfig Get the cluster's install config YAML. */
 	V2GetClusterInstallConfig(ctx context.Context, params installer.V2GetClusterInstallConfigParams) middleware.Responder
 
+	/* V2GetClusterInstallConfigDiff Get a unified diff between the install config YAML rendered with two versions of the install config overrides.
+	 */
+	V2GetClusterInstallConfigDiff(ctx context.Context, params installer.V2GetClusterInstallConfigDiffParams) middleware.Responder
+
+	/* V2GetClusterInstallationTimeline Get the timeline of the cluster installation, built from the installation stages that each host went through.
+	 */
+	V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder
+
 	/* V2GetHost Retrieves the details of the OpenShift host. */
 	V2GetHost(ctx context.Context, params installer.V2GetHostParams) middleware.Responder
 
+	/* V2GetHostDiagnostic Retrieves a diagnostic action of the host, including its output once the agent uploaded it. */
+	V2GetHostDiagnostic(ctx context.Context, params installer.V2GetHostDiagnosticParams) middleware.Responder
+
 	/* V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error */
 	V2GetHostIgnition(ctx context.Context, params installer.V2GetHostIgnitionParams) middleware.Responder
 
@@ -148,18 +234,41 @@ type InstallerAPI interface {
 	/* V2ImportCluster Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster */
 	V2ImportCluster(ctx context.Context, params installer.V2ImportClusterParams) middleware.Responder
 
-	/* V2InstallCluster Installs the OpenShift cluster. */
+	/* V2InstallCluster Installs the OpenShift cluster. When an install schedule is given and its start time is in the future,
+	   the installation is scheduled instead and starts automatically once the cluster is ready within the schedule's window.
+	*/
 	V2InstallCluster(ctx context.Context, params installer.V2InstallClusterParams) middleware.Responder
 
 	/* V2InstallHost install specific host for day2 cluster. */
 	V2InstallHost(ctx context.Context, params installer.V2InstallHostParams) middleware.Responder
 
+	/* V2ListClusterInstallConfigHistory Lists the versions of the install config overrides of the cluster, oldest first. */
+	V2ListClusterInstallConfigHistory(ctx context.Context, params installer.V2ListClusterInstallConfigHistoryParams) middleware.Responder
+
+	/* V2ListClusterStateTransitions Lists the status transitions of the cluster, oldest first. */
+	V2ListClusterStateTransitions(ctx context.Context, params installer.V2ListClusterStateTransitionsParams) middleware.Responder
+
 	/* V2ListClusters Retrieves the list of OpenShift clusters. */
 	V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder
 
+	/* V2ListDeletedClusters Lists the deregistered clusters that can still be restored, before they are permanently deleted.
+	 */
+	V2ListDeletedClusters(ctx context.Context, params installer.V2ListDeletedClustersParams) middleware.Responder
+
 	/* V2ListFeatureSupportLevels Retrieves the support levels for features for each OpenShift version. */
 	V2ListFeatureSupportLevels(ctx context.Context, params installer.V2ListFeatureSupportLevelsParams) middleware.Responder
 
+	/* V2ListHostDiagnostics Lists the diagnostic actions that were requested for the host. */
+	V2ListHostDiagnostics(ctx context.Context, params installer.V2ListHostDiagnosticsParams) middleware.Responder
+
+	/* V2ListHostStateTransitions Lists the status transitions of the host, oldest first. */
+	V2ListHostStateTransitions(ctx context.Context, params installer.V2ListHostStateTransitionsParams) middleware.Responder
+
+	/* V2ListHostValidationStats Lists the statistics of the validations of the host: how long they took to succeed after the host was
+	   discovered, and how often they changed between success and failure.
+	*/
+	V2ListHostValidationStats(ctx context.Context, params installer.V2ListHostValidationStatsParams) middleware.Responder
+
 	/* V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env. */
 	V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder
 
@@ -172,6 +281,13 @@ type InstallerAPI interface {
 	/* V2RegisterHost Registers a new OpenShift agent. */
 	V2RegisterHost(ctx context.Context, params installer.V2RegisterHostParams) middleware.Responder
 
+	/* V2ReinstallFailedHosts Resets only the failed or cancelled workers of an installing or installed cluster, without touching
+	   its other hosts. The hosts become day-2 hosts of the cluster: once they are rebooted into the discovery
+	   image and register again, they are installed with v2InstallHost and join the cluster through the
+	   add-hosts flow, using a freshly generated day-2 ignition.
+	*/
+	V2ReinstallFailedHosts(ctx context.Context, params installer.V2ReinstallFailedHostsParams) middleware.Responder
+
 	/* V2ResetCluster Resets a failed installation. */
 	V2ResetCluster(ctx context.Context, params installer.V2ResetClusterParams) middleware.Responder
 
@@ -181,6 +297,11 @@ type InstallerAPI interface {
 	/* V2ResetHostValidation Reset failed host validation. */
 	V2ResetHostValidation(ctx context.Context, params installer.V2ResetHostValidationParams) middleware.Responder
 
+	/* V2RestoreDeletedCluster Restores a deregistered cluster, together with the hosts that were deregistered with it, before it is
+	   permanently deleted.
+	*/
+	V2RestoreDeletedCluster(ctx context.Context, params installer.V2RestoreDeletedClusterParams) middleware.Responder
+
 	/* V2UpdateClusterInstallConfig Override values in the install config. */
 	V2UpdateClusterInstallConfig(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) middleware.Responder
 
@@ -261,6 +382,9 @@ type VersionsAPI interface {
 
 // Config is configuration for Handler
 type Config struct {
+	AuditAPI
+	ClusterTemplatesAPI
+	EventSubscriptionsAPI
 	EventsAPI
 	InstallerAPI
 	ManagedDomainsAPI
@@ -332,6 +456,9 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	api.MultipartformConsumer = runtime.DiscardConsumer
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
 	api.AgentAuthAuth = func(token string) (interface{}, error) {
 		if c.AuthAgentAuth == nil {
 			return token, nil
@@ -523,16 +650,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadLogs(ctx, params)
 	})
+	api.InstallerV2CancelInstallScheduleHandler = installer.V2CancelInstallScheduleHandlerFunc(func(params installer.V2CancelInstallScheduleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2CancelInstallSchedule(ctx, params)
+	})
 	api.InstallerV2CompleteInstallationHandler = installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2CompleteInstallation(ctx, params)
 	})
+	api.InstallerV2CreateHostDiagnosticHandler = installer.V2CreateHostDiagnosticHandlerFunc(func(params installer.V2CreateHostDiagnosticParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2CreateHostDiagnostic(ctx, params)
+	})
 	api.InstallerV2DeregisterClusterHandler = installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DeregisterCluster(ctx, params)
 	})
+	api.ClusterTemplatesV2DeregisterClusterTemplateHandler = cluster_templates.V2DeregisterClusterTemplateHandlerFunc(func(params cluster_templates.V2DeregisterClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2DeregisterClusterTemplate(ctx, params)
+	})
+	api.EventSubscriptionsV2DeregisterEventSubscriptionHandler = event_subscriptions.V2DeregisterEventSubscriptionHandlerFunc(func(params event_subscriptions.V2DeregisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventSubscriptionsAPI.V2DeregisterEventSubscription(ctx, params)
+	})
 	api.InstallerV2DeregisterHostHandler = installer.V2DeregisterHostHandlerFunc(func(params installer.V2DeregisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -553,6 +700,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadInfraEnvFiles(ctx, params)
 	})
+	api.InstallerV2DryRunInstallationHandler = installer.V2DryRunInstallationHandlerFunc(func(params installer.V2DryRunInstallationParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DryRunInstallation(ctx, params)
+	})
 	api.InstallerV2GetClusterHandler = installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -563,11 +715,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetClusterInstallConfig(ctx, params)
 	})
+	api.InstallerV2GetClusterInstallConfigDiffHandler = installer.V2GetClusterInstallConfigDiffHandlerFunc(func(params installer.V2GetClusterInstallConfigDiffParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterInstallConfigDiff(ctx, params)
+	})
+	api.InstallerV2GetClusterInstallationTimelineHandler = installer.V2GetClusterInstallationTimelineHandlerFunc(func(params installer.V2GetClusterInstallationTimelineParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterInstallationTimeline(ctx, params)
+	})
+	api.ClusterTemplatesV2GetClusterTemplateHandler = cluster_templates.V2GetClusterTemplateHandlerFunc(func(params cluster_templates.V2GetClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2GetClusterTemplate(ctx, params)
+	})
+	api.EventSubscriptionsV2GetEventSubscriptionHandler = event_subscriptions.V2GetEventSubscriptionHandlerFunc(func(params event_subscriptions.V2GetEventSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventSubscriptionsAPI.V2GetEventSubscription(ctx, params)
+	})
 	api.InstallerV2GetHostHandler = installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetHost(ctx, params)
 	})
+	api.InstallerV2GetHostDiagnosticHandler = installer.V2GetHostDiagnosticHandlerFunc(func(params installer.V2GetHostDiagnosticParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetHostDiagnostic(ctx, params)
+	})
 	api.InstallerV2GetHostIgnitionHandler = installer.V2GetHostIgnitionHandlerFunc(func(params installer.V2GetHostIgnitionParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -598,6 +775,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2InstallHost(ctx, params)
 	})
+	api.AuditV2ListAuditLogHandler = audit.V2ListAuditLogHandlerFunc(func(params audit.V2ListAuditLogParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AuditAPI.V2ListAuditLog(ctx, params)
+	})
+	api.InstallerV2ListClusterInstallConfigHistoryHandler = installer.V2ListClusterInstallConfigHistoryHandlerFunc(func(params installer.V2ListClusterInstallConfigHistoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListClusterInstallConfigHistory(ctx, params)
+	})
+	api.InstallerV2ListClusterStateTransitionsHandler = installer.V2ListClusterStateTransitionsHandlerFunc(func(params installer.V2ListClusterStateTransitionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListClusterStateTransitions(ctx, params)
+	})
+	api.ClusterTemplatesV2ListClusterTemplatesHandler = cluster_templates.V2ListClusterTemplatesHandlerFunc(func(params cluster_templates.V2ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2ListClusterTemplates(ctx, params)
+	})
 	api.InstallerV2ListClustersHandler = installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -608,6 +805,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.V2ListComponentVersions(ctx, params)
 	})
+	api.InstallerV2ListDeletedClustersHandler = installer.V2ListDeletedClustersHandlerFunc(func(params installer.V2ListDeletedClustersParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListDeletedClusters(ctx, params)
+	})
+	api.EventSubscriptionsV2ListEventSubscriptionDeliveriesHandler = event_subscriptions.V2ListEventSubscriptionDeliveriesHandlerFunc(func(params event_subscriptions.V2ListEventSubscriptionDeliveriesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventSubscriptionsAPI.V2ListEventSubscriptionDeliveries(ctx, params)
+	})
+	api.EventSubscriptionsV2ListEventSubscriptionsHandler = event_subscriptions.V2ListEventSubscriptionsHandlerFunc(func(params event_subscriptions.V2ListEventSubscriptionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventSubscriptionsAPI.V2ListEventSubscriptions(ctx, params)
+	})
 	api.EventsV2ListEventsHandler = events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -618,6 +830,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ListFeatureSupportLevels(ctx, params)
 	})
+	api.InstallerV2ListHostDiagnosticsHandler = installer.V2ListHostDiagnosticsHandlerFunc(func(params installer.V2ListHostDiagnosticsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListHostDiagnostics(ctx, params)
+	})
+	api.InstallerV2ListHostStateTransitionsHandler = installer.V2ListHostStateTransitionsHandlerFunc(func(params installer.V2ListHostStateTransitionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListHostStateTransitions(ctx, params)
+	})
+	api.InstallerV2ListHostValidationStatsHandler = installer.V2ListHostValidationStatsHandlerFunc(func(params installer.V2ListHostValidationStatsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListHostValidationStats(ctx, params)
+	})
 	api.InstallerV2ListHostsHandler = installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -638,11 +865,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterCluster(ctx, params)
 	})
+	api.ClusterTemplatesV2RegisterClusterTemplateHandler = cluster_templates.V2RegisterClusterTemplateHandlerFunc(func(params cluster_templates.V2RegisterClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2RegisterClusterTemplate(ctx, params)
+	})
+	api.EventSubscriptionsV2RegisterEventSubscriptionHandler = event_subscriptions.V2RegisterEventSubscriptionHandlerFunc(func(params event_subscriptions.V2RegisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventSubscriptionsAPI.V2RegisterEventSubscription(ctx, params)
+	})
 	api.InstallerV2RegisterHostHandler = installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterHost(ctx, params)
 	})
+	api.InstallerV2ReinstallFailedHostsHandler = installer.V2ReinstallFailedHostsHandlerFunc(func(params installer.V2ReinstallFailedHostsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ReinstallFailedHosts(ctx, params)
+	})
 	api.OperatorsV2ReportMonitoredOperatorStatusHandler = operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -663,6 +905,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ResetHostValidation(ctx, params)
 	})
+	api.InstallerV2RestoreDeletedClusterHandler = installer.V2RestoreDeletedClusterHandlerFunc(func(params installer.V2RestoreDeletedClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2RestoreDeletedCluster(ctx, params)
+	})
+	api.EventsV2StreamEventsHandler = events.V2StreamEventsHandlerFunc(func(params events.V2StreamEventsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.V2StreamEvents(ctx, params)
+	})
 	api.InstallerV2UpdateClusterInstallConfigHandler = installer.V2UpdateClusterInstallConfigHandlerFunc(func(params installer.V2UpdateClusterInstallConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -673,6 +925,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UpdateClusterLogsProgress(ctx, params)
 	})
+	api.ClusterTemplatesV2UpdateClusterTemplateHandler = cluster_templates.V2UpdateClusterTemplateHandlerFunc(func(params cluster_templates.V2UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.V2UpdateClusterTemplate(ctx, params)
+	})
+	api.EventSubscriptionsV2UpdateEventSubscriptionHandler = event_subscriptions.V2UpdateEventSubscriptionHandlerFunc(func(params event_subscriptions.V2UpdateEventSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventSubscriptionsAPI.V2UpdateEventSubscription(ctx, params)
+	})
 	api.InstallerV2UpdateHostHandler = installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -41,122 +41,81 @@ func init() {
   "host": "api.openshift.com",
   "basePath": "/api/assisted-install",
   "paths": {
-    "/v2/clusters": {
+    "/v2/audit-log": {
       "get": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
-              "user"
+              "read-only-admin"
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Lists the audit log entries of the changes made through the REST API and through the kube-api, from the\nlatest.\n",
         "tags": [
-          "installer"
+          "audit"
         ],
-        "operationId": "v2ListClusters",
+        "operationId": "v2ListAuditLog",
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
+            "description": "Return the entries of the changes made to this cluster, host or infra-env.",
+            "name": "resource_id",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
+            "type": "string",
+            "description": "Return the entries of the changes made by this user.",
+            "name": "actor",
             "in": "query"
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
+            "type": "string",
+            "description": "Return the entries of the changes made by the users of this organization.",
+            "name": "org_id",
+            "in": "query"
           },
           {
+            "enum": [
+              "rest-api",
+              "kube-api"
+            ],
             "type": "string",
-            "description": "If provided, returns only clusters that are owned by the specified user.",
-            "name": "owner",
+            "description": "Return the entries of the changes made through this interface.",
+            "name": "source",
             "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster-list"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
           },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return the entries of the changes made at or after this time.",
+            "name": "since",
+            "in": "query"
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The maximal number of entries to return.",
+            "name": "limit",
+            "in": "query"
           },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2RegisterCluster",
-        "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of entries to skip before starting to return entries.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/audit-log-entry-list"
             }
           },
           "400": {
@@ -192,27 +151,18 @@ func init() {
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/cluster-templates": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Retrieves the list of cluster templates.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "V2GetClusterDefaultConfig",
+        "operationId": "v2ListClusterTemplates",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "$ref": "#/definitions/cluster-template-list"
             }
           },
           "401": {
@@ -227,6 +177,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -234,23 +190,21 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/import": {
+      },
       "post": {
-        "description": "Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "description": "Creates a new cluster template.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2ImportCluster",
+        "operationId": "v2RegisterClusterTemplate",
         "parameters": [
           {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
+            "description": "The properties describing the new cluster template.",
+            "name": "new-cluster-template-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/import-cluster-params"
+              "$ref": "#/definitions/cluster-template-create-params"
             }
           }
         ],
@@ -258,7 +212,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "400": {
@@ -279,6 +233,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -288,59 +248,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}": {
+    "/v2/cluster-templates/{template_id}": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Retrieves the details of the cluster template.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "v2GetClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
-            "name": "cluster_id",
+            "description": "The cluster template to be retrieved.",
+            "name": "template_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "description": "If true, do not include hosts.",
-            "name": "exclude-hosts",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "401": {
@@ -372,34 +301,46 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      "put": {
+        "description": "Replaces the properties of the cluster template. Clusters created from the template are not affected.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "v2UpdateClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
-            "name": "cluster_id",
+            "description": "The cluster template to be updated.",
+            "name": "template_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The new properties of the cluster template.",
+            "name": "cluster-template-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -425,12 +366,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -439,43 +374,25 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      "delete": {
+        "description": "Deletes the cluster template. Clusters created from the template are not affected.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "v2DeregisterClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
-            "name": "cluster_id",
+            "description": "The cluster template to be deleted.",
+            "name": "template_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -501,12 +418,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -516,28 +427,66 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
-      "post": {
-        "description": "Transforming cluster to day2 and allowing adding hosts",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "TransformClusterToDay2",
-        "parameters": [
+    "/v2/clusters": {
+      "get": {
+        "security": [
           {
-            "type": "string",
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform to day2 and to allow adding hosts by this.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only clusters that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-list"
             }
           },
           "401": {
@@ -552,57 +501,63 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
+      },
       "post": {
-        "description": "Cancels an ongoing installation.",
+        "description": "Creates a new OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2CancelInstallation",
+        "operationId": "v2RegisterCluster",
         "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "A cluster template to create the cluster from. The properties describing the new cluster take precedence\nover the ones of the template, and the manifests and install config overrides of the template are added\nto the cluster.\n",
+            "name": "from_template",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -615,24 +570,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -642,48 +585,27 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    "/v2/clusters/default-config": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "description": "Get the default values for various cluster properties.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          }
-        ],
+        "operationId": "V2GetClusterDefaultConfig",
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster_default_config"
             }
           },
           "401": {
@@ -698,32 +620,60 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+          }
+        }
+      }
+    },
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
             }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -731,34 +681,65 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/v2/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "v2GetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster to be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, do not include hosts.",
+            "name": "exclude-hosts",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the cluster, to be sent in the If-Match header of its updates."
+              }
             }
           },
           "401": {
@@ -785,7 +766,65 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -798,30 +837,55 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "V2UpdateCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster to be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Update the cluster only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the cluster, to be sent in the If-Match header of its updates."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -854,6 +918,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "412": {
+            "description": "Precondition Failed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -863,35 +933,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      "post": {
+        "description": "Transforming cluster to day2 and allowing adding hosts",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "TransformClusterToDay2",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster to transform to day2 and to allow adding hosts by this.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -933,56 +996,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "V2CancelInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster whose installation is to be canceled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1020,63 +1055,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/v2/clusters/{cluster_id}/actions/cancel-install-schedule": {
+      "post": {
+        "description": "Cancels the scheduled installation of the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "v2CancelInstallSchedule",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose scheduled installation is to be canceled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1118,68 +1122,48 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files": {
-      "get": {
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "v2CompleteInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is being completing.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
+            "description": "The software version of the discovery agent that is completing the installation.",
             "name": "discovery_agent_version",
             "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1227,83 +1211,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+    "/v2/clusters/{cluster_id}/actions/dry-run": {
+      "post": {
+        "description": "Generates the install-config, the operator manifests and the ignition files of the cluster as the installation\nwould, in a scratch working directory, and returns the generated artifacts together with the errors of every\ngeneration stage. The cluster, its hosts and its stored files are not modified.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "v2DryRunInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is to be tried.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/dry-run-result"
             }
           },
           "401": {
@@ -1345,69 +1274,42 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Get a list of cluster hosts according to supplied filters.",
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster. When an install schedule is given and its start time is in the future,\nthe installation is scheduled instead and starts automatically once the cluster is ready within the schedule's window.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "ListClusterHosts",
+        "operationId": "v2InstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be retrieved.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "master",
-              "worker",
-              "auto-assign"
-            ],
-            "type": "string",
-            "description": "Role to request.",
-            "name": "role",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Hosts status to request.",
-            "name": "status",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's inventory.",
-            "name": "with-inventory",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's connectivity.",
-            "name": "with-connectivity",
-            "in": "query"
+            "description": "The window in which the installation is allowed to start.",
+            "name": "install_schedule",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/install-schedule"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1428,6 +1330,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1437,37 +1351,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster's install config YAML.",
+    "/v2/clusters/{cluster_id}/actions/reinstall-failed-hosts": {
+      "post": {
+        "description": "Resets only the failed or cancelled workers of an installing or installed cluster, without touching\nits other hosts. The hosts become day-2 hosts of the cluster: once they are rebooted into the discovery\nimage and register again, they are installed with v2InstallHost and join the cluster through the\nadd-hosts flow, using a freshly generated day-2 ignition.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "v2ReinstallFailedHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster whose failed hosts are to be re-installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1494,6 +1399,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1501,40 +1412,30 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "v2ResetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster whose installation is to be reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "202": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1561,6 +1462,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1570,61 +1477,35 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
+    "/v2/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "V2GetCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster whose admin credentials should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -1664,67 +1545,59 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
+        "description": "Downloads credentials relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "V2DownloadClusterCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster that owns the credential file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
           {
             "enum": [
-              "host",
-              "controller"
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
             ],
             "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
             "in": "query",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -1771,40 +1644,54 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "user"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -1841,43 +1728,72 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
+    "/v2/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2ListClusterManifests",
+        "operationId": "V2DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "type": "file"
             }
           },
           "401": {
@@ -1915,44 +1831,87 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "V2GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
@@ -1998,44 +1957,72 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Get a list of cluster hosts according to supplied filters.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2DeleteClusterManifest",
+        "operationId": "ListClusterHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose hosts should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "manifests",
-              "openshift"
+              "master",
+              "worker",
+              "auto-assign"
             ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "description": "Role to request.",
+            "name": "role",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success."
+            "description": "Hosts status to request.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true return the host's inventory.",
+            "name": "with-inventory",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true return the host's connectivity.",
+            "name": "with-connectivity",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -2055,18 +2042,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2076,54 +2051,43 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests/files": {
+    "/v2/clusters/{cluster_id}/install-config": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "type": "integer",
+            "description": "Render the install config with the overrides of this version of the install config overrides history\ninstead of the current ones. Version 0 renders the install config without overrides.\n",
+            "name": "version",
             "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "type": "string"
             }
           },
           "401": {
@@ -2150,12 +2114,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2163,42 +2121,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
-      "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Lists operators to be monitored for a cluster.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2232,46 +2188,52 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/install-config/diff": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Get a unified diff between the install config YAML rendered with two versions of the install config overrides.\n",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "v2GetClusterInstallConfigDiff",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster whose install config is being compared.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
-            }
+            "type": "integer",
+            "default": 0,
+            "description": "The version of the install config overrides to compare from. Version 0 is the install config without overrides.",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The version of the install config overrides to compare to. Defaults to the current overrides.",
+            "name": "to",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string"
             }
           },
           "401": {
@@ -2298,28 +2260,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
+    "/v2/clusters/{cluster_id}/install-config/history": {
       "get": {
         "security": [
           {
@@ -2330,16 +2280,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Lists the versions of the install config overrides of the cluster, oldest first.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "v2ListClusterInstallConfigHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
+            "description": "The cluster whose install config overrides history is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2349,7 +2299,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/install-config-override-version-list"
             }
           },
           "401": {
@@ -2385,7 +2335,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
+    "/v2/clusters/{cluster_id}/installation-timeline": {
       "get": {
         "security": [
           {
@@ -2396,16 +2346,16 @@ func init() {
             ]
           }
         ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Get the timeline of the cluster installation, built from the installation stages that each host went through.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "v2GetClusterInstallationTimeline",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
+            "description": "The cluster whose installation timeline is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2415,10 +2365,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "$ref": "#/definitions/installation-timeline"
             }
           },
           "401": {
@@ -2439,6 +2386,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2448,51 +2401,61 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/v2/clusters/{cluster_id}/logs": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -2519,136 +2482,80 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/component-versions": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "List of component versions.",
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-managed-domains"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/events": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Lists events for a cluster.",
-        "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
-            "in": "query"
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
           },
           {
+            "enum": [
+              "host",
+              "controller"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to return events for.",
+            "description": "The infra-env ID of the host.",
             "name": "infra_env_id",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
             "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/event-list"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -2674,48 +2581,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/feature-support-levels": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the support levels for features for each OpenShift version.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2ListFeatureSupportLevels",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/feature-support-levels"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
           },
-          "403": {
-            "description": "Forbidden.",
+          "500": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "503": {
@@ -2727,52 +2602,40 @@ func init() {
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
-      "get": {
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
-            "name": "infra_env_id",
+            "description": "The cluster whose log progress is being updated.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -2819,42 +2682,33 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs": {
+    "/v2/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Retrieves the list of infra-envs.",
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "ListInfraEnvs",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If provided, returns only infra-envs that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env-list"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -2869,26 +2723,26 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2896,27 +2750,40 @@ func init() {
         }
       },
       "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which a new manifest should be created.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/manifest"
             }
           },
           "400": {
@@ -2960,9 +2827,79 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "V2DeleteClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be deleted.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "501": {
-            "description": "Not implemented.",
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2970,40 +2907,54 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Retrieves the details of the infra-env.",
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
-            "name": "infra_env_id",
+            "description": "The cluster whose manifest should be downloaded.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "type": "file"
             }
           },
           "401": {
@@ -3030,45 +2981,56 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an infra-env.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/monitored-operators": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
-            "name": "infra_env_id",
+            "description": "The cluster to return operators for.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3094,60 +3056,51 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
-      "patch": {
-        "description": "Updates an infra-env.",
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
-            "name": "infra_env_id",
+            "description": "The cluster whose operators are being monitored.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "infra-env-update-params",
+            "description": "The operators monitor report.",
+            "name": "report-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
+              "$ref": "#/definitions/operator-monitor-report"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/infra-env"
-            }
+          "200": {
+            "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3188,8 +3141,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3197,7 +3150,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
       "get": {
         "security": [
           {
@@ -3206,77 +3159,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadInfraEnvFiles",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
-            "name": "infra_env_id",
+            "description": "The cluster to return preflight requirements for.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
-            "name": "mac",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
-            }
-          },
-          "400": {
-            "description": "Bad Request.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -3303,76 +3207,46 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+    "/v2/clusters/{cluster_id}/state-transitions": {
       "get": {
-        "description": "Creates a new pre-signed download URL for the infra-env.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the status transitions of the cluster, oldest first.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvPresignedFileURL",
+        "operationId": "v2ListClusterStateTransitions",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The file's infra-env.",
-            "name": "infra_env_id",
+            "description": "The cluster whose status transitions should be listed.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/state-transition-list"
             }
           },
           "401": {
@@ -3404,35 +3278,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
+    "/v2/clusters/{cluster_id}/supported-platforms": {
       "get": {
-        "description": "Creates a new pre-signed image download URL for the infra-env.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "A list of platforms that this cluster can support in its current configuration.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvDownloadURL",
+        "operationId": "GetClusterSupportedPlatforms",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
-            "name": "infra_env_id",
+            "description": "The cluster whose platform types should be retrieved.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           }
@@ -3441,13 +3312,10 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Bad Request.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
             }
           },
           "401": {
@@ -3468,79 +3336,62 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
-      "get": {
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadMinimalInitrd",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
-            "name": "infra_env_id",
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/error"
             }
           },
-          "204": {
-            "description": "Empty Success."
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -3565,14 +3416,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3580,7 +3431,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts": {
+    "/v2/component-versions": {
       "get": {
         "security": [
           {
@@ -3589,31 +3440,43 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
+        "description": "List of component versions.",
         "tags": [
-          "installer"
+          "versions"
         ],
-        "operationId": "v2ListHosts",
-        "parameters": [
+        "operationId": "v2ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/v2/deleted-clusters": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env that the hosts are asociated with.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
           }
         ],
+        "description": "Lists the deregistered clusters that can still be restored, before they are permanently deleted.\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListDeletedClusters",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/deleted-cluster-list"
             }
           },
           "401": {
@@ -3639,62 +3502,39 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
+      }
+    },
+    "/v2/deleted-clusters/{cluster_id}/actions/restore": {
       "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin"
+            ]
           }
         ],
-        "description": "Registers a new OpenShift agent.",
+        "description": "Restores a deregistered cluster, together with the hosts that were deregistered with it, before it is\npermanently deleted.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterHost",
+        "operationId": "v2RestoreDeletedCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the agent is associated with.",
-            "name": "infra_env_id",
+            "description": "The deregistered cluster to be restored.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The description of the agent being registered.",
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the agent.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/cluster"
             }
           },
           "400": {
@@ -3728,7 +3568,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3738,23 +3578,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+    "/v2/domains": {
       "get": {
         "security": [
           {
@@ -3765,34 +3593,39 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "List of managed DNS domains.",
         "tags": [
-          "installer"
+          "managed_domains"
         ],
-        "operationId": "v2GetHost",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+        "operationId": "V2ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
           },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be retrieved.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
+        }
+      }
+    },
+    "/v2/event-subscriptions": {
+      "get": {
+        "description": "Retrieves the list of event subscriptions.",
+        "tags": [
+          "event_subscriptions"
         ],
+        "operationId": "v2ListEventSubscriptions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/event-subscription-list"
             }
           },
           "401": {
@@ -3807,12 +3640,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -3824,42 +3651,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      "post": {
+        "description": "Creates a subscription that delivers events to an HTTP endpoint as CloudEvents.",
         "tags": [
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "v2DeregisterHost",
+        "operationId": "v2RegisterEventSubscription",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that should be deregistered.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be deregistered.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The properties describing the new event subscription.",
+            "name": "new-event-subscription-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/event-subscription-create-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-subscription"
+            }
           },
           "400": {
             "description": "Error.",
@@ -3879,12 +3696,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -3898,51 +3709,30 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Update an Openshift host",
+      }
+    },
+    "/v2/event-subscriptions/{subscription_id}": {
+      "get": {
+        "description": "Retrieves the details of the event subscription.",
         "tags": [
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "v2UpdateHost",
+        "operationId": "v2GetEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env ID of the host to be updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be updated.",
-            "name": "host_id",
+            "description": "The event subscription to be retrieved.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "host-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/event-subscription"
             }
           },
           "401": {
@@ -3969,12 +3759,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3982,39 +3766,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
-      "post": {
-        "description": "Bind host to a cluster",
+      },
+      "put": {
+        "description": "Replaces the properties of the event subscription. The secret of the subscription is kept when it is not set.",
         "tags": [
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "BindHost",
+        "operationId": "v2UpdateEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being bound.",
-            "name": "host_id",
+            "description": "The event subscription to be updated.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the host binding.",
-            "name": "bind-host-params",
+            "description": "The new properties of the event subscription.",
+            "name": "event-subscription-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bind-host-params"
+              "$ref": "#/definitions/event-subscription-create-params"
             }
           }
         ],
@@ -4022,7 +3796,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/event-subscription"
             }
           },
           "400": {
@@ -4060,53 +3834,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+      },
+      "delete": {
+        "description": "Deletes the event subscription and its delivery log.",
         "tags": [
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "v2InstallHost",
+        "operationId": "v2DeregisterEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being installed.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
+            "description": "The event subscription to be deleted.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4126,8 +3875,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4141,36 +3890,48 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/v2/event-subscriptions/{subscription_id}/deliveries": {
+      "get": {
+        "description": "Retrieves the delivery log of the event subscription, latest deliveries first.",
         "tags": [
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "v2ListEventSubscriptionDeliveries",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
-            "name": "infra_env_id",
+            "description": "The event subscription whose deliveries should be retrieved.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Only retrieve the deliveries with this status.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of deliveries to retrieve.",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/event-subscription-delivery-list"
             }
           },
           "401": {
@@ -4191,8 +3952,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4206,48 +3967,150 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+    "/v2/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "The cluster to return events for.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "A host in the specified cluster to return events for.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities. Events of all severities are returned when not set.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event names. Events of all names are returned when not set.",
+            "name": "names",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the events whose message contains this text, ignoring case.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the events that occurred at or after this time.",
+            "name": "start_time",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the events that occurred before this time.",
+            "name": "end_time",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "default": "ascending",
+            "description": "Order the events by their time, from the oldest (ascending) or from the latest (descending).",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximal number of events to return. All the events are returned when not set.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of events to skip before starting to return events.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Return the events that were pruned from the database and archived, instead of the current events. Requires either cluster_id or infra_env_id.",
+            "name": "archived",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "type": "integer",
+                "format": "int64",
+                "description": "The number of events that match the filters, regardless of the limit and offset."
+              }
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4270,8 +4133,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4285,36 +4148,105 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
-      "post": {
-        "description": "Unbind host to a cluster",
+    "/v2/events/stream": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Streams the events of a cluster, host or infra-env as server-sent events while they are saved.\nEach message holds an event in JSON format and its ID, which can be sent back in the Last-Event-ID header\n(or the last_event_id parameter) to resume the stream after the last received event.\n",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2StreamEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "The cluster to stream events for.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "A host in the specified cluster to stream events for.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to stream events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities. Events of all severities are streamed when not set.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event names. Events of all names are streamed when not set.",
+            "name": "names",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Resume the stream after the event with this ID. Only new events are streamed when neither this parameter nor the Last-Event-ID header are set.",
+            "name": "last_event_id",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "x-go-name": "LastEventIDHeader",
+            "description": "The ID of the last event received before the stream was interrupted, as sent by EventSource clients when they reconnect.",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string",
+              "format": "binary"
             }
           },
           "400": {
@@ -4335,75 +4267,42 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
+    "/v2/feature-support-levels": {
       "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2GetHostIgnition",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "Retrieves the support levels for features for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListFeatureSupportLevels",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/feature-support-levels"
             }
           },
           "401": {
@@ -4418,49 +4317,42 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "v2DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -4468,29 +4360,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
+            "description": "The host whose ignition file should be downloaded.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -4517,14 +4397,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4532,37 +4418,96 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v2/infra-envs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "If provided, returns only infra-envs that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift Discovery ISO.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterInfraEnv",
+        "parameters": [
           {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installer-args-params"
+              "$ref": "#/definitions/infra-env-create-params"
             }
           }
         ],
@@ -4570,7 +4515,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
@@ -4624,53 +4569,46 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+    "/v2/infra-envs/{infra_env_id}": {
       "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetNextSteps",
+        "operationId": "GetInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
+            "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "The time on the host as seconds since the Unix epoch.",
-            "name": "timestamp",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/infra-env"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the infra-env, to be sent in the If-Match header of its updates."
+              }
             }
           },
           "401": {
@@ -4717,59 +4655,26 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2PostStepReply",
+        "operationId": "DeregisterInfraEnv",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
+            "description": "The infra-env to be deleted.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4794,69 +4699,75 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "patch": {
+        "description": "Updates an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
+        "operationId": "UpdateInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
+            "description": "The infra-env to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/infra-env-update-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "Update the infra-env only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the infra-env, to be sent in the If-Match header of its updates."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -4888,20 +4799,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "412": {
+            "description": "Precondition Failed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4909,54 +4820,87 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "v2DownloadInfraEnvFiles",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host being updated.",
+            "description": "The infra-env whose file should be downloaded.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to update.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
             "required": true
           },
           {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
+            "type": "string",
+            "format": "mac",
+            "description": "Mac address of the host running ipxe script.",
+            "name": "mac",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -4982,12 +4926,24 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -4997,26 +4953,50 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+      "get": {
+        "description": "Creates a new pre-signed download URL for the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "GetInfraEnvPresignedFileURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
+            "description": "The file's infra-env.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5047,47 +5027,90 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/openshift-versions": {
+    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
       "get": {
-        "security": [
+        "description": "Creates a new pre-signed image download URL for the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetInfraEnvDownloadURL",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Bad Request.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5095,23 +5118,52 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators": {
+    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
       "get": {
-        "description": "Retrieves the list of supported operators.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          }
+        ],
+        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators"
+          "installer"
+        ],
+        "operationId": "DownloadMinimalInitrd",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that should be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "V2ListSupportedOperators",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "type": "string",
+              "format": "binary"
             }
           },
+          "204": {
+            "description": "Empty Success."
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -5124,6 +5176,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5133,7 +5203,7 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators/{operator_name}": {
+    "/v2/infra-envs/{infra_env_id}/hosts": {
       "get": {
         "security": [
           {
@@ -5142,18 +5212,22 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "operationId": "V2ListOperatorProperties",
+        "operationId": "v2ListHosts",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The infra-env that the hosts are asociated with.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           }
@@ -5162,7 +5236,94 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/host-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift agent.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env that the agent is associated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The description of the agent being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the agent.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host_registration_response"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5183,1746 +5344,5137 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Cluster cannot accept new agents due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "ca_certificate": {
-          "description": "A CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "url": {
-          "description": "URL address of the API.",
-          "type": "string"
-        },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
-          "type": "boolean"
-        }
-      }
     },
-    "api_vip_connectivity_response": {
-      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
-      "type": "object",
-      "properties": {
-        "download_error": {
-          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
-          "type": "string"
-        },
-        "ignition": {
-          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
-          "type": "string"
-        },
-        "is_success": {
-          "description": "Whether the agent was able to download the ignition or not",
-          "type": "boolean"
-        },
-        "url": {
-          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
-          "type": "string"
-        }
-      }
-    },
-    "bind-host-params": {
-      "required": [
-        "cluster_id"
-      ],
-      "properties": {
-        "cluster_id": {
-          "type": "string",
-          "format": "uuid"
-        }
-      }
-    },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "current_boot_mode": {
-          "type": "string"
-        },
-        "pxe_interface": {
-          "type": "string"
-        }
-      }
-    },
-    "cluster": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
-      ],
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/cluster_network"
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that should be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
-        },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "x-nullable": false
-        },
-        "created_at": {
-          "description": "The time that this cluster was created.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
             },
-            "type": "DeletedAt"
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the host, to be sent in the If-Match header of its updates."
+              }
+            }
           },
-          "x-nullable": false
-        },
-        "disk_encryption": {
-          "description": "Information regarding hosts' installation disks encryption.",
-          "type": "object",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "email_domain": {
-          "type": "string"
-        },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
-          "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ]
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "type": "object",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "image_info": {
-          "$ref": "#/definitions/image_info"
-        },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that should be deregistered.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update an Openshift host",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host to be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "host-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-update-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Update the host only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the host, to be sent in the If-Match header of its updates."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "412": {
+            "description": "Precondition Failed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
+      "post": {
+        "description": "Bind host to a cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "BindHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being bound.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The parameters for the host binding.",
+            "name": "bind-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bind-host-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2InstallHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being installed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ResetHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being reset.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+        "tags": [
+          "installer"
+        ],
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that its validation is being reset.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+      "post": {
+        "description": "Unbind host to a cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UnbindHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being bound.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the diagnostic actions that were requested for the host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListHostDiagnostics",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose diagnostics should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose diagnostics should be listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Queues a diagnostic action from the service allowlist to be run on the host by its agent.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2CreateHostDiagnostic",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that the diagnostic action should run on.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that the diagnostic action should run on.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The diagnostic action to be run.",
+            "name": "host-diagnostic-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a diagnostic action of the host, including its output once the agent uploaded it.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetHostDiagnostic",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose diagnostic should be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose diagnostic should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The diagnostic that should be retrieved.",
+            "name": "diagnostic_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be obtained.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallerArgs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetNextSteps",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is retrieving instructions.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The time on the host as seconds since the Unix epoch.",
+            "name": "timestamp",
+            "in": "query"
+          },
+          {
+            "maximum": 120,
+            "type": "integer",
+            "description": "Long-poll mode. The number of seconds the service may hold the request open, waiting for the state\nof the host to change or for a new command to be queued for it, before it returns the next operations.\nWhen omitted or 0 the next operations are returned immediately. The request is not held\nwhen the host changed since it last checked in, nor when the service is unable to\nwatch the host for changes.\n",
+            "name": "wait_timeout",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/steps"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2PostStepReply",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is posting results.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose log progress is being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to update.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the status transitions of the host, oldest first.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListHostStateTransitions",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose status transitions should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose status transitions should be listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/state-transition-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the statistics of the validations of the host: how long they took to succeed after the host was\ndiscovered, and how often they changed between success and failure.\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListHostValidationStats",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose validation statistics should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose validation statistics should be listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-validation-stats-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegenerateInfraEnvSigningKey",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The target InfraEnv.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "ca_certificate": {
+          "description": "A CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
+      "type": "object",
+      "properties": {
+        "download_error": {
+          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
+          "type": "string"
+        },
+        "ignition": {
+          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
+          "type": "string"
+        },
+        "is_success": {
+          "description": "Whether the agent was able to download the ignition or not",
+          "type": "boolean"
+        },
+        "url": {
+          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
+          "type": "string"
+        }
+      }
+    },
+    "audit-log-change": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "description": "The path of the field, such as platform.type.",
+          "type": "string"
+        },
+        "from": {
+          "description": "The value of the field before the change.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The value of the field after the change.",
+          "type": "string"
+        }
+      }
+    },
+    "audit-log-entry": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "description": "The operation that made the change, such as v2UpdateCluster.",
+          "type": "string"
+        },
+        "actor": {
+          "description": "The user that made the change.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "changes": {
+          "description": "The fields of the resource that changed. The values of secret fields are redacted.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audit-log-change"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "created_at": {
+          "description": "The time at which the change was made.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "id": {
+          "description": "Unique identifier of the entry.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "org_id": {
+          "description": "The organization of the user that made the change.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "request_id": {
+          "description": "The ID of the request that made the change, as written to the logs.",
+          "type": "string"
+        },
+        "resource_id": {
+          "description": "The changed cluster, host or infra-env.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "resource_kind": {
+          "description": "The kind of the changed resource, empty when the change is not made to a cluster, a host or an infra-env.",
+          "type": "string",
+          "enum": [
+            "cluster",
+            "host",
+            "infra-env"
+          ]
+        },
+        "source": {
+          "description": "The interface through which the change was made.",
+          "type": "string",
+          "enum": [
+            "rest-api",
+            "kube-api"
+          ]
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response, for changes made through the REST API.",
+          "type": "integer"
+        },
+        "succeeded": {
+          "description": "Whether the operation succeeded.",
+          "type": "boolean"
+        }
+      }
+    },
+    "audit-log-entry-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-log-entry"
+      }
+    },
+    "bind-host-params": {
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "x-nullable": false
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "default": false,
+          "x-go-custom-tag": "gorm:\"default:false\""
+        },
+        "disk_encryption": {
+          "description": "Information regarding hosts' installation disks encryption.",
+          "type": "object",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "host_stage_timeouts": {
+          "description": "JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "type": "object",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "image_info": {
+          "$ref": "#/definitions/image_info"
+        },
         "imported": {
           "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-workers endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
           "type": "boolean",
           "default": false
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
+        },
+        "install_schedule_end_at": {
+          "description": "The time after which the scheduled installation of this cluster expires if it did not start.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "install_schedule_start_at": {
+          "description": "The time from which the scheduled installation of this cluster is allowed to start.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "platform": {
+          "$ref": "#/definitions/platform"
+        },
+        "progress": {
+          "description": "Installation progress percentages of the cluster.",
+          "$ref": "#/definitions/cluster-progress-info"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "resource_version": {
+          "description": "Incremented on each update of the cluster through its update API, and returned as its ETag.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"default:1\""
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "schedulable_masters_forced_true": {
+          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
+          "type": "boolean",
+          "default": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/service_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string"
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "x-nullable": false
+        },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "type": "object",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_stage_timeouts": {
+          "description": "JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.",
+          "type": "string",
+          "x-nullable": true,
+          "example": "[{\"stage\": \"Writing image to disk\", \"timeout\": 600}, {\"stage\": \"Waiting for control plane\", \"role\": \"master\", \"timeout\": 7200}]"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "type": "object",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "default": "OpenShiftSDN",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "platform": {
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        },
+        "tpm_enabled_in_bios": {
+          "description": "Whether TPM module should be enabled in host's BIOS.",
+          "type": "boolean"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-progress-info": {
+      "type": "object",
+      "properties": {
+        "estimated_completion_at": {
+          "description": "Estimated time at which the installation completes, based on the stage durations of previous installations with the same OpenShift version and topology.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "finalizing_stage_percentage": {
+          "type": "integer"
+        },
+        "installing_stage_percentage": {
+          "type": "integer"
+        },
+        "preparing_for_installation_stage_percentage": {
+          "type": "integer"
+        },
+        "total_percentage": {
+          "type": "integer"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "cluster_params": {
+          "description": "The properties of the clusters created from the template, without a pull secret.",
+          "$ref": "#/definitions/cluster-create-params"
+        },
+        "created_at": {
+          "description": "The time that the cluster template was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "description": {
+          "description": "A description of the cluster template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "id": {
+          "description": "Unique identifier of the cluster template.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the install config overrides of the clusters created from the template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "manifests": {
+          "description": "Manifests added to the clusters created from the template.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "name": {
+          "description": "Name of the cluster template.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization of the user that created the cluster template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "updated_at": {
+          "description": "The last time that the cluster template was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_name": {
+          "description": "The user that created the cluster template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "cluster_params"
+      ],
+      "properties": {
+        "cluster_params": {
+          "description": "The properties of the clusters created from the template. The name and the pull secret of every cluster\nare given when it is registered, so the ones of the template are not used and the pull secret is not stored.\n",
+          "$ref": "#/definitions/cluster-create-params"
+        },
+        "description": {
+          "description": "A description of the cluster template.",
+          "type": "string"
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the install config overrides of the clusters created from the template.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "Manifests to add to the clusters created from the template.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "name": {
+          "description": "Name of the cluster template.",
+          "type": "string",
+          "maxLength": 256,
+          "minLength": 1
+        }
+      }
+    },
+    "cluster-template-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-template"
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "networks-same-address-families",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vip-defined",
+        "api-vip-valid",
+        "ingress-vip-defined",
+        "ingress-vip-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "cnv-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "network-type-valid"
+      ]
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "cluster_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "cluster_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "forbidden_hostnames": {
+          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "inactive_deletion_hours": {
+          "type": "integer"
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "service_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/service_network"
+          }
+        },
+        "service_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/service_network"
+          }
+        }
+      }
+    },
+    "cluster_network": {
+      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          "format": "uuid"
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "mac": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "mac"
         },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
+        }
+      }
+    },
+    "container_image_availability_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string",
+            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "base64 encoded manifest content.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
+          "type": "string",
+          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "deleted-cluster": {
+      "type": "object",
+      "required": [
+        "id",
+        "deleted_at",
+        "restorable_until"
+      ],
+      "properties": {
+        "deleted_at": {
+          "description": "The time the cluster was deregistered.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "description": "Unique identifier of the deregistered cluster.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the deregistered cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
         },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
+        "restorable_until": {
+          "description": "The time after which the cluster is permanently deleted and can no longer be restored.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "date-time"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
-          "type": "string",
-          "enum": [
-            "Cluster",
-            "AddHostsCluster"
-          ]
+        "status": {
+          "description": "Status of the cluster when it was deregistered.",
+          "type": "string"
         },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
+        "user_name": {
+          "type": "string"
+        }
+      }
+    },
+    "deleted-cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/deleted-cluster"
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
+          "type": "string"
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/machine_network"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
-        },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/monitored-operator"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+          "format": "mac"
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
           "type": "string"
         },
-        "network_type": {
-          "description": "The desired network type used.",
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
           "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
+          "format": "mac"
         },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
           "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+          "type": "string",
+          "format": "ipv4"
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
           "type": "string"
         },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
           "type": "string",
-          "format": "uuid"
+          "format": "ipv4"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
           "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "bootable": {
+          "type": "boolean"
         },
-        "org_id": {
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
           "type": "string"
         },
-        "platform": {
-          "$ref": "#/definitions/platform"
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
+          "type": "string"
         },
-        "progress": {
-          "description": "Installation progress percentages of the cluster.",
-          "$ref": "#/definitions/cluster-progress-info"
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
         },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
+        "has_uuid": {
           "type": "boolean"
         },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
+        "hctl": {
+          "type": "string"
         },
-        "schedulable_masters_forced_true": {
-          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
-          "type": "boolean",
-          "default": true
+        "holders": {
+          "description": "A comma-separated list of disk names that this disk belongs to",
+          "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
         },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/service_network"
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
+            },
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+          "x-nullable": false
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
         },
-        "status": {
-          "description": "Status of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "insufficient",
-            "ready",
-            "error",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "finalizing",
-            "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action"
-          ]
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
         },
-        "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "model": {
+          "type": "string"
         },
-        "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "name": {
+          "type": "string"
         },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
+        "path": {
           "type": "string"
         },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+        "removable": {
+          "type": "boolean"
         },
-        "updated_at": {
-          "description": "The last time that this cluster was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+        "serial": {
+          "type": "string"
         },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
+        "size_bytes": {
+          "type": "integer"
         },
-        "user_name": {
+        "smart": {
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "vendor": {
+          "type": "string"
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+        "wwn": {
+          "type": "string"
         }
       }
     },
-    "cluster-create-params": {
+    "disk-config-params": {
       "type": "object",
       "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
+        "id"
       ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "id": {
           "type": "string"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/cluster_network"
-          },
-          "x-nullable": true
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-encryption": {
+      "type": "object",
+      "properties": {
+        "enable_on": {
+          "description": "Enable/disable disk encryption on master nodes, worker nodes, or all nodes.",
           "type": "string",
-          "default": "x86_64",
-          "x-nullable": false
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "type": "object",
-          "$ref": "#/definitions/disk-encryption"
+          "default": "none",
+          "enum": [
+            "none",
+            "all",
+            "masters",
+            "workers"
+          ]
         },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+        "mode": {
+          "description": "The disk encryption mode to use.",
           "type": "string",
-          "default": "Full",
+          "default": "tpmv2",
           "enum": [
-            "Full",
-            "None"
+            "tpmv2",
+            "tang"
           ]
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+    },
+    "disk-role": {
+      "type": "string",
+      "enum": [
+        "none",
+        "install"
+      ]
+    },
+    "disk-skip-formatting-params": {
+      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+      "type": "object",
+      "required": [
+        "disk_id",
+        "skip_formatting"
+      ],
+      "properties": {
+        "disk_id": {
+          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+          "type": "string"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+        "skip_formatting": {
+          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_info": {
+      "type": "object",
+      "properties": {
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+        "id": {
           "type": "string",
-          "default": "all",
-          "enum": [
-            "masters",
-            "workers",
-            "none",
-            "all"
-          ]
+          "format": "uuid"
         },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "type": "object",
-          "$ref": "#/definitions/ignition-endpoint"
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        "speed_ms": {
+          "type": "integer"
         },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
           "type": "array",
           "items": {
             "type": "object",
-            "$ref": "#/definitions/machine_network"
-          },
-          "x-nullable": true
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain name that should be resolved",
+                "type": "string",
+                "pattern": "^([a-zA-Z0-9]+(-[a-zA-Z0-9]+)*[.])+[a-zA-Z]{2,}$"
+              }
+            },
+            "x-go-name": "DomainResolutionRequestDomain"
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
+            },
+            "x-go-name": "DomainResolutionResponseDomain"
+          }
+        }
+      }
+    },
+    "download_boot_artifacts_request": {
+      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+      "type": "object",
+      "required": [
+        "kernel_url",
+        "rootfs_url",
+        "initrd_url",
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+          "type": "string"
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
+        "initrd_url": {
+          "description": "URL address to download the initrd.",
+          "type": "string"
         },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "default": "OpenShiftSDN",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ]
+        "kernel_url": {
+          "description": "URL address to download the kernel.",
+          "type": "string"
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
+        "rootfs_url": {
+          "description": "URL address to download the rootfs.",
+          "type": "string"
+        }
+      }
+    },
+    "drive_type": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "HDD",
+        "FDD",
+        "ODD",
+        "SSD",
+        "virtual",
+        "Multipath",
+        "iSCSI",
+        "FC",
+        "LVM"
+      ]
+    },
+    "dry-run-artifact": {
+      "type": "object",
+      "required": [
+        "name",
+        "stage",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "The content of the file.",
+          "type": "string"
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
+        "name": {
+          "description": "The path of the file relative to the installation directory, for example openshift/50_openshift-lso_ns.yaml or master.ign.",
           "type": "string"
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
+        "stage": {
+          "$ref": "#/definitions/dry-run-stage-name"
+        }
+      }
+    },
+    "dry-run-result": {
+      "type": "object",
+      "required": [
+        "succeeded",
+        "stages",
+        "artifacts"
+      ],
+      "properties": {
+        "artifacts": {
+          "description": "The generated files. The credentials of the throw-away installation are not returned.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-create-params"
+            "$ref": "#/definitions/dry-run-artifact"
           }
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "platform": {
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/platform"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
+        "stages": {
           "type": "array",
           "items": {
-            "type": "object",
-            "$ref": "#/definitions/service_network"
-          },
-          "x-nullable": true
+            "$ref": "#/definitions/dry-run-stage"
+          }
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "succeeded": {
+          "description": "Whether all the stages succeeded.",
+          "type": "boolean"
+        }
+      }
+    },
+    "dry-run-stage": {
+      "type": "object",
+      "required": [
+        "name",
+        "status"
+      ],
+      "properties": {
+        "error": {
+          "description": "The error that failed the stage.",
           "type": "string"
         },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
+        "name": {
+          "$ref": "#/definitions/dry-run-stage-name"
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
+        "status": {
+          "description": "The outcome of the stage. A stage is skipped when a stage it depends on failed.",
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed",
+            "skipped"
+          ]
         }
       }
     },
-    "cluster-host-requirements": {
+    "dry-run-stage-name": {
+      "description": "A stage of the generation of the installation files.",
+      "type": "string",
+      "enum": [
+        "install-config",
+        "operator-manifests",
+        "ignition"
+      ]
+    },
+    "error": {
       "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
       "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
-          "type": "string",
-          "format": "uuid"
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
         },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "href": {
+          "description": "Self link.",
+          "type": "string"
         },
-        "operators": {
-          "description": "Host requirements related to requested operators",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-host-requirements"
-          }
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
         },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
         }
       }
     },
-    "cluster-host-requirements-details": {
+    "event": {
       "type": "object",
+      "required": [
+        "severity",
+        "message",
+        "event_time"
+      ],
       "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
+        "category": {
+          "type": "string",
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
         },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index;index:events_cluster_id_event_time_idx,priority:1\"",
+          "x-nullable": true
         },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index:events_cluster_id_event_time_idx,priority:2;index:events_host_id_event_time_idx,priority:2;index:events_infra_env_id_event_time_idx,priority:2\""
         },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index;index:events_host_id_event_time_idx,priority:1\"",
           "x-nullable": true
         },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index;index:events_infra_env_id_event_time_idx,priority:1\"",
           "x-nullable": true
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "name": {
+          "description": "Event Name.",
+          "type": "string"
+        },
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
         },
-        "tpm_enabled_in_bios": {
-          "description": "Whether TPM module should be enabled in host's BIOS.",
-          "type": "boolean"
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
         }
       }
     },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
-      }
-    },
-    "cluster-list": {
+    "event-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/cluster"
+        "$ref": "#/definitions/event"
       }
     },
-    "cluster-progress-info": {
+    "event-subscription": {
       "type": "object",
+      "required": [
+        "id",
+        "name",
+        "url"
+      ],
       "properties": {
-        "finalizing_stage_percentage": {
-          "type": "integer"
-        },
-        "installing_stage_percentage": {
-          "type": "integer"
+        "categories": {
+          "description": "Only deliver the events of one of these categories. Only user events are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
         },
-        "preparing_for_installation_stage_percentage": {
-          "type": "integer"
+        "cluster_id": {
+          "description": "Only deliver the events of this cluster, its hosts and infra-envs.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
         },
-        "total_percentage": {
-          "type": "integer"
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "networks-same-address-families",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vip-defined",
-        "api-vip-valid",
-        "ingress-vip-defined",
-        "ingress-vip-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "cnv-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "network-type-valid"
-      ]
-    },
-    "cluster_default_config": {
-      "type": "object",
-      "properties": {
-        "cluster_network_cidr": {
+        "created_at": {
+          "description": "The time that the event subscription was created.",
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "enabled": {
+          "description": "Whether events are delivered to the subscription.",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "cluster_networks_dualstack": {
+        "event_names": {
+          "description": "Only deliver the events with one of these names. All events are delivered when empty.",
           "type": "array",
           "items": {
-            "type": "object",
-            "$ref": "#/definitions/cluster_network"
-          }
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
         },
-        "cluster_networks_ipv4": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/cluster_network"
-          }
+        "id": {
+          "description": "Unique identifier of the event subscription.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "forbidden_hostnames": {
-          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
+        "name": {
+          "description": "Name of the event subscription.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization of the user that created the event subscription. Only the events of this organization are delivered, unless the subscription was created by an admin.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "severities": {
+          "description": "Only deliver the events with one of these severities. All severities are delivered when empty.",
           "type": "array",
           "items": {
             "type": "string"
-          }
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
         },
-        "inactive_deletion_hours": {
-          "type": "integer"
+        "signed": {
+          "description": "Whether the delivered events are signed with the secret of the subscription.",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "ntp_source": {
+        "updated_at": {
+          "description": "The last time that the event subscription was updated.",
           "type": "string",
-          "x-omitempty": false
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "service_network_cidr": {
+        "url": {
+          "description": "The HTTP or HTTPS endpoint that the events are delivered to.",
+          "type": "string"
+        },
+        "user_name": {
+          "description": "The user that created the event subscription.",
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "event-subscription-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "url"
+      ],
+      "properties": {
+        "categories": {
+          "description": "Only deliver the events of one of these categories. Only user events are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "user",
+              "metrics"
+            ]
+          }
         },
-        "service_networks_dualstack": {
+        "cluster_id": {
+          "description": "Only deliver the events of this cluster, its hosts and infra-envs.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "enabled": {
+          "description": "Whether events are delivered to the subscription.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        },
+        "event_names": {
+          "description": "Only deliver the events with one of these names. All events are delivered when empty.",
           "type": "array",
           "items": {
-            "type": "object",
-            "$ref": "#/definitions/service_network"
+            "type": "string"
           }
         },
-        "service_networks_ipv4": {
+        "name": {
+          "description": "Name of the event subscription.",
+          "type": "string"
+        },
+        "secret": {
+          "description": "A secret that signs the delivered events with HMAC-SHA256, in the X-Assisted-Signature header.",
+          "type": "string"
+        },
+        "severities": {
+          "description": "Only deliver the events with one of these severities. All severities are delivered when empty.",
           "type": "array",
           "items": {
-            "type": "object",
-            "$ref": "#/definitions/service_network"
+            "type": "string",
+            "enum": [
+              "info",
+              "warning",
+              "error",
+              "critical"
+            ]
           }
+        },
+        "url": {
+          "description": "The HTTP or HTTPS endpoint that the events are delivered to.",
+          "type": "string"
         }
       }
     },
-    "cluster_network": {
-      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+    "event-subscription-delivery": {
       "type": "object",
+      "required": [
+        "id",
+        "subscription_id",
+        "status"
+      ],
       "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "attempts": {
+          "description": "The number of delivery attempts.",
+          "type": "integer",
+          "format": "int64"
         },
         "cluster_id": {
-          "description": "The cluster that this network is associated with.",
+          "description": "The cluster of the delivered event.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "x-nullable": true
         },
-        "host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
+        "created_at": {
+          "description": "The time that the event was queued for delivery.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "error": {
+          "description": "The error of the last attempt.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "event_id": {
+          "description": "The identifier of the delivered event, which is also the id of the CloudEvent.",
           "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        }
-      }
-    },
-    "completion-params": {
-      "type": "object",
-      "required": [
-        "is_success"
-      ],
-      "properties": {
-        "error_info": {
+          "format": "int64"
+        },
+        "event_name": {
+          "description": "The name of the delivered event.",
           "type": "string"
         },
-        "is_success": {
-          "type": "boolean"
-        }
-      }
-    },
-    "connectivity-check-host": {
-      "type": "object",
-      "properties": {
-        "host_id": {
+        "id": {
+          "description": "Unique identifier of the delivery.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement\""
+        },
+        "last_attempt_at": {
+          "description": "The time of the last delivery attempt.",
           "type": "string",
-          "format": "uuid"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
+        "next_attempt_at": {
+          "description": "The time of the next delivery attempt of a pending delivery.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "payload": {
+          "description": "The CloudEvent that is delivered, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "response_code": {
+          "description": "The HTTP status code returned by the endpoint on the last attempt.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "Pending deliveries are retried with a backoff until they are delivered, or fail after too many attempts.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "subscription_id": {
+          "description": "The event subscription of the delivery.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "connectivity-check-nic": {
+    "event-subscription-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event-subscription-delivery"
+      }
+    },
+    "event-subscription-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event-subscription"
+      }
+    },
+    "feature-support-level": {
       "type": "object",
       "properties": {
-        "ip_addresses": {
+        "features": {
           "type": "array",
+          "required": [
+            "feature_id",
+            "support_level"
+          ],
           "items": {
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+            "type": "object",
+            "properties": {
+              "feature_id": {
+                "description": "The ID of the feature",
+                "type": "string",
+                "enum": [
+                  "ADDITIONAL_NTP_SOURCE",
+                  "REQUESTED_HOSTNAME",
+                  "PROXY",
+                  "SNO",
+                  "DAY2_HOSTS",
+                  "VIP_AUTO_ALLOC",
+                  "DISK_SELECTION",
+                  "OVN_NETWORK_TYPE",
+                  "SDN_NETWORK_TYPE",
+                  "PLATFORM_SELECTION",
+                  "SCHEDULABLE_MASTERS",
+                  "AUTO_ASSIGN_ROLE",
+                  "CUSTOM_MANIFEST",
+                  "DISK_ENCRYPTION",
+                  "CLUSTER_MANAGED_NETWORKING_WITH_VMS",
+                  "ARM64_ARCHITECTURE",
+                  "ARM64_ARCHITECTURE_WITH_CLUSTER_MANAGED_NETWORKING",
+                  "SINGLE_NODE_EXPANSION",
+                  "LVM",
+                  "DUAL_STACK_NETWORKING",
+                  "MULTIARCH_RELEASE_IMAGE"
+                ]
+              },
+              "support_level": {
+                "type": "string",
+                "enum": [
+                  "supported",
+                  "unsupported",
+                  "tech-preview",
+                  "dev-preview"
+                ]
+              }
+            }
           }
         },
-        "mac": {
-          "type": "string",
-          "format": "mac"
-        },
-        "name": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         }
       }
     },
-    "connectivity-check-params": {
+    "feature-support-levels": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/connectivity-check-host"
+        "$ref": "#/definitions/feature-support-level"
       }
     },
-    "connectivity-remote-host": {
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+      }
+    },
+    "free_network_addresses": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "l2_connectivity": {
+        "free_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l2-connectivity"
+            "type": "string",
+            "format": "ipv4"
           }
         },
-        "l3_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l3-connectivity"
-          }
+        "network": {
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
         }
       }
     },
-    "connectivity-report": {
-      "type": "object",
-      "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
-        }
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
       }
     },
-    "container_image_availability": {
+    "gpu": {
       "type": "object",
       "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
+          "type": "string"
         },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
           "type": "string"
         },
-        "result": {
-          "$ref": "#/definitions/container_image_availability_result"
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+          "type": "string"
         },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
         },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
+          "type": "string"
         }
       }
     },
-    "container_image_availability_request": {
+    "host": {
       "type": "object",
       "required": [
-        "images"
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
       ],
       "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
-          "type": "array",
-          "items": {
-            "description": "A fully qualified image name (FQIN).",
-            "type": "string",
-            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-          }
+        "api_vip_connectivity": {
+          "description": "Contains a serialized api_vip_connectivity_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
-          "type": "integer"
-        }
-      }
-    },
-    "container_image_availability_response": {
-      "type": "object",
-      "required": [
-        "images"
-      ],
-      "properties": {
-        "images": {
-          "description": "List of images that were checked.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/container_image_availability"
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
+          "x-nullable": true
+        },
+        "connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
           }
-        }
-      }
-    },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "cpu": {
-      "type": "object",
-      "properties": {
-        "architecture": {
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "discovery_agent_version": {
           "type": "string"
         },
-        "count": {
-          "type": "integer"
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "disks_to_be_formatted": {
+          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "domain_name_resolutions": {
+          "description": "The domain name resolution result.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "frequency": {
-          "type": "number"
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "model_name": {
-          "type": "string"
-        }
-      }
-    },
-    "create-manifest-params": {
-      "type": "object",
-      "required": [
-        "file_name",
-        "content"
-      ],
-      "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
+        "href": {
+          "description": "Self link.",
           "type": "string"
         },
-        "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
+        "id": {
+          "description": "Unique identifier of the object.",
           "type": "string",
-          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
           "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
-      }
-    },
-    "credentials": {
-      "type": "object",
-      "properties": {
-        "console_url": {
-          "type": "string"
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
-        "password": {
+        "ignition_endpoint_token_set": {
+          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
+          "type": "boolean"
+        },
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+        },
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
           "type": "string"
         },
-        "username": {
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+          "type": "string",
+          "example": "/dev/sda"
+        },
+        "installer_args": {
           "type": "string"
-        }
-      }
-    },
-    "dhcp_allocation_request": {
-      "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
-      "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
+        },
+        "installer_version": {
+          "description": "Installer version.",
           "type": "string"
         },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
+        "inventory": {
           "type": "string",
-          "format": "mac"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
-          "type": "string"
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+          "type": "string",
+          "enum": [
+            "Host",
+            "AddToExistingClusterHost"
+          ]
         },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
+        "logs_collected_at": {
           "type": "string",
-          "format": "mac"
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
-          "type": "string"
-        }
-      }
-    },
-    "dhcp_allocation_response": {
-      "type": "object",
-      "required": [
-        "api_vip_address",
-        "ingress_vip_address"
-      ],
-      "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
+        },
+        "logs_started_at": {
           "type": "string",
-          "format": "ipv4"
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
+        "machine_config_pool_name": {
           "type": "string"
         },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+        "media_status": {
           "type": "string",
-          "format": "ipv4"
+          "default": "connected",
+          "enum": [
+            "connected",
+            "disconnected"
+          ],
+          "x-nullable": true
         },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
-          "type": "string"
-        }
-      }
-    },
-    "disk": {
-      "type": "object",
-      "properties": {
-        "bootable": {
-          "type": "boolean"
+        "node_labels": {
+          "description": "Json containing node's labels.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
-          "type": "string"
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
-          "type": "string"
+        "progress": {
+          "$ref": "#/definitions/host-progress-info"
         },
-        "drive_type": {
-          "$ref": "#/definitions/drive_type"
+        "progress_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "has_uuid": {
-          "type": "boolean"
+        "registered_at": {
+          "description": "The last time the host's agent tried to register in the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "hctl": {
+        "requested_hostname": {
           "type": "string"
         },
-        "holders": {
-          "description": "A comma-separated list of disk names that this disk belongs to",
-          "type": "string"
+        "resource_version": {
+          "description": "Incremented on each update of the host through its update API, and returned as its ETag.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"default:1\""
         },
-        "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
-          "type": "string"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": false
+        "skip_formatting_disks": {
+          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
-          "type": "boolean"
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "model": {
-          "type": "string"
+        "status": {
+          "type": "string",
+          "enum": [
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "preparing-for-installation",
+            "preparing-failed",
+            "preparing-successful",
+            "pending-for-input",
+            "installing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled",
+            "binding",
+            "unbinding",
+            "unbinding-pending-user-action",
+            "known-unbound",
+            "disconnected-unbound",
+            "insufficient-unbound",
+            "disabled-unbound",
+            "discovering-unbound",
+            "reclaiming",
+            "reclaiming-rebooting"
+          ]
         },
-        "name": {
-          "type": "string"
+        "status_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "path": {
-          "type": "string"
+        "status_updated_at": {
+          "description": "The last time that the host status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "removable": {
-          "type": "boolean"
+        "suggested_role": {
+          "$ref": "#/definitions/host-role"
         },
-        "serial": {
-          "type": "string"
+        "tang_connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "size_bytes": {
+        "timestamp": {
+          "description": "The time on the host as seconds since the Unix epoch.",
           "type": "integer"
         },
-        "smart": {
-          "type": "string"
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
-        "vendor": {
+        "user_name": {
           "type": "string"
         },
-        "wwn": {
-          "type": "string"
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "disk-config-params": {
+    "host-create-params": {
       "type": "object",
       "required": [
-        "id"
+        "host_id"
       ],
       "properties": {
-        "id": {
+        "discovery_agent_version": {
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/disk-role"
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
-    "disk-encryption": {
+    "host-diagnostic": {
       "type": "object",
       "properties": {
-        "enable_on": {
-          "description": "Enable/disable disk encryption on master nodes, worker nodes, or all nodes.",
+        "action": {
+          "$ref": "#/definitions/host-diagnostic-action"
+        },
+        "completed_at": {
+          "description": "The time at which the agent uploaded the diagnostic result.",
           "type": "string",
-          "default": "none",
-          "enum": [
-            "none",
-            "all",
-            "masters",
-            "workers"
-          ]
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "mode": {
-          "description": "The disk encryption mode to use.",
+        "error": {
+          "description": "The standard error of the diagnostic command, truncated to a size limit.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "exit_code": {
+          "description": "The exit code of the diagnostic command.",
+          "type": "integer"
+        },
+        "host_id": {
+          "description": "The host that the diagnostic runs on.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "id": {
+          "description": "Unique identifier of the diagnostic.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env of the host.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "output": {
+          "description": "The standard output of the diagnostic command, truncated to a size limit.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "requested_at": {
+          "description": "The time at which the diagnostic was requested.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "requested_by": {
+          "description": "The user that requested the diagnostic.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The state of the diagnostic.",
           "type": "string",
-          "default": "tpmv2",
           "enum": [
-            "tpmv2",
-            "tang"
+            "pending",
+            "sent",
+            "completed",
+            "failed"
           ]
         },
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+        "step_id": {
+          "description": "The identifier of the step that delivered the diagnostic to the agent.",
+          "type": "string"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+      }
     },
-    "disk-role": {
+    "host-diagnostic-action": {
+      "description": "A diagnostic action from the service allowlist. Each action maps to a fixed, read-only command\nthat the agent runs on the host.\n",
       "type": "string",
       "enum": [
-        "none",
-        "install"
+        "ip-addresses",
+        "ip-routes",
+        "block-devices",
+        "journal",
+        "kernel-ring-buffer",
+        "time-sources"
       ]
     },
-    "disk-skip-formatting-params": {
-      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+    "host-diagnostic-create-params": {
       "type": "object",
       "required": [
-        "disk_id",
-        "skip_formatting"
+        "action"
       ],
       "properties": {
-        "disk_id": {
-          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+        "action": {
+          "$ref": "#/definitions/host-diagnostic-action"
+        }
+      }
+    },
+    "host-diagnostic-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-diagnostic"
+      }
+    },
+    "host-ignition-params": {
+      "properties": {
+        "config": {
           "type": "string"
-        },
-        "skip_formatting": {
-          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
-          "type": "boolean"
         }
       }
     },
-    "disk_info": {
+    "host-installation-timeline": {
       "type": "object",
       "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
+        "bootstrap": {
+          "type": "boolean"
         },
-        "id": {
+        "host_id": {
           "type": "string",
           "format": "uuid"
         },
-        "path": {
+        "host_name": {
           "type": "string"
-        }
-      }
-    },
-    "disk_speed": {
-      "type": "object",
-      "properties": {
-        "exit_code": {
-          "type": "integer"
         },
-        "speed_ms": {
-          "type": "integer"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "tested": {
-          "type": "boolean"
+        "stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-segment"
+          }
         }
       }
     },
-    "disk_speed_check_request": {
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "host-progress": {
       "type": "object",
-      "required": [
-        "path"
-      ],
       "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
-          "type": "string"
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         }
       }
     },
-    "disk_speed_check_response": {
+    "host-progress-info": {
       "type": "object",
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "estimated_completion_at": {
+          "description": "Estimated time at which the host completes its installation, based on the stage durations of previous installations with the same OpenShift version, host role and topology.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "installation_percentage": {
           "type": "integer"
         },
-        "path": {
-          "description": "The device path.",
-          "type": "string"
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Waiting for bootkube",
+        "Waiting for controller",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
     },
-    "domain_resolution_request": {
+    "host-stage-timeout": {
       "type": "object",
       "required": [
-        "domains"
+        "stage",
+        "timeout"
       ],
       "properties": {
-        "domains": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain name that should be resolved",
-                "type": "string",
-                "pattern": "^([a-zA-Z0-9]+(-[a-zA-Z0-9]+)*[.])+[a-zA-Z]{2,}$"
-              }
-            },
-            "x-go-name": "DomainResolutionRequestDomain"
-          }
+        "role": {
+          "description": "The role of the hosts the timeout applies to. Applies to all the roles when not set.",
+          "$ref": "#/definitions/host-role"
+        },
+        "stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "timeout": {
+          "description": "The maximum time, in seconds, a host may spend in the stage before its installation fails.",
+          "type": "integer",
+          "minimum": 1
         }
       }
     },
-    "domain_resolution_response": {
+    "host-type-hardware-requirements": {
       "type": "object",
-      "required": [
-        "resolutions"
-      ],
       "properties": {
-        "resolutions": {
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
           "type": "array",
           "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
-                }
-              }
-            },
-            "x-go-name": "DomainResolutionResponseDomain"
+            "type": "string"
           }
+        },
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "download_boot_artifacts_request": {
-      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+    "host-type-hardware-requirements-wrapper": {
       "type": "object",
-      "required": [
-        "kernel_url",
-        "rootfs_url",
-        "initrd_url",
-        "host_fs_mount_dir"
-      ],
       "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
-          "type": "string"
-        },
-        "initrd_url": {
-          "description": "URL address to download the initrd.",
-          "type": "string"
-        },
-        "kernel_url": {
-          "description": "URL address to download the kernel.",
-          "type": "string"
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         },
-        "rootfs_url": {
-          "description": "URL address to download the rootfs.",
-          "type": "string"
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         }
       }
     },
-    "drive_type": {
-      "type": "string",
-      "enum": [
-        "Unknown",
-        "HDD",
-        "FDD",
-        "ODD",
-        "SSD",
-        "virtual",
-        "Multipath",
-        "iSCSI",
-        "FC",
-        "LVM"
-      ]
-    },
-    "error": {
+    "host-update-params": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
-      ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-config-params"
+          },
+          "x-nullable": true
         },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
+        "disks_skip_formatting": {
+          "description": "Allows changing the host's skip_formatting_disks parameter",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-skip-formatting-params"
+          },
+          "x-nullable": true
         },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+        "host_name": {
+          "type": "string",
+          "x-nullable": true
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+        "host_role": {
           "type": "string",
           "enum": [
-            "Error"
-          ]
+            "auto-assign",
+            "master",
+            "worker"
+          ],
+          "x-nullable": true
         },
-        "reason": {
-          "description": "Human-readable description of the error.",
-          "type": "string"
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "machine_config_pool_name": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "node_labels": {
+          "description": "Labels to be added to the corresponding node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/node-label-params"
+          },
+          "x-nullable": true
         }
       }
     },
-    "event": {
+    "host-validation-id": {
+      "type": "string",
+      "enum": [
+        "connected",
+        "media-connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "ignition-downloadable",
+        "belongs-to-majority-group",
+        "valid-platform-network-settings",
+        "ntp-synced",
+        "container-images-available",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "sufficient-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sufficient-network-latency-requirement-for-role",
+        "sufficient-packet-loss-requirement-for-role",
+        "has-default-route",
+        "api-domain-name-resolved-correctly",
+        "api-int-domain-name-resolved-correctly",
+        "apps-domain-name-resolved-correctly",
+        "compatible-with-cluster-platform",
+        "dns-wildcard-not-configured",
+        "disk-encryption-requirements-satisfied",
+        "non-overlapping-subnets",
+        "vsphere-disk-uuid-enabled",
+        "compatible-agent",
+        "no-skip-installation-disk",
+        "no-skip-missing-disk",
+        "service-has-sufficient-spoke-kube-api-access"
+      ]
+    },
+    "host-validation-stats": {
       "type": "object",
-      "required": [
-        "severity",
-        "message",
-        "event_time"
-      ],
       "properties": {
-        "category": {
-          "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
-        },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
+        "first_succeeded_at": {
+          "description": "The time at which the validation succeeded for the first time, if it did.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-nullable": true
         },
-        "event_time": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "flapping": {
+          "description": "Whether the validation is flapping, changing between success and failure more times than the threshold\nwithin the flapping window.\n",
+          "type": "boolean"
+        },
+        "flip_count": {
+          "description": "The number of times the validation changed between success and failure.",
+          "type": "integer"
         },
         "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+          "description": "The host of the validation.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
         "infra_env_id": {
-          "description": "Unique identifier of the infra-env this event relates to.",
+          "description": "The infra-env of the host.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "message": {
+        "last_flipped_at": {
+          "description": "The last time at which the validation changed between success and failure.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
         },
-        "name": {
-          "description": "Event Name.",
-          "type": "string"
+        "recent_flips": {
+          "description": "The times at which the validation changed between success and failure within the flapping window.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "date-time"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "status": {
+          "description": "The last status of the validation.",
+          "type": "string"
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
-          "type": "string",
-          "format": "uuid"
+        "time_to_success_seconds": {
+          "description": "How long the validation took to succeed for the first time after the host was discovered.",
+          "type": "number",
+          "format": "double"
         },
-        "severity": {
+        "validation_id": {
+          "description": "The validation, such as has-min-cpu-cores.",
           "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
       }
     },
-    "event-list": {
+    "host-validation-stats-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/event"
+        "$ref": "#/definitions/host-validation-stats"
       }
     },
-    "feature-support-level": {
+    "host_network": {
       "type": "object",
       "properties": {
-        "features": {
+        "cidr": {
+          "type": "string"
+        },
+        "host_ids": {
           "type": "array",
-          "required": [
-            "feature_id",
-            "support_level"
-          ],
           "items": {
-            "type": "object",
-            "properties": {
-              "feature_id": {
-                "description": "The ID of the feature",
-                "type": "string",
-                "enum": [
-                  "ADDITIONAL_NTP_SOURCE",
-                  "REQUESTED_HOSTNAME",
-                  "PROXY",
-                  "SNO",
-                  "DAY2_HOSTS",
-                  "VIP_AUTO_ALLOC",
-                  "DISK_SELECTION",
-                  "OVN_NETWORK_TYPE",
-                  "SDN_NETWORK_TYPE",
-                  "PLATFORM_SELECTION",
-                  "SCHEDULABLE_MASTERS",
-                  "AUTO_ASSIGN_ROLE",
-                  "CUSTOM_MANIFEST",
-                  "DISK_ENCRYPTION",
-                  "CLUSTER_MANAGED_NETWORKING_WITH_VMS",
-                  "ARM64_ARCHITECTURE",
-                  "ARM64_ARCHITECTURE_WITH_CLUSTER_MANAGED_NETWORKING",
-                  "SINGLE_NODE_EXPANSION",
-                  "LVM",
-                  "DUAL_STACK_NETWORKING",
-                  "MULTIARCH_RELEASE_IMAGE"
-                ]
-              },
-              "support_level": {
-                "type": "string",
-                "enum": [
-                  "supported",
-                  "unsupported",
-                  "tech-preview",
-                  "dev-preview"
-                ]
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
+        },
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
+                  "type": "string"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
+                }
               }
             }
           }
+        }
+      ]
+    },
+    "host_static_network_config": {
+      "type": "object",
+      "properties": {
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
           "type": "string"
         }
       }
     },
-    "feature-support-levels": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/feature-support-level"
-      }
-    },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
-      }
-    },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-      }
+    "ignition-endpoint": {
+      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+      "type": "object",
+      "properties": {
+        "ca_certificate": {
+          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "url": {
+          "description": "The URL for the ignition endpoint.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
     },
-    "free_network_addresses": {
+    "image-create-params": {
       "type": "object",
       "properties": {
-        "free_addresses": {
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "ipv4"
+            "$ref": "#/definitions/host_static_network_config"
           }
-        },
-        "network": {
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
         }
       }
     },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "gpu": {
+    "image_info": {
       "type": "object",
       "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "download_url": {
           "type": "string"
         },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "generator_version": {
+          "description": "Image generator version.",
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
+    },
+    "image_type": {
+      "type": "string",
+      "enum": [
+        "full-iso",
+        "minimal-iso"
+      ]
+    },
+    "import-cluster-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "api_vip_dnsname",
+        "openshift_cluster_id"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
           "type": "string"
         },
         "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+          "description": "OpenShift cluster name.",
           "type": "string"
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
-          "type": "string"
+        "openshift_cluster_id": {
+          "description": "The id of the OCP cluster, that hosts will be added to",
+          "type": "string",
+          "format": "uuid"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         }
       }
     },
-    "host": {
+    "infra-env": {
       "type": "object",
       "required": [
         "kind",
         "id",
         "href",
-        "status",
-        "status_info"
+        "name",
+        "type",
+        "updated_at",
+        "created_at"
       ],
       "properties": {
-        "api_vip_connectivity": {
-          "description": "Contains a serialized api_vip_connectivity_response",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "bootstrap": {
-          "type": "boolean"
-        },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
         },
         "cluster_id": {
-          "description": "The cluster that this host is associated with.",
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "connectivity": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "default": "x86_64",
+          "x-nullable": false
         },
         "created_at": {
           "type": "string",
@@ -6938,2477 +10490,3525 @@ func init() {
             "type": "Time"
           }
         },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+        "deletion_protected": {
+          "description": "Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "default": false,
+          "x-go-custom-tag": "gorm:\"default:false\""
+        },
+        "download_url": {
+          "type": "string"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "generator_version": {
+          "description": "Image generator version.",
+          "type": "string"
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_config_override": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "kind": {
+          "description": "Indicates the type of this object.",
+          "type": "string",
+          "enum": [
+            "InfraEnv"
+          ]
+        },
+        "name": {
+          "description": "Name of the infra-env.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "resource_version": {
+          "description": "Incremented on each update of the infra-env through its update API, and returned as its ETag.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"default:1\""
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation.",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "updated_at": {
+          "description": "The last time that this infra-env was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-go-type": {
             "hints": {
               "noValidation": true
             },
             "import": {
-              "package": "gorm.io/gorm"
+              "package": "time"
             },
-            "type": "DeletedAt"
-          },
+            "type": "Time"
+          }
+        },
+        "user_name": {
+          "type": "string"
+        }
+      }
+    },
+    "infra-env-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_id": {
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
           "x-nullable": false
         },
-        "discovery_agent_version": {
+        "deletion_protected": {
+          "description": "Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "image_type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "name": {
+          "description": "Name of the infra-env.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "infra-env-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/infra-env"
+      }
+    },
+    "infra-env-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "image_type": {
+          "$ref": "#/definitions/image_type"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "infra_error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
+        },
+        "message": {
+          "description": "Human-readable description of the error.",
           "type": "string"
-        },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
+        }
+      }
+    },
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "install-config-override-version": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that the install config overrides belong to.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "disks_to_be_formatted": {
-          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
+        "created_at": {
+          "description": "The time at which the install config overrides were set.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "domain_name_resolutions": {
-          "description": "The domain name resolution result.",
+        "overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "free_addresses": {
+        "user_name": {
+          "description": "The user that set the install config overrides.",
+          "type": "string"
+        },
+        "version": {
+          "description": "The version of the install config overrides, starting from 1.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement:false\""
+        }
+      }
+    },
+    "install-config-override-version-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/install-config-override-version"
+      }
+    },
+    "install-schedule": {
+      "type": "object",
+      "required": [
+        "start_at"
+      ],
+      "properties": {
+        "end_at": {
+          "description": "The time after which the installation is no longer allowed to start. If the cluster is not ready to be\ninstalled before this time, the scheduled installation expires. When not set, the window never ends.\n",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "date-time",
+          "x-nullable": true
         },
-        "href": {
-          "description": "Self link.",
+        "start_at": {
+          "description": "The time from which the installation is allowed to start.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "install_cmd_request": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "role",
+        "boot_device",
+        "controller_image",
+        "installer_image"
+      ],
+      "properties": {
+        "boot_device": {
+          "description": "Boot device to write image on",
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
+        "check_cvo": {
+          "description": "Check CVO status if needed",
+          "type": "boolean",
+          "default": true
+        },
+        "cluster_id": {
+          "description": "Cluster id",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "format": "uuid"
         },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+        "controller_image": {
+          "description": "Assisted installer controller image",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "ignition_endpoint_token_set": {
-          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
-          "type": "boolean"
+        "disks_to_format": {
+          "description": "List of disks to format",
+          "type": "array",
+          "items": {
+            "description": "Disk to format",
+            "type": "string"
+          }
         },
-        "images_status": {
-          "description": "Array of image statuses.",
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_id": {
+          "description": "Host id",
+          "type": "string",
+          "format": "uuid"
         },
         "infra_env_id": {
-          "description": "The infra-env that this host is associated with.",
+          "description": "Infra env id",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+          "format": "uuid"
         },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
+        "installer_args": {
+          "description": "Core-os installer addtional args",
           "type": "string"
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+        "installer_image": {
+          "description": "Assisted installer image",
           "type": "string",
-          "example": "/dev/sda"
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "installer_args": {
+        "mco_image": {
+          "description": "Machine config operator image",
+          "type": "string",
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+        },
+        "must_gather_image": {
+          "description": "Must-gather images to use",
           "type": "string"
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "inventory": {
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "service_ips": {
+          "description": "List of service ips",
+          "type": "array",
+          "items": {
+            "description": "Service ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "skip_installation_disk_cleanup": {
+          "description": "Skip formatting installation disk",
+          "type": "boolean"
+        }
+      }
+    },
+    "installation-timeline": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+        "critical_path": {
+          "description": "The stages that determined the installation duration, starting with the stages of the bootstrap host,\nfollowed by the stages of the last host to finish after the bootstrap host and the cluster finalization.\n",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-segment"
+          }
+        },
+        "hosts": {
+          "description": "The installation stages of each host, in the order the hosts started installing.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-installation-timeline"
+          }
+        },
+        "install_completed_at": {
+          "description": "The time that the cluster completed installation, if it did.",
           "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
+          "format": "date-time",
+          "x-nullable": true
         },
-        "logs_collected_at": {
+        "install_started_at": {
+          "description": "The time that the cluster started installation.",
           "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "date-time"
         },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
+        "slowest_hosts": {
+          "description": "The slowest host of each installation stage.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-segment"
+          }
+        }
+      }
+    },
+    "installation-timeline-segment": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "description": "The duration of the stage, up to now if the stage is still in progress.",
+          "type": "number"
         },
-        "logs_started_at": {
+        "ended_at": {
+          "description": "The time the stage ended, empty if the stage is still in progress.",
           "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "date-time",
+          "x-nullable": true
         },
-        "machine_config_pool_name": {
+        "host_id": {
+          "description": "The host that was in the stage, empty for cluster stages.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "host_name": {
           "type": "string"
         },
-        "media_status": {
-          "type": "string",
-          "default": "connected",
-          "enum": [
-            "connected",
-            "disconnected"
-          ],
-          "x-nullable": true
+        "stage": {
+          "description": "The installation stage of the host, or Finalizing for the cluster finalization.",
+          "type": "string"
         },
-        "node_labels": {
-          "description": "Json containing node's labels.",
+        "started_at": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "date-time"
+        }
+      }
+    },
+    "installer-args-params": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
+        }
+      }
+    },
+    "interface": {
+      "type": "object",
+      "properties": {
+        "biosdevname": {
+          "type": "string"
         },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "client_id": {
+          "type": "string"
         },
-        "progress": {
-          "$ref": "#/definitions/host-progress-info"
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "progress_stages": {
+        "has_carrier": {
+          "type": "boolean"
+        },
+        "ipv4_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+            "type": "string"
+          }
         },
-        "registered_at": {
-          "description": "The last time the host's agent tried to register in the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "requested_hostname": {
+        "mac_address": {
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
+        "mtu": {
+          "type": "integer"
         },
-        "skip_formatting_disks": {
-          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "name": {
+          "type": "string"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "product": {
+          "type": "string"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "speed_mbps": {
+          "type": "integer"
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-failed",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled",
-            "binding",
-            "unbinding",
-            "unbinding-pending-user-action",
-            "known-unbound",
-            "disconnected-unbound",
-            "insufficient-unbound",
-            "disabled-unbound",
-            "discovering-unbound",
-            "reclaiming",
-            "reclaiming-rebooting"
-          ]
+        "type": {
+          "type": "string"
         },
-        "status_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "vendor": {
+          "type": "string"
+        }
+      }
+    },
+    "inventory": {
+      "type": "object",
+      "properties": {
+        "bmc_address": {
+          "type": "string"
         },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "bmc_v6address": {
+          "type": "string"
         },
-        "suggested_role": {
-          "$ref": "#/definitions/host-role"
+        "boot": {
+          "$ref": "#/definitions/boot"
         },
-        "tang_connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "cpu": {
+          "$ref": "#/definitions/cpu"
         },
-        "timestamp": {
-          "description": "The time on the host as seconds since the Unix epoch.",
-          "type": "integer"
+        "disks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk"
+          }
         },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
           }
         },
-        "user_name": {
+        "hostname": {
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        }
-      }
-    },
-    "host-create-params": {
-      "type": "object",
-      "required": [
-        "host_id"
-      ],
-      "properties": {
-        "discovery_agent_version": {
-          "type": "string"
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
         },
-        "host_id": {
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
+          }
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        },
+        "tpm_version": {
           "type": "string",
-          "format": "uuid"
+          "enum": [
+            "none",
+            "1.2",
+            "2.0"
+          ]
         }
       }
     },
-    "host-ignition-params": {
+    "io_perf": {
+      "type": "object",
       "properties": {
-        "config": {
-          "type": "string"
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
+          "type": "integer"
         }
       }
     },
-    "host-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/host"
-      }
-    },
-    "host-progress": {
+    "l2-connectivity": {
       "type": "object",
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
+        "outgoing_ip_address": {
+          "type": "string"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "host-progress-info": {
+    "l3-connectivity": {
       "type": "object",
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
-        },
-        "installation_percentage": {
-          "type": "integer"
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "outgoing_nic": {
+          "type": "string"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker",
-        "bootstrap"
-      ]
+      }
     },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker"
-      ]
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
+      }
     },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Waiting for bootkube",
-        "Waiting for controller",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
     },
-    "host-type-hardware-requirements": {
+    "list-versions": {
       "type": "object",
       "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "release_tag": {
+          "type": "string"
         },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "versions": {
+          "$ref": "#/definitions/versions"
         }
       }
     },
-    "host-type-hardware-requirements-wrapper": {
+    "logs-progress-params": {
       "type": "object",
+      "required": [
+        "logs_state"
+      ],
       "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
-        },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
         }
       }
     },
-    "host-update-params": {
+    "logs_gather_cmd_request": {
       "type": "object",
+      "required": [
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "bootstrap",
+        "installer_gather"
+      ],
       "properties": {
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-config-params"
-          },
-          "x-nullable": true
-        },
-        "disks_skip_formatting": {
-          "description": "Allows changing the host's skip_formatting_disks parameter",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-skip-formatting-params"
-          },
-          "x-nullable": true
+        "bootstrap": {
+          "description": "Host is bootstrap or not",
+          "type": "boolean"
         },
-        "host_name": {
+        "cluster_id": {
+          "description": "Cluster id",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "host_role": {
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "enum": [
-            "auto-assign",
-            "master",
-            "worker"
-          ],
-          "x-nullable": true
+          "format": "uuid"
         },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
+        "infra_env_id": {
+          "description": "Infra env id",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "machine_config_pool_name": {
-          "type": "string",
-          "x-nullable": true
+        "installer_gather": {
+          "description": "Run installer gather logs",
+          "type": "boolean",
+          "default": true
         },
-        "node_labels": {
-          "description": "Labels to be added to the corresponding node.",
+        "master_ips": {
+          "description": "List of master ips",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/node-label-params"
-          },
-          "x-nullable": true
+            "description": "Master ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
         }
       }
     },
-    "host-validation-id": {
+    "logs_state": {
       "type": "string",
       "enum": [
-        "connected",
-        "media-connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "ignition-downloadable",
-        "belongs-to-majority-group",
-        "valid-platform-network-settings",
-        "ntp-synced",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "sufficient-installation-disk-speed",
-        "cnv-requirements-satisfied",
-        "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role",
-        "has-default-route",
-        "api-domain-name-resolved-correctly",
-        "api-int-domain-name-resolved-correctly",
-        "apps-domain-name-resolved-correctly",
-        "compatible-with-cluster-platform",
-        "dns-wildcard-not-configured",
-        "disk-encryption-requirements-satisfied",
-        "non-overlapping-subnets",
-        "vsphere-disk-uuid-enabled",
-        "compatible-agent",
-        "no-skip-installation-disk",
-        "no-skip-missing-disk",
-        "service-has-sufficient-spoke-kube-api-access"
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
+      ],
+      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+    },
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
       ]
     },
-    "host_network": {
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "type": "string"
-        },
-        "host_ids": {
-          "type": "array",
-          "items": {
+    "mac_interface_map": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
             "type": "string",
-            "format": "uuid"
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
           }
         }
       }
     },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
+    "machine_network": {
+      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
-            }
-          }
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
-      ]
+      }
     },
-    "host_static_network_config": {
+    "managed-domain": {
       "type": "object",
       "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
-        },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
+        "domain": {
           "type": "string"
+        },
+        "provider": {
+          "type": "string",
+          "enum": [
+            "route53"
+          ]
         }
       }
     },
-    "ignition-endpoint": {
-      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+    "manifest": {
       "type": "object",
       "properties": {
-        "ca_certificate": {
-          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
+        "file_name": {
+          "description": "The file name prefaced by the folder that contains it.",
+          "type": "string"
         },
-        "url": {
-          "description": "The URL for the ignition endpoint.",
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
           "type": "string",
-          "x-nullable": true
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
+      }
     },
-    "image-create-params": {
+    "memory": {
       "type": "object",
       "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
+        "physical_bytes": {
+          "type": "integer"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+        "physical_bytes_method": {
+          "description": "The method by which the physical memory was set",
+          "$ref": "#/definitions/memory_method"
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "usable_bytes": {
+          "type": "integer"
         }
       }
     },
-    "image_info": {
+    "memory_method": {
+      "type": "string",
+      "enum": [
+        "dmidecode",
+        "ghw",
+        "meminfo"
+      ]
+    },
+    "monitored-operator": {
       "type": "object",
       "properties": {
-        "created_at": {
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "download_url": {
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
           "type": "string"
         },
-        "expires_at": {
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        },
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "generator_version": {
-          "description": "Image generator version.",
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
           "type": "string"
         },
-        "size_bytes": {
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
           "type": "integer"
+        }
+      }
+    },
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
+      }
+    },
+    "next_step_cmd_request": {
+      "type": "object",
+      "required": [
+        "infra_env_id",
+        "host_id",
+        "agent_version"
+      ],
+      "properties": {
+        "agent_version": {
+          "description": "Agent image version",
+          "type": "string",
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "host_id": {
+          "description": "Host id",
+          "type": "string",
+          "format": "uuid"
+        },
+        "infra_env_id": {
+          "description": "Infra env id",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "node-label-params": {
+      "type": "object",
+      "required": [
+        "key",
+        "value"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key for the label's key-value pair.",
           "type": "string"
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
+        "value": {
+          "description": "The value for the label's key-value pair.",
+          "type": "string"
+        }
+      }
+    },
+    "ntp_source": {
+      "type": "object",
+      "properties": {
+        "source_name": {
+          "description": "NTP source name or IP.",
           "type": "string"
         },
-        "type": {
-          "$ref": "#/definitions/image_type"
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
+      }
     },
-    "image_type": {
-      "type": "string",
-      "enum": [
-        "full-iso",
-        "minimal-iso"
-      ]
+    "ntp_synchronization_request": {
+      "type": "object",
+      "required": [
+        "ntp_source"
+      ],
+      "properties": {
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        }
+      }
     },
-    "import-cluster-params": {
+    "ntp_synchronization_response": {
+      "properties": {
+        "ntp_sources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ntp_source"
+          }
+        }
+      }
+    },
+    "openshift-version": {
       "type": "object",
       "required": [
-        "name",
-        "api_vip_dnsname",
-        "openshift_cluster_id"
+        "display_name",
+        "support_level",
+        "cpu_architectures"
       ],
       "properties": {
-        "api_vip_dnsname": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string"
-        },
+        "cpu_architectures": {
+          "description": "Available CPU architectures.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
+        },
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
+          "type": "string"
+        },
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "maintenance"
+          ]
+        }
+      }
+    },
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
+    "operator-create-params": {
+      "type": "object",
+      "properties": {
         "name": {
-          "description": "OpenShift cluster name.",
           "type": "string"
         },
-        "openshift_cluster_id": {
-          "description": "The id of the OCP cluster, that hosts will be added to",
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
-          "format": "uuid"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "infra-env": {
+    "operator-hardware-requirements": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "name",
-        "type",
-        "updated_at",
-        "created_at"
-      ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "x-nullable": false
-        },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "download_url": {
-          "type": "string"
-        },
-        "email_domain": {
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
           "type": "string"
         },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version.",
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
+    "operator-host-requirements": {
+      "type": "object",
+      "properties": {
+        "operator_name": {
+          "description": "Name of the operator",
           "type": "string"
         },
-        "href": {
-          "description": "Self link.",
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "operator-monitor-report": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Unique name of the operator.",
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "ignition_config_override": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config.",
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
-        },
-        "kind": {
-          "description": "Indicates the type of this object.",
+        }
+      }
+    },
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
+      "type": "object",
+      "properties": {
+        "data_type": {
+          "description": "Type of the property",
           "type": "string",
           "enum": [
-            "InfraEnv"
+            "boolean",
+            "string",
+            "integer",
+            "float"
           ]
         },
-        "name": {
-          "description": "Name of the infra-env.",
-          "type": "string"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+        "default_value": {
+          "description": "Default value for the property",
           "type": "string"
         },
-        "org_id": {
+        "description": {
+          "description": "Description of a property",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
           "type": "boolean"
         },
-        "size_bytes": {
-          "type": "integer"
+        "name": {
+          "description": "Name of the property",
+          "type": "string"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
+        "options": {
+          "description": "Values to select from",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "operator-status": {
+      "description": "Represents the operator state.",
+      "type": "string",
+      "enum": [
+        "failed",
+        "progressing",
+        "available"
+      ]
+    },
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
+      "type": "string",
+      "enum": [
+        "builtin",
+        "olm"
+      ]
+    },
+    "os-image": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
+      "properties": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation.",
+        "url": {
+          "description": "The base OS image used for the discovery iso.",
           "type": "string"
         },
+        "version": {
+          "description": "Build ID of the OS image.",
+          "type": "string"
+        }
+      }
+    },
+    "os-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/os-image"
+      }
+    },
+    "platform": {
+      "description": "The configuration for the specific platform upon which to perform the installation.",
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
         "type": {
-          "$ref": "#/definitions/image_type"
+          "$ref": "#/definitions/platform_type"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
+    },
+    "platform_type": {
+      "type": "string",
+      "enum": [
+        "baremetal",
+        "vsphere",
+        "ovirt",
+        "none"
+      ]
+    },
+    "preflight-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         },
-        "updated_at": {
-          "description": "The last time that this infra-env was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
+        "operators": {
+          "description": "Preflight operators hardware requirements",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-hardware-requirements"
           }
-        },
-        "user_name": {
-          "type": "string"
         }
       }
     },
-    "infra-env-create-params": {
+    "presigned-url": {
       "type": "object",
       "required": [
-        "name",
-        "pull_secret"
+        "url"
       ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+        "expires_at": {
+          "description": "Expiration time for the URL token.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "url": {
+          "description": "Pre-signed URL for downloading the infra-env discovery image.",
+          "type": "string"
+        }
+      }
+    },
+    "proxy": {
+      "type": "object",
+      "properties": {
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
           "x-nullable": true
         },
-        "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
-          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
           "x-nullable": true
         },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
+    },
+    "reboot_for_reclaim_request": {
+      "description": "Information sent to the agent for rebooting a host into discovery.",
+      "type": "object",
+      "required": [
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
+          "type": "string"
+        }
+      }
+    },
+    "release-image": {
+      "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
+      "properties": {
         "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
           "default": "x86_64",
-          "x-nullable": false
-        },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
-          "type": "string"
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
+        "cpu_architectures": {
+          "description": "List of CPU architectures provided by the image.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "name": {
-          "description": "Name of the infra-env.",
-          "type": "string"
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
         },
         "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "maintenance"
+          ]
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "url": {
+          "description": "The installation image of the OpenShift cluster.",
           "type": "string"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
         }
       }
     },
-    "infra-env-list": {
+    "release-images": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/infra-env"
+        "$ref": "#/definitions/release-image"
       }
     },
-    "infra-env-update-params": {
+    "route": {
       "type": "object",
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+        "destination": {
+          "description": "The destination network or destination host",
           "type": "string"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
-        },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "family": {
+          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
+          "type": "integer",
+          "format": "int32"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "gateway": {
+          "description": "Gateway address where the packets are sent",
           "type": "string"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string",
-          "x-nullable": true
+        "interface": {
+          "description": "Interface to which packets for this route will be sent",
+          "type": "string"
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "metric": {
+          "description": "Route priority metric",
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "infra_error": {
+    "service_network": {
+      "description": "IP address block for service IP blocks.",
       "type": "object",
-      "required": [
-        "code",
-        "message"
-      ],
       "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        "message": {
-          "description": "Human-readable description of the error.",
-          "type": "string"
+        "cluster_id": {
+          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
     },
-    "install_cmd_request": {
+    "state-transition": {
       "type": "object",
-      "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "role",
-        "boot_device",
-        "controller_image",
-        "installer_image"
-      ],
       "properties": {
-        "boot_device": {
-          "description": "Boot device to write image on",
-          "type": "string"
-        },
-        "check_cvo": {
-          "description": "Check CVO status if needed",
-          "type": "boolean",
-          "default": true
-        },
         "cluster_id": {
-          "description": "Cluster id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "controller_image": {
-          "description": "Assisted installer controller image",
+          "description": "The cluster of the object, if any.",
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "disks_to_format": {
-          "description": "List of disks to format",
-          "type": "array",
-          "items": {
-            "description": "Disk to format",
-            "type": "string"
-          }
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
+        "from_state": {
+          "description": "The status that the object left, empty when the object was created by the transition.",
+          "type": "string"
         },
-        "host_id": {
-          "description": "Host id",
-          "type": "string",
-          "format": "uuid"
+        "id": {
+          "description": "Unique identifier of the transition.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
         "infra_env_id": {
-          "description": "Infra env id",
+          "description": "The infra-env of the host.",
           "type": "string",
-          "format": "uuid"
-        },
-        "installer_args": {
-          "description": "Core-os installer addtional args",
-          "type": "string"
+          "format": "uuid",
+          "x-nullable": true
         },
-        "installer_image": {
-          "description": "Assisted installer image",
+        "object_id": {
+          "description": "The host or cluster whose status changed.",
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "mco_image": {
-          "description": "Machine config operator image",
+        "object_kind": {
+          "description": "The kind of the object whose status changed.",
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          "enum": [
+            "host",
+            "cluster"
+          ]
         },
-        "must_gather_image": {
-          "description": "Must-gather images to use",
-          "type": "string"
+        "time_in_state_seconds": {
+          "description": "How long the object was in the status that it left.",
+          "type": "number",
+          "format": "double"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "to_state": {
+          "description": "The status that the object reached.",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "service_ips": {
-          "description": "List of service ips",
-          "type": "array",
-          "items": {
-            "description": "Service ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
+        "transitioned_at": {
+          "description": "The time at which the status changed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "skip_installation_disk_cleanup": {
-          "description": "Skip formatting installation disk",
-          "type": "boolean"
+        "trigger": {
+          "description": "The state machine transition, or the operation, that changed the status.",
+          "type": "string"
         }
       }
     },
-    "installer-args-params": {
+    "state-transition-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/state-transition"
+      }
+    },
+    "step": {
       "type": "object",
       "properties": {
         "args": {
-          "description": "List of additional arguments passed to coreos-installer",
           "type": "array",
           "items": {
             "type": "string"
-          },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
-          ]
+          }
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "interface": {
+    "step-reply": {
       "type": "object",
       "properties": {
-        "biosdevname": {
-          "type": "string"
-        },
-        "client_id": {
+        "error": {
           "type": "string"
         },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "exit_code": {
+          "type": "integer"
         },
-        "has_carrier": {
-          "type": "boolean"
+        "output": {
+          "type": "string"
         },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "step_id": {
+          "type": "string"
         },
-        "ipv6_addresses": {
+        "step_type": {
+          "$ref": "#/definitions/step-type"
+        }
+      }
+    },
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "tang-connectivity-check",
+        "ntp-synchronizer",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution",
+        "stop-installation",
+        "logs-gather",
+        "next-step-runner",
+        "upgrade-agent",
+        "download-boot-artifacts",
+        "reboot-for-reclaim"
+      ]
+    },
+    "steps": {
+      "type": "object",
+      "properties": {
+        "instructions": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/step"
           }
         },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
+        "next_instruction_seconds": {
           "type": "integer"
         },
-        "name": {
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
+          "type": "string",
+          "default": "continue",
+          "enum": [
+            "exit",
+            "continue"
+          ]
+        }
+      }
+    },
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
+      }
+    },
+    "subnet": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+      "x-go-custom-tag": "gorm:\"primaryKey\""
+    },
+    "system_vendor": {
+      "type": "object",
+      "properties": {
+        "manufacturer": {
           "type": "string"
         },
-        "product": {
+        "product_name": {
           "type": "string"
         },
-        "speed_mbps": {
-          "type": "integer"
-        },
-        "type": {
+        "serial_number": {
           "type": "string"
         },
-        "vendor": {
-          "type": "string"
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
+          "type": "boolean"
         }
       }
     },
-    "inventory": {
+    "tang_connectivity_request": {
       "type": "object",
+      "required": [
+        "tang_servers"
+      ],
       "properties": {
-        "bmc_address": {
-          "type": "string"
-        },
-        "bmc_v6address": {
-          "type": "string"
-        },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk"
-          }
-        },
-        "gpus": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/gpu"
-          }
-        },
-        "hostname": {
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
           "type": "string"
-        },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
-          }
-        },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "routes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/route"
-          }
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
-        },
-        "tpm_version": {
-          "type": "string",
-          "enum": [
-            "none",
-            "1.2",
-            "2.0"
-          ]
         }
       }
     },
-    "io_perf": {
+    "tang_connectivity_response": {
       "type": "object",
       "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
+        "is_success": {
+          "description": "Tang check result.",
+          "type": "boolean"
+        },
+        "tang_server_response": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "payload": {
+                "description": "Tang response payload.",
+                "type": "string"
+              },
+              "signatures": {
+                "type": "array",
+                "items": {
+                  "type": "object",
+                  "properties": {
+                    "protected": {
+                      "type": "string"
+                    },
+                    "signature": {
+                      "type": "string"
+                    }
+                  },
+                  "x-go-name": "TangServerSignatures"
+                }
+              },
+              "tang_url": {
+                "description": "Tang URL.",
+                "type": "string"
+              }
+            },
+            "x-go-name": "TangServerResponse"
+          }
         }
       }
     },
-    "l2-connectivity": {
+    "upgrade_agent_request": {
       "type": "object",
       "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "remote_mac": {
+        "agent_image": {
+          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
           "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
         }
       }
     },
-    "l3-connectivity": {
+    "upgrade_agent_response": {
       "type": "object",
       "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
-        },
-        "remote_ip_address": {
+        "agent_image": {
+          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
           "type": "string"
         },
-        "successful": {
-          "type": "boolean"
+        "result": {
+          "$ref": "#/definitions/upgrade_agent_result"
         }
       }
     },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
-      }
-    },
-    "list-manifests": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/manifest"
-      }
+    "upgrade_agent_result": {
+      "description": "Agent upgrade result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
     },
-    "list-versions": {
+    "usage": {
       "type": "object",
       "properties": {
-        "release_tag": {
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "id": {
+          "description": "Unique idenftifier of the feature",
           "type": "string"
         },
-        "versions": {
-          "$ref": "#/definitions/versions"
-        }
-      }
-    },
-    "logs-progress-params": {
-      "type": "object",
-      "required": [
-        "logs_state"
-      ],
-      "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
+        "name": {
+          "description": "name of the feature to track",
+          "type": "string"
         }
       }
     },
-    "logs_gather_cmd_request": {
+    "v2-cluster-update-params": {
       "type": "object",
-      "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "bootstrap",
-        "installer_gather"
-      ],
       "properties": {
-        "bootstrap": {
-          "description": "Host is bootstrap or not",
-          "type": "boolean"
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
         },
-        "cluster_id": {
-          "description": "Cluster id",
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
           "type": "string",
-          "format": "uuid"
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "type": "object",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "host_stage_timeouts": {
+          "description": "JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.",
+          "type": "string",
+          "x-nullable": true,
+          "example": "[{\"stage\": \"Writing image to disk\", \"timeout\": 600}, {\"stage\": \"Waiting for control plane\", \"role\": \"master\", \"timeout\": 7200}]"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ],
+          "x-nullable": true
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "type": "object",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "platform": {
+          "type": "object",
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
         },
-        "host_id": {
-          "description": "Host id",
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "Infra env id",
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
         },
-        "installer_gather": {
-          "description": "Run installer gather logs",
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
           "type": "boolean",
-          "default": true
+          "x-nullable": true
         },
-        "master_ips": {
-          "description": "List of master ips",
-          "type": "array",
-          "items": {
-            "description": "Master ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
-        }
-      }
-    },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
-      ],
-      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-    },
-    "logs_type": {
-      "type": "string",
-      "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
-      ]
-    },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
-          },
-          "mac_address": {
-            "description": "mac address present on the host",
-            "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
-          }
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
         }
       }
     },
-    "machine_network": {
-      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
+    "versioned-host-requirements": {
       "type": "object",
       "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "cluster_id": {
-          "description": "The cluster that this network is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        }
-      }
-    },
-    "managed-domain": {
-      "type": "object",
-      "properties": {
-        "domain": {
+        "sno": {
+          "description": "Single node OpenShift node requirements",
+          "x-go-name": "SNORequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "version": {
+          "description": "Version of the component for which requirements are defined",
           "type": "string"
         },
-        "provider": {
-          "type": "string",
-          "enum": [
-            "route53"
-          ]
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "manifest": {
+    "versions": {
       "type": "object",
-      "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
-          "type": "string"
-        },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
+      "additionalProperties": {
+        "type": "string"
       }
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
     },
-    "memory": {
-      "type": "object",
-      "properties": {
-        "physical_bytes": {
-          "type": "integer"
-        },
-        "physical_bytes_method": {
-          "description": "The method by which the physical memory was set",
-          "$ref": "#/definitions/memory_method"
-        },
-        "usable_bytes": {
-          "type": "integer"
+    "imageAuth": {
+      "type": "apiKey",
+      "name": "Image-Token",
+      "in": "header"
+    },
+    "imageURLAuth": {
+      "type": "apiKey",
+      "name": "image_token",
+      "in": "query"
+    },
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
+      ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
+    },
+    {
+      "description": "Audit log of the changes made to clusters, hosts and infra-envs.",
+      "name": "audit"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "Reusable templates for registering clusters.",
+      "name": "cluster_templates"
+    },
+    {
+      "description": "Subscriptions that deliver events to HTTP endpoints.",
+      "name": "event_subscriptions"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
+    },
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
+    },
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
+    },
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
+    },
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install",
+  "paths": {
+    "/v2/audit-log": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the audit log entries of the changes made through the REST API and through the kube-api, from the\nlatest.\n",
+        "tags": [
+          "audit"
+        ],
+        "operationId": "v2ListAuditLog",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Return the entries of the changes made to this cluster, host or infra-env.",
+            "name": "resource_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return the entries of the changes made by this user.",
+            "name": "actor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return the entries of the changes made by the users of this organization.",
+            "name": "org_id",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "rest-api",
+              "kube-api"
+            ],
+            "type": "string",
+            "description": "Return the entries of the changes made through this interface.",
+            "name": "source",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return the entries of the changes made at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The maximal number of entries to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of entries to skip before starting to return entries.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/audit-log-entry-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "memory_method": {
-      "type": "string",
-      "enum": [
-        "dmidecode",
-        "ghw",
-        "meminfo"
-      ]
-    },
-    "monitored-operator": {
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
-        },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
-        },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
-          "type": "string"
-        },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+    "/v2/cluster-templates": {
+      "get": {
+        "description": "Retrieves the list of cluster templates.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2ListClusterTemplates",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
-    "next_step_cmd_request": {
-      "type": "object",
-      "required": [
-        "infra_env_id",
-        "host_id",
-        "agent_version"
-      ],
-      "properties": {
-        "agent_version": {
-          "description": "Agent image version",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "host_id": {
-          "description": "Host id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "infra_env_id": {
-          "description": "Infra env id",
-          "type": "string",
-          "format": "uuid"
+      },
+      "post": {
+        "description": "Creates a new cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2RegisterClusterTemplate",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster template.",
+            "name": "new-cluster-template-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "node-label-params": {
-      "type": "object",
-      "required": [
-        "key",
-        "value"
-      ],
-      "properties": {
-        "key": {
-          "description": "The key for the label's key-value pair.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value for the label's key-value pair.",
-          "type": "string"
+    "/v2/cluster-templates/{template_id}": {
+      "get": {
+        "description": "Retrieves the details of the cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2GetClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be retrieved.",
+            "name": "template_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "ntp_source": {
-      "type": "object",
-      "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
-          "type": "string"
-        },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
+      },
+      "put": {
+        "description": "Replaces the properties of the cluster template. Clusters created from the template are not affected.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2UpdateClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be updated.",
+            "name": "template_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new properties of the cluster template.",
+            "name": "cluster-template-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "ntp_synchronization_request": {
-      "type": "object",
-      "required": [
-        "ntp_source"
-      ],
-      "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
+      },
+      "delete": {
+        "description": "Deletes the cluster template. Clusters created from the template are not affected.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "v2DeregisterClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster template to be deleted.",
+            "name": "template_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "ntp_synchronization_response": {
-      "properties": {
-        "ntp_sources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ntp_source"
+    "/v2/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only clusters that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "openshift-version": {
-      "type": "object",
-      "required": [
-        "display_name",
-        "support_level",
-        "cpu_architectures"
-      ],
-      "properties": {
-        "cpu_architectures": {
-          "description": "Available CPU architectures.",
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A cluster template to create the cluster from. The properties describing the new cluster take precedence\nover the ones of the template, and the manifests and install config overrides of the template are added\nto the cluster.\n",
+            "name": "from_template",
+            "in": "query"
           }
-        },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
-          "type": "string"
-        },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "maintenance"
-          ]
-        }
-      }
-    },
-    "openshift-versions": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
-      }
-    },
-    "operator-create-params": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        }
-      }
-    },
-    "operator-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "string"
-        },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         }
       }
     },
-    "operator-host-requirements": {
-      "type": "object",
-      "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
-          "type": "string"
-        },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        }
-      }
-    },
-    "operator-monitor-report": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
-        }
-      }
-    },
-    "operator-properties": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/operator-property"
-      }
-    },
-    "operator-property": {
-      "type": "object",
-      "properties": {
-        "data_type": {
-          "description": "Type of the property",
-          "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
-        },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
-        },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Name of the property",
-          "type": "string"
-        },
-        "options": {
-          "description": "Values to select from",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/v2/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "operator-status": {
-      "description": "Represents the operator state.",
-      "type": "string",
-      "enum": [
-        "failed",
-        "progressing",
-        "available"
-      ]
-    },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
-      "type": "string",
-      "enum": [
-        "builtin",
-        "olm"
-      ]
-    },
-    "os-image": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "cpu_architecture",
-        "url",
-        "version"
-      ],
-      "properties": {
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "url": {
-          "description": "The base OS image used for the discovery iso.",
-          "type": "string"
-        },
-        "version": {
-          "description": "Build ID of the OS image.",
-          "type": "string"
-        }
-      }
-    },
-    "os-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/os-image"
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "platform": {
-      "description": "The configuration for the specific platform upon which to perform the installation.",
-      "type": "object",
-      "required": [
-        "type"
-      ],
-      "properties": {
-        "type": {
-          "$ref": "#/definitions/platform_type"
+    "/v2/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, do not include hosts.",
+            "name": "exclude-hosts",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the cluster, to be sent in the If-Match header of its updates."
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
-    },
-    "platform_type": {
-      "type": "string",
-      "enum": [
-        "baremetal",
-        "vsphere",
-        "ovirt",
-        "none"
-      ]
-    },
-    "preflight-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "presigned-url": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "expires_at": {
-          "description": "Expiration time for the URL token.",
-          "type": "string",
-          "format": "date-time"
-        },
-        "url": {
-          "description": "Pre-signed URL for downloading the infra-env discovery image.",
-          "type": "string"
-        }
-      }
-    },
-    "proxy": {
-      "type": "object",
-      "properties": {
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
-    },
-    "reboot_for_reclaim_request": {
-      "description": "Information sent to the agent for rebooting a host into discovery.",
-      "type": "object",
-      "required": [
-        "host_fs_mount_dir"
-      ],
-      "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
-          "type": "string"
-        }
-      }
-    },
-    "release-image": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "cpu_architecture",
-        "url",
-        "version"
-      ],
-      "properties": {
-        "cpu_architecture": {
-          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "cpu_architectures": {
-          "description": "List of CPU architectures provided by the image.",
-          "type": "array",
-          "items": {
-            "type": "string"
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Update the cluster only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the cluster, to be sent in the If-Match header of its updates."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "412": {
+            "description": "Precondition Failed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "maintenance"
-          ]
-        },
-        "url": {
-          "description": "The installation image of the OpenShift cluster.",
-          "type": "string"
-        },
-        "version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
-        }
-      }
-    },
-    "release-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-image"
-      }
-    },
-    "route": {
-      "type": "object",
-      "properties": {
-        "destination": {
-          "description": "The destination network or destination host",
-          "type": "string"
-        },
-        "family": {
-          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
-          "type": "integer",
-          "format": "int32"
-        },
-        "gateway": {
-          "description": "Gateway address where the packets are sent",
-          "type": "string"
-        },
-        "interface": {
-          "description": "Interface to which packets for this route will be sent",
-          "type": "string"
-        },
-        "metric": {
-          "description": "Route priority metric",
-          "type": "integer",
-          "format": "int32"
         }
       }
     },
-    "service_network": {
-      "description": "IP address block for service IP blocks.",
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
-        },
-        "cluster_id": {
-          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      "post": {
+        "description": "Transforming cluster to day2 and allowing adding hosts",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToDay2",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform to day2 and to allow adding hosts by this.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/v2/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+    "/v2/clusters/{cluster_id}/actions/cancel-install-schedule": {
+      "post": {
+        "description": "Cancels the scheduled installation of the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2CancelInstallSchedule",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose scheduled installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "tang-connectivity-check",
-        "ntp-synchronizer",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution",
-        "stop-installation",
-        "logs-gather",
-        "next-step-runner",
-        "upgrade-agent",
-        "download-boot-artifacts",
-        "reboot-for-reclaim"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
-        }
-      }
-    },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "subnet": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-      "x-go-custom-tag": "gorm:\"primaryKey\""
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
         }
       }
     },
-    "tang_connectivity_request": {
-      "type": "object",
-      "required": [
-        "tang_servers"
-      ],
-      "properties": {
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
-          "type": "string"
+    "/v2/clusters/{cluster_id}/actions/dry-run": {
+      "post": {
+        "description": "Generates the install-config, the operator manifests and the ignition files of the cluster as the installation\nwould, in a scratch working directory, and returns the generated artifacts together with the errors of every\ngeneration stage. The cluster, its hosts and its stored files are not modified.\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DryRunInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be tried.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/dry-run-result"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tang_connectivity_response": {
-      "type": "object",
-      "properties": {
-        "is_success": {
-          "description": "Tang check result.",
-          "type": "boolean"
-        },
-        "tang_server_response": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "payload": {
-                "description": "Tang response payload.",
-                "type": "string"
-              },
-              "signatures": {
-                "type": "array",
-                "items": {
-                  "type": "object",
-                  "properties": {
-                    "protected": {
-                      "type": "string"
-                    },
-                    "signature": {
-                      "type": "string"
-                    }
-                  },
-                  "x-go-name": "TangServerSignatures"
-                }
-              },
-              "tang_url": {
-                "description": "Tang URL.",
-                "type": "string"
-              }
-            },
-            "x-go-name": "TangServerResponse"
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster. When an install schedule is given and its start time is in the future,\nthe installation is scheduled instead and starts automatically once the cluster is ready within the schedule's window.\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The window in which the installation is allowed to start.",
+            "name": "install_schedule",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/install-schedule"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "upgrade_agent_request": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
-        }
-      }
-    },
-    "upgrade_agent_response": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
-        },
-        "result": {
-          "$ref": "#/definitions/upgrade_agent_result"
-        }
-      }
-    },
-    "upgrade_agent_result": {
-      "description": "Agent upgrade result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/v2/clusters/{cluster_id}/actions/reinstall-failed-hosts": {
+      "post": {
+        "description": "Resets only the failed or cancelled workers of an installing or installed cluster, without touching\nits other hosts. The hosts become day-2 hosts of the cluster: once they are rebooted into the discovery\nimage and register again, they are installed with v2InstallHost and join the cluster through the\nadd-hosts flow, using a freshly generated day-2 ignition.\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ReinstallFailedHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose failed hosts are to be re-installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "id": {
-          "description": "Unique idenftifier of the feature",
-          "type": "string"
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
-        }
-      }
-    },
-    "v2-cluster-update-params": {
-      "type": "object",
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/cluster_network"
-          },
-          "x-nullable": true
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "type": "object",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
-          "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ],
-          "x-nullable": true
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "type": "object",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
-        },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/machine_network"
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
-          "x-nullable": true
-        },
-        "name": {
-          "description": "OpenShift cluster name.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
-          "x-nullable": true
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
-          }
-        },
-        "platform": {
-          "type": "object",
-          "$ref": "#/definitions/platform"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/service_network"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "sno": {
-          "description": "Single node OpenShift node requirements",
-          "x-go-name": "SNORequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "imageAuth": {
-      "type": "apiKey",
-      "name": "Image-Token",
-      "in": "header"
-    },
-    "imageURLAuth": {
-      "type": "apiKey",
-      "name": "image_token",
-      "in": "query"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    "/v2/clusters/{cluster_id}/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install",
-  "paths": {
-    "/v2/clusters": {
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Downloads credentials relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListClusters",
+        "operationId": "V2DownloadClusterCredentials",
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
+            "description": "The cluster that owns the credential file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
             "type": "string",
-            "description": "If provided, returns only clusters that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "type": "file"
             }
           },
           "401": {
@@ -9423,12 +14023,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9442,29 +14054,49 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterCluster",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
@@ -9485,22 +14117,143 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
@@ -9511,16 +14264,72 @@ func init() {
             ]
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterDefaultConfig",
+        "operationId": "V2GetPresignedForClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "$ref": "#/definitions/presigned-url"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -9535,56 +14344,22 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/clusters/import": {
-      "post": {
-        "description": "Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2ImportCluster",
-        "parameters": [
-          {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/import-cluster-params"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
           },
-          "400": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -9596,7 +14371,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}": {
+    "/v2/clusters/{cluster_id}/hosts": {
       "get": {
         "security": [
           {
@@ -9610,37 +14385,47 @@ func init() {
             "agentAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Get a list of cluster hosts according to supplied filters.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "ListClusterHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster whose hosts should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "master",
+              "worker",
+              "auto-assign"
+            ],
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Role to request.",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Hosts status to request.",
+            "name": "status",
+            "in": "query"
           },
           {
             "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "description": "If true return the host's inventory.",
+            "name": "with-inventory",
+            "in": "query"
           },
           {
             "type": "boolean",
-            "description": "If true, do not include hosts.",
-            "name": "exclude-hosts",
+            "description": "If true return the host's connectivity.",
+            "name": "with-connectivity",
             "in": "query"
           }
         ],
@@ -9648,7 +14433,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -9669,45 +14454,54 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/install-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Render the install config with the overrides of this version of the install config overrides history\ninstead of the current ones. Version 0 renders the install config without overrides.\n",
+            "name": "version",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -9733,12 +14527,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9748,36 +14536,33 @@ func init() {
         }
       },
       "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
+            "description": "Install config overrides.",
+            "name": "install-config-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
+              "type": "string"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -9809,12 +14594,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9824,28 +14603,52 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
-      "post": {
-        "description": "Transforming cluster to day2 and allowing adding hosts",
+    "/v2/clusters/{cluster_id}/install-config/diff": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get a unified diff between the install config YAML rendered with two versions of the install config overrides.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToDay2",
+        "operationId": "v2GetClusterInstallConfigDiff",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform to day2 and to allow adding hosts by this.",
+            "description": "The cluster whose install config is being compared.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "The version of the install config overrides to compare from. Version 0 is the install config without overrides.",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "The version of the install config overrides to compare to. Defaults to the current overrides.",
+            "name": "to",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string"
             }
           },
           "401": {
@@ -9866,14 +14669,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9887,28 +14684,37 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+    "/v2/clusters/{cluster_id}/install-config/history": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the versions of the install config overrides of the cluster, oldest first.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2CancelInstallation",
+        "operationId": "v2ListClusterInstallConfigHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster whose install config overrides history is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/install-config-override-version-list"
             }
           },
           "401": {
@@ -9935,12 +14741,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9950,48 +14750,37 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    "/v2/clusters/{cluster_id}/installation-timeline": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "description": "Get the timeline of the cluster installation, built from the installation stages that each host went through.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
+        "operationId": "v2GetClusterInstallationTimeline",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster whose installation timeline is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/installation-timeline"
             }
           },
           "401": {
@@ -10018,55 +14807,70 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/v2/clusters/{cluster_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -10106,31 +14910,67 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/reset": {
+      },
       "post": {
-        "description": "Resets a failed installation.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10167,40 +15007,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/credentials"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10237,60 +15087,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
+    "/v2/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -10328,57 +15161,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/manifest"
             }
           },
           "400": {
@@ -10424,71 +15244,44 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10525,93 +15318,58 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -10653,61 +15411,32 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/hosts": {
+    "/v2/clusters/{cluster_id}/monitored-operators": {
       "get": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Get a list of cluster hosts according to supplied filters.",
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "ListClusterHosts",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be retrieved.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "master",
-              "worker",
-              "auto-assign"
-            ],
-            "type": "string",
-            "description": "Role to request.",
-            "name": "role",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "Hosts status to request.",
-            "name": "status",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's inventory.",
-            "name": "with-inventory",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's connectivity.",
-            "name": "with-connectivity",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
             "in": "query"
           }
         ],
@@ -10715,7 +15444,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -10736,6 +15465,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10743,39 +15478,46 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/install-config": {
-      "get": {
+      },
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10802,47 +15544,58 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster to return preflight requirements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -10878,7 +15631,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
+    "/v2/clusters/{cluster_id}/state-transitions": {
       "get": {
         "security": [
           {
@@ -10887,52 +15640,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists the status transitions of the cluster, oldest first.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "v2ListClusterStateTransitions",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster whose status transitions should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/state-transition-list"
             }
           },
           "401": {
@@ -10959,12 +15688,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10972,67 +15695,43 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/supported-platforms": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "A list of platforms that this cluster can support in its current configuration.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "GetClusterSupportedPlatforms",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster whose platform types should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11052,67 +15751,61 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11138,12 +15831,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11159,33 +15846,52 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
+    "/v2/component-versions": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "List of component versions.",
         "tags": [
-          "manifests"
+          "versions"
         ],
-        "operationId": "V2ListClusterManifests",
-        "parameters": [
+        "operationId": "v2ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/v2/deleted-clusters": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
           }
         ],
+        "description": "Lists the deregistered clusters that can still be restored, before they are permanently deleted.\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListDeletedClusters",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/deleted-cluster-list"
             }
           },
           "401": {
@@ -11200,24 +15906,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11225,42 +15919,37 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v2/deleted-clusters/{cluster_id}/actions/restore": {
       "post": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin"
+            ]
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Restores a deregistered cluster, together with the hosts that were deregistered with it, before it is\npermanently deleted.\n",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "v2RestoreDeletedCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The deregistered cluster to be restored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
+              "$ref": "#/definitions/cluster"
             }
           },
           "400": {
@@ -11306,44 +15995,53 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      }
+    },
+    "/v2/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
         "tags": [
-          "manifests"
+          "managed_domains"
         ],
-        "operationId": "V2DeleteClusterManifest",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
+        "operationId": "V2ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
           },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
+        }
+      }
+    },
+    "/v2/event-subscriptions": {
+      "get": {
+        "description": "Retrieves the list of event subscriptions.",
+        "tags": [
+          "event_subscriptions"
         ],
+        "operationId": "v2ListEventSubscriptions",
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-subscription-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11357,24 +16055,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11382,56 +16068,35 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/manifests/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "post": {
+        "description": "Creates a subscription that delivers events to an HTTP endpoint as CloudEvents.",
         "tags": [
-          "manifests"
+          "event_subscriptions"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "v2RegisterEventSubscription",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The properties describing the new event subscription.",
+            "name": "new-event-subscription-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/event-subscription-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/event-subscription"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11446,24 +16111,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11473,40 +16126,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
+    "/v2/event-subscriptions/{subscription_id}": {
       "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Retrieves the details of the event subscription.",
         "tags": [
-          "operators",
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "v2GetEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
-            "name": "cluster_id",
+            "description": "The event subscription to be retrieved.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/event-subscription"
             }
           },
           "401": {
@@ -11542,42 +16183,39 @@ func init() {
         }
       },
       "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Replaces the properties of the event subscription. The secret of the subscription is kept when it is not set.",
         "tags": [
-          "operators",
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "v2UpdateEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
-            "name": "cluster_id",
+            "description": "The event subscription to be updated.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "description": "The new properties of the event subscription.",
+            "name": "event-subscription-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/event-subscription-create-params"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-subscription"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11606,59 +16244,33 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get preflight requirements for a cluster.",
+      },
+      "delete": {
+        "description": "Deletes the event subscription and its delivery log.",
         "tags": [
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "v2DeregisterEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
-            "name": "cluster_id",
+            "description": "The event subscription to be deleted.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
-            }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11693,40 +16305,48 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
+    "/v2/event-subscriptions/{subscription_id}/deliveries": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Retrieves the delivery log of the event subscription, latest deliveries first.",
         "tags": [
-          "installer"
+          "event_subscriptions"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "v2ListEventSubscriptionDeliveries",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
-            "name": "cluster_id",
+            "description": "The event subscription whose deliveries should be retrieved.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "description": "Only retrieve the deliveries with this status.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of deliveries to retrieve.",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "$ref": "#/definitions/event-subscription-delivery-list"
             }
           },
           "401": {
@@ -11747,6 +16367,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11756,46 +16382,148 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/v2/events": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for.",
+            "name": "host_id",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities. Events of all severities are returned when not set.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event names. Events of all names are returned when not set.",
+            "name": "names",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the events whose message contains this text, ignoring case.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the events that occurred at or after this time.",
+            "name": "start_time",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the events that occurred before this time.",
+            "name": "end_time",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "default": "ascending",
+            "description": "Order the events by their time, from the oldest (ascending) or from the latest (descending).",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximal number of events to return. All the events are returned when not set.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of events to skip before starting to return events.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Return the events that were pruned from the database and archived, instead of the current events. Requires either cluster_id or infra_env_id.",
+            "name": "archived",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "type": "integer",
+                "format": "int64",
+                "description": "The number of events that match the filters, regardless of the limit and offset."
+              }
+            }
           },
           "400": {
             "description": "Error.",
@@ -11827,70 +16555,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/component-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of component versions.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-managed-domains"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11900,7 +16564,7 @@ func init() {
         }
       }
     },
-    "/v2/events": {
+    "/v2/events/stream": {
       "get": {
         "security": [
           {
@@ -11914,30 +16578,33 @@ func init() {
             "urlAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Streams the events of a cluster, host or infra-env as server-sent events while they are saved.\nEach message holds an event in JSON format and its ID, which can be sent back in the Last-Event-ID header\n(or the last_event_id parameter) to resume the stream after the last received event.\n",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
           "events"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "v2StreamEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster to stream events for.",
             "name": "cluster_id",
             "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
+            "description": "A host in the specified cluster to stream events for.",
             "name": "host_id",
             "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to return events for.",
+            "description": "The infra-env to stream events for.",
             "name": "infra_env_id",
             "in": "query"
           },
@@ -11949,13 +16616,61 @@ func init() {
             "description": "A comma-separated list of event categories.",
             "name": "categories",
             "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities. Events of all severities are streamed when not set.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event names. Events of all names are streamed when not set.",
+            "name": "names",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "Resume the stream after the event with this ID. Only new events are streamed when neither this parameter nor the Last-Event-ID header are set.",
+            "name": "last_event_id",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "x-go-name": "LastEventIDHeader",
+            "description": "The ID of the last event received before the stream was interrupted, as sent by EventSource clients when they reconnect.",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11970,12 +16685,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -12312,6 +17021,12 @@ func init() {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/infra-env"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the infra-env, to be sent in the If-Match header of its updates."
+              }
             }
           },
           "401": {
@@ -12445,6 +17160,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/infra-env-update-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "Update the infra-env only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
@@ -12452,6 +17173,12 @@ func init() {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/infra-env"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the infra-env, to be sent in the If-Match header of its updates."
+              }
             }
           },
           "400": {
@@ -12490,6 +17217,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "412": {
+            "description": "Precondition Failed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13101,6 +17834,12 @@ func init() {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the host, to be sent in the If-Match header of its updates."
+              }
             }
           },
           "401": {
@@ -13238,6 +17977,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/host-update-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "Update the host only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
@@ -13245,6 +17990,12 @@ func init() {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The resource version of the host, to be sent in the If-Match header of its updates."
+              }
             }
           },
           "400": {
@@ -13283,6 +18034,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "412": {
+            "description": "Precondition Failed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13512,21 +18269,254 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+        "tags": [
+          "installer"
+        ],
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that its validation is being reset.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+      "post": {
+        "description": "Unbind host to a cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UnbindHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is being bound.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the diagnostic actions that were requested for the host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListHostDiagnostics",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose diagnostics should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose diagnostics should be listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Queues a diagnostic action from the service allowlist to be run on the host by its agent.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2CreateHostDiagnostic",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
+            "description": "The infra-env of the host that the diagnostic action should run on.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -13534,28 +18524,30 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "The host that the diagnostic action should run on.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "The diagnostic action to be run.",
+            "name": "host-diagnostic-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-diagnostic"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13593,18 +18585,27 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
-      "post": {
-        "description": "Unbind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a diagnostic action of the host, including its output once the agent uploaded it.",
         "tags": [
           "installer"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2GetHostDiagnostic",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host whose diagnostic should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -13612,23 +18613,25 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host whose diagnostic should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The diagnostic that should be retrieved.",
+            "name": "diagnostic_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-diagnostic"
             }
           },
           "401": {
@@ -13649,35 +18652,11 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
@@ -13967,6 +18946,14 @@ func init() {
             "name": "timestamp",
             "in": "query"
           },
+          {
+            "maximum": 120,
+            "minimum": 0,
+            "type": "integer",
+            "description": "Long-poll mode. The number of seconds the service may hold the request open, waiting for the state\nof the host to change or for a new command to be queued for it, before it returns the next operations.\nWhen omitted or 0 the next operations are returned immediately. The request is not held\nwhen the host changed since it last checked in, nor when the service is unable to\nwatch the host for changes.\n",
+            "name": "wait_timeout",
+            "in": "query"
+          },
           {
             "type": "string",
             "description": "The software version of the discovery agent that is retrieving instructions.",
@@ -14184,62 +19171,216 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to update.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the status transitions of the host, oldest first.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListHostStateTransitions",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose status transitions should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose status transitions should be listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/state-transition-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Lists the statistics of the validations of the host: how long they took to succeed after the host was\ndiscovered, and how often they changed between success and failure.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "v2ListHostValidationStats",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host being updated.",
+            "description": "The infra-env of the host whose validation statistics should be listed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -14247,24 +19388,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to update.",
+            "description": "The host whose validation statistics should be listed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-validation-stats-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -14284,23 +19419,11 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
@@ -14716,6 +19839,103 @@ func init() {
         }
       }
     },
+    "audit-log-change": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "description": "The path of the field, such as platform.type.",
+          "type": "string"
+        },
+        "from": {
+          "description": "The value of the field before the change.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The value of the field after the change.",
+          "type": "string"
+        }
+      }
+    },
+    "audit-log-entry": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "description": "The operation that made the change, such as v2UpdateCluster.",
+          "type": "string"
+        },
+        "actor": {
+          "description": "The user that made the change.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "changes": {
+          "description": "The fields of the resource that changed. The values of secret fields are redacted.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audit-log-change"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "created_at": {
+          "description": "The time at which the change was made.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "id": {
+          "description": "Unique identifier of the entry.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "org_id": {
+          "description": "The organization of the user that made the change.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "request_id": {
+          "description": "The ID of the request that made the change, as written to the logs.",
+          "type": "string"
+        },
+        "resource_id": {
+          "description": "The changed cluster, host or infra-env.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "resource_kind": {
+          "description": "The kind of the changed resource, empty when the change is not made to a cluster, a host or an infra-env.",
+          "type": "string",
+          "enum": [
+            "cluster",
+            "host",
+            "infra-env"
+          ]
+        },
+        "source": {
+          "description": "The interface through which the change was made.",
+          "type": "string",
+          "enum": [
+            "rest-api",
+            "kube-api"
+          ]
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response, for changes made through the REST API.",
+          "type": "integer"
+        },
+        "succeeded": {
+          "description": "Whether the operation succeeded.",
+          "type": "boolean"
+        }
+      }
+    },
+    "audit-log-entry-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-log-entry"
+      }
+    },
     "bind-host-params": {
       "required": [
         "cluster_id"
@@ -14842,6 +20062,12 @@ func init() {
           },
           "x-nullable": false
         },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "default": false,
+          "x-go-custom-tag": "gorm:\"default:false\""
+        },
         "disk_encryption": {
           "description": "Information regarding hosts' installation disks encryption.",
           "type": "object",
@@ -14878,6 +20104,11 @@ func init() {
           },
           "x-go-custom-tag": "gorm:\"-\""
         },
+        "host_stage_timeouts": {
+          "description": "JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "hosts": {
           "description": "Hosts that are associated with this cluster.",
           "type": "array",
@@ -14946,6 +20177,20 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:text\"",
           "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
         },
+        "install_schedule_end_at": {
+          "description": "The time after which the scheduled installation of this cluster expires if it did not start.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "install_schedule_start_at": {
+          "description": "The time from which the scheduled installation of this cluster is allowed to start.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
         "install_started_at": {
           "description": "The time that this cluster started installation.",
           "type": "string",
@@ -15037,6 +20282,12 @@ func init() {
           "format": "int64",
           "x-go-custom-tag": "gorm:\"-\""
         },
+        "resource_version": {
+          "description": "Incremented on each update of the cluster through its update API, and returned as its ETag.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"default:1\""
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -15188,6 +20439,11 @@ func init() {
           "default": "x86_64",
           "x-nullable": false
         },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "disk_encryption": {
           "description": "Installation disks encryption mode and host roles to be applied.",
           "type": "object",
@@ -15202,6 +20458,12 @@ func init() {
             "None"
           ]
         },
+        "host_stage_timeouts": {
+          "description": "JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.",
+          "type": "string",
+          "x-nullable": true,
+          "example": "[{\"stage\": \"Writing image to disk\", \"timeout\": 600}, {\"stage\": \"Waiting for control plane\", \"role\": \"master\", \"timeout\": 7200}]"
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
@@ -15327,7 +20589,8 @@ func init() {
           "default": false,
           "x-nullable": true
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
     },
     "cluster-host-requirements": {
       "type": "object",
@@ -15406,6 +20669,13 @@ func init() {
     "cluster-progress-info": {
       "type": "object",
       "properties": {
+        "estimated_completion_at": {
+          "description": "Estimated time at which the installation completes, based on the stage durations of previous installations with the same OpenShift version and topology.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
         "finalizing_stage_percentage": {
           "type": "integer"
         },
@@ -15421,6 +20691,109 @@ func init() {
       },
       "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
     },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "cluster_params": {
+          "description": "The properties of the clusters created from the template, without a pull secret.",
+          "$ref": "#/definitions/cluster-create-params"
+        },
+        "created_at": {
+          "description": "The time that the cluster template was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "description": {
+          "description": "A description of the cluster template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "id": {
+          "description": "Unique identifier of the cluster template.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the install config overrides of the clusters created from the template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "manifests": {
+          "description": "Manifests added to the clusters created from the template.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "name": {
+          "description": "Name of the cluster template.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization of the user that created the cluster template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "updated_at": {
+          "description": "The last time that the cluster template was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_name": {
+          "description": "The user that created the cluster template.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "cluster_params"
+      ],
+      "properties": {
+        "cluster_params": {
+          "description": "The properties of the clusters created from the template. The name and the pull secret of every cluster\nare given when it is registered, so the ones of the template are not used and the pull secret is not stored.\n",
+          "$ref": "#/definitions/cluster-create-params"
+        },
+        "description": {
+          "description": "A description of the cluster template.",
+          "type": "string"
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the install config overrides of the clusters created from the template.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "Manifests to add to the clusters created from the template.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "name": {
+          "description": "Name of the cluster template.",
+          "type": "string",
+          "maxLength": 256,
+          "minLength": 1
+        }
+      }
+    },
+    "cluster-template-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-template"
+      }
+    },
     "cluster-validation-id": {
       "type": "string",
       "enum": [
@@ -15740,14 +21113,63 @@ func init() {
         "console_url": {
           "type": "string"
         },
-        "password": {
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "deleted-cluster": {
+      "type": "object",
+      "required": [
+        "id",
+        "deleted_at",
+        "restorable_until"
+      ],
+      "properties": {
+        "deleted_at": {
+          "description": "The time the cluster was deregistered.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "description": "Unique identifier of the deregistered cluster.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the deregistered cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "username": {
+        "org_id": {
+          "type": "string"
+        },
+        "restorable_until": {
+          "description": "The time after which the cluster is permanently deleted and can no longer be restored.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "Status of the cluster when it was deregistered.",
+          "type": "string"
+        },
+        "user_name": {
           "type": "string"
         }
       }
     },
+    "deleted-cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/deleted-cluster"
+      }
+    },
     "dhcp_allocation_request": {
       "type": "object",
       "required": [
@@ -16058,148 +21480,477 @@ func init() {
           "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
           "type": "string"
         },
-        "initrd_url": {
-          "description": "URL address to download the initrd.",
-          "type": "string"
+        "initrd_url": {
+          "description": "URL address to download the initrd.",
+          "type": "string"
+        },
+        "kernel_url": {
+          "description": "URL address to download the kernel.",
+          "type": "string"
+        },
+        "rootfs_url": {
+          "description": "URL address to download the rootfs.",
+          "type": "string"
+        }
+      }
+    },
+    "drive_type": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "HDD",
+        "FDD",
+        "ODD",
+        "SSD",
+        "virtual",
+        "Multipath",
+        "iSCSI",
+        "FC",
+        "LVM"
+      ]
+    },
+    "dry-run-artifact": {
+      "type": "object",
+      "required": [
+        "name",
+        "stage",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "The content of the file.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The path of the file relative to the installation directory, for example openshift/50_openshift-lso_ns.yaml or master.ign.",
+          "type": "string"
+        },
+        "stage": {
+          "$ref": "#/definitions/dry-run-stage-name"
+        }
+      }
+    },
+    "dry-run-result": {
+      "type": "object",
+      "required": [
+        "succeeded",
+        "stages",
+        "artifacts"
+      ],
+      "properties": {
+        "artifacts": {
+          "description": "The generated files. The credentials of the throw-away installation are not returned.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dry-run-artifact"
+          }
+        },
+        "stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dry-run-stage"
+          }
+        },
+        "succeeded": {
+          "description": "Whether all the stages succeeded.",
+          "type": "boolean"
+        }
+      }
+    },
+    "dry-run-stage": {
+      "type": "object",
+      "required": [
+        "name",
+        "status"
+      ],
+      "properties": {
+        "error": {
+          "description": "The error that failed the stage.",
+          "type": "string"
+        },
+        "name": {
+          "$ref": "#/definitions/dry-run-stage-name"
+        },
+        "status": {
+          "description": "The outcome of the stage. A stage is skipped when a stage it depends on failed.",
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed",
+            "skipped"
+          ]
+        }
+      }
+    },
+    "dry-run-stage-name": {
+      "description": "A stage of the generation of the installation files.",
+      "type": "string",
+      "enum": [
+        "install-config",
+        "operator-manifests",
+        "ignition"
+      ]
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
+          "type": "string",
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
+        },
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index;index:events_cluster_id_event_time_idx,priority:1\"",
+          "x-nullable": true
+        },
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index:events_cluster_id_event_time_idx,priority:2;index:events_host_id_event_time_idx,priority:2;index:events_infra_env_id_event_time_idx,priority:2\""
+        },
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index;index:events_host_id_event_time_idx,priority:1\"",
+          "x-nullable": true
+        },
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index;index:events_infra_env_id_event_time_idx,priority:1\"",
+          "x-nullable": true
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "name": {
+          "description": "Event Name.",
+          "type": "string"
+        },
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "event-subscription": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "url"
+      ],
+      "properties": {
+        "categories": {
+          "description": "Only deliver the events of one of these categories. Only user events are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "cluster_id": {
+          "description": "Only deliver the events of this cluster, its hosts and infra-envs.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "created_at": {
+          "description": "The time that the event subscription was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "enabled": {
+          "description": "Whether events are delivered to the subscription.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "event_names": {
+          "description": "Only deliver the events with one of these names. All events are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "id": {
+          "description": "Unique identifier of the event subscription.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "name": {
+          "description": "Name of the event subscription.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization of the user that created the event subscription. Only the events of this organization are delivered, unless the subscription was created by an admin.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "severities": {
+          "description": "Only deliver the events with one of these severities. All severities are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "signed": {
+          "description": "Whether the delivered events are signed with the secret of the subscription.",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "kernel_url": {
-          "description": "URL address to download the kernel.",
-          "type": "string"
+        "updated_at": {
+          "description": "The last time that the event subscription was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "rootfs_url": {
-          "description": "URL address to download the rootfs.",
+        "url": {
+          "description": "The HTTP or HTTPS endpoint that the events are delivered to.",
           "type": "string"
+        },
+        "user_name": {
+          "description": "The user that created the event subscription.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "drive_type": {
-      "type": "string",
-      "enum": [
-        "Unknown",
-        "HDD",
-        "FDD",
-        "ODD",
-        "SSD",
-        "virtual",
-        "Multipath",
-        "iSCSI",
-        "FC",
-        "LVM"
-      ]
-    },
-    "error": {
+    "event-subscription-create-params": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
+        "name",
+        "url"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
+        "categories": {
+          "description": "Only deliver the events of one of these categories. Only user events are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "user",
+              "metrics"
+            ]
+          }
         },
-        "href": {
-          "description": "Self link.",
+        "cluster_id": {
+          "description": "Only deliver the events of this cluster, its hosts and infra-envs.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "enabled": {
+          "description": "Whether events are delivered to the subscription.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        },
+        "event_names": {
+          "description": "Only deliver the events with one of these names. All events are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the event subscription.",
           "type": "string"
         },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+        "secret": {
+          "description": "A secret that signs the delivered events with HMAC-SHA256, in the X-Assisted-Signature header.",
+          "type": "string"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
-          "type": "string",
-          "enum": [
-            "Error"
-          ]
+        "severities": {
+          "description": "Only deliver the events with one of these severities. All severities are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "info",
+              "warning",
+              "error",
+              "critical"
+            ]
+          }
         },
-        "reason": {
-          "description": "Human-readable description of the error.",
+        "url": {
+          "description": "The HTTP or HTTPS endpoint that the events are delivered to.",
           "type": "string"
         }
       }
     },
-    "event": {
+    "event-subscription-delivery": {
       "type": "object",
       "required": [
-        "severity",
-        "message",
-        "event_time"
+        "id",
+        "subscription_id",
+        "status"
       ],
       "properties": {
-        "category": {
-          "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
+        "attempts": {
+          "description": "The number of delivery attempts.",
+          "type": "integer",
+          "format": "int64"
         },
         "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
+          "description": "The cluster of the delivered event.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
           "x-nullable": true
         },
-        "event_time": {
+        "created_at": {
+          "description": "The time that the event was queued for delivery.",
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+        "error": {
+          "description": "The error of the last attempt.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "infra_env_id": {
-          "description": "Unique identifier of the infra-env this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+        "event_id": {
+          "description": "The identifier of the delivered event, which is also the id of the CloudEvent.",
+          "type": "integer",
+          "format": "int64"
         },
-        "message": {
+        "event_name": {
+          "description": "The name of the delivered event.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the delivery.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement\""
+        },
+        "last_attempt_at": {
+          "description": "The time of the last delivery attempt.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "Event Name.",
-          "type": "string"
+        "next_attempt_at": {
+          "description": "The time of the next delivery attempt of a pending delivery.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
+        "payload": {
+          "description": "The CloudEvent that is delivered, in JSON format.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
-          "type": "string",
-          "format": "uuid"
+        "response_code": {
+          "description": "The HTTP status code returned by the endpoint on the last attempt.",
+          "type": "integer",
+          "format": "int64"
         },
-        "severity": {
+        "status": {
+          "description": "Pending deliveries are retried with a backoff until they are delivered, or fail after too many attempts.",
           "type": "string",
           "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "subscription_id": {
+          "description": "The event subscription of the delivery.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "event-list": {
+    "event-subscription-delivery-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/event"
+        "$ref": "#/definitions/event-subscription-delivery"
+      }
+    },
+    "event-subscription-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event-subscription"
       }
     },
     "feature-support-level": {
@@ -16487,6 +22238,12 @@ func init() {
         "requested_hostname": {
           "type": "string"
         },
+        "resource_version": {
+          "description": "Incremented on each update of the host through its update API, and returned as its ETag.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"default:1\""
+        },
         "role": {
           "$ref": "#/definitions/host-role"
         },
@@ -16577,33 +22334,155 @@ func init() {
         },
         "user_name": {
           "type": "string"
-        },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "host-create-params": {
+      "type": "object",
+      "required": [
+        "host_id"
+      ],
+      "properties": {
+        "discovery_agent_version": {
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "host-diagnostic": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/host-diagnostic-action"
+        },
+        "completed_at": {
+          "description": "The time at which the agent uploaded the diagnostic result.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "error": {
+          "description": "The standard error of the diagnostic command, truncated to a size limit.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "exit_code": {
+          "description": "The exit code of the diagnostic command.",
+          "type": "integer"
+        },
+        "host_id": {
+          "description": "The host that the diagnostic runs on.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "id": {
+          "description": "Unique identifier of the diagnostic.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env of the host.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "output": {
+          "description": "The standard output of the diagnostic command, truncated to a size limit.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "requested_at": {
+          "description": "The time at which the diagnostic was requested.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "requested_by": {
+          "description": "The user that requested the diagnostic.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The state of the diagnostic.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "sent",
+            "completed",
+            "failed"
+          ]
+        },
+        "step_id": {
+          "description": "The identifier of the step that delivered the diagnostic to the agent.",
+          "type": "string"
+        }
+      }
+    },
+    "host-diagnostic-action": {
+      "description": "A diagnostic action from the service allowlist. Each action maps to a fixed, read-only command\nthat the agent runs on the host.\n",
+      "type": "string",
+      "enum": [
+        "ip-addresses",
+        "ip-routes",
+        "block-devices",
+        "journal",
+        "kernel-ring-buffer",
+        "time-sources"
+      ]
+    },
+    "host-diagnostic-create-params": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/host-diagnostic-action"
+        }
+      }
+    },
+    "host-diagnostic-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-diagnostic"
+      }
+    },
+    "host-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
         }
       }
     },
-    "host-create-params": {
+    "host-installation-timeline": {
       "type": "object",
-      "required": [
-        "host_id"
-      ],
       "properties": {
-        "discovery_agent_version": {
-          "type": "string"
+        "bootstrap": {
+          "type": "boolean"
         },
         "host_id": {
           "type": "string",
           "format": "uuid"
-        }
-      }
-    },
-    "host-ignition-params": {
-      "properties": {
-        "config": {
+        },
+        "host_name": {
           "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-segment"
+          }
         }
       }
     },
@@ -16631,6 +22510,13 @@ func init() {
         "current_stage": {
           "$ref": "#/definitions/host-stage"
         },
+        "estimated_completion_at": {
+          "description": "Estimated time at which the host completes its installation, based on the stage durations of previous installations with the same OpenShift version, host role and topology.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
         "installation_percentage": {
           "type": "integer"
         },
@@ -16687,6 +22573,27 @@ func init() {
         "Failed"
       ]
     },
+    "host-stage-timeout": {
+      "type": "object",
+      "required": [
+        "stage",
+        "timeout"
+      ],
+      "properties": {
+        "role": {
+          "description": "The role of the hosts the timeout applies to. Applies to all the roles when not set.",
+          "$ref": "#/definitions/host-role"
+        },
+        "stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "timeout": {
+          "description": "The maximum time, in seconds, a host may spend in the stage before its installation fails.",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
     "host-type-hardware-requirements": {
       "type": "object",
       "properties": {
@@ -16809,6 +22716,74 @@ func init() {
         "service-has-sufficient-spoke-kube-api-access"
       ]
     },
+    "host-validation-stats": {
+      "type": "object",
+      "properties": {
+        "first_succeeded_at": {
+          "description": "The time at which the validation succeeded for the first time, if it did.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "flapping": {
+          "description": "Whether the validation is flapping, changing between success and failure more times than the threshold\nwithin the flapping window.\n",
+          "type": "boolean"
+        },
+        "flip_count": {
+          "description": "The number of times the validation changed between success and failure.",
+          "type": "integer"
+        },
+        "host_id": {
+          "description": "The host of the validation.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env of the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "last_flipped_at": {
+          "description": "The last time at which the validation changed between success and failure.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "recent_flips": {
+          "description": "The times at which the validation changed between success and failure within the flapping window.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "date-time"
+          },
+          "x-go-custom-tag": "gorm:\"type:text;serializer:json\""
+        },
+        "status": {
+          "description": "The last status of the validation.",
+          "type": "string"
+        },
+        "time_to_success_seconds": {
+          "description": "How long the validation took to succeed for the first time after the host was discovered.",
+          "type": "number",
+          "format": "double"
+        },
+        "validation_id": {
+          "description": "The validation, such as has-min-cpu-cores.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        }
+      }
+    },
+    "host-validation-stats-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-validation-stats"
+      }
+    },
     "host_network": {
       "type": "object",
       "properties": {
@@ -17026,6 +23001,12 @@ func init() {
             "type": "Time"
           }
         },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "default": false,
+          "x-go-custom-tag": "gorm:\"default:false\""
+        },
         "download_url": {
           "type": "string"
         },
@@ -17080,6 +23061,12 @@ func init() {
           "description": "True if the pull secret has been added to the cluster.",
           "type": "boolean"
         },
+        "resource_version": {
+          "description": "Incremented on each update of the infra-env through its update API, and returned as its ETag.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"default:1\""
+        },
         "size_bytes": {
           "type": "integer",
           "minimum": 0
@@ -17139,6 +23126,11 @@ func init() {
           "default": "x86_64",
           "x-nullable": false
         },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "ignition_config_override": {
           "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
@@ -17188,6 +23180,11 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the infra-env, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "ignition_config_override": {
           "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
@@ -17238,6 +23235,62 @@ func init() {
     "ingress-cert-params": {
       "type": "string"
     },
+    "install-config-override-version": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that the install config overrides belong to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "created_at": {
+          "description": "The time at which the install config overrides were set.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "user_name": {
+          "description": "The user that set the install config overrides.",
+          "type": "string"
+        },
+        "version": {
+          "description": "The version of the install config overrides, starting from 1.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primaryKey;autoIncrement:false\""
+        }
+      }
+    },
+    "install-config-override-version-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/install-config-override-version"
+      }
+    },
+    "install-schedule": {
+      "type": "object",
+      "required": [
+        "start_at"
+      ],
+      "properties": {
+        "end_at": {
+          "description": "The time after which the installation is no longer allowed to start. If the cluster is not ready to be\ninstalled before this time, the scheduled installation expires. When not set, the window never ends.\n",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "start_at": {
+          "description": "The time from which the installation is allowed to start.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "install_cmd_request": {
       "type": "object",
       "required": [
@@ -17339,6 +23392,78 @@ func init() {
         }
       }
     },
+    "installation-timeline": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "critical_path": {
+          "description": "The stages that determined the installation duration, starting with the stages of the bootstrap host,\nfollowed by the stages of the last host to finish after the bootstrap host and the cluster finalization.\n",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-segment"
+          }
+        },
+        "hosts": {
+          "description": "The installation stages of each host, in the order the hosts started installing.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-installation-timeline"
+          }
+        },
+        "install_completed_at": {
+          "description": "The time that the cluster completed installation, if it did.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "install_started_at": {
+          "description": "The time that the cluster started installation.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "slowest_hosts": {
+          "description": "The slowest host of each installation stage.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-segment"
+          }
+        }
+      }
+    },
+    "installation-timeline-segment": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "description": "The duration of the stage, up to now if the stage is still in progress.",
+          "type": "number"
+        },
+        "ended_at": {
+          "description": "The time the stage ended, empty if the stage is still in progress.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "host_id": {
+          "description": "The host that was in the stage, empty for cluster stages.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "host_name": {
+          "type": "string"
+        },
+        "stage": {
+          "description": "The installation stage of the host, or Finalizing for the cluster finalization.",
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "installer-args-params": {
       "type": "object",
       "properties": {
@@ -18227,6 +24352,72 @@ func init() {
         "unreachable"
       ]
     },
+    "state-transition": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster of the object, if any.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "from_state": {
+          "description": "The status that the object left, empty when the object was created by the transition.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the transition.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env of the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "object_id": {
+          "description": "The host or cluster whose status changed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "object_kind": {
+          "description": "The kind of the object whose status changed.",
+          "type": "string",
+          "enum": [
+            "host",
+            "cluster"
+          ]
+        },
+        "time_in_state_seconds": {
+          "description": "How long the object was in the status that it left.",
+          "type": "number",
+          "format": "double"
+        },
+        "to_state": {
+          "description": "The status that the object reached.",
+          "type": "string"
+        },
+        "transitioned_at": {
+          "description": "The time at which the status changed.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "trigger": {
+          "description": "The state machine transition, or the operation, that changed the status.",
+          "type": "string"
+        }
+      }
+    },
+    "state-transition-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/state-transition"
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -18461,11 +24652,22 @@ func init() {
           },
           "x-nullable": true
         },
+        "deletion_protected": {
+          "description": "Prevents the deletion of the cluster, through the API and the garbage collector, until it is set back to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "disk_encryption": {
           "description": "Installation disks encryption mode and host roles to be applied.",
           "type": "object",
           "$ref": "#/definitions/disk-encryption"
         },
+        "host_stage_timeouts": {
+          "description": "JSON-formatted list of host-stage-timeout, overriding the maximum time the hosts of the cluster may spend in an installation stage.",
+          "type": "string",
+          "x-nullable": true,
+          "example": "[{\"stage\": \"Writing image to disk\", \"timeout\": 600}, {\"stage\": \"Waiting for control plane\", \"role\": \"master\", \"timeout\": 7200}]"
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
@@ -18663,10 +24865,22 @@ func init() {
       "description": "Agent-driven installation",
       "name": "Assisted installation"
     },
+    {
+      "description": "Audit log of the changes made to clusters, hosts and infra-envs.",
+      "name": "audit"
+    },
     {
       "description": "Events related to a cluster installation.",
       "name": "events"
     },
+    {
+      "description": "Reusable templates for registering clusters.",
+      "name": "cluster_templates"
+    },
+    {
+      "description": "Subscriptions that deliver events to HTTP endpoints.",
+      "name": "event_subscriptions"
+    },
     {
       "description": "General OpenShift cluster installation APIs.",
       "name": "installer"
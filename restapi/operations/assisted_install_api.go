@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,6 +20,9 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/openshift/assisted-service/restapi/operations/event_subscriptions"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
@@ -50,6 +54,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
 		InstallerBindHostHandler: installer.BindHostHandlerFunc(func(params installer.BindHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.BindHost has not yet been implemented")
@@ -144,12 +151,24 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadLogsHandler: installer.V2UploadLogsHandlerFunc(func(params installer.V2UploadLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadLogs has not yet been implemented")
 		}),
+		InstallerV2CancelInstallScheduleHandler: installer.V2CancelInstallScheduleHandlerFunc(func(params installer.V2CancelInstallScheduleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2CancelInstallSchedule has not yet been implemented")
+		}),
 		InstallerV2CompleteInstallationHandler: installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CompleteInstallation has not yet been implemented")
 		}),
+		InstallerV2CreateHostDiagnosticHandler: installer.V2CreateHostDiagnosticHandlerFunc(func(params installer.V2CreateHostDiagnosticParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2CreateHostDiagnostic has not yet been implemented")
+		}),
 		InstallerV2DeregisterClusterHandler: installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterCluster has not yet been implemented")
 		}),
+		ClusterTemplatesV2DeregisterClusterTemplateHandler: cluster_templates.V2DeregisterClusterTemplateHandlerFunc(func(params cluster_templates.V2DeregisterClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2DeregisterClusterTemplate has not yet been implemented")
+		}),
+		EventSubscriptionsV2DeregisterEventSubscriptionHandler: event_subscriptions.V2DeregisterEventSubscriptionHandlerFunc(func(params event_subscriptions.V2DeregisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_subscriptions.V2DeregisterEventSubscription has not yet been implemented")
+		}),
 		InstallerV2DeregisterHostHandler: installer.V2DeregisterHostHandlerFunc(func(params installer.V2DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterHost has not yet been implemented")
 		}),
@@ -162,15 +181,33 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2DownloadInfraEnvFilesHandler: installer.V2DownloadInfraEnvFilesHandlerFunc(func(params installer.V2DownloadInfraEnvFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadInfraEnvFiles has not yet been implemented")
 		}),
+		InstallerV2DryRunInstallationHandler: installer.V2DryRunInstallationHandlerFunc(func(params installer.V2DryRunInstallationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DryRunInstallation has not yet been implemented")
+		}),
 		InstallerV2GetClusterHandler: installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCluster has not yet been implemented")
 		}),
 		InstallerV2GetClusterInstallConfigHandler: installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerV2GetClusterInstallConfigDiffHandler: installer.V2GetClusterInstallConfigDiffHandlerFunc(func(params installer.V2GetClusterInstallConfigDiffParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterInstallConfigDiff has not yet been implemented")
+		}),
+		InstallerV2GetClusterInstallationTimelineHandler: installer.V2GetClusterInstallationTimelineHandlerFunc(func(params installer.V2GetClusterInstallationTimelineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterInstallationTimeline has not yet been implemented")
+		}),
+		ClusterTemplatesV2GetClusterTemplateHandler: cluster_templates.V2GetClusterTemplateHandlerFunc(func(params cluster_templates.V2GetClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2GetClusterTemplate has not yet been implemented")
+		}),
+		EventSubscriptionsV2GetEventSubscriptionHandler: event_subscriptions.V2GetEventSubscriptionHandlerFunc(func(params event_subscriptions.V2GetEventSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_subscriptions.V2GetEventSubscription has not yet been implemented")
+		}),
 		InstallerV2GetHostHandler: installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHost has not yet been implemented")
 		}),
+		InstallerV2GetHostDiagnosticHandler: installer.V2GetHostDiagnosticHandlerFunc(func(params installer.V2GetHostDiagnosticParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetHostDiagnostic has not yet been implemented")
+		}),
 		InstallerV2GetHostIgnitionHandler: installer.V2GetHostIgnitionHandlerFunc(func(params installer.V2GetHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHostIgnition has not yet been implemented")
 		}),
@@ -189,18 +226,48 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2InstallHostHandler: installer.V2InstallHostHandlerFunc(func(params installer.V2InstallHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallHost has not yet been implemented")
 		}),
+		AuditV2ListAuditLogHandler: audit.V2ListAuditLogHandlerFunc(func(params audit.V2ListAuditLogParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation audit.V2ListAuditLog has not yet been implemented")
+		}),
+		InstallerV2ListClusterInstallConfigHistoryHandler: installer.V2ListClusterInstallConfigHistoryHandlerFunc(func(params installer.V2ListClusterInstallConfigHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListClusterInstallConfigHistory has not yet been implemented")
+		}),
+		InstallerV2ListClusterStateTransitionsHandler: installer.V2ListClusterStateTransitionsHandlerFunc(func(params installer.V2ListClusterStateTransitionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListClusterStateTransitions has not yet been implemented")
+		}),
+		ClusterTemplatesV2ListClusterTemplatesHandler: cluster_templates.V2ListClusterTemplatesHandlerFunc(func(params cluster_templates.V2ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2ListClusterTemplates has not yet been implemented")
+		}),
 		InstallerV2ListClustersHandler: installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListClusters has not yet been implemented")
 		}),
 		VersionsV2ListComponentVersionsHandler: versions.V2ListComponentVersionsHandlerFunc(func(params versions.V2ListComponentVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListComponentVersions has not yet been implemented")
 		}),
+		InstallerV2ListDeletedClustersHandler: installer.V2ListDeletedClustersHandlerFunc(func(params installer.V2ListDeletedClustersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListDeletedClusters has not yet been implemented")
+		}),
+		EventSubscriptionsV2ListEventSubscriptionDeliveriesHandler: event_subscriptions.V2ListEventSubscriptionDeliveriesHandlerFunc(func(params event_subscriptions.V2ListEventSubscriptionDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_subscriptions.V2ListEventSubscriptionDeliveries has not yet been implemented")
+		}),
+		EventSubscriptionsV2ListEventSubscriptionsHandler: event_subscriptions.V2ListEventSubscriptionsHandlerFunc(func(params event_subscriptions.V2ListEventSubscriptionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_subscriptions.V2ListEventSubscriptions has not yet been implemented")
+		}),
 		EventsV2ListEventsHandler: events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2ListEvents has not yet been implemented")
 		}),
 		InstallerV2ListFeatureSupportLevelsHandler: installer.V2ListFeatureSupportLevelsHandlerFunc(func(params installer.V2ListFeatureSupportLevelsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListFeatureSupportLevels has not yet been implemented")
 		}),
+		InstallerV2ListHostDiagnosticsHandler: installer.V2ListHostDiagnosticsHandlerFunc(func(params installer.V2ListHostDiagnosticsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListHostDiagnostics has not yet been implemented")
+		}),
+		InstallerV2ListHostStateTransitionsHandler: installer.V2ListHostStateTransitionsHandlerFunc(func(params installer.V2ListHostStateTransitionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListHostStateTransitions has not yet been implemented")
+		}),
+		InstallerV2ListHostValidationStatsHandler: installer.V2ListHostValidationStatsHandlerFunc(func(params installer.V2ListHostValidationStatsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListHostValidationStats has not yet been implemented")
+		}),
 		InstallerV2ListHostsHandler: installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListHosts has not yet been implemented")
 		}),
@@ -213,9 +280,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2RegisterClusterHandler: installer.V2RegisterClusterHandlerFunc(func(params installer.V2RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterCluster has not yet been implemented")
 		}),
+		ClusterTemplatesV2RegisterClusterTemplateHandler: cluster_templates.V2RegisterClusterTemplateHandlerFunc(func(params cluster_templates.V2RegisterClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2RegisterClusterTemplate has not yet been implemented")
+		}),
+		EventSubscriptionsV2RegisterEventSubscriptionHandler: event_subscriptions.V2RegisterEventSubscriptionHandlerFunc(func(params event_subscriptions.V2RegisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_subscriptions.V2RegisterEventSubscription has not yet been implemented")
+		}),
 		InstallerV2RegisterHostHandler: installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterHost has not yet been implemented")
 		}),
+		InstallerV2ReinstallFailedHostsHandler: installer.V2ReinstallFailedHostsHandlerFunc(func(params installer.V2ReinstallFailedHostsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ReinstallFailedHosts has not yet been implemented")
+		}),
 		OperatorsV2ReportMonitoredOperatorStatusHandler: operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
@@ -228,12 +304,24 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ResetHostValidationHandler: installer.V2ResetHostValidationHandlerFunc(func(params installer.V2ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ResetHostValidation has not yet been implemented")
 		}),
+		InstallerV2RestoreDeletedClusterHandler: installer.V2RestoreDeletedClusterHandlerFunc(func(params installer.V2RestoreDeletedClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2RestoreDeletedCluster has not yet been implemented")
+		}),
+		EventsV2StreamEventsHandler: events.V2StreamEventsHandlerFunc(func(params events.V2StreamEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.V2StreamEvents has not yet been implemented")
+		}),
 		InstallerV2UpdateClusterInstallConfigHandler: installer.V2UpdateClusterInstallConfigHandlerFunc(func(params installer.V2UpdateClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateClusterInstallConfig has not yet been implemented")
 		}),
 		InstallerV2UpdateClusterLogsProgressHandler: installer.V2UpdateClusterLogsProgressHandlerFunc(func(params installer.V2UpdateClusterLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateClusterLogsProgress has not yet been implemented")
 		}),
+		ClusterTemplatesV2UpdateClusterTemplateHandler: cluster_templates.V2UpdateClusterTemplateHandlerFunc(func(params cluster_templates.V2UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.V2UpdateClusterTemplate has not yet been implemented")
+		}),
+		EventSubscriptionsV2UpdateEventSubscriptionHandler: event_subscriptions.V2UpdateEventSubscriptionHandlerFunc(func(params event_subscriptions.V2UpdateEventSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_subscriptions.V2UpdateEventSubscription has not yet been implemented")
+		}),
 		InstallerV2UpdateHostHandler: installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateHost has not yet been implemented")
 		}),
@@ -316,6 +404,9 @@ type AssistedInstallAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// AgentAuthAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key X-Secret-Key provided in the header
@@ -402,10 +493,18 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateClusterHandler installer.V2UpdateClusterHandler
 	// InstallerV2UploadLogsHandler sets the operation handler for the v2 upload logs operation
 	InstallerV2UploadLogsHandler installer.V2UploadLogsHandler
+	// InstallerV2CancelInstallScheduleHandler sets the operation handler for the v2 cancel install schedule operation
+	InstallerV2CancelInstallScheduleHandler installer.V2CancelInstallScheduleHandler
 	// InstallerV2CompleteInstallationHandler sets the operation handler for the v2 complete installation operation
 	InstallerV2CompleteInstallationHandler installer.V2CompleteInstallationHandler
+	// InstallerV2CreateHostDiagnosticHandler sets the operation handler for the v2 create host diagnostic operation
+	InstallerV2CreateHostDiagnosticHandler installer.V2CreateHostDiagnosticHandler
 	// InstallerV2DeregisterClusterHandler sets the operation handler for the v2 deregister cluster operation
 	InstallerV2DeregisterClusterHandler installer.V2DeregisterClusterHandler
+	// ClusterTemplatesV2DeregisterClusterTemplateHandler sets the operation handler for the v2 deregister cluster template operation
+	ClusterTemplatesV2DeregisterClusterTemplateHandler cluster_templates.V2DeregisterClusterTemplateHandler
+	// EventSubscriptionsV2DeregisterEventSubscriptionHandler sets the operation handler for the v2 deregister event subscription operation
+	EventSubscriptionsV2DeregisterEventSubscriptionHandler event_subscriptions.V2DeregisterEventSubscriptionHandler
 	// InstallerV2DeregisterHostHandler sets the operation handler for the v2 deregister host operation
 	InstallerV2DeregisterHostHandler installer.V2DeregisterHostHandler
 	// ManifestsV2DownloadClusterManifestHandler sets the operation handler for the v2 download cluster manifest operation
@@ -414,12 +513,24 @@ type AssistedInstallAPI struct {
 	InstallerV2DownloadHostIgnitionHandler installer.V2DownloadHostIgnitionHandler
 	// InstallerV2DownloadInfraEnvFilesHandler sets the operation handler for the v2 download infra env files operation
 	InstallerV2DownloadInfraEnvFilesHandler installer.V2DownloadInfraEnvFilesHandler
+	// InstallerV2DryRunInstallationHandler sets the operation handler for the v2 dry run installation operation
+	InstallerV2DryRunInstallationHandler installer.V2DryRunInstallationHandler
 	// InstallerV2GetClusterHandler sets the operation handler for the v2 get cluster operation
 	InstallerV2GetClusterHandler installer.V2GetClusterHandler
 	// InstallerV2GetClusterInstallConfigHandler sets the operation handler for the v2 get cluster install config operation
 	InstallerV2GetClusterInstallConfigHandler installer.V2GetClusterInstallConfigHandler
+	// InstallerV2GetClusterInstallConfigDiffHandler sets the operation handler for the v2 get cluster install config diff operation
+	InstallerV2GetClusterInstallConfigDiffHandler installer.V2GetClusterInstallConfigDiffHandler
+	// InstallerV2GetClusterInstallationTimelineHandler sets the operation handler for the v2 get cluster installation timeline operation
+	InstallerV2GetClusterInstallationTimelineHandler installer.V2GetClusterInstallationTimelineHandler
+	// ClusterTemplatesV2GetClusterTemplateHandler sets the operation handler for the v2 get cluster template operation
+	ClusterTemplatesV2GetClusterTemplateHandler cluster_templates.V2GetClusterTemplateHandler
+	// EventSubscriptionsV2GetEventSubscriptionHandler sets the operation handler for the v2 get event subscription operation
+	EventSubscriptionsV2GetEventSubscriptionHandler event_subscriptions.V2GetEventSubscriptionHandler
 	// InstallerV2GetHostHandler sets the operation handler for the v2 get host operation
 	InstallerV2GetHostHandler installer.V2GetHostHandler
+	// InstallerV2GetHostDiagnosticHandler sets the operation handler for the v2 get host diagnostic operation
+	InstallerV2GetHostDiagnosticHandler installer.V2GetHostDiagnosticHandler
 	// InstallerV2GetHostIgnitionHandler sets the operation handler for the v2 get host ignition operation
 	InstallerV2GetHostIgnitionHandler installer.V2GetHostIgnitionHandler
 	// InstallerV2GetNextStepsHandler sets the operation handler for the v2 get next steps operation
@@ -432,14 +543,34 @@ type AssistedInstallAPI struct {
 	InstallerV2InstallClusterHandler installer.V2InstallClusterHandler
 	// InstallerV2InstallHostHandler sets the operation handler for the v2 install host operation
 	InstallerV2InstallHostHandler installer.V2InstallHostHandler
+	// AuditV2ListAuditLogHandler sets the operation handler for the v2 list audit log operation
+	AuditV2ListAuditLogHandler audit.V2ListAuditLogHandler
+	// InstallerV2ListClusterInstallConfigHistoryHandler sets the operation handler for the v2 list cluster install config history operation
+	InstallerV2ListClusterInstallConfigHistoryHandler installer.V2ListClusterInstallConfigHistoryHandler
+	// InstallerV2ListClusterStateTransitionsHandler sets the operation handler for the v2 list cluster state transitions operation
+	InstallerV2ListClusterStateTransitionsHandler installer.V2ListClusterStateTransitionsHandler
+	// ClusterTemplatesV2ListClusterTemplatesHandler sets the operation handler for the v2 list cluster templates operation
+	ClusterTemplatesV2ListClusterTemplatesHandler cluster_templates.V2ListClusterTemplatesHandler
 	// InstallerV2ListClustersHandler sets the operation handler for the v2 list clusters operation
 	InstallerV2ListClustersHandler installer.V2ListClustersHandler
 	// VersionsV2ListComponentVersionsHandler sets the operation handler for the v2 list component versions operation
 	VersionsV2ListComponentVersionsHandler versions.V2ListComponentVersionsHandler
+	// InstallerV2ListDeletedClustersHandler sets the operation handler for the v2 list deleted clusters operation
+	InstallerV2ListDeletedClustersHandler installer.V2ListDeletedClustersHandler
+	// EventSubscriptionsV2ListEventSubscriptionDeliveriesHandler sets the operation handler for the v2 list event subscription deliveries operation
+	EventSubscriptionsV2ListEventSubscriptionDeliveriesHandler event_subscriptions.V2ListEventSubscriptionDeliveriesHandler
+	// EventSubscriptionsV2ListEventSubscriptionsHandler sets the operation handler for the v2 list event subscriptions operation
+	EventSubscriptionsV2ListEventSubscriptionsHandler event_subscriptions.V2ListEventSubscriptionsHandler
 	// EventsV2ListEventsHandler sets the operation handler for the v2 list events operation
 	EventsV2ListEventsHandler events.V2ListEventsHandler
 	// InstallerV2ListFeatureSupportLevelsHandler sets the operation handler for the v2 list feature support levels operation
 	InstallerV2ListFeatureSupportLevelsHandler installer.V2ListFeatureSupportLevelsHandler
+	// InstallerV2ListHostDiagnosticsHandler sets the operation handler for the v2 list host diagnostics operation
+	InstallerV2ListHostDiagnosticsHandler installer.V2ListHostDiagnosticsHandler
+	// InstallerV2ListHostStateTransitionsHandler sets the operation handler for the v2 list host state transitions operation
+	InstallerV2ListHostStateTransitionsHandler installer.V2ListHostStateTransitionsHandler
+	// InstallerV2ListHostValidationStatsHandler sets the operation handler for the v2 list host validation stats operation
+	InstallerV2ListHostValidationStatsHandler installer.V2ListHostValidationStatsHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
 	InstallerV2ListHostsHandler installer.V2ListHostsHandler
 	// VersionsV2ListSupportedOpenshiftVersionsHandler sets the operation handler for the v2 list supported openshift versions operation
@@ -448,8 +579,14 @@ type AssistedInstallAPI struct {
 	InstallerV2PostStepReplyHandler installer.V2PostStepReplyHandler
 	// InstallerV2RegisterClusterHandler sets the operation handler for the v2 register cluster operation
 	InstallerV2RegisterClusterHandler installer.V2RegisterClusterHandler
+	// ClusterTemplatesV2RegisterClusterTemplateHandler sets the operation handler for the v2 register cluster template operation
+	ClusterTemplatesV2RegisterClusterTemplateHandler cluster_templates.V2RegisterClusterTemplateHandler
+	// EventSubscriptionsV2RegisterEventSubscriptionHandler sets the operation handler for the v2 register event subscription operation
+	EventSubscriptionsV2RegisterEventSubscriptionHandler event_subscriptions.V2RegisterEventSubscriptionHandler
 	// InstallerV2RegisterHostHandler sets the operation handler for the v2 register host operation
 	InstallerV2RegisterHostHandler installer.V2RegisterHostHandler
+	// InstallerV2ReinstallFailedHostsHandler sets the operation handler for the v2 reinstall failed hosts operation
+	InstallerV2ReinstallFailedHostsHandler installer.V2ReinstallFailedHostsHandler
 	// OperatorsV2ReportMonitoredOperatorStatusHandler sets the operation handler for the v2 report monitored operator status operation
 	OperatorsV2ReportMonitoredOperatorStatusHandler operators.V2ReportMonitoredOperatorStatusHandler
 	// InstallerV2ResetClusterHandler sets the operation handler for the v2 reset cluster operation
@@ -458,10 +595,18 @@ type AssistedInstallAPI struct {
 	InstallerV2ResetHostHandler installer.V2ResetHostHandler
 	// InstallerV2ResetHostValidationHandler sets the operation handler for the v2 reset host validation operation
 	InstallerV2ResetHostValidationHandler installer.V2ResetHostValidationHandler
+	// InstallerV2RestoreDeletedClusterHandler sets the operation handler for the v2 restore deleted cluster operation
+	InstallerV2RestoreDeletedClusterHandler installer.V2RestoreDeletedClusterHandler
+	// EventsV2StreamEventsHandler sets the operation handler for the v2 stream events operation
+	EventsV2StreamEventsHandler events.V2StreamEventsHandler
 	// InstallerV2UpdateClusterInstallConfigHandler sets the operation handler for the v2 update cluster install config operation
 	InstallerV2UpdateClusterInstallConfigHandler installer.V2UpdateClusterInstallConfigHandler
 	// InstallerV2UpdateClusterLogsProgressHandler sets the operation handler for the v2 update cluster logs progress operation
 	InstallerV2UpdateClusterLogsProgressHandler installer.V2UpdateClusterLogsProgressHandler
+	// ClusterTemplatesV2UpdateClusterTemplateHandler sets the operation handler for the v2 update cluster template operation
+	ClusterTemplatesV2UpdateClusterTemplateHandler cluster_templates.V2UpdateClusterTemplateHandler
+	// EventSubscriptionsV2UpdateEventSubscriptionHandler sets the operation handler for the v2 update event subscription operation
+	EventSubscriptionsV2UpdateEventSubscriptionHandler event_subscriptions.V2UpdateEventSubscriptionHandler
 	// InstallerV2UpdateHostHandler sets the operation handler for the v2 update host operation
 	InstallerV2UpdateHostHandler installer.V2UpdateHostHandler
 	// InstallerV2UpdateHostIgnitionHandler sets the operation handler for the v2 update host ignition operation
@@ -556,6 +701,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.AgentAuthAuth == nil {
 		unregistered = append(unregistered, "XSecretKeyAuth")
@@ -666,12 +814,24 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadLogsHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadLogsHandler")
 	}
+	if o.InstallerV2CancelInstallScheduleHandler == nil {
+		unregistered = append(unregistered, "installer.V2CancelInstallScheduleHandler")
+	}
 	if o.InstallerV2CompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CompleteInstallationHandler")
 	}
+	if o.InstallerV2CreateHostDiagnosticHandler == nil {
+		unregistered = append(unregistered, "installer.V2CreateHostDiagnosticHandler")
+	}
 	if o.InstallerV2DeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterClusterHandler")
 	}
+	if o.ClusterTemplatesV2DeregisterClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2DeregisterClusterTemplateHandler")
+	}
+	if o.EventSubscriptionsV2DeregisterEventSubscriptionHandler == nil {
+		unregistered = append(unregistered, "event_subscriptions.V2DeregisterEventSubscriptionHandler")
+	}
 	if o.InstallerV2DeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterHostHandler")
 	}
@@ -684,15 +844,33 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2DownloadInfraEnvFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadInfraEnvFilesHandler")
 	}
+	if o.InstallerV2DryRunInstallationHandler == nil {
+		unregistered = append(unregistered, "installer.V2DryRunInstallationHandler")
+	}
 	if o.InstallerV2GetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterHandler")
 	}
 	if o.InstallerV2GetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterInstallConfigHandler")
 	}
+	if o.InstallerV2GetClusterInstallConfigDiffHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterInstallConfigDiffHandler")
+	}
+	if o.InstallerV2GetClusterInstallationTimelineHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterInstallationTimelineHandler")
+	}
+	if o.ClusterTemplatesV2GetClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2GetClusterTemplateHandler")
+	}
+	if o.EventSubscriptionsV2GetEventSubscriptionHandler == nil {
+		unregistered = append(unregistered, "event_subscriptions.V2GetEventSubscriptionHandler")
+	}
 	if o.InstallerV2GetHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostHandler")
 	}
+	if o.InstallerV2GetHostDiagnosticHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetHostDiagnosticHandler")
+	}
 	if o.InstallerV2GetHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostIgnitionHandler")
 	}
@@ -711,18 +889,48 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2InstallHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallHostHandler")
 	}
+	if o.AuditV2ListAuditLogHandler == nil {
+		unregistered = append(unregistered, "audit.V2ListAuditLogHandler")
+	}
+	if o.InstallerV2ListClusterInstallConfigHistoryHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListClusterInstallConfigHistoryHandler")
+	}
+	if o.InstallerV2ListClusterStateTransitionsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListClusterStateTransitionsHandler")
+	}
+	if o.ClusterTemplatesV2ListClusterTemplatesHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2ListClusterTemplatesHandler")
+	}
 	if o.InstallerV2ListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListClustersHandler")
 	}
 	if o.VersionsV2ListComponentVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListComponentVersionsHandler")
 	}
+	if o.InstallerV2ListDeletedClustersHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListDeletedClustersHandler")
+	}
+	if o.EventSubscriptionsV2ListEventSubscriptionDeliveriesHandler == nil {
+		unregistered = append(unregistered, "event_subscriptions.V2ListEventSubscriptionDeliveriesHandler")
+	}
+	if o.EventSubscriptionsV2ListEventSubscriptionsHandler == nil {
+		unregistered = append(unregistered, "event_subscriptions.V2ListEventSubscriptionsHandler")
+	}
 	if o.EventsV2ListEventsHandler == nil {
 		unregistered = append(unregistered, "events.V2ListEventsHandler")
 	}
 	if o.InstallerV2ListFeatureSupportLevelsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListFeatureSupportLevelsHandler")
 	}
+	if o.InstallerV2ListHostDiagnosticsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListHostDiagnosticsHandler")
+	}
+	if o.InstallerV2ListHostStateTransitionsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListHostStateTransitionsHandler")
+	}
+	if o.InstallerV2ListHostValidationStatsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListHostValidationStatsHandler")
+	}
 	if o.InstallerV2ListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListHostsHandler")
 	}
@@ -735,9 +943,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2RegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterClusterHandler")
 	}
+	if o.ClusterTemplatesV2RegisterClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2RegisterClusterTemplateHandler")
+	}
+	if o.EventSubscriptionsV2RegisterEventSubscriptionHandler == nil {
+		unregistered = append(unregistered, "event_subscriptions.V2RegisterEventSubscriptionHandler")
+	}
 	if o.InstallerV2RegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterHostHandler")
 	}
+	if o.InstallerV2ReinstallFailedHostsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ReinstallFailedHostsHandler")
+	}
 	if o.OperatorsV2ReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.V2ReportMonitoredOperatorStatusHandler")
 	}
@@ -750,12 +967,24 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.V2ResetHostValidationHandler")
 	}
+	if o.InstallerV2RestoreDeletedClusterHandler == nil {
+		unregistered = append(unregistered, "installer.V2RestoreDeletedClusterHandler")
+	}
+	if o.EventsV2StreamEventsHandler == nil {
+		unregistered = append(unregistered, "events.V2StreamEventsHandler")
+	}
 	if o.InstallerV2UpdateClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterInstallConfigHandler")
 	}
 	if o.InstallerV2UpdateClusterLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterLogsProgressHandler")
 	}
+	if o.ClusterTemplatesV2UpdateClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.V2UpdateClusterTemplateHandler")
+	}
+	if o.EventSubscriptionsV2UpdateEventSubscriptionHandler == nil {
+		unregistered = append(unregistered, "event_subscriptions.V2UpdateEventSubscriptionHandler")
+	}
 	if o.InstallerV2UpdateHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateHostHandler")
 	}
@@ -851,6 +1080,8 @@ func (o *AssistedInstallAPI) ProducersFor(mediaTypes []string) map[string]runtim
 			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -1018,7 +1249,15 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/cancel-install-schedule"] = installer.NewV2CancelInstallSchedule(o.context, o.InstallerV2CancelInstallScheduleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/complete-installation"] = installer.NewV2CompleteInstallation(o.context, o.InstallerV2CompleteInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics"] = installer.NewV2CreateHostDiagnostic(o.context, o.InstallerV2CreateHostDiagnosticHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1026,6 +1265,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/v2/cluster-templates/{template_id}"] = cluster_templates.NewV2DeregisterClusterTemplate(o.context, o.ClusterTemplatesV2DeregisterClusterTemplateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/event-subscriptions/{subscription_id}"] = event_subscriptions.NewV2DeregisterEventSubscription(o.context, o.EventSubscriptionsV2DeregisterEventSubscriptionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2DeregisterHost(o.context, o.InstallerV2DeregisterHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1039,6 +1286,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/downloads/files"] = installer.NewV2DownloadInfraEnvFiles(o.context, o.InstallerV2DownloadInfraEnvFilesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/dry-run"] = installer.NewV2DryRunInstallation(o.context, o.InstallerV2DryRunInstallationHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1050,10 +1301,30 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/install-config/diff"] = installer.NewV2GetClusterInstallConfigDiff(o.context, o.InstallerV2GetClusterInstallConfigDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/installation-timeline"] = installer.NewV2GetClusterInstallationTimeline(o.context, o.InstallerV2GetClusterInstallationTimelineHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/cluster-templates/{template_id}"] = cluster_templates.NewV2GetClusterTemplate(o.context, o.ClusterTemplatesV2GetClusterTemplateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/event-subscriptions/{subscription_id}"] = event_subscriptions.NewV2GetEventSubscription(o.context, o.EventSubscriptionsV2GetEventSubscriptionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2GetHost(o.context, o.InstallerV2GetHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id}"] = installer.NewV2GetHostDiagnostic(o.context, o.InstallerV2GetHostDiagnosticHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition"] = installer.NewV2GetHostIgnition(o.context, o.InstallerV2GetHostIgnitionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1078,6 +1349,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/audit-log"] = audit.NewV2ListAuditLog(o.context, o.AuditV2ListAuditLogHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/install-config/history"] = installer.NewV2ListClusterInstallConfigHistory(o.context, o.InstallerV2ListClusterInstallConfigHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/state-transitions"] = installer.NewV2ListClusterStateTransitions(o.context, o.InstallerV2ListClusterStateTransitionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/cluster-templates"] = cluster_templates.NewV2ListClusterTemplates(o.context, o.ClusterTemplatesV2ListClusterTemplatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters"] = installer.NewV2ListClusters(o.context, o.InstallerV2ListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1086,6 +1373,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/deleted-clusters"] = installer.NewV2ListDeletedClusters(o.context, o.InstallerV2ListDeletedClustersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/event-subscriptions/{subscription_id}/deliveries"] = event_subscriptions.NewV2ListEventSubscriptionDeliveries(o.context, o.EventSubscriptionsV2ListEventSubscriptionDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/event-subscriptions"] = event_subscriptions.NewV2ListEventSubscriptions(o.context, o.EventSubscriptionsV2ListEventSubscriptionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/events"] = events.NewV2ListEvents(o.context, o.EventsV2ListEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1094,6 +1393,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics"] = installer.NewV2ListHostDiagnostics(o.context, o.InstallerV2ListHostDiagnosticsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions"] = installer.NewV2ListHostStateTransitions(o.context, o.InstallerV2ListHostStateTransitionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats"] = installer.NewV2ListHostValidationStats(o.context, o.InstallerV2ListHostValidationStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2ListHosts(o.context, o.InstallerV2ListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1110,7 +1421,19 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/cluster-templates"] = cluster_templates.NewV2RegisterClusterTemplate(o.context, o.ClusterTemplatesV2RegisterClusterTemplateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/event-subscriptions"] = event_subscriptions.NewV2RegisterEventSubscription(o.context, o.EventSubscriptionsV2RegisterEventSubscriptionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2RegisterHost(o.context, o.InstallerV2RegisterHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/reinstall-failed-hosts"] = installer.NewV2ReinstallFailedHosts(o.context, o.InstallerV2ReinstallFailedHostsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1127,6 +1450,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewV2ResetHostValidation(o.context, o.InstallerV2ResetHostValidationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/deleted-clusters/{cluster_id}/actions/restore"] = installer.NewV2RestoreDeletedCluster(o.context, o.InstallerV2RestoreDeletedClusterHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/events/stream"] = events.NewV2StreamEvents(o.context, o.EventsV2StreamEventsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1135,6 +1466,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/v2/clusters/{cluster_id}/logs-progress"] = installer.NewV2UpdateClusterLogsProgress(o.context, o.InstallerV2UpdateClusterLogsProgressHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/v2/cluster-templates/{template_id}"] = cluster_templates.NewV2UpdateClusterTemplate(o.context, o.ClusterTemplatesV2UpdateClusterTemplateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/v2/event-subscriptions/{subscription_id}"] = event_subscriptions.NewV2UpdateEventSubscription(o.context, o.EventSubscriptionsV2UpdateEventSubscriptionHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
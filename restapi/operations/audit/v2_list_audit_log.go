@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListAuditLogHandlerFunc turns a function with the right signature into a v2 list audit log handler
+type V2ListAuditLogHandlerFunc func(V2ListAuditLogParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListAuditLogHandlerFunc) Handle(params V2ListAuditLogParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListAuditLogHandler interface for that can handle valid v2 list audit log params
+type V2ListAuditLogHandler interface {
+	Handle(V2ListAuditLogParams, interface{}) middleware.Responder
+}
+
+// NewV2ListAuditLog creates a new http.Handler for the v2 list audit log operation
+func NewV2ListAuditLog(ctx *middleware.Context, handler V2ListAuditLogHandler) *V2ListAuditLog {
+	return &V2ListAuditLog{Context: ctx, Handler: handler}
+}
+
+/* V2ListAuditLog swagger:route GET /v2/audit-log audit v2ListAuditLog
+
+Lists the audit log entries of the changes made through the REST API and through the kube-api, from the
+latest.
+
+
+*/
+type V2ListAuditLog struct {
+	Context *middleware.Context
+	Handler V2ListAuditLogHandler
+}
+
+func (o *V2ListAuditLog) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListAuditLogParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,347 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2ListAuditLogParams creates a new V2ListAuditLogParams object
+// with the default values initialized.
+func NewV2ListAuditLogParams() V2ListAuditLogParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault = int64(100)
+	)
+
+	return V2ListAuditLogParams{
+		Limit: &limitDefault,
+	}
+}
+
+// V2ListAuditLogParams contains all the bound params for the v2 list audit log operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2ListAuditLog
+type V2ListAuditLogParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Return the entries of the changes made by this user.
+	  In: query
+	*/
+	Actor *string
+	/*The maximal number of entries to return.
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	  Default: 100
+	*/
+	Limit *int64
+	/*The number of entries to skip before starting to return entries.
+	  Minimum: 0
+	  In: query
+	*/
+	Offset *int64
+	/*Return the entries of the changes made by the users of this organization.
+	  In: query
+	*/
+	OrgID *string
+	/*Return the entries of the changes made to this cluster, host or infra-env.
+	  In: query
+	*/
+	ResourceID *strfmt.UUID
+	/*Return the entries of the changes made at or after this time.
+	  In: query
+	*/
+	Since *strfmt.DateTime
+	/*Return the entries of the changes made through this interface.
+	  In: query
+	*/
+	Source *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ListAuditLogParams() beforehand.
+func (o *V2ListAuditLogParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qActor, qhkActor, _ := qs.GetOK("actor")
+	if err := o.bindActor(qActor, qhkActor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrgID, qhkOrgID, _ := qs.GetOK("org_id")
+	if err := o.bindOrgID(qOrgID, qhkOrgID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qResourceID, qhkResourceID, _ := qs.GetOK("resource_id")
+	if err := o.bindResourceID(qResourceID, qhkResourceID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSource, qhkSource, _ := qs.GetOK("source")
+	if err := o.bindSource(qSource, qhkSource, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindActor binds and validates parameter Actor from query.
+func (o *V2ListAuditLogParams) bindActor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Actor = &raw
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *V2ListAuditLogParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ListAuditLogParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *V2ListAuditLogParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *V2ListAuditLogParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	if err := o.validateOffset(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOffset carries on validations for parameter Offset
+func (o *V2ListAuditLogParams) validateOffset(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("offset", "query", *o.Offset, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrgID binds and validates parameter OrgID from query.
+func (o *V2ListAuditLogParams) bindOrgID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.OrgID = &raw
+
+	return nil
+}
+
+// bindResourceID binds and validates parameter ResourceID from query.
+func (o *V2ListAuditLogParams) bindResourceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("resource_id", "query", "strfmt.UUID", raw)
+	}
+	o.ResourceID = (value.(*strfmt.UUID))
+
+	if err := o.validateResourceID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateResourceID carries on validations for parameter ResourceID
+func (o *V2ListAuditLogParams) validateResourceID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("resource_id", "query", "uuid", o.ResourceID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindSince binds and validates parameter Since from query.
+func (o *V2ListAuditLogParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("since", "query", "strfmt.DateTime", raw)
+	}
+	o.Since = (value.(*strfmt.DateTime))
+
+	if err := o.validateSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSince carries on validations for parameter Since
+func (o *V2ListAuditLogParams) validateSince(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("since", "query", "date-time", o.Since.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindSource binds and validates parameter Source from query.
+func (o *V2ListAuditLogParams) bindSource(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Source = &raw
+
+	if err := o.validateSource(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSource carries on validations for parameter Source
+func (o *V2ListAuditLogParams) validateSource(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("source", "query", *o.Source, []interface{}{"rest-api", "kube-api"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListAuditLogOKCode is the HTTP code returned for type V2ListAuditLogOK
+const V2ListAuditLogOKCode int = 200
+
+/*V2ListAuditLogOK Success.
+
+swagger:response v2ListAuditLogOK
+*/
+type V2ListAuditLogOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.AuditLogEntryList `json:"body,omitempty"`
+}
+
+// NewV2ListAuditLogOK creates V2ListAuditLogOK with default headers values
+func NewV2ListAuditLogOK() *V2ListAuditLogOK {
+
+	return &V2ListAuditLogOK{}
+}
+
+// WithPayload adds the payload to the v2 list audit log o k response
+func (o *V2ListAuditLogOK) WithPayload(payload models.AuditLogEntryList) *V2ListAuditLogOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit log o k response
+func (o *V2ListAuditLogOK) SetPayload(payload models.AuditLogEntryList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditLogOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.AuditLogEntryList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListAuditLogBadRequestCode is the HTTP code returned for type V2ListAuditLogBadRequest
+const V2ListAuditLogBadRequestCode int = 400
+
+/*V2ListAuditLogBadRequest Error.
+
+swagger:response v2ListAuditLogBadRequest
+*/
+type V2ListAuditLogBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListAuditLogBadRequest creates V2ListAuditLogBadRequest with default headers values
+func NewV2ListAuditLogBadRequest() *V2ListAuditLogBadRequest {
+
+	return &V2ListAuditLogBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 list audit log bad request response
+func (o *V2ListAuditLogBadRequest) WithPayload(payload *models.Error) *V2ListAuditLogBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit log bad request response
+func (o *V2ListAuditLogBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditLogBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListAuditLogUnauthorizedCode is the HTTP code returned for type V2ListAuditLogUnauthorized
+const V2ListAuditLogUnauthorizedCode int = 401
+
+/*V2ListAuditLogUnauthorized Unauthorized.
+
+swagger:response v2ListAuditLogUnauthorized
+*/
+type V2ListAuditLogUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListAuditLogUnauthorized creates V2ListAuditLogUnauthorized with default headers values
+func NewV2ListAuditLogUnauthorized() *V2ListAuditLogUnauthorized {
+
+	return &V2ListAuditLogUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list audit log unauthorized response
+func (o *V2ListAuditLogUnauthorized) WithPayload(payload *models.InfraError) *V2ListAuditLogUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit log unauthorized response
+func (o *V2ListAuditLogUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditLogUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListAuditLogForbiddenCode is the HTTP code returned for type V2ListAuditLogForbidden
+const V2ListAuditLogForbiddenCode int = 403
+
+/*V2ListAuditLogForbidden Forbidden.
+
+swagger:response v2ListAuditLogForbidden
+*/
+type V2ListAuditLogForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListAuditLogForbidden creates V2ListAuditLogForbidden with default headers values
+func NewV2ListAuditLogForbidden() *V2ListAuditLogForbidden {
+
+	return &V2ListAuditLogForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list audit log forbidden response
+func (o *V2ListAuditLogForbidden) WithPayload(payload *models.InfraError) *V2ListAuditLogForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit log forbidden response
+func (o *V2ListAuditLogForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditLogForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListAuditLogMethodNotAllowedCode is the HTTP code returned for type V2ListAuditLogMethodNotAllowed
+const V2ListAuditLogMethodNotAllowedCode int = 405
+
+/*V2ListAuditLogMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ListAuditLogMethodNotAllowed
+*/
+type V2ListAuditLogMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListAuditLogMethodNotAllowed creates V2ListAuditLogMethodNotAllowed with default headers values
+func NewV2ListAuditLogMethodNotAllowed() *V2ListAuditLogMethodNotAllowed {
+
+	return &V2ListAuditLogMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 list audit log method not allowed response
+func (o *V2ListAuditLogMethodNotAllowed) WithPayload(payload *models.Error) *V2ListAuditLogMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit log method not allowed response
+func (o *V2ListAuditLogMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditLogMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListAuditLogInternalServerErrorCode is the HTTP code returned for type V2ListAuditLogInternalServerError
+const V2ListAuditLogInternalServerErrorCode int = 500
+
+/*V2ListAuditLogInternalServerError Error.
+
+swagger:response v2ListAuditLogInternalServerError
+*/
+type V2ListAuditLogInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListAuditLogInternalServerError creates V2ListAuditLogInternalServerError with default headers values
+func NewV2ListAuditLogInternalServerError() *V2ListAuditLogInternalServerError {
+
+	return &V2ListAuditLogInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list audit log internal server error response
+func (o *V2ListAuditLogInternalServerError) WithPayload(payload *models.Error) *V2ListAuditLogInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list audit log internal server error response
+func (o *V2ListAuditLogInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListAuditLogInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
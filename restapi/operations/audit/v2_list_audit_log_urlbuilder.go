@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V2ListAuditLogURL generates an URL for the v2 list audit log operation
+type V2ListAuditLogURL struct {
+	Actor      *string
+	Limit      *int64
+	Offset     *int64
+	OrgID      *string
+	ResourceID *strfmt.UUID
+	Since      *strfmt.DateTime
+	Source     *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2ListAuditLogURL) WithBasePath(bp string) *V2ListAuditLogURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2ListAuditLogURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *V2ListAuditLogURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/v2/audit-log"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/assisted-install"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var actorQ string
+	if o.Actor != nil {
+		actorQ = *o.Actor
+	}
+	if actorQ != "" {
+		qs.Set("actor", actorQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var offsetQ string
+	if o.Offset != nil {
+		offsetQ = swag.FormatInt64(*o.Offset)
+	}
+	if offsetQ != "" {
+		qs.Set("offset", offsetQ)
+	}
+
+	var orgIDQ string
+	if o.OrgID != nil {
+		orgIDQ = *o.OrgID
+	}
+	if orgIDQ != "" {
+		qs.Set("org_id", orgIDQ)
+	}
+
+	var resourceIDQ string
+	if o.ResourceID != nil {
+		resourceIDQ = o.ResourceID.String()
+	}
+	if resourceIDQ != "" {
+		qs.Set("resource_id", resourceIDQ)
+	}
+
+	var sinceQ string
+	if o.Since != nil {
+		sinceQ = o.Since.String()
+	}
+	if sinceQ != "" {
+		qs.Set("since", sinceQ)
+	}
+
+	var sourceQ string
+	if o.Source != nil {
+		sourceQ = *o.Source
+	}
+	if sourceQ != "" {
+		qs.Set("source", sourceQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *V2ListAuditLogURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *V2ListAuditLogURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *V2ListAuditLogURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on V2ListAuditLogURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on V2ListAuditLogURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *V2ListAuditLogURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DeregisterClusterTemplateHandlerFunc turns a function with the right signature into a v2 deregister cluster template handler
+type V2DeregisterClusterTemplateHandlerFunc func(V2DeregisterClusterTemplateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DeregisterClusterTemplateHandlerFunc) Handle(params V2DeregisterClusterTemplateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DeregisterClusterTemplateHandler interface for that can handle valid v2 deregister cluster template params
+type V2DeregisterClusterTemplateHandler interface {
+	Handle(V2DeregisterClusterTemplateParams, interface{}) middleware.Responder
+}
+
+// NewV2DeregisterClusterTemplate creates a new http.Handler for the v2 deregister cluster template operation
+func NewV2DeregisterClusterTemplate(ctx *middleware.Context, handler V2DeregisterClusterTemplateHandler) *V2DeregisterClusterTemplate {
+	return &V2DeregisterClusterTemplate{Context: ctx, Handler: handler}
+}
+
+/* V2DeregisterClusterTemplate swagger:route DELETE /v2/cluster-templates/{template_id} cluster_templates v2DeregisterClusterTemplate
+
+Deletes the cluster template. Clusters created from the template are not affected.
+
+*/
+type V2DeregisterClusterTemplate struct {
+	Context *middleware.Context
+	Handler V2DeregisterClusterTemplateHandler
+}
+
+func (o *V2DeregisterClusterTemplate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DeregisterClusterTemplateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,258 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeregisterClusterTemplateNoContentCode is the HTTP code returned for type V2DeregisterClusterTemplateNoContent
+const V2DeregisterClusterTemplateNoContentCode int = 204
+
+/*V2DeregisterClusterTemplateNoContent Success.
+
+swagger:response v2DeregisterClusterTemplateNoContent
+*/
+type V2DeregisterClusterTemplateNoContent struct {
+}
+
+// NewV2DeregisterClusterTemplateNoContent creates V2DeregisterClusterTemplateNoContent with default headers values
+func NewV2DeregisterClusterTemplateNoContent() *V2DeregisterClusterTemplateNoContent {
+
+	return &V2DeregisterClusterTemplateNoContent{}
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterClusterTemplateNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// V2DeregisterClusterTemplateUnauthorizedCode is the HTTP code returned for type V2DeregisterClusterTemplateUnauthorized
+const V2DeregisterClusterTemplateUnauthorizedCode int = 401
+
+/*V2DeregisterClusterTemplateUnauthorized Unauthorized.
+
+swagger:response v2DeregisterClusterTemplateUnauthorized
+*/
+type V2DeregisterClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeregisterClusterTemplateUnauthorized creates V2DeregisterClusterTemplateUnauthorized with default headers values
+func NewV2DeregisterClusterTemplateUnauthorized() *V2DeregisterClusterTemplateUnauthorized {
+
+	return &V2DeregisterClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 deregister cluster template unauthorized response
+func (o *V2DeregisterClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *V2DeregisterClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister cluster template unauthorized response
+func (o *V2DeregisterClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterClusterTemplateForbiddenCode is the HTTP code returned for type V2DeregisterClusterTemplateForbidden
+const V2DeregisterClusterTemplateForbiddenCode int = 403
+
+/*V2DeregisterClusterTemplateForbidden Forbidden.
+
+swagger:response v2DeregisterClusterTemplateForbidden
+*/
+type V2DeregisterClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeregisterClusterTemplateForbidden creates V2DeregisterClusterTemplateForbidden with default headers values
+func NewV2DeregisterClusterTemplateForbidden() *V2DeregisterClusterTemplateForbidden {
+
+	return &V2DeregisterClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 deregister cluster template forbidden response
+func (o *V2DeregisterClusterTemplateForbidden) WithPayload(payload *models.InfraError) *V2DeregisterClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister cluster template forbidden response
+func (o *V2DeregisterClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterClusterTemplateNotFoundCode is the HTTP code returned for type V2DeregisterClusterTemplateNotFound
+const V2DeregisterClusterTemplateNotFoundCode int = 404
+
+/*V2DeregisterClusterTemplateNotFound Error.
+
+swagger:response v2DeregisterClusterTemplateNotFound
+*/
+type V2DeregisterClusterTemplateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterClusterTemplateNotFound creates V2DeregisterClusterTemplateNotFound with default headers values
+func NewV2DeregisterClusterTemplateNotFound() *V2DeregisterClusterTemplateNotFound {
+
+	return &V2DeregisterClusterTemplateNotFound{}
+}
+
+// WithPayload adds the payload to the v2 deregister cluster template not found response
+func (o *V2DeregisterClusterTemplateNotFound) WithPayload(payload *models.Error) *V2DeregisterClusterTemplateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister cluster template not found response
+func (o *V2DeregisterClusterTemplateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterClusterTemplateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterClusterTemplateMethodNotAllowedCode is the HTTP code returned for type V2DeregisterClusterTemplateMethodNotAllowed
+const V2DeregisterClusterTemplateMethodNotAllowedCode int = 405
+
+/*V2DeregisterClusterTemplateMethodNotAllowed Method Not Allowed.
+
+swagger:response v2DeregisterClusterTemplateMethodNotAllowed
+*/
+type V2DeregisterClusterTemplateMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterClusterTemplateMethodNotAllowed creates V2DeregisterClusterTemplateMethodNotAllowed with default headers values
+func NewV2DeregisterClusterTemplateMethodNotAllowed() *V2DeregisterClusterTemplateMethodNotAllowed {
+
+	return &V2DeregisterClusterTemplateMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 deregister cluster template method not allowed response
+func (o *V2DeregisterClusterTemplateMethodNotAllowed) WithPayload(payload *models.Error) *V2DeregisterClusterTemplateMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister cluster template method not allowed response
+func (o *V2DeregisterClusterTemplateMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterClusterTemplateMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterClusterTemplateInternalServerErrorCode is the HTTP code returned for type V2DeregisterClusterTemplateInternalServerError
+const V2DeregisterClusterTemplateInternalServerErrorCode int = 500
+
+/*V2DeregisterClusterTemplateInternalServerError Error.
+
+swagger:response v2DeregisterClusterTemplateInternalServerError
+*/
+type V2DeregisterClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterClusterTemplateInternalServerError creates V2DeregisterClusterTemplateInternalServerError with default headers values
+func NewV2DeregisterClusterTemplateInternalServerError() *V2DeregisterClusterTemplateInternalServerError {
+
+	return &V2DeregisterClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 deregister cluster template internal server error response
+func (o *V2DeregisterClusterTemplateInternalServerError) WithPayload(payload *models.Error) *V2DeregisterClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister cluster template internal server error response
+func (o *V2DeregisterClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2GetClusterTemplateParams creates a new V2GetClusterTemplateParams object
+//
+// There are no default values defined in the spec.
+func NewV2GetClusterTemplateParams() V2GetClusterTemplateParams {
+
+	return V2GetClusterTemplateParams{}
+}
+
+// V2GetClusterTemplateParams contains all the bound params for the v2 get cluster template operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2GetClusterTemplate
+type V2GetClusterTemplateParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster template to be retrieved.
+	  Required: true
+	  In: path
+	*/
+	TemplateID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2GetClusterTemplateParams() beforehand.
+func (o *V2GetClusterTemplateParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rTemplateID, rhkTemplateID, _ := route.Params.GetOK("template_id")
+	if err := o.bindTemplateID(rTemplateID, rhkTemplateID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindTemplateID binds and validates parameter TemplateID from path.
+func (o *V2GetClusterTemplateParams) bindTemplateID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("template_id", "path", "strfmt.UUID", raw)
+	}
+	o.TemplateID = *(value.(*strfmt.UUID))
+
+	if err := o.validateTemplateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTemplateID carries on validations for parameter TemplateID
+func (o *V2GetClusterTemplateParams) validateTemplateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("template_id", "path", "uuid", o.TemplateID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
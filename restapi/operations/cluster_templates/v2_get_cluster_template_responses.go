@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterTemplateOKCode is the HTTP code returned for type V2GetClusterTemplateOK
+const V2GetClusterTemplateOKCode int = 200
+
+/*V2GetClusterTemplateOK Success.
+
+swagger:response v2GetClusterTemplateOK
+*/
+type V2GetClusterTemplateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterTemplate `json:"body,omitempty"`
+}
+
+// NewV2GetClusterTemplateOK creates V2GetClusterTemplateOK with default headers values
+func NewV2GetClusterTemplateOK() *V2GetClusterTemplateOK {
+
+	return &V2GetClusterTemplateOK{}
+}
+
+// WithPayload adds the payload to the v2 get cluster template o k response
+func (o *V2GetClusterTemplateOK) WithPayload(payload *models.ClusterTemplate) *V2GetClusterTemplateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster template o k response
+func (o *V2GetClusterTemplateOK) SetPayload(payload *models.ClusterTemplate) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterTemplateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterTemplateUnauthorizedCode is the HTTP code returned for type V2GetClusterTemplateUnauthorized
+const V2GetClusterTemplateUnauthorizedCode int = 401
+
+/*V2GetClusterTemplateUnauthorized Unauthorized.
+
+swagger:response v2GetClusterTemplateUnauthorized
+*/
+type V2GetClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterTemplateUnauthorized creates V2GetClusterTemplateUnauthorized with default headers values
+func NewV2GetClusterTemplateUnauthorized() *V2GetClusterTemplateUnauthorized {
+
+	return &V2GetClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get cluster template unauthorized response
+func (o *V2GetClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *V2GetClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster template unauthorized response
+func (o *V2GetClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterTemplateForbiddenCode is the HTTP code returned for type V2GetClusterTemplateForbidden
+const V2GetClusterTemplateForbiddenCode int = 403
+
+/*V2GetClusterTemplateForbidden Forbidden.
+
+swagger:response v2GetClusterTemplateForbidden
+*/
+type V2GetClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterTemplateForbidden creates V2GetClusterTemplateForbidden with default headers values
+func NewV2GetClusterTemplateForbidden() *V2GetClusterTemplateForbidden {
+
+	return &V2GetClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get cluster template forbidden response
+func (o *V2GetClusterTemplateForbidden) WithPayload(payload *models.InfraError) *V2GetClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster template forbidden response
+func (o *V2GetClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterTemplateNotFoundCode is the HTTP code returned for type V2GetClusterTemplateNotFound
+const V2GetClusterTemplateNotFoundCode int = 404
+
+/*V2GetClusterTemplateNotFound Error.
+
+swagger:response v2GetClusterTemplateNotFound
+*/
+type V2GetClusterTemplateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterTemplateNotFound creates V2GetClusterTemplateNotFound with default headers values
+func NewV2GetClusterTemplateNotFound() *V2GetClusterTemplateNotFound {
+
+	return &V2GetClusterTemplateNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get cluster template not found response
+func (o *V2GetClusterTemplateNotFound) WithPayload(payload *models.Error) *V2GetClusterTemplateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster template not found response
+func (o *V2GetClusterTemplateNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterTemplateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterTemplateMethodNotAllowedCode is the HTTP code returned for type V2GetClusterTemplateMethodNotAllowed
+const V2GetClusterTemplateMethodNotAllowedCode int = 405
+
+/*V2GetClusterTemplateMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetClusterTemplateMethodNotAllowed
+*/
+type V2GetClusterTemplateMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterTemplateMethodNotAllowed creates V2GetClusterTemplateMethodNotAllowed with default headers values
+func NewV2GetClusterTemplateMethodNotAllowed() *V2GetClusterTemplateMethodNotAllowed {
+
+	return &V2GetClusterTemplateMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get cluster template method not allowed response
+func (o *V2GetClusterTemplateMethodNotAllowed) WithPayload(payload *models.Error) *V2GetClusterTemplateMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster template method not allowed response
+func (o *V2GetClusterTemplateMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterTemplateMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterTemplateInternalServerErrorCode is the HTTP code returned for type V2GetClusterTemplateInternalServerError
+const V2GetClusterTemplateInternalServerErrorCode int = 500
+
+/*V2GetClusterTemplateInternalServerError Error.
+
+swagger:response v2GetClusterTemplateInternalServerError
+*/
+type V2GetClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterTemplateInternalServerError creates V2GetClusterTemplateInternalServerError with default headers values
+func NewV2GetClusterTemplateInternalServerError() *V2GetClusterTemplateInternalServerError {
+
+	return &V2GetClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get cluster template internal server error response
+func (o *V2GetClusterTemplateInternalServerError) WithPayload(payload *models.Error) *V2GetClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster template internal server error response
+func (o *V2GetClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
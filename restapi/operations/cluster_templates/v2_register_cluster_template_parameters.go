@@ -0,0 +1,85 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2RegisterClusterTemplateParams creates a new V2RegisterClusterTemplateParams object
+//
+// There are no default values defined in the spec.
+func NewV2RegisterClusterTemplateParams() V2RegisterClusterTemplateParams {
+
+	return V2RegisterClusterTemplateParams{}
+}
+
+// V2RegisterClusterTemplateParams contains all the bound params for the v2 register cluster template operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2RegisterClusterTemplate
+type V2RegisterClusterTemplateParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The properties describing the new cluster template.
+	  Required: true
+	  In: body
+	*/
+	NewClusterTemplateParams *models.ClusterTemplateCreateParams
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2RegisterClusterTemplateParams() beforehand.
+func (o *V2RegisterClusterTemplateParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.ClusterTemplateCreateParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("newClusterTemplateParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("newClusterTemplateParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(context.Background())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.NewClusterTemplateParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("newClusterTemplateParams", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
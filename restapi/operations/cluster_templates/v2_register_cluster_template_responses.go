@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RegisterClusterTemplateCreatedCode is the HTTP code returned for type V2RegisterClusterTemplateCreated
+const V2RegisterClusterTemplateCreatedCode int = 201
+
+/*V2RegisterClusterTemplateCreated Success.
+
+swagger:response v2RegisterClusterTemplateCreated
+*/
+type V2RegisterClusterTemplateCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterTemplate `json:"body,omitempty"`
+}
+
+// NewV2RegisterClusterTemplateCreated creates V2RegisterClusterTemplateCreated with default headers values
+func NewV2RegisterClusterTemplateCreated() *V2RegisterClusterTemplateCreated {
+
+	return &V2RegisterClusterTemplateCreated{}
+}
+
+// WithPayload adds the payload to the v2 register cluster template created response
+func (o *V2RegisterClusterTemplateCreated) WithPayload(payload *models.ClusterTemplate) *V2RegisterClusterTemplateCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register cluster template created response
+func (o *V2RegisterClusterTemplateCreated) SetPayload(payload *models.ClusterTemplate) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterClusterTemplateCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterClusterTemplateBadRequestCode is the HTTP code returned for type V2RegisterClusterTemplateBadRequest
+const V2RegisterClusterTemplateBadRequestCode int = 400
+
+/*V2RegisterClusterTemplateBadRequest Error.
+
+swagger:response v2RegisterClusterTemplateBadRequest
+*/
+type V2RegisterClusterTemplateBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterClusterTemplateBadRequest creates V2RegisterClusterTemplateBadRequest with default headers values
+func NewV2RegisterClusterTemplateBadRequest() *V2RegisterClusterTemplateBadRequest {
+
+	return &V2RegisterClusterTemplateBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 register cluster template bad request response
+func (o *V2RegisterClusterTemplateBadRequest) WithPayload(payload *models.Error) *V2RegisterClusterTemplateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register cluster template bad request response
+func (o *V2RegisterClusterTemplateBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterClusterTemplateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterClusterTemplateUnauthorizedCode is the HTTP code returned for type V2RegisterClusterTemplateUnauthorized
+const V2RegisterClusterTemplateUnauthorizedCode int = 401
+
+/*V2RegisterClusterTemplateUnauthorized Unauthorized.
+
+swagger:response v2RegisterClusterTemplateUnauthorized
+*/
+type V2RegisterClusterTemplateUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RegisterClusterTemplateUnauthorized creates V2RegisterClusterTemplateUnauthorized with default headers values
+func NewV2RegisterClusterTemplateUnauthorized() *V2RegisterClusterTemplateUnauthorized {
+
+	return &V2RegisterClusterTemplateUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 register cluster template unauthorized response
+func (o *V2RegisterClusterTemplateUnauthorized) WithPayload(payload *models.InfraError) *V2RegisterClusterTemplateUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register cluster template unauthorized response
+func (o *V2RegisterClusterTemplateUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterClusterTemplateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterClusterTemplateForbiddenCode is the HTTP code returned for type V2RegisterClusterTemplateForbidden
+const V2RegisterClusterTemplateForbiddenCode int = 403
+
+/*V2RegisterClusterTemplateForbidden Forbidden.
+
+swagger:response v2RegisterClusterTemplateForbidden
+*/
+type V2RegisterClusterTemplateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RegisterClusterTemplateForbidden creates V2RegisterClusterTemplateForbidden with default headers values
+func NewV2RegisterClusterTemplateForbidden() *V2RegisterClusterTemplateForbidden {
+
+	return &V2RegisterClusterTemplateForbidden{}
+}
+
+// WithPayload adds the payload to the v2 register cluster template forbidden response
+func (o *V2RegisterClusterTemplateForbidden) WithPayload(payload *models.InfraError) *V2RegisterClusterTemplateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register cluster template forbidden response
+func (o *V2RegisterClusterTemplateForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterClusterTemplateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterClusterTemplateMethodNotAllowedCode is the HTTP code returned for type V2RegisterClusterTemplateMethodNotAllowed
+const V2RegisterClusterTemplateMethodNotAllowedCode int = 405
+
+/*V2RegisterClusterTemplateMethodNotAllowed Method Not Allowed.
+
+swagger:response v2RegisterClusterTemplateMethodNotAllowed
+*/
+type V2RegisterClusterTemplateMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterClusterTemplateMethodNotAllowed creates V2RegisterClusterTemplateMethodNotAllowed with default headers values
+func NewV2RegisterClusterTemplateMethodNotAllowed() *V2RegisterClusterTemplateMethodNotAllowed {
+
+	return &V2RegisterClusterTemplateMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 register cluster template method not allowed response
+func (o *V2RegisterClusterTemplateMethodNotAllowed) WithPayload(payload *models.Error) *V2RegisterClusterTemplateMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register cluster template method not allowed response
+func (o *V2RegisterClusterTemplateMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterClusterTemplateMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterClusterTemplateInternalServerErrorCode is the HTTP code returned for type V2RegisterClusterTemplateInternalServerError
+const V2RegisterClusterTemplateInternalServerErrorCode int = 500
+
+/*V2RegisterClusterTemplateInternalServerError Error.
+
+swagger:response v2RegisterClusterTemplateInternalServerError
+*/
+type V2RegisterClusterTemplateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterClusterTemplateInternalServerError creates V2RegisterClusterTemplateInternalServerError with default headers values
+func NewV2RegisterClusterTemplateInternalServerError() *V2RegisterClusterTemplateInternalServerError {
+
+	return &V2RegisterClusterTemplateInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 register cluster template internal server error response
+func (o *V2RegisterClusterTemplateInternalServerError) WithPayload(payload *models.Error) *V2RegisterClusterTemplateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register cluster template internal server error response
+func (o *V2RegisterClusterTemplateInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterClusterTemplateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_templates
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateClusterTemplateParams creates a new V2UpdateClusterTemplateParams object
+//
+// There are no default values defined in the spec.
+func NewV2UpdateClusterTemplateParams() V2UpdateClusterTemplateParams {
+
+	return V2UpdateClusterTemplateParams{}
+}
+
+// V2UpdateClusterTemplateParams contains all the bound params for the v2 update cluster template operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2UpdateClusterTemplate
+type V2UpdateClusterTemplateParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The new properties of the cluster template.
+	  Required: true
+	  In: body
+	*/
+	ClusterTemplateParams *models.ClusterTemplateCreateParams
+	/*The cluster template to be updated.
+	  Required: true
+	  In: path
+	*/
+	TemplateID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2UpdateClusterTemplateParams() beforehand.
+func (o *V2UpdateClusterTemplateParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.ClusterTemplateCreateParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("clusterTemplateParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("clusterTemplateParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(context.Background())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.ClusterTemplateParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("clusterTemplateParams", "body", ""))
+	}
+
+	rTemplateID, rhkTemplateID, _ := route.Params.GetOK("template_id")
+	if err := o.bindTemplateID(rTemplateID, rhkTemplateID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindTemplateID binds and validates parameter TemplateID from path.
+func (o *V2UpdateClusterTemplateParams) bindTemplateID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("template_id", "path", "strfmt.UUID", raw)
+	}
+	o.TemplateID = *(value.(*strfmt.UUID))
+
+	if err := o.validateTemplateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTemplateID carries on validations for parameter TemplateID
+func (o *V2UpdateClusterTemplateParams) validateTemplateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("template_id", "path", "uuid", o.TemplateID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
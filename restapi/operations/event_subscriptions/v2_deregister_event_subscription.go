@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DeregisterEventSubscriptionHandlerFunc turns a function with the right signature into a v2 deregister event subscription handler
+type V2DeregisterEventSubscriptionHandlerFunc func(V2DeregisterEventSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DeregisterEventSubscriptionHandlerFunc) Handle(params V2DeregisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DeregisterEventSubscriptionHandler interface for that can handle valid v2 deregister event subscription params
+type V2DeregisterEventSubscriptionHandler interface {
+	Handle(V2DeregisterEventSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2DeregisterEventSubscription creates a new http.Handler for the v2 deregister event subscription operation
+func NewV2DeregisterEventSubscription(ctx *middleware.Context, handler V2DeregisterEventSubscriptionHandler) *V2DeregisterEventSubscription {
+	return &V2DeregisterEventSubscription{Context: ctx, Handler: handler}
+}
+
+/* V2DeregisterEventSubscription swagger:route DELETE /v2/event-subscriptions/{subscription_id} event_subscriptions v2DeregisterEventSubscription
+
+Deletes the event subscription and its delivery log.
+
+*/
+type V2DeregisterEventSubscription struct {
+	Context *middleware.Context
+	Handler V2DeregisterEventSubscriptionHandler
+}
+
+func (o *V2DeregisterEventSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DeregisterEventSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,258 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DeregisterEventSubscriptionNoContentCode is the HTTP code returned for type V2DeregisterEventSubscriptionNoContent
+const V2DeregisterEventSubscriptionNoContentCode int = 204
+
+/*V2DeregisterEventSubscriptionNoContent Success.
+
+swagger:response v2DeregisterEventSubscriptionNoContent
+*/
+type V2DeregisterEventSubscriptionNoContent struct {
+}
+
+// NewV2DeregisterEventSubscriptionNoContent creates V2DeregisterEventSubscriptionNoContent with default headers values
+func NewV2DeregisterEventSubscriptionNoContent() *V2DeregisterEventSubscriptionNoContent {
+
+	return &V2DeregisterEventSubscriptionNoContent{}
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterEventSubscriptionNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// V2DeregisterEventSubscriptionUnauthorizedCode is the HTTP code returned for type V2DeregisterEventSubscriptionUnauthorized
+const V2DeregisterEventSubscriptionUnauthorizedCode int = 401
+
+/*V2DeregisterEventSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2DeregisterEventSubscriptionUnauthorized
+*/
+type V2DeregisterEventSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeregisterEventSubscriptionUnauthorized creates V2DeregisterEventSubscriptionUnauthorized with default headers values
+func NewV2DeregisterEventSubscriptionUnauthorized() *V2DeregisterEventSubscriptionUnauthorized {
+
+	return &V2DeregisterEventSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 deregister event subscription unauthorized response
+func (o *V2DeregisterEventSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2DeregisterEventSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister event subscription unauthorized response
+func (o *V2DeregisterEventSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterEventSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterEventSubscriptionForbiddenCode is the HTTP code returned for type V2DeregisterEventSubscriptionForbidden
+const V2DeregisterEventSubscriptionForbiddenCode int = 403
+
+/*V2DeregisterEventSubscriptionForbidden Forbidden.
+
+swagger:response v2DeregisterEventSubscriptionForbidden
+*/
+type V2DeregisterEventSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DeregisterEventSubscriptionForbidden creates V2DeregisterEventSubscriptionForbidden with default headers values
+func NewV2DeregisterEventSubscriptionForbidden() *V2DeregisterEventSubscriptionForbidden {
+
+	return &V2DeregisterEventSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 deregister event subscription forbidden response
+func (o *V2DeregisterEventSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2DeregisterEventSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister event subscription forbidden response
+func (o *V2DeregisterEventSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterEventSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterEventSubscriptionNotFoundCode is the HTTP code returned for type V2DeregisterEventSubscriptionNotFound
+const V2DeregisterEventSubscriptionNotFoundCode int = 404
+
+/*V2DeregisterEventSubscriptionNotFound Error.
+
+swagger:response v2DeregisterEventSubscriptionNotFound
+*/
+type V2DeregisterEventSubscriptionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterEventSubscriptionNotFound creates V2DeregisterEventSubscriptionNotFound with default headers values
+func NewV2DeregisterEventSubscriptionNotFound() *V2DeregisterEventSubscriptionNotFound {
+
+	return &V2DeregisterEventSubscriptionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 deregister event subscription not found response
+func (o *V2DeregisterEventSubscriptionNotFound) WithPayload(payload *models.Error) *V2DeregisterEventSubscriptionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister event subscription not found response
+func (o *V2DeregisterEventSubscriptionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterEventSubscriptionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterEventSubscriptionMethodNotAllowedCode is the HTTP code returned for type V2DeregisterEventSubscriptionMethodNotAllowed
+const V2DeregisterEventSubscriptionMethodNotAllowedCode int = 405
+
+/*V2DeregisterEventSubscriptionMethodNotAllowed Method Not Allowed.
+
+swagger:response v2DeregisterEventSubscriptionMethodNotAllowed
+*/
+type V2DeregisterEventSubscriptionMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterEventSubscriptionMethodNotAllowed creates V2DeregisterEventSubscriptionMethodNotAllowed with default headers values
+func NewV2DeregisterEventSubscriptionMethodNotAllowed() *V2DeregisterEventSubscriptionMethodNotAllowed {
+
+	return &V2DeregisterEventSubscriptionMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 deregister event subscription method not allowed response
+func (o *V2DeregisterEventSubscriptionMethodNotAllowed) WithPayload(payload *models.Error) *V2DeregisterEventSubscriptionMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister event subscription method not allowed response
+func (o *V2DeregisterEventSubscriptionMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterEventSubscriptionMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DeregisterEventSubscriptionInternalServerErrorCode is the HTTP code returned for type V2DeregisterEventSubscriptionInternalServerError
+const V2DeregisterEventSubscriptionInternalServerErrorCode int = 500
+
+/*V2DeregisterEventSubscriptionInternalServerError Error.
+
+swagger:response v2DeregisterEventSubscriptionInternalServerError
+*/
+type V2DeregisterEventSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DeregisterEventSubscriptionInternalServerError creates V2DeregisterEventSubscriptionInternalServerError with default headers values
+func NewV2DeregisterEventSubscriptionInternalServerError() *V2DeregisterEventSubscriptionInternalServerError {
+
+	return &V2DeregisterEventSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 deregister event subscription internal server error response
+func (o *V2DeregisterEventSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2DeregisterEventSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 deregister event subscription internal server error response
+func (o *V2DeregisterEventSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DeregisterEventSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetEventSubscriptionHandlerFunc turns a function with the right signature into a v2 get event subscription handler
+type V2GetEventSubscriptionHandlerFunc func(V2GetEventSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetEventSubscriptionHandlerFunc) Handle(params V2GetEventSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetEventSubscriptionHandler interface for that can handle valid v2 get event subscription params
+type V2GetEventSubscriptionHandler interface {
+	Handle(V2GetEventSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2GetEventSubscription creates a new http.Handler for the v2 get event subscription operation
+func NewV2GetEventSubscription(ctx *middleware.Context, handler V2GetEventSubscriptionHandler) *V2GetEventSubscription {
+	return &V2GetEventSubscription{Context: ctx, Handler: handler}
+}
+
+/* V2GetEventSubscription swagger:route GET /v2/event-subscriptions/{subscription_id} event_subscriptions v2GetEventSubscription
+
+Retrieves the details of the event subscription.
+
+*/
+type V2GetEventSubscription struct {
+	Context *middleware.Context
+	Handler V2GetEventSubscriptionHandler
+}
+
+func (o *V2GetEventSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetEventSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
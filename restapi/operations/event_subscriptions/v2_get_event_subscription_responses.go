@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetEventSubscriptionOKCode is the HTTP code returned for type V2GetEventSubscriptionOK
+const V2GetEventSubscriptionOKCode int = 200
+
+/*V2GetEventSubscriptionOK Success.
+
+swagger:response v2GetEventSubscriptionOK
+*/
+type V2GetEventSubscriptionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EventSubscription `json:"body,omitempty"`
+}
+
+// NewV2GetEventSubscriptionOK creates V2GetEventSubscriptionOK with default headers values
+func NewV2GetEventSubscriptionOK() *V2GetEventSubscriptionOK {
+
+	return &V2GetEventSubscriptionOK{}
+}
+
+// WithPayload adds the payload to the v2 get event subscription o k response
+func (o *V2GetEventSubscriptionOK) WithPayload(payload *models.EventSubscription) *V2GetEventSubscriptionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get event subscription o k response
+func (o *V2GetEventSubscriptionOK) SetPayload(payload *models.EventSubscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetEventSubscriptionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetEventSubscriptionUnauthorizedCode is the HTTP code returned for type V2GetEventSubscriptionUnauthorized
+const V2GetEventSubscriptionUnauthorizedCode int = 401
+
+/*V2GetEventSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2GetEventSubscriptionUnauthorized
+*/
+type V2GetEventSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetEventSubscriptionUnauthorized creates V2GetEventSubscriptionUnauthorized with default headers values
+func NewV2GetEventSubscriptionUnauthorized() *V2GetEventSubscriptionUnauthorized {
+
+	return &V2GetEventSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get event subscription unauthorized response
+func (o *V2GetEventSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2GetEventSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get event subscription unauthorized response
+func (o *V2GetEventSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetEventSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetEventSubscriptionForbiddenCode is the HTTP code returned for type V2GetEventSubscriptionForbidden
+const V2GetEventSubscriptionForbiddenCode int = 403
+
+/*V2GetEventSubscriptionForbidden Forbidden.
+
+swagger:response v2GetEventSubscriptionForbidden
+*/
+type V2GetEventSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetEventSubscriptionForbidden creates V2GetEventSubscriptionForbidden with default headers values
+func NewV2GetEventSubscriptionForbidden() *V2GetEventSubscriptionForbidden {
+
+	return &V2GetEventSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get event subscription forbidden response
+func (o *V2GetEventSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2GetEventSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get event subscription forbidden response
+func (o *V2GetEventSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetEventSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetEventSubscriptionNotFoundCode is the HTTP code returned for type V2GetEventSubscriptionNotFound
+const V2GetEventSubscriptionNotFoundCode int = 404
+
+/*V2GetEventSubscriptionNotFound Error.
+
+swagger:response v2GetEventSubscriptionNotFound
+*/
+type V2GetEventSubscriptionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetEventSubscriptionNotFound creates V2GetEventSubscriptionNotFound with default headers values
+func NewV2GetEventSubscriptionNotFound() *V2GetEventSubscriptionNotFound {
+
+	return &V2GetEventSubscriptionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get event subscription not found response
+func (o *V2GetEventSubscriptionNotFound) WithPayload(payload *models.Error) *V2GetEventSubscriptionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get event subscription not found response
+func (o *V2GetEventSubscriptionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetEventSubscriptionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetEventSubscriptionMethodNotAllowedCode is the HTTP code returned for type V2GetEventSubscriptionMethodNotAllowed
+const V2GetEventSubscriptionMethodNotAllowedCode int = 405
+
+/*V2GetEventSubscriptionMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetEventSubscriptionMethodNotAllowed
+*/
+type V2GetEventSubscriptionMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetEventSubscriptionMethodNotAllowed creates V2GetEventSubscriptionMethodNotAllowed with default headers values
+func NewV2GetEventSubscriptionMethodNotAllowed() *V2GetEventSubscriptionMethodNotAllowed {
+
+	return &V2GetEventSubscriptionMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get event subscription method not allowed response
+func (o *V2GetEventSubscriptionMethodNotAllowed) WithPayload(payload *models.Error) *V2GetEventSubscriptionMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get event subscription method not allowed response
+func (o *V2GetEventSubscriptionMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetEventSubscriptionMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetEventSubscriptionInternalServerErrorCode is the HTTP code returned for type V2GetEventSubscriptionInternalServerError
+const V2GetEventSubscriptionInternalServerErrorCode int = 500
+
+/*V2GetEventSubscriptionInternalServerError Error.
+
+swagger:response v2GetEventSubscriptionInternalServerError
+*/
+type V2GetEventSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetEventSubscriptionInternalServerError creates V2GetEventSubscriptionInternalServerError with default headers values
+func NewV2GetEventSubscriptionInternalServerError() *V2GetEventSubscriptionInternalServerError {
+
+	return &V2GetEventSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get event subscription internal server error response
+func (o *V2GetEventSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2GetEventSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get event subscription internal server error response
+func (o *V2GetEventSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetEventSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
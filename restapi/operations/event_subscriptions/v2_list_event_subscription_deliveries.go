@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListEventSubscriptionDeliveriesHandlerFunc turns a function with the right signature into a v2 list event subscription deliveries handler
+type V2ListEventSubscriptionDeliveriesHandlerFunc func(V2ListEventSubscriptionDeliveriesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListEventSubscriptionDeliveriesHandlerFunc) Handle(params V2ListEventSubscriptionDeliveriesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListEventSubscriptionDeliveriesHandler interface for that can handle valid v2 list event subscription deliveries params
+type V2ListEventSubscriptionDeliveriesHandler interface {
+	Handle(V2ListEventSubscriptionDeliveriesParams, interface{}) middleware.Responder
+}
+
+// NewV2ListEventSubscriptionDeliveries creates a new http.Handler for the v2 list event subscription deliveries operation
+func NewV2ListEventSubscriptionDeliveries(ctx *middleware.Context, handler V2ListEventSubscriptionDeliveriesHandler) *V2ListEventSubscriptionDeliveries {
+	return &V2ListEventSubscriptionDeliveries{Context: ctx, Handler: handler}
+}
+
+/* V2ListEventSubscriptionDeliveries swagger:route GET /v2/event-subscriptions/{subscription_id}/deliveries event_subscriptions v2ListEventSubscriptionDeliveries
+
+Retrieves the delivery log of the event subscription, latest deliveries first.
+
+*/
+type V2ListEventSubscriptionDeliveries struct {
+	Context *middleware.Context
+	Handler V2ListEventSubscriptionDeliveriesHandler
+}
+
+func (o *V2ListEventSubscriptionDeliveries) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListEventSubscriptionDeliveriesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
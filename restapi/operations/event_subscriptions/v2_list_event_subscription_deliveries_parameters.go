@@ -0,0 +1,197 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2ListEventSubscriptionDeliveriesParams creates a new V2ListEventSubscriptionDeliveriesParams object
+// with the default values initialized.
+func NewV2ListEventSubscriptionDeliveriesParams() V2ListEventSubscriptionDeliveriesParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault = int64(100)
+	)
+
+	return V2ListEventSubscriptionDeliveriesParams{
+		Limit: &limitDefault,
+	}
+}
+
+// V2ListEventSubscriptionDeliveriesParams contains all the bound params for the v2 list event subscription deliveries operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2ListEventSubscriptionDeliveries
+type V2ListEventSubscriptionDeliveriesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The maximal number of deliveries to retrieve.
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	  Default: 100
+	*/
+	Limit *int64
+	/*Only retrieve the deliveries with this status.
+	  In: query
+	*/
+	Status *string
+	/*The event subscription whose deliveries should be retrieved.
+	  Required: true
+	  In: path
+	*/
+	SubscriptionID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ListEventSubscriptionDeliveriesParams() beforehand.
+func (o *V2ListEventSubscriptionDeliveriesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rSubscriptionID, rhkSubscriptionID, _ := route.Params.GetOK("subscription_id")
+	if err := o.bindSubscriptionID(rSubscriptionID, rhkSubscriptionID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *V2ListEventSubscriptionDeliveriesParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ListEventSubscriptionDeliveriesParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *V2ListEventSubscriptionDeliveriesParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindStatus binds and validates parameter Status from query.
+func (o *V2ListEventSubscriptionDeliveriesParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Status = &raw
+
+	if err := o.validateStatus(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStatus carries on validations for parameter Status
+func (o *V2ListEventSubscriptionDeliveriesParams) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("status", "query", *o.Status, []interface{}{"pending", "delivered", "failed"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSubscriptionID binds and validates parameter SubscriptionID from path.
+func (o *V2ListEventSubscriptionDeliveriesParams) bindSubscriptionID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("subscription_id", "path", "strfmt.UUID", raw)
+	}
+	o.SubscriptionID = *(value.(*strfmt.UUID))
+
+	if err := o.validateSubscriptionID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSubscriptionID carries on validations for parameter SubscriptionID
+func (o *V2ListEventSubscriptionDeliveriesParams) validateSubscriptionID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("subscription_id", "path", "uuid", o.SubscriptionID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
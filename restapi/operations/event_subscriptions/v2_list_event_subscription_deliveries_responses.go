@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListEventSubscriptionDeliveriesOKCode is the HTTP code returned for type V2ListEventSubscriptionDeliveriesOK
+const V2ListEventSubscriptionDeliveriesOKCode int = 200
+
+/*V2ListEventSubscriptionDeliveriesOK Success.
+
+swagger:response v2ListEventSubscriptionDeliveriesOK
+*/
+type V2ListEventSubscriptionDeliveriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.EventSubscriptionDeliveryList `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionDeliveriesOK creates V2ListEventSubscriptionDeliveriesOK with default headers values
+func NewV2ListEventSubscriptionDeliveriesOK() *V2ListEventSubscriptionDeliveriesOK {
+
+	return &V2ListEventSubscriptionDeliveriesOK{}
+}
+
+// WithPayload adds the payload to the v2 list event subscription deliveries o k response
+func (o *V2ListEventSubscriptionDeliveriesOK) WithPayload(payload models.EventSubscriptionDeliveryList) *V2ListEventSubscriptionDeliveriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscription deliveries o k response
+func (o *V2ListEventSubscriptionDeliveriesOK) SetPayload(payload models.EventSubscriptionDeliveryList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionDeliveriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.EventSubscriptionDeliveryList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListEventSubscriptionDeliveriesUnauthorizedCode is the HTTP code returned for type V2ListEventSubscriptionDeliveriesUnauthorized
+const V2ListEventSubscriptionDeliveriesUnauthorizedCode int = 401
+
+/*V2ListEventSubscriptionDeliveriesUnauthorized Unauthorized.
+
+swagger:response v2ListEventSubscriptionDeliveriesUnauthorized
+*/
+type V2ListEventSubscriptionDeliveriesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionDeliveriesUnauthorized creates V2ListEventSubscriptionDeliveriesUnauthorized with default headers values
+func NewV2ListEventSubscriptionDeliveriesUnauthorized() *V2ListEventSubscriptionDeliveriesUnauthorized {
+
+	return &V2ListEventSubscriptionDeliveriesUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list event subscription deliveries unauthorized response
+func (o *V2ListEventSubscriptionDeliveriesUnauthorized) WithPayload(payload *models.InfraError) *V2ListEventSubscriptionDeliveriesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscription deliveries unauthorized response
+func (o *V2ListEventSubscriptionDeliveriesUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionDeliveriesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListEventSubscriptionDeliveriesForbiddenCode is the HTTP code returned for type V2ListEventSubscriptionDeliveriesForbidden
+const V2ListEventSubscriptionDeliveriesForbiddenCode int = 403
+
+/*V2ListEventSubscriptionDeliveriesForbidden Forbidden.
+
+swagger:response v2ListEventSubscriptionDeliveriesForbidden
+*/
+type V2ListEventSubscriptionDeliveriesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionDeliveriesForbidden creates V2ListEventSubscriptionDeliveriesForbidden with default headers values
+func NewV2ListEventSubscriptionDeliveriesForbidden() *V2ListEventSubscriptionDeliveriesForbidden {
+
+	return &V2ListEventSubscriptionDeliveriesForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list event subscription deliveries forbidden response
+func (o *V2ListEventSubscriptionDeliveriesForbidden) WithPayload(payload *models.InfraError) *V2ListEventSubscriptionDeliveriesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscription deliveries forbidden response
+func (o *V2ListEventSubscriptionDeliveriesForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionDeliveriesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListEventSubscriptionDeliveriesNotFoundCode is the HTTP code returned for type V2ListEventSubscriptionDeliveriesNotFound
+const V2ListEventSubscriptionDeliveriesNotFoundCode int = 404
+
+/*V2ListEventSubscriptionDeliveriesNotFound Error.
+
+swagger:response v2ListEventSubscriptionDeliveriesNotFound
+*/
+type V2ListEventSubscriptionDeliveriesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionDeliveriesNotFound creates V2ListEventSubscriptionDeliveriesNotFound with default headers values
+func NewV2ListEventSubscriptionDeliveriesNotFound() *V2ListEventSubscriptionDeliveriesNotFound {
+
+	return &V2ListEventSubscriptionDeliveriesNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list event subscription deliveries not found response
+func (o *V2ListEventSubscriptionDeliveriesNotFound) WithPayload(payload *models.Error) *V2ListEventSubscriptionDeliveriesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscription deliveries not found response
+func (o *V2ListEventSubscriptionDeliveriesNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionDeliveriesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListEventSubscriptionDeliveriesMethodNotAllowedCode is the HTTP code returned for type V2ListEventSubscriptionDeliveriesMethodNotAllowed
+const V2ListEventSubscriptionDeliveriesMethodNotAllowedCode int = 405
+
+/*V2ListEventSubscriptionDeliveriesMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ListEventSubscriptionDeliveriesMethodNotAllowed
+*/
+type V2ListEventSubscriptionDeliveriesMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionDeliveriesMethodNotAllowed creates V2ListEventSubscriptionDeliveriesMethodNotAllowed with default headers values
+func NewV2ListEventSubscriptionDeliveriesMethodNotAllowed() *V2ListEventSubscriptionDeliveriesMethodNotAllowed {
+
+	return &V2ListEventSubscriptionDeliveriesMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 list event subscription deliveries method not allowed response
+func (o *V2ListEventSubscriptionDeliveriesMethodNotAllowed) WithPayload(payload *models.Error) *V2ListEventSubscriptionDeliveriesMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscription deliveries method not allowed response
+func (o *V2ListEventSubscriptionDeliveriesMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionDeliveriesMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListEventSubscriptionDeliveriesInternalServerErrorCode is the HTTP code returned for type V2ListEventSubscriptionDeliveriesInternalServerError
+const V2ListEventSubscriptionDeliveriesInternalServerErrorCode int = 500
+
+/*V2ListEventSubscriptionDeliveriesInternalServerError Error.
+
+swagger:response v2ListEventSubscriptionDeliveriesInternalServerError
+*/
+type V2ListEventSubscriptionDeliveriesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionDeliveriesInternalServerError creates V2ListEventSubscriptionDeliveriesInternalServerError with default headers values
+func NewV2ListEventSubscriptionDeliveriesInternalServerError() *V2ListEventSubscriptionDeliveriesInternalServerError {
+
+	return &V2ListEventSubscriptionDeliveriesInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list event subscription deliveries internal server error response
+func (o *V2ListEventSubscriptionDeliveriesInternalServerError) WithPayload(payload *models.Error) *V2ListEventSubscriptionDeliveriesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscription deliveries internal server error response
+func (o *V2ListEventSubscriptionDeliveriesInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionDeliveriesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
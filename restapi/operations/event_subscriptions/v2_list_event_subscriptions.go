@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListEventSubscriptionsHandlerFunc turns a function with the right signature into a v2 list event subscriptions handler
+type V2ListEventSubscriptionsHandlerFunc func(V2ListEventSubscriptionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListEventSubscriptionsHandlerFunc) Handle(params V2ListEventSubscriptionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListEventSubscriptionsHandler interface for that can handle valid v2 list event subscriptions params
+type V2ListEventSubscriptionsHandler interface {
+	Handle(V2ListEventSubscriptionsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListEventSubscriptions creates a new http.Handler for the v2 list event subscriptions operation
+func NewV2ListEventSubscriptions(ctx *middleware.Context, handler V2ListEventSubscriptionsHandler) *V2ListEventSubscriptions {
+	return &V2ListEventSubscriptions{Context: ctx, Handler: handler}
+}
+
+/* V2ListEventSubscriptions swagger:route GET /v2/event-subscriptions event_subscriptions v2ListEventSubscriptions
+
+Retrieves the list of event subscriptions.
+
+*/
+type V2ListEventSubscriptions struct {
+	Context *middleware.Context
+	Handler V2ListEventSubscriptionsHandler
+}
+
+func (o *V2ListEventSubscriptions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListEventSubscriptionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,46 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// NewV2ListEventSubscriptionsParams creates a new V2ListEventSubscriptionsParams object
+//
+// There are no default values defined in the spec.
+func NewV2ListEventSubscriptionsParams() V2ListEventSubscriptionsParams {
+
+	return V2ListEventSubscriptionsParams{}
+}
+
+// V2ListEventSubscriptionsParams contains all the bound params for the v2 list event subscriptions operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2ListEventSubscriptions
+type V2ListEventSubscriptionsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ListEventSubscriptionsParams() beforehand.
+func (o *V2ListEventSubscriptionsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
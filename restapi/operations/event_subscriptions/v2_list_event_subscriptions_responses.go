@@ -0,0 +1,237 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListEventSubscriptionsOKCode is the HTTP code returned for type V2ListEventSubscriptionsOK
+const V2ListEventSubscriptionsOKCode int = 200
+
+/*V2ListEventSubscriptionsOK Success.
+
+swagger:response v2ListEventSubscriptionsOK
+*/
+type V2ListEventSubscriptionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.EventSubscriptionList `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionsOK creates V2ListEventSubscriptionsOK with default headers values
+func NewV2ListEventSubscriptionsOK() *V2ListEventSubscriptionsOK {
+
+	return &V2ListEventSubscriptionsOK{}
+}
+
+// WithPayload adds the payload to the v2 list event subscriptions o k response
+func (o *V2ListEventSubscriptionsOK) WithPayload(payload models.EventSubscriptionList) *V2ListEventSubscriptionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscriptions o k response
+func (o *V2ListEventSubscriptionsOK) SetPayload(payload models.EventSubscriptionList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.EventSubscriptionList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListEventSubscriptionsUnauthorizedCode is the HTTP code returned for type V2ListEventSubscriptionsUnauthorized
+const V2ListEventSubscriptionsUnauthorizedCode int = 401
+
+/*V2ListEventSubscriptionsUnauthorized Unauthorized.
+
+swagger:response v2ListEventSubscriptionsUnauthorized
+*/
+type V2ListEventSubscriptionsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionsUnauthorized creates V2ListEventSubscriptionsUnauthorized with default headers values
+func NewV2ListEventSubscriptionsUnauthorized() *V2ListEventSubscriptionsUnauthorized {
+
+	return &V2ListEventSubscriptionsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list event subscriptions unauthorized response
+func (o *V2ListEventSubscriptionsUnauthorized) WithPayload(payload *models.InfraError) *V2ListEventSubscriptionsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscriptions unauthorized response
+func (o *V2ListEventSubscriptionsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListEventSubscriptionsForbiddenCode is the HTTP code returned for type V2ListEventSubscriptionsForbidden
+const V2ListEventSubscriptionsForbiddenCode int = 403
+
+/*V2ListEventSubscriptionsForbidden Forbidden.
+
+swagger:response v2ListEventSubscriptionsForbidden
+*/
+type V2ListEventSubscriptionsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionsForbidden creates V2ListEventSubscriptionsForbidden with default headers values
+func NewV2ListEventSubscriptionsForbidden() *V2ListEventSubscriptionsForbidden {
+
+	return &V2ListEventSubscriptionsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list event subscriptions forbidden response
+func (o *V2ListEventSubscriptionsForbidden) WithPayload(payload *models.InfraError) *V2ListEventSubscriptionsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscriptions forbidden response
+func (o *V2ListEventSubscriptionsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListEventSubscriptionsMethodNotAllowedCode is the HTTP code returned for type V2ListEventSubscriptionsMethodNotAllowed
+const V2ListEventSubscriptionsMethodNotAllowedCode int = 405
+
+/*V2ListEventSubscriptionsMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ListEventSubscriptionsMethodNotAllowed
+*/
+type V2ListEventSubscriptionsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionsMethodNotAllowed creates V2ListEventSubscriptionsMethodNotAllowed with default headers values
+func NewV2ListEventSubscriptionsMethodNotAllowed() *V2ListEventSubscriptionsMethodNotAllowed {
+
+	return &V2ListEventSubscriptionsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 list event subscriptions method not allowed response
+func (o *V2ListEventSubscriptionsMethodNotAllowed) WithPayload(payload *models.Error) *V2ListEventSubscriptionsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscriptions method not allowed response
+func (o *V2ListEventSubscriptionsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListEventSubscriptionsInternalServerErrorCode is the HTTP code returned for type V2ListEventSubscriptionsInternalServerError
+const V2ListEventSubscriptionsInternalServerErrorCode int = 500
+
+/*V2ListEventSubscriptionsInternalServerError Error.
+
+swagger:response v2ListEventSubscriptionsInternalServerError
+*/
+type V2ListEventSubscriptionsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListEventSubscriptionsInternalServerError creates V2ListEventSubscriptionsInternalServerError with default headers values
+func NewV2ListEventSubscriptionsInternalServerError() *V2ListEventSubscriptionsInternalServerError {
+
+	return &V2ListEventSubscriptionsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list event subscriptions internal server error response
+func (o *V2ListEventSubscriptionsInternalServerError) WithPayload(payload *models.Error) *V2ListEventSubscriptionsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list event subscriptions internal server error response
+func (o *V2ListEventSubscriptionsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventSubscriptionsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
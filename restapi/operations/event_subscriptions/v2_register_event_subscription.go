@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2RegisterEventSubscriptionHandlerFunc turns a function with the right signature into a v2 register event subscription handler
+type V2RegisterEventSubscriptionHandlerFunc func(V2RegisterEventSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2RegisterEventSubscriptionHandlerFunc) Handle(params V2RegisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2RegisterEventSubscriptionHandler interface for that can handle valid v2 register event subscription params
+type V2RegisterEventSubscriptionHandler interface {
+	Handle(V2RegisterEventSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2RegisterEventSubscription creates a new http.Handler for the v2 register event subscription operation
+func NewV2RegisterEventSubscription(ctx *middleware.Context, handler V2RegisterEventSubscriptionHandler) *V2RegisterEventSubscription {
+	return &V2RegisterEventSubscription{Context: ctx, Handler: handler}
+}
+
+/* V2RegisterEventSubscription swagger:route POST /v2/event-subscriptions event_subscriptions v2RegisterEventSubscription
+
+Creates a subscription that delivers events to an HTTP endpoint as CloudEvents.
+
+*/
+type V2RegisterEventSubscription struct {
+	Context *middleware.Context
+	Handler V2RegisterEventSubscriptionHandler
+}
+
+func (o *V2RegisterEventSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2RegisterEventSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
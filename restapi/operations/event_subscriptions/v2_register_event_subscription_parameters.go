@@ -0,0 +1,85 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2RegisterEventSubscriptionParams creates a new V2RegisterEventSubscriptionParams object
+//
+// There are no default values defined in the spec.
+func NewV2RegisterEventSubscriptionParams() V2RegisterEventSubscriptionParams {
+
+	return V2RegisterEventSubscriptionParams{}
+}
+
+// V2RegisterEventSubscriptionParams contains all the bound params for the v2 register event subscription operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2RegisterEventSubscription
+type V2RegisterEventSubscriptionParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The properties describing the new event subscription.
+	  Required: true
+	  In: body
+	*/
+	NewEventSubscriptionParams *models.EventSubscriptionCreateParams
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2RegisterEventSubscriptionParams() beforehand.
+func (o *V2RegisterEventSubscriptionParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.EventSubscriptionCreateParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("newEventSubscriptionParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("newEventSubscriptionParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(context.Background())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.NewEventSubscriptionParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("newEventSubscriptionParams", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
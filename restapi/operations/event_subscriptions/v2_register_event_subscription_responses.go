@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RegisterEventSubscriptionCreatedCode is the HTTP code returned for type V2RegisterEventSubscriptionCreated
+const V2RegisterEventSubscriptionCreatedCode int = 201
+
+/*V2RegisterEventSubscriptionCreated Success.
+
+swagger:response v2RegisterEventSubscriptionCreated
+*/
+type V2RegisterEventSubscriptionCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EventSubscription `json:"body,omitempty"`
+}
+
+// NewV2RegisterEventSubscriptionCreated creates V2RegisterEventSubscriptionCreated with default headers values
+func NewV2RegisterEventSubscriptionCreated() *V2RegisterEventSubscriptionCreated {
+
+	return &V2RegisterEventSubscriptionCreated{}
+}
+
+// WithPayload adds the payload to the v2 register event subscription created response
+func (o *V2RegisterEventSubscriptionCreated) WithPayload(payload *models.EventSubscription) *V2RegisterEventSubscriptionCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register event subscription created response
+func (o *V2RegisterEventSubscriptionCreated) SetPayload(payload *models.EventSubscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterEventSubscriptionCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterEventSubscriptionBadRequestCode is the HTTP code returned for type V2RegisterEventSubscriptionBadRequest
+const V2RegisterEventSubscriptionBadRequestCode int = 400
+
+/*V2RegisterEventSubscriptionBadRequest Error.
+
+swagger:response v2RegisterEventSubscriptionBadRequest
+*/
+type V2RegisterEventSubscriptionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterEventSubscriptionBadRequest creates V2RegisterEventSubscriptionBadRequest with default headers values
+func NewV2RegisterEventSubscriptionBadRequest() *V2RegisterEventSubscriptionBadRequest {
+
+	return &V2RegisterEventSubscriptionBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 register event subscription bad request response
+func (o *V2RegisterEventSubscriptionBadRequest) WithPayload(payload *models.Error) *V2RegisterEventSubscriptionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register event subscription bad request response
+func (o *V2RegisterEventSubscriptionBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterEventSubscriptionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterEventSubscriptionUnauthorizedCode is the HTTP code returned for type V2RegisterEventSubscriptionUnauthorized
+const V2RegisterEventSubscriptionUnauthorizedCode int = 401
+
+/*V2RegisterEventSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2RegisterEventSubscriptionUnauthorized
+*/
+type V2RegisterEventSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RegisterEventSubscriptionUnauthorized creates V2RegisterEventSubscriptionUnauthorized with default headers values
+func NewV2RegisterEventSubscriptionUnauthorized() *V2RegisterEventSubscriptionUnauthorized {
+
+	return &V2RegisterEventSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 register event subscription unauthorized response
+func (o *V2RegisterEventSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2RegisterEventSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register event subscription unauthorized response
+func (o *V2RegisterEventSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterEventSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterEventSubscriptionForbiddenCode is the HTTP code returned for type V2RegisterEventSubscriptionForbidden
+const V2RegisterEventSubscriptionForbiddenCode int = 403
+
+/*V2RegisterEventSubscriptionForbidden Forbidden.
+
+swagger:response v2RegisterEventSubscriptionForbidden
+*/
+type V2RegisterEventSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RegisterEventSubscriptionForbidden creates V2RegisterEventSubscriptionForbidden with default headers values
+func NewV2RegisterEventSubscriptionForbidden() *V2RegisterEventSubscriptionForbidden {
+
+	return &V2RegisterEventSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 register event subscription forbidden response
+func (o *V2RegisterEventSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2RegisterEventSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register event subscription forbidden response
+func (o *V2RegisterEventSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterEventSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterEventSubscriptionMethodNotAllowedCode is the HTTP code returned for type V2RegisterEventSubscriptionMethodNotAllowed
+const V2RegisterEventSubscriptionMethodNotAllowedCode int = 405
+
+/*V2RegisterEventSubscriptionMethodNotAllowed Method Not Allowed.
+
+swagger:response v2RegisterEventSubscriptionMethodNotAllowed
+*/
+type V2RegisterEventSubscriptionMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterEventSubscriptionMethodNotAllowed creates V2RegisterEventSubscriptionMethodNotAllowed with default headers values
+func NewV2RegisterEventSubscriptionMethodNotAllowed() *V2RegisterEventSubscriptionMethodNotAllowed {
+
+	return &V2RegisterEventSubscriptionMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 register event subscription method not allowed response
+func (o *V2RegisterEventSubscriptionMethodNotAllowed) WithPayload(payload *models.Error) *V2RegisterEventSubscriptionMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register event subscription method not allowed response
+func (o *V2RegisterEventSubscriptionMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterEventSubscriptionMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RegisterEventSubscriptionInternalServerErrorCode is the HTTP code returned for type V2RegisterEventSubscriptionInternalServerError
+const V2RegisterEventSubscriptionInternalServerErrorCode int = 500
+
+/*V2RegisterEventSubscriptionInternalServerError Error.
+
+swagger:response v2RegisterEventSubscriptionInternalServerError
+*/
+type V2RegisterEventSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RegisterEventSubscriptionInternalServerError creates V2RegisterEventSubscriptionInternalServerError with default headers values
+func NewV2RegisterEventSubscriptionInternalServerError() *V2RegisterEventSubscriptionInternalServerError {
+
+	return &V2RegisterEventSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 register event subscription internal server error response
+func (o *V2RegisterEventSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2RegisterEventSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 register event subscription internal server error response
+func (o *V2RegisterEventSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RegisterEventSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
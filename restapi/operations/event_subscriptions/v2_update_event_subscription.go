@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2UpdateEventSubscriptionHandlerFunc turns a function with the right signature into a v2 update event subscription handler
+type V2UpdateEventSubscriptionHandlerFunc func(V2UpdateEventSubscriptionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2UpdateEventSubscriptionHandlerFunc) Handle(params V2UpdateEventSubscriptionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2UpdateEventSubscriptionHandler interface for that can handle valid v2 update event subscription params
+type V2UpdateEventSubscriptionHandler interface {
+	Handle(V2UpdateEventSubscriptionParams, interface{}) middleware.Responder
+}
+
+// NewV2UpdateEventSubscription creates a new http.Handler for the v2 update event subscription operation
+func NewV2UpdateEventSubscription(ctx *middleware.Context, handler V2UpdateEventSubscriptionHandler) *V2UpdateEventSubscription {
+	return &V2UpdateEventSubscription{Context: ctx, Handler: handler}
+}
+
+/* V2UpdateEventSubscription swagger:route PUT /v2/event-subscriptions/{subscription_id} event_subscriptions v2UpdateEventSubscription
+
+Replaces the properties of the event subscription. The secret of the subscription is kept when it is not set.
+
+*/
+type V2UpdateEventSubscription struct {
+	Context *middleware.Context
+	Handler V2UpdateEventSubscriptionHandler
+}
+
+func (o *V2UpdateEventSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2UpdateEventSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package event_subscriptions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateEventSubscriptionOKCode is the HTTP code returned for type V2UpdateEventSubscriptionOK
+const V2UpdateEventSubscriptionOKCode int = 200
+
+/*V2UpdateEventSubscriptionOK Success.
+
+swagger:response v2UpdateEventSubscriptionOK
+*/
+type V2UpdateEventSubscriptionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EventSubscription `json:"body,omitempty"`
+}
+
+// NewV2UpdateEventSubscriptionOK creates V2UpdateEventSubscriptionOK with default headers values
+func NewV2UpdateEventSubscriptionOK() *V2UpdateEventSubscriptionOK {
+
+	return &V2UpdateEventSubscriptionOK{}
+}
+
+// WithPayload adds the payload to the v2 update event subscription o k response
+func (o *V2UpdateEventSubscriptionOK) WithPayload(payload *models.EventSubscription) *V2UpdateEventSubscriptionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update event subscription o k response
+func (o *V2UpdateEventSubscriptionOK) SetPayload(payload *models.EventSubscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateEventSubscriptionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateEventSubscriptionBadRequestCode is the HTTP code returned for type V2UpdateEventSubscriptionBadRequest
+const V2UpdateEventSubscriptionBadRequestCode int = 400
+
+/*V2UpdateEventSubscriptionBadRequest Error.
+
+swagger:response v2UpdateEventSubscriptionBadRequest
+*/
+type V2UpdateEventSubscriptionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateEventSubscriptionBadRequest creates V2UpdateEventSubscriptionBadRequest with default headers values
+func NewV2UpdateEventSubscriptionBadRequest() *V2UpdateEventSubscriptionBadRequest {
+
+	return &V2UpdateEventSubscriptionBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 update event subscription bad request response
+func (o *V2UpdateEventSubscriptionBadRequest) WithPayload(payload *models.Error) *V2UpdateEventSubscriptionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update event subscription bad request response
+func (o *V2UpdateEventSubscriptionBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateEventSubscriptionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateEventSubscriptionUnauthorizedCode is the HTTP code returned for type V2UpdateEventSubscriptionUnauthorized
+const V2UpdateEventSubscriptionUnauthorizedCode int = 401
+
+/*V2UpdateEventSubscriptionUnauthorized Unauthorized.
+
+swagger:response v2UpdateEventSubscriptionUnauthorized
+*/
+type V2UpdateEventSubscriptionUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateEventSubscriptionUnauthorized creates V2UpdateEventSubscriptionUnauthorized with default headers values
+func NewV2UpdateEventSubscriptionUnauthorized() *V2UpdateEventSubscriptionUnauthorized {
+
+	return &V2UpdateEventSubscriptionUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 update event subscription unauthorized response
+func (o *V2UpdateEventSubscriptionUnauthorized) WithPayload(payload *models.InfraError) *V2UpdateEventSubscriptionUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update event subscription unauthorized response
+func (o *V2UpdateEventSubscriptionUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateEventSubscriptionUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateEventSubscriptionForbiddenCode is the HTTP code returned for type V2UpdateEventSubscriptionForbidden
+const V2UpdateEventSubscriptionForbiddenCode int = 403
+
+/*V2UpdateEventSubscriptionForbidden Forbidden.
+
+swagger:response v2UpdateEventSubscriptionForbidden
+*/
+type V2UpdateEventSubscriptionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateEventSubscriptionForbidden creates V2UpdateEventSubscriptionForbidden with default headers values
+func NewV2UpdateEventSubscriptionForbidden() *V2UpdateEventSubscriptionForbidden {
+
+	return &V2UpdateEventSubscriptionForbidden{}
+}
+
+// WithPayload adds the payload to the v2 update event subscription forbidden response
+func (o *V2UpdateEventSubscriptionForbidden) WithPayload(payload *models.InfraError) *V2UpdateEventSubscriptionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update event subscription forbidden response
+func (o *V2UpdateEventSubscriptionForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateEventSubscriptionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateEventSubscriptionNotFoundCode is the HTTP code returned for type V2UpdateEventSubscriptionNotFound
+const V2UpdateEventSubscriptionNotFoundCode int = 404
+
+/*V2UpdateEventSubscriptionNotFound Error.
+
+swagger:response v2UpdateEventSubscriptionNotFound
+*/
+type V2UpdateEventSubscriptionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateEventSubscriptionNotFound creates V2UpdateEventSubscriptionNotFound with default headers values
+func NewV2UpdateEventSubscriptionNotFound() *V2UpdateEventSubscriptionNotFound {
+
+	return &V2UpdateEventSubscriptionNotFound{}
+}
+
+// WithPayload adds the payload to the v2 update event subscription not found response
+func (o *V2UpdateEventSubscriptionNotFound) WithPayload(payload *models.Error) *V2UpdateEventSubscriptionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update event subscription not found response
+func (o *V2UpdateEventSubscriptionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateEventSubscriptionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateEventSubscriptionMethodNotAllowedCode is the HTTP code returned for type V2UpdateEventSubscriptionMethodNotAllowed
+const V2UpdateEventSubscriptionMethodNotAllowedCode int = 405
+
+/*V2UpdateEventSubscriptionMethodNotAllowed Method Not Allowed.
+
+swagger:response v2UpdateEventSubscriptionMethodNotAllowed
+*/
+type V2UpdateEventSubscriptionMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateEventSubscriptionMethodNotAllowed creates V2UpdateEventSubscriptionMethodNotAllowed with default headers values
+func NewV2UpdateEventSubscriptionMethodNotAllowed() *V2UpdateEventSubscriptionMethodNotAllowed {
+
+	return &V2UpdateEventSubscriptionMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 update event subscription method not allowed response
+func (o *V2UpdateEventSubscriptionMethodNotAllowed) WithPayload(payload *models.Error) *V2UpdateEventSubscriptionMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update event subscription method not allowed response
+func (o *V2UpdateEventSubscriptionMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateEventSubscriptionMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateEventSubscriptionInternalServerErrorCode is the HTTP code returned for type V2UpdateEventSubscriptionInternalServerError
+const V2UpdateEventSubscriptionInternalServerErrorCode int = 500
+
+/*V2UpdateEventSubscriptionInternalServerError Error.
+
+swagger:response v2UpdateEventSubscriptionInternalServerError
+*/
+type V2UpdateEventSubscriptionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateEventSubscriptionInternalServerError creates V2UpdateEventSubscriptionInternalServerError with default headers values
+func NewV2UpdateEventSubscriptionInternalServerError() *V2UpdateEventSubscriptionInternalServerError {
+
+	return &V2UpdateEventSubscriptionInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 update event subscription internal server error response
+func (o *V2UpdateEventSubscriptionInternalServerError) WithPayload(payload *models.Error) *V2UpdateEventSubscriptionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update event subscription internal server error response
+func (o *V2UpdateEventSubscriptionInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateEventSubscriptionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
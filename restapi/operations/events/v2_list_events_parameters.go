@@ -6,6 +6,7 @@ package events
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -17,11 +18,22 @@ import (
 )
 
 // NewV2ListEventsParams creates a new V2ListEventsParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewV2ListEventsParams() V2ListEventsParams {
 
-	return V2ListEventsParams{}
+	var (
+		// initialize parameters with default values
+
+		archivedDefault = bool(false)
+
+		orderDefault = string("ascending")
+	)
+
+	return V2ListEventsParams{
+		Archived: &archivedDefault,
+
+		Order: &orderDefault,
+	}
 }
 
 // V2ListEventsParams contains all the bound params for the v2 list events operation
@@ -33,6 +45,11 @@ type V2ListEventsParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Return the events that were pruned from the database and archived, instead of the current events. Requires either cluster_id or infra_env_id.
+	  In: query
+	  Default: false
+	*/
+	Archived *bool
 	/*A comma-separated list of event categories.
 	  In: query
 	*/
@@ -41,6 +58,10 @@ type V2ListEventsParams struct {
 	  In: query
 	*/
 	ClusterID *strfmt.UUID
+	/*Only return the events that occurred before this time.
+	  In: query
+	*/
+	EndTime *strfmt.DateTime
 	/*A host in the specified cluster to return events for.
 	  In: query
 	*/
@@ -49,6 +70,37 @@ type V2ListEventsParams struct {
 	  In: query
 	*/
 	InfraEnvID *strfmt.UUID
+	/*The maximal number of events to return. All the events are returned when not set.
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
+	/*Only return the events whose message contains this text, ignoring case.
+	  In: query
+	*/
+	Message *string
+	/*A comma-separated list of event names. Events of all names are returned when not set.
+	  In: query
+	*/
+	Names []string
+	/*The number of events to skip before starting to return events.
+	  Minimum: 0
+	  In: query
+	*/
+	Offset *int64
+	/*Order the events by their time, from the oldest (ascending) or from the latest (descending).
+	  In: query
+	  Default: "ascending"
+	*/
+	Order *string
+	/*A comma-separated list of event severities. Events of all severities are returned when not set.
+	  In: query
+	*/
+	Severities []string
+	/*Only return the events that occurred at or after this time.
+	  In: query
+	*/
+	StartTime *strfmt.DateTime
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -62,6 +114,11 @@ func (o *V2ListEventsParams) BindRequest(r *http.Request, route *middleware.Matc
 
 	qs := runtime.Values(r.URL.Query())
 
+	qArchived, qhkArchived, _ := qs.GetOK("archived")
+	if err := o.bindArchived(qArchived, qhkArchived, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qCategories, qhkCategories, _ := qs.GetOK("categories")
 	if err := o.bindCategories(qCategories, qhkCategories, route.Formats); err != nil {
 		res = append(res, err)
@@ -72,6 +129,11 @@ func (o *V2ListEventsParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	qEndTime, qhkEndTime, _ := qs.GetOK("end_time")
+	if err := o.bindEndTime(qEndTime, qhkEndTime, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qHostID, qhkHostID, _ := qs.GetOK("host_id")
 	if err := o.bindHostID(qHostID, qhkHostID, route.Formats); err != nil {
 		res = append(res, err)
@@ -81,12 +143,71 @@ func (o *V2ListEventsParams) BindRequest(r *http.Request, route *middleware.Matc
 	if err := o.bindInfraEnvID(qInfraEnvID, qhkInfraEnvID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMessage, qhkMessage, _ := qs.GetOK("message")
+	if err := o.bindMessage(qMessage, qhkMessage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNames, qhkNames, _ := qs.GetOK("names")
+	if err := o.bindNames(qNames, qhkNames, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrder, qhkOrder, _ := qs.GetOK("order")
+	if err := o.bindOrder(qOrder, qhkOrder, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSeverities, qhkSeverities, _ := qs.GetOK("severities")
+	if err := o.bindSeverities(qSeverities, qhkSeverities, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStartTime, qhkStartTime, _ := qs.GetOK("start_time")
+	if err := o.bindStartTime(qStartTime, qhkStartTime, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindArchived binds and validates parameter Archived from query.
+func (o *V2ListEventsParams) bindArchived(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ListEventsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("archived", "query", "bool", raw)
+	}
+	o.Archived = &value
+
+	return nil
+}
+
 // bindCategories binds and validates array parameter Categories from query.
 //
 // Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
@@ -151,6 +272,43 @@ func (o *V2ListEventsParams) validateClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindEndTime binds and validates parameter EndTime from query.
+func (o *V2ListEventsParams) bindEndTime(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("end_time", "query", "strfmt.DateTime", raw)
+	}
+	o.EndTime = (value.(*strfmt.DateTime))
+
+	if err := o.validateEndTime(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateEndTime carries on validations for parameter EndTime
+func (o *V2ListEventsParams) validateEndTime(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("end_time", "query", "date-time", o.EndTime.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
 // bindHostID binds and validates parameter HostID from query.
 func (o *V2ListEventsParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -224,3 +382,223 @@ func (o *V2ListEventsParams) validateInfraEnvID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *V2ListEventsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *V2ListEventsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMessage binds and validates parameter Message from query.
+func (o *V2ListEventsParams) bindMessage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Message = &raw
+
+	return nil
+}
+
+// bindNames binds and validates array parameter Names from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2ListEventsParams) bindNames(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvNames string
+	if len(rawData) > 0 {
+		qvNames = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	namesIC := swag.SplitByFormat(qvNames, "")
+	if len(namesIC) == 0 {
+		return nil
+	}
+
+	var namesIR []string
+	for _, namesIV := range namesIC {
+		namesI := namesIV
+
+		namesIR = append(namesIR, namesI)
+	}
+
+	o.Names = namesIR
+
+	return nil
+}
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *V2ListEventsParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	if err := o.validateOffset(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOffset carries on validations for parameter Offset
+func (o *V2ListEventsParams) validateOffset(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("offset", "query", *o.Offset, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrder binds and validates parameter Order from query.
+func (o *V2ListEventsParams) bindOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ListEventsParams()
+		return nil
+	}
+	o.Order = &raw
+
+	if err := o.validateOrder(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrder carries on validations for parameter Order
+func (o *V2ListEventsParams) validateOrder(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("order", "query", *o.Order, []interface{}{"ascending", "descending"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSeverities binds and validates array parameter Severities from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2ListEventsParams) bindSeverities(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvSeverities string
+	if len(rawData) > 0 {
+		qvSeverities = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	severitiesIC := swag.SplitByFormat(qvSeverities, "")
+	if len(severitiesIC) == 0 {
+		return nil
+	}
+
+	var severitiesIR []string
+	for i, severitiesIV := range severitiesIC {
+		severitiesI := severitiesIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "severities", i), "query", severitiesI, []interface{}{"info", "warning", "error", "critical"}, true); err != nil {
+			return err
+		}
+
+		severitiesIR = append(severitiesIR, severitiesI)
+	}
+
+	o.Severities = severitiesIR
+
+	return nil
+}
+
+// bindStartTime binds and validates parameter StartTime from query.
+func (o *V2ListEventsParams) bindStartTime(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("start_time", "query", "strfmt.DateTime", raw)
+	}
+	o.StartTime = (value.(*strfmt.DateTime))
+
+	if err := o.validateStartTime(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStartTime carries on validations for parameter StartTime
+func (o *V2ListEventsParams) validateStartTime(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("start_time", "query", "date-time", o.StartTime.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
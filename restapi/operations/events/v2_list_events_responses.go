@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -21,6 +22,10 @@ const V2ListEventsOKCode int = 200
 swagger:response v2ListEventsOK
 */
 type V2ListEventsOK struct {
+	/*The number of events that match the filters, regardless of the limit and offset.
+
+	 */
+	EventCount int64 `json:"Event-Count"`
 
 	/*
 	  In: Body
@@ -34,6 +39,17 @@ func NewV2ListEventsOK() *V2ListEventsOK {
 	return &V2ListEventsOK{}
 }
 
+// WithEventCount adds the eventCount to the v2 list events o k response
+func (o *V2ListEventsOK) WithEventCount(eventCount int64) *V2ListEventsOK {
+	o.EventCount = eventCount
+	return o
+}
+
+// SetEventCount sets the eventCount to the v2 list events o k response
+func (o *V2ListEventsOK) SetEventCount(eventCount int64) {
+	o.EventCount = eventCount
+}
+
 // WithPayload adds the payload to the v2 list events o k response
 func (o *V2ListEventsOK) WithPayload(payload models.EventList) *V2ListEventsOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *V2ListEventsOK) SetPayload(payload models.EventList) {
 // WriteResponse to the client
 func (o *V2ListEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Event-Count
+
+	eventCount := swag.FormatInt64(o.EventCount)
+	if eventCount != "" {
+		rw.Header().Set("Event-Count", eventCount)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -60,6 +83,50 @@ func (o *V2ListEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.
 	}
 }
 
+// V2ListEventsBadRequestCode is the HTTP code returned for type V2ListEventsBadRequest
+const V2ListEventsBadRequestCode int = 400
+
+/*V2ListEventsBadRequest Error.
+
+swagger:response v2ListEventsBadRequest
+*/
+type V2ListEventsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListEventsBadRequest creates V2ListEventsBadRequest with default headers values
+func NewV2ListEventsBadRequest() *V2ListEventsBadRequest {
+
+	return &V2ListEventsBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 list events bad request response
+func (o *V2ListEventsBadRequest) WithPayload(payload *models.Error) *V2ListEventsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list events bad request response
+func (o *V2ListEventsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListEventsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // V2ListEventsUnauthorizedCode is the HTTP code returned for type V2ListEventsUnauthorized
 const V2ListEventsUnauthorizedCode int = 401
 
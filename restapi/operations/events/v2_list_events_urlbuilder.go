@@ -16,10 +16,19 @@ import (
 
 // V2ListEventsURL generates an URL for the v2 list events operation
 type V2ListEventsURL struct {
+	Archived   *bool
 	Categories []string
 	ClusterID  *strfmt.UUID
+	EndTime    *strfmt.DateTime
 	HostID     *strfmt.UUID
 	InfraEnvID *strfmt.UUID
+	Limit      *int64
+	Message    *string
+	Names      []string
+	Offset     *int64
+	Order      *string
+	Severities []string
+	StartTime  *strfmt.DateTime
 
 	_basePath string
 	// avoid unkeyed usage
@@ -55,6 +64,14 @@ func (o *V2ListEventsURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var archivedQ string
+	if o.Archived != nil {
+		archivedQ = swag.FormatBool(*o.Archived)
+	}
+	if archivedQ != "" {
+		qs.Set("archived", archivedQ)
+	}
+
 	var categoriesIR []string
 	for _, categoriesI := range o.Categories {
 		categoriesIS := categoriesI
@@ -80,6 +97,14 @@ func (o *V2ListEventsURL) Build() (*url.URL, error) {
 		qs.Set("cluster_id", clusterIDQ)
 	}
 
+	var endTimeQ string
+	if o.EndTime != nil {
+		endTimeQ = o.EndTime.String()
+	}
+	if endTimeQ != "" {
+		qs.Set("end_time", endTimeQ)
+	}
+
 	var hostIDQ string
 	if o.HostID != nil {
 		hostIDQ = o.HostID.String()
@@ -96,6 +121,80 @@ func (o *V2ListEventsURL) Build() (*url.URL, error) {
 		qs.Set("infra_env_id", infraEnvIDQ)
 	}
 
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var messageQ string
+	if o.Message != nil {
+		messageQ = *o.Message
+	}
+	if messageQ != "" {
+		qs.Set("message", messageQ)
+	}
+
+	var namesIR []string
+	for _, namesI := range o.Names {
+		namesIS := namesI
+		if namesIS != "" {
+			namesIR = append(namesIR, namesIS)
+		}
+	}
+
+	names := swag.JoinByFormat(namesIR, "")
+
+	if len(names) > 0 {
+		qsv := names[0]
+		if qsv != "" {
+			qs.Set("names", qsv)
+		}
+	}
+
+	var offsetQ string
+	if o.Offset != nil {
+		offsetQ = swag.FormatInt64(*o.Offset)
+	}
+	if offsetQ != "" {
+		qs.Set("offset", offsetQ)
+	}
+
+	var orderQ string
+	if o.Order != nil {
+		orderQ = *o.Order
+	}
+	if orderQ != "" {
+		qs.Set("order", orderQ)
+	}
+
+	var severitiesIR []string
+	for _, severitiesI := range o.Severities {
+		severitiesIS := severitiesI
+		if severitiesIS != "" {
+			severitiesIR = append(severitiesIR, severitiesIS)
+		}
+	}
+
+	severities := swag.JoinByFormat(severitiesIR, "")
+
+	if len(severities) > 0 {
+		qsv := severities[0]
+		if qsv != "" {
+			qs.Set("severities", qsv)
+		}
+	}
+
+	var startTimeQ string
+	if o.StartTime != nil {
+		startTimeQ = o.StartTime.String()
+	}
+	if startTimeQ != "" {
+		qs.Set("start_time", startTimeQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
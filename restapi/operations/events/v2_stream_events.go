@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2StreamEventsHandlerFunc turns a function with the right signature into a v2 stream events handler
+type V2StreamEventsHandlerFunc func(V2StreamEventsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2StreamEventsHandlerFunc) Handle(params V2StreamEventsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2StreamEventsHandler interface for that can handle valid v2 stream events params
+type V2StreamEventsHandler interface {
+	Handle(V2StreamEventsParams, interface{}) middleware.Responder
+}
+
+// NewV2StreamEvents creates a new http.Handler for the v2 stream events operation
+func NewV2StreamEvents(ctx *middleware.Context, handler V2StreamEventsHandler) *V2StreamEvents {
+	return &V2StreamEvents{Context: ctx, Handler: handler}
+}
+
+/* V2StreamEvents swagger:route GET /v2/events/stream events v2StreamEvents
+
+Streams the events of a cluster, host or infra-env as server-sent events while they are saved.
+Each message holds an event in JSON format and its ID, which can be sent back in the Last-Event-ID header
+(or the last_event_id parameter) to resume the stream after the last received event.
+
+
+*/
+type V2StreamEvents struct {
+	Context *middleware.Context
+	Handler V2StreamEventsHandler
+}
+
+func (o *V2StreamEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2StreamEventsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
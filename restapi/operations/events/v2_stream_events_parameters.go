@@ -0,0 +1,395 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2StreamEventsParams creates a new V2StreamEventsParams object
+//
+// There are no default values defined in the spec.
+func NewV2StreamEventsParams() V2StreamEventsParams {
+
+	return V2StreamEventsParams{}
+}
+
+// V2StreamEventsParams contains all the bound params for the v2 stream events operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2StreamEvents
+type V2StreamEventsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The ID of the last event received before the stream was interrupted, as sent by EventSource clients when they reconnect.
+	  Minimum: 0
+	  In: header
+	*/
+	LastEventIDHeader *int64
+	/*A comma-separated list of event categories.
+	  In: query
+	*/
+	Categories []string
+	/*The cluster to stream events for.
+	  In: query
+	*/
+	ClusterID *strfmt.UUID
+	/*A host in the specified cluster to stream events for.
+	  In: query
+	*/
+	HostID *strfmt.UUID
+	/*The infra-env to stream events for.
+	  In: query
+	*/
+	InfraEnvID *strfmt.UUID
+	/*Resume the stream after the event with this ID. Only new events are streamed when neither this parameter nor the Last-Event-ID header are set.
+	  Minimum: 0
+	  In: query
+	*/
+	LastEventID *int64
+	/*A comma-separated list of event names. Events of all names are streamed when not set.
+	  In: query
+	*/
+	Names []string
+	/*A comma-separated list of event severities. Events of all severities are streamed when not set.
+	  In: query
+	*/
+	Severities []string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2StreamEventsParams() beforehand.
+func (o *V2StreamEventsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindLastEventIDHeader(r.Header[http.CanonicalHeaderKey("Last-Event-ID")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCategories, qhkCategories, _ := qs.GetOK("categories")
+	if err := o.bindCategories(qCategories, qhkCategories, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qClusterID, qhkClusterID, _ := qs.GetOK("cluster_id")
+	if err := o.bindClusterID(qClusterID, qhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qHostID, qhkHostID, _ := qs.GetOK("host_id")
+	if err := o.bindHostID(qHostID, qhkHostID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qInfraEnvID, qhkInfraEnvID, _ := qs.GetOK("infra_env_id")
+	if err := o.bindInfraEnvID(qInfraEnvID, qhkInfraEnvID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLastEventID, qhkLastEventID, _ := qs.GetOK("last_event_id")
+	if err := o.bindLastEventID(qLastEventID, qhkLastEventID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNames, qhkNames, _ := qs.GetOK("names")
+	if err := o.bindNames(qNames, qhkNames, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSeverities, qhkSeverities, _ := qs.GetOK("severities")
+	if err := o.bindSeverities(qSeverities, qhkSeverities, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLastEventIDHeader binds and validates parameter LastEventIDHeader from header.
+func (o *V2StreamEventsParams) bindLastEventIDHeader(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("Last-Event-ID", "header", "int64", raw)
+	}
+	o.LastEventIDHeader = &value
+
+	if err := o.validateLastEventIDHeader(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLastEventIDHeader carries on validations for parameter LastEventIDHeader
+func (o *V2StreamEventsParams) validateLastEventIDHeader(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("Last-Event-ID", "header", *o.LastEventIDHeader, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindCategories binds and validates array parameter Categories from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2StreamEventsParams) bindCategories(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvCategories string
+	if len(rawData) > 0 {
+		qvCategories = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	categoriesIC := swag.SplitByFormat(qvCategories, "")
+	if len(categoriesIC) == 0 {
+		return nil
+	}
+
+	var categoriesIR []string
+	for _, categoriesIV := range categoriesIC {
+		categoriesI := categoriesIV
+
+		categoriesIR = append(categoriesIR, categoriesI)
+	}
+
+	o.Categories = categoriesIR
+
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from query.
+func (o *V2StreamEventsParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "query", "strfmt.UUID", raw)
+	}
+	o.ClusterID = (value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *V2StreamEventsParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "query", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindHostID binds and validates parameter HostID from query.
+func (o *V2StreamEventsParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("host_id", "query", "strfmt.UUID", raw)
+	}
+	o.HostID = (value.(*strfmt.UUID))
+
+	if err := o.validateHostID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateHostID carries on validations for parameter HostID
+func (o *V2StreamEventsParams) validateHostID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("host_id", "query", "uuid", o.HostID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindInfraEnvID binds and validates parameter InfraEnvID from query.
+func (o *V2StreamEventsParams) bindInfraEnvID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("infra_env_id", "query", "strfmt.UUID", raw)
+	}
+	o.InfraEnvID = (value.(*strfmt.UUID))
+
+	if err := o.validateInfraEnvID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateInfraEnvID carries on validations for parameter InfraEnvID
+func (o *V2StreamEventsParams) validateInfraEnvID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("infra_env_id", "query", "uuid", o.InfraEnvID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindLastEventID binds and validates parameter LastEventID from query.
+func (o *V2StreamEventsParams) bindLastEventID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("last_event_id", "query", "int64", raw)
+	}
+	o.LastEventID = &value
+
+	if err := o.validateLastEventID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLastEventID carries on validations for parameter LastEventID
+func (o *V2StreamEventsParams) validateLastEventID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("last_event_id", "query", *o.LastEventID, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindNames binds and validates array parameter Names from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2StreamEventsParams) bindNames(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvNames string
+	if len(rawData) > 0 {
+		qvNames = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	namesIC := swag.SplitByFormat(qvNames, "")
+	if len(namesIC) == 0 {
+		return nil
+	}
+
+	var namesIR []string
+	for _, namesIV := range namesIC {
+		namesI := namesIV
+
+		namesIR = append(namesIR, namesI)
+	}
+
+	o.Names = namesIR
+
+	return nil
+}
+
+// bindSeverities binds and validates array parameter Severities from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2StreamEventsParams) bindSeverities(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvSeverities string
+	if len(rawData) > 0 {
+		qvSeverities = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	severitiesIC := swag.SplitByFormat(qvSeverities, "")
+	if len(severitiesIC) == 0 {
+		return nil
+	}
+
+	var severitiesIR []string
+	for i, severitiesIV := range severitiesIC {
+		severitiesI := severitiesIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "severities", i), "query", severitiesI, []interface{}{"info", "warning", "error", "critical"}, true); err != nil {
+			return err
+		}
+
+		severitiesIR = append(severitiesIR, severitiesI)
+	}
+
+	o.Severities = severitiesIR
+
+	return nil
+}
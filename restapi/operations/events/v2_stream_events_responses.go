@@ -0,0 +1,277 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2StreamEventsOKCode is the HTTP code returned for type V2StreamEventsOK
+const V2StreamEventsOKCode int = 200
+
+/*V2StreamEventsOK Success.
+
+swagger:response v2StreamEventsOK
+*/
+type V2StreamEventsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2StreamEventsOK creates V2StreamEventsOK with default headers values
+func NewV2StreamEventsOK() *V2StreamEventsOK {
+
+	return &V2StreamEventsOK{}
+}
+
+// WithPayload adds the payload to the v2 stream events o k response
+func (o *V2StreamEventsOK) WithPayload(payload io.ReadCloser) *V2StreamEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 stream events o k response
+func (o *V2StreamEventsOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2StreamEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2StreamEventsBadRequestCode is the HTTP code returned for type V2StreamEventsBadRequest
+const V2StreamEventsBadRequestCode int = 400
+
+/*V2StreamEventsBadRequest Error.
+
+swagger:response v2StreamEventsBadRequest
+*/
+type V2StreamEventsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2StreamEventsBadRequest creates V2StreamEventsBadRequest with default headers values
+func NewV2StreamEventsBadRequest() *V2StreamEventsBadRequest {
+
+	return &V2StreamEventsBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 stream events bad request response
+func (o *V2StreamEventsBadRequest) WithPayload(payload *models.Error) *V2StreamEventsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 stream events bad request response
+func (o *V2StreamEventsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2StreamEventsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2StreamEventsUnauthorizedCode is the HTTP code returned for type V2StreamEventsUnauthorized
+const V2StreamEventsUnauthorizedCode int = 401
+
+/*V2StreamEventsUnauthorized Unauthorized.
+
+swagger:response v2StreamEventsUnauthorized
+*/
+type V2StreamEventsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2StreamEventsUnauthorized creates V2StreamEventsUnauthorized with default headers values
+func NewV2StreamEventsUnauthorized() *V2StreamEventsUnauthorized {
+
+	return &V2StreamEventsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 stream events unauthorized response
+func (o *V2StreamEventsUnauthorized) WithPayload(payload *models.InfraError) *V2StreamEventsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 stream events unauthorized response
+func (o *V2StreamEventsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2StreamEventsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2StreamEventsForbiddenCode is the HTTP code returned for type V2StreamEventsForbidden
+const V2StreamEventsForbiddenCode int = 403
+
+/*V2StreamEventsForbidden Forbidden.
+
+swagger:response v2StreamEventsForbidden
+*/
+type V2StreamEventsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2StreamEventsForbidden creates V2StreamEventsForbidden with default headers values
+func NewV2StreamEventsForbidden() *V2StreamEventsForbidden {
+
+	return &V2StreamEventsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 stream events forbidden response
+func (o *V2StreamEventsForbidden) WithPayload(payload *models.InfraError) *V2StreamEventsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 stream events forbidden response
+func (o *V2StreamEventsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2StreamEventsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2StreamEventsMethodNotAllowedCode is the HTTP code returned for type V2StreamEventsMethodNotAllowed
+const V2StreamEventsMethodNotAllowedCode int = 405
+
+/*V2StreamEventsMethodNotAllowed Method Not Allowed.
+
+swagger:response v2StreamEventsMethodNotAllowed
+*/
+type V2StreamEventsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2StreamEventsMethodNotAllowed creates V2StreamEventsMethodNotAllowed with default headers values
+func NewV2StreamEventsMethodNotAllowed() *V2StreamEventsMethodNotAllowed {
+
+	return &V2StreamEventsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 stream events method not allowed response
+func (o *V2StreamEventsMethodNotAllowed) WithPayload(payload *models.Error) *V2StreamEventsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 stream events method not allowed response
+func (o *V2StreamEventsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2StreamEventsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2StreamEventsInternalServerErrorCode is the HTTP code returned for type V2StreamEventsInternalServerError
+const V2StreamEventsInternalServerErrorCode int = 500
+
+/*V2StreamEventsInternalServerError Error.
+
+swagger:response v2StreamEventsInternalServerError
+*/
+type V2StreamEventsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2StreamEventsInternalServerError creates V2StreamEventsInternalServerError with default headers values
+func NewV2StreamEventsInternalServerError() *V2StreamEventsInternalServerError {
+
+	return &V2StreamEventsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 stream events internal server error response
+func (o *V2StreamEventsInternalServerError) WithPayload(payload *models.Error) *V2StreamEventsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 stream events internal server error response
+func (o *V2StreamEventsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2StreamEventsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
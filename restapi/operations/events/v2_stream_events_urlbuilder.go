@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V2StreamEventsURL generates an URL for the v2 stream events operation
+type V2StreamEventsURL struct {
+	Categories  []string
+	ClusterID   *strfmt.UUID
+	HostID      *strfmt.UUID
+	InfraEnvID  *strfmt.UUID
+	LastEventID *int64
+	Names       []string
+	Severities  []string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2StreamEventsURL) WithBasePath(bp string) *V2StreamEventsURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *V2StreamEventsURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *V2StreamEventsURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/v2/events/stream"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api/assisted-install"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var categoriesIR []string
+	for _, categoriesI := range o.Categories {
+		categoriesIS := categoriesI
+		if categoriesIS != "" {
+			categoriesIR = append(categoriesIR, categoriesIS)
+		}
+	}
+
+	categories := swag.JoinByFormat(categoriesIR, "")
+
+	if len(categories) > 0 {
+		qsv := categories[0]
+		if qsv != "" {
+			qs.Set("categories", qsv)
+		}
+	}
+
+	var clusterIDQ string
+	if o.ClusterID != nil {
+		clusterIDQ = o.ClusterID.String()
+	}
+	if clusterIDQ != "" {
+		qs.Set("cluster_id", clusterIDQ)
+	}
+
+	var hostIDQ string
+	if o.HostID != nil {
+		hostIDQ = o.HostID.String()
+	}
+	if hostIDQ != "" {
+		qs.Set("host_id", hostIDQ)
+	}
+
+	var infraEnvIDQ string
+	if o.InfraEnvID != nil {
+		infraEnvIDQ = o.InfraEnvID.String()
+	}
+	if infraEnvIDQ != "" {
+		qs.Set("infra_env_id", infraEnvIDQ)
+	}
+
+	var lastEventIDQ string
+	if o.LastEventID != nil {
+		lastEventIDQ = swag.FormatInt64(*o.LastEventID)
+	}
+	if lastEventIDQ != "" {
+		qs.Set("last_event_id", lastEventIDQ)
+	}
+
+	var namesIR []string
+	for _, namesI := range o.Names {
+		namesIS := namesI
+		if namesIS != "" {
+			namesIR = append(namesIR, namesIS)
+		}
+	}
+
+	names := swag.JoinByFormat(namesIR, "")
+
+	if len(names) > 0 {
+		qsv := names[0]
+		if qsv != "" {
+			qs.Set("names", qsv)
+		}
+	}
+
+	var severitiesIR []string
+	for _, severitiesI := range o.Severities {
+		severitiesIS := severitiesI
+		if severitiesIS != "" {
+			severitiesIR = append(severitiesIR, severitiesIS)
+		}
+	}
+
+	severities := swag.JoinByFormat(severitiesIR, "")
+
+	if len(severities) > 0 {
+		qsv := severities[0]
+		if qsv != "" {
+			qs.Set("severities", qsv)
+		}
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *V2StreamEventsURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *V2StreamEventsURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *V2StreamEventsURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on V2StreamEventsURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on V2StreamEventsURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *V2StreamEventsURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
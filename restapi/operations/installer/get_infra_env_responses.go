@@ -21,6 +21,10 @@ const GetInfraEnvOKCode int = 200
 swagger:response getInfraEnvOK
 */
 type GetInfraEnvOK struct {
+	/*The resource version of the infra-env, to be sent in the If-Match header of its updates.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewGetInfraEnvOK() *GetInfraEnvOK {
 	return &GetInfraEnvOK{}
 }
 
+// WithETag adds the eTag to the get infra env o k response
+func (o *GetInfraEnvOK) WithETag(eTag string) *GetInfraEnvOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get infra env o k response
+func (o *GetInfraEnvOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get infra env o k response
 func (o *GetInfraEnvOK) WithPayload(payload *models.InfraEnv) *GetInfraEnvOK {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *GetInfraEnvOK) SetPayload(payload *models.InfraEnv) {
 // WriteResponse to the client
 func (o *GetInfraEnvOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
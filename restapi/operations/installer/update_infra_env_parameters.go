@@ -36,6 +36,10 @@ type UpdateInfraEnvParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Update the infra-env only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.
+	  In: header
+	*/
+	IfMatch *string
 	/*The properties to update.
 	  Required: true
 	  In: body
@@ -57,6 +61,10 @@ func (o *UpdateInfraEnvParams) BindRequest(r *http.Request, route *middleware.Ma
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.InfraEnvUpdateParams
@@ -95,6 +103,23 @@ func (o *UpdateInfraEnvParams) BindRequest(r *http.Request, route *middleware.Ma
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *UpdateInfraEnvParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindInfraEnvID binds and validates parameter InfraEnvID from path.
 func (o *UpdateInfraEnvParams) bindInfraEnvID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -21,6 +21,10 @@ const UpdateInfraEnvCreatedCode int = 201
 swagger:response updateInfraEnvCreated
 */
 type UpdateInfraEnvCreated struct {
+	/*The resource version of the infra-env, to be sent in the If-Match header of its updates.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewUpdateInfraEnvCreated() *UpdateInfraEnvCreated {
 	return &UpdateInfraEnvCreated{}
 }
 
+// WithETag adds the eTag to the update infra env created response
+func (o *UpdateInfraEnvCreated) WithETag(eTag string) *UpdateInfraEnvCreated {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the update infra env created response
+func (o *UpdateInfraEnvCreated) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the update infra env created response
 func (o *UpdateInfraEnvCreated) WithPayload(payload *models.InfraEnv) *UpdateInfraEnvCreated {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *UpdateInfraEnvCreated) SetPayload(payload *models.InfraEnv) {
 // WriteResponse to the client
 func (o *UpdateInfraEnvCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(201)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -321,6 +343,50 @@ func (o *UpdateInfraEnvConflict) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// UpdateInfraEnvPreconditionFailedCode is the HTTP code returned for type UpdateInfraEnvPreconditionFailed
+const UpdateInfraEnvPreconditionFailedCode int = 412
+
+/*UpdateInfraEnvPreconditionFailed Precondition Failed.
+
+swagger:response updateInfraEnvPreconditionFailed
+*/
+type UpdateInfraEnvPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateInfraEnvPreconditionFailed creates UpdateInfraEnvPreconditionFailed with default headers values
+func NewUpdateInfraEnvPreconditionFailed() *UpdateInfraEnvPreconditionFailed {
+
+	return &UpdateInfraEnvPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the update infra env precondition failed response
+func (o *UpdateInfraEnvPreconditionFailed) WithPayload(payload *models.Error) *UpdateInfraEnvPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update infra env precondition failed response
+func (o *UpdateInfraEnvPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateInfraEnvPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // UpdateInfraEnvInternalServerErrorCode is the HTTP code returned for type UpdateInfraEnvInternalServerError
 const UpdateInfraEnvInternalServerErrorCode int = 500
 
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2CancelInstallScheduleHandlerFunc turns a function with the right signature into a v2 cancel install schedule handler
+type V2CancelInstallScheduleHandlerFunc func(V2CancelInstallScheduleParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2CancelInstallScheduleHandlerFunc) Handle(params V2CancelInstallScheduleParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2CancelInstallScheduleHandler interface for that can handle valid v2 cancel install schedule params
+type V2CancelInstallScheduleHandler interface {
+	Handle(V2CancelInstallScheduleParams, interface{}) middleware.Responder
+}
+
+// NewV2CancelInstallSchedule creates a new http.Handler for the v2 cancel install schedule operation
+func NewV2CancelInstallSchedule(ctx *middleware.Context, handler V2CancelInstallScheduleHandler) *V2CancelInstallSchedule {
+	return &V2CancelInstallSchedule{Context: ctx, Handler: handler}
+}
+
+/* V2CancelInstallSchedule swagger:route POST /v2/clusters/{cluster_id}/actions/cancel-install-schedule installer v2CancelInstallSchedule
+
+Cancels the scheduled installation of the cluster.
+
+*/
+type V2CancelInstallSchedule struct {
+	Context *middleware.Context
+	Handler V2CancelInstallScheduleHandler
+}
+
+func (o *V2CancelInstallSchedule) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2CancelInstallScheduleParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CancelInstallScheduleAcceptedCode is the HTTP code returned for type V2CancelInstallScheduleAccepted
+const V2CancelInstallScheduleAcceptedCode int = 202
+
+/*V2CancelInstallScheduleAccepted Success.
+
+swagger:response v2CancelInstallScheduleAccepted
+*/
+type V2CancelInstallScheduleAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Cluster `json:"body,omitempty"`
+}
+
+// NewV2CancelInstallScheduleAccepted creates V2CancelInstallScheduleAccepted with default headers values
+func NewV2CancelInstallScheduleAccepted() *V2CancelInstallScheduleAccepted {
+
+	return &V2CancelInstallScheduleAccepted{}
+}
+
+// WithPayload adds the payload to the v2 cancel install schedule accepted response
+func (o *V2CancelInstallScheduleAccepted) WithPayload(payload *models.Cluster) *V2CancelInstallScheduleAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel install schedule accepted response
+func (o *V2CancelInstallScheduleAccepted) SetPayload(payload *models.Cluster) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelInstallScheduleAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelInstallScheduleUnauthorizedCode is the HTTP code returned for type V2CancelInstallScheduleUnauthorized
+const V2CancelInstallScheduleUnauthorizedCode int = 401
+
+/*V2CancelInstallScheduleUnauthorized Unauthorized.
+
+swagger:response v2CancelInstallScheduleUnauthorized
+*/
+type V2CancelInstallScheduleUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CancelInstallScheduleUnauthorized creates V2CancelInstallScheduleUnauthorized with default headers values
+func NewV2CancelInstallScheduleUnauthorized() *V2CancelInstallScheduleUnauthorized {
+
+	return &V2CancelInstallScheduleUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 cancel install schedule unauthorized response
+func (o *V2CancelInstallScheduleUnauthorized) WithPayload(payload *models.InfraError) *V2CancelInstallScheduleUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel install schedule unauthorized response
+func (o *V2CancelInstallScheduleUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelInstallScheduleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelInstallScheduleForbiddenCode is the HTTP code returned for type V2CancelInstallScheduleForbidden
+const V2CancelInstallScheduleForbiddenCode int = 403
+
+/*V2CancelInstallScheduleForbidden Forbidden.
+
+swagger:response v2CancelInstallScheduleForbidden
+*/
+type V2CancelInstallScheduleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CancelInstallScheduleForbidden creates V2CancelInstallScheduleForbidden with default headers values
+func NewV2CancelInstallScheduleForbidden() *V2CancelInstallScheduleForbidden {
+
+	return &V2CancelInstallScheduleForbidden{}
+}
+
+// WithPayload adds the payload to the v2 cancel install schedule forbidden response
+func (o *V2CancelInstallScheduleForbidden) WithPayload(payload *models.InfraError) *V2CancelInstallScheduleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel install schedule forbidden response
+func (o *V2CancelInstallScheduleForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelInstallScheduleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelInstallScheduleNotFoundCode is the HTTP code returned for type V2CancelInstallScheduleNotFound
+const V2CancelInstallScheduleNotFoundCode int = 404
+
+/*V2CancelInstallScheduleNotFound Error.
+
+swagger:response v2CancelInstallScheduleNotFound
+*/
+type V2CancelInstallScheduleNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CancelInstallScheduleNotFound creates V2CancelInstallScheduleNotFound with default headers values
+func NewV2CancelInstallScheduleNotFound() *V2CancelInstallScheduleNotFound {
+
+	return &V2CancelInstallScheduleNotFound{}
+}
+
+// WithPayload adds the payload to the v2 cancel install schedule not found response
+func (o *V2CancelInstallScheduleNotFound) WithPayload(payload *models.Error) *V2CancelInstallScheduleNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel install schedule not found response
+func (o *V2CancelInstallScheduleNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelInstallScheduleNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelInstallScheduleMethodNotAllowedCode is the HTTP code returned for type V2CancelInstallScheduleMethodNotAllowed
+const V2CancelInstallScheduleMethodNotAllowedCode int = 405
+
+/*V2CancelInstallScheduleMethodNotAllowed Method Not Allowed.
+
+swagger:response v2CancelInstallScheduleMethodNotAllowed
+*/
+type V2CancelInstallScheduleMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CancelInstallScheduleMethodNotAllowed creates V2CancelInstallScheduleMethodNotAllowed with default headers values
+func NewV2CancelInstallScheduleMethodNotAllowed() *V2CancelInstallScheduleMethodNotAllowed {
+
+	return &V2CancelInstallScheduleMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 cancel install schedule method not allowed response
+func (o *V2CancelInstallScheduleMethodNotAllowed) WithPayload(payload *models.Error) *V2CancelInstallScheduleMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel install schedule method not allowed response
+func (o *V2CancelInstallScheduleMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelInstallScheduleMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelInstallScheduleConflictCode is the HTTP code returned for type V2CancelInstallScheduleConflict
+const V2CancelInstallScheduleConflictCode int = 409
+
+/*V2CancelInstallScheduleConflict Error.
+
+swagger:response v2CancelInstallScheduleConflict
+*/
+type V2CancelInstallScheduleConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CancelInstallScheduleConflict creates V2CancelInstallScheduleConflict with default headers values
+func NewV2CancelInstallScheduleConflict() *V2CancelInstallScheduleConflict {
+
+	return &V2CancelInstallScheduleConflict{}
+}
+
+// WithPayload adds the payload to the v2 cancel install schedule conflict response
+func (o *V2CancelInstallScheduleConflict) WithPayload(payload *models.Error) *V2CancelInstallScheduleConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel install schedule conflict response
+func (o *V2CancelInstallScheduleConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelInstallScheduleConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelInstallScheduleInternalServerErrorCode is the HTTP code returned for type V2CancelInstallScheduleInternalServerError
+const V2CancelInstallScheduleInternalServerErrorCode int = 500
+
+/*V2CancelInstallScheduleInternalServerError Error.
+
+swagger:response v2CancelInstallScheduleInternalServerError
+*/
+type V2CancelInstallScheduleInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CancelInstallScheduleInternalServerError creates V2CancelInstallScheduleInternalServerError with default headers values
+func NewV2CancelInstallScheduleInternalServerError() *V2CancelInstallScheduleInternalServerError {
+
+	return &V2CancelInstallScheduleInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 cancel install schedule internal server error response
+func (o *V2CancelInstallScheduleInternalServerError) WithPayload(payload *models.Error) *V2CancelInstallScheduleInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel install schedule internal server error response
+func (o *V2CancelInstallScheduleInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelInstallScheduleInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2CreateHostDiagnosticHandlerFunc turns a function with the right signature into a v2 create host diagnostic handler
+type V2CreateHostDiagnosticHandlerFunc func(V2CreateHostDiagnosticParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2CreateHostDiagnosticHandlerFunc) Handle(params V2CreateHostDiagnosticParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2CreateHostDiagnosticHandler interface for that can handle valid v2 create host diagnostic params
+type V2CreateHostDiagnosticHandler interface {
+	Handle(V2CreateHostDiagnosticParams, interface{}) middleware.Responder
+}
+
+// NewV2CreateHostDiagnostic creates a new http.Handler for the v2 create host diagnostic operation
+func NewV2CreateHostDiagnostic(ctx *middleware.Context, handler V2CreateHostDiagnosticHandler) *V2CreateHostDiagnostic {
+	return &V2CreateHostDiagnostic{Context: ctx, Handler: handler}
+}
+
+/* V2CreateHostDiagnostic swagger:route POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics installer v2CreateHostDiagnostic
+
+Queues a diagnostic action from the service allowlist to be run on the host by its agent.
+
+*/
+type V2CreateHostDiagnostic struct {
+	Context *middleware.Context
+	Handler V2CreateHostDiagnosticHandler
+}
+
+func (o *V2CreateHostDiagnostic) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2CreateHostDiagnosticParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
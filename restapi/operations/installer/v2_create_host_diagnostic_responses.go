@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CreateHostDiagnosticCreatedCode is the HTTP code returned for type V2CreateHostDiagnosticCreated
+const V2CreateHostDiagnosticCreatedCode int = 201
+
+/*V2CreateHostDiagnosticCreated Success.
+
+swagger:response v2CreateHostDiagnosticCreated
+*/
+type V2CreateHostDiagnosticCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HostDiagnostic `json:"body,omitempty"`
+}
+
+// NewV2CreateHostDiagnosticCreated creates V2CreateHostDiagnosticCreated with default headers values
+func NewV2CreateHostDiagnosticCreated() *V2CreateHostDiagnosticCreated {
+
+	return &V2CreateHostDiagnosticCreated{}
+}
+
+// WithPayload adds the payload to the v2 create host diagnostic created response
+func (o *V2CreateHostDiagnosticCreated) WithPayload(payload *models.HostDiagnostic) *V2CreateHostDiagnosticCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create host diagnostic created response
+func (o *V2CreateHostDiagnosticCreated) SetPayload(payload *models.HostDiagnostic) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateHostDiagnosticCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateHostDiagnosticBadRequestCode is the HTTP code returned for type V2CreateHostDiagnosticBadRequest
+const V2CreateHostDiagnosticBadRequestCode int = 400
+
+/*V2CreateHostDiagnosticBadRequest Error.
+
+swagger:response v2CreateHostDiagnosticBadRequest
+*/
+type V2CreateHostDiagnosticBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateHostDiagnosticBadRequest creates V2CreateHostDiagnosticBadRequest with default headers values
+func NewV2CreateHostDiagnosticBadRequest() *V2CreateHostDiagnosticBadRequest {
+
+	return &V2CreateHostDiagnosticBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 create host diagnostic bad request response
+func (o *V2CreateHostDiagnosticBadRequest) WithPayload(payload *models.Error) *V2CreateHostDiagnosticBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create host diagnostic bad request response
+func (o *V2CreateHostDiagnosticBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateHostDiagnosticBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateHostDiagnosticUnauthorizedCode is the HTTP code returned for type V2CreateHostDiagnosticUnauthorized
+const V2CreateHostDiagnosticUnauthorizedCode int = 401
+
+/*V2CreateHostDiagnosticUnauthorized Unauthorized.
+
+swagger:response v2CreateHostDiagnosticUnauthorized
+*/
+type V2CreateHostDiagnosticUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CreateHostDiagnosticUnauthorized creates V2CreateHostDiagnosticUnauthorized with default headers values
+func NewV2CreateHostDiagnosticUnauthorized() *V2CreateHostDiagnosticUnauthorized {
+
+	return &V2CreateHostDiagnosticUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 create host diagnostic unauthorized response
+func (o *V2CreateHostDiagnosticUnauthorized) WithPayload(payload *models.InfraError) *V2CreateHostDiagnosticUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create host diagnostic unauthorized response
+func (o *V2CreateHostDiagnosticUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateHostDiagnosticUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateHostDiagnosticForbiddenCode is the HTTP code returned for type V2CreateHostDiagnosticForbidden
+const V2CreateHostDiagnosticForbiddenCode int = 403
+
+/*V2CreateHostDiagnosticForbidden Forbidden.
+
+swagger:response v2CreateHostDiagnosticForbidden
+*/
+type V2CreateHostDiagnosticForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CreateHostDiagnosticForbidden creates V2CreateHostDiagnosticForbidden with default headers values
+func NewV2CreateHostDiagnosticForbidden() *V2CreateHostDiagnosticForbidden {
+
+	return &V2CreateHostDiagnosticForbidden{}
+}
+
+// WithPayload adds the payload to the v2 create host diagnostic forbidden response
+func (o *V2CreateHostDiagnosticForbidden) WithPayload(payload *models.InfraError) *V2CreateHostDiagnosticForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create host diagnostic forbidden response
+func (o *V2CreateHostDiagnosticForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateHostDiagnosticForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateHostDiagnosticNotFoundCode is the HTTP code returned for type V2CreateHostDiagnosticNotFound
+const V2CreateHostDiagnosticNotFoundCode int = 404
+
+/*V2CreateHostDiagnosticNotFound Error.
+
+swagger:response v2CreateHostDiagnosticNotFound
+*/
+type V2CreateHostDiagnosticNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateHostDiagnosticNotFound creates V2CreateHostDiagnosticNotFound with default headers values
+func NewV2CreateHostDiagnosticNotFound() *V2CreateHostDiagnosticNotFound {
+
+	return &V2CreateHostDiagnosticNotFound{}
+}
+
+// WithPayload adds the payload to the v2 create host diagnostic not found response
+func (o *V2CreateHostDiagnosticNotFound) WithPayload(payload *models.Error) *V2CreateHostDiagnosticNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create host diagnostic not found response
+func (o *V2CreateHostDiagnosticNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateHostDiagnosticNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateHostDiagnosticConflictCode is the HTTP code returned for type V2CreateHostDiagnosticConflict
+const V2CreateHostDiagnosticConflictCode int = 409
+
+/*V2CreateHostDiagnosticConflict Error.
+
+swagger:response v2CreateHostDiagnosticConflict
+*/
+type V2CreateHostDiagnosticConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateHostDiagnosticConflict creates V2CreateHostDiagnosticConflict with default headers values
+func NewV2CreateHostDiagnosticConflict() *V2CreateHostDiagnosticConflict {
+
+	return &V2CreateHostDiagnosticConflict{}
+}
+
+// WithPayload adds the payload to the v2 create host diagnostic conflict response
+func (o *V2CreateHostDiagnosticConflict) WithPayload(payload *models.Error) *V2CreateHostDiagnosticConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create host diagnostic conflict response
+func (o *V2CreateHostDiagnosticConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateHostDiagnosticConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateHostDiagnosticInternalServerErrorCode is the HTTP code returned for type V2CreateHostDiagnosticInternalServerError
+const V2CreateHostDiagnosticInternalServerErrorCode int = 500
+
+/*V2CreateHostDiagnosticInternalServerError Error.
+
+swagger:response v2CreateHostDiagnosticInternalServerError
+*/
+type V2CreateHostDiagnosticInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateHostDiagnosticInternalServerError creates V2CreateHostDiagnosticInternalServerError with default headers values
+func NewV2CreateHostDiagnosticInternalServerError() *V2CreateHostDiagnosticInternalServerError {
+
+	return &V2CreateHostDiagnosticInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 create host diagnostic internal server error response
+func (o *V2CreateHostDiagnosticInternalServerError) WithPayload(payload *models.Error) *V2CreateHostDiagnosticInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create host diagnostic internal server error response
+func (o *V2CreateHostDiagnosticInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateHostDiagnosticInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
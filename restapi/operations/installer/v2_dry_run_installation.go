@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DryRunInstallationHandlerFunc turns a function with the right signature into a v2 dry run installation handler
+type V2DryRunInstallationHandlerFunc func(V2DryRunInstallationParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DryRunInstallationHandlerFunc) Handle(params V2DryRunInstallationParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DryRunInstallationHandler interface for that can handle valid v2 dry run installation params
+type V2DryRunInstallationHandler interface {
+	Handle(V2DryRunInstallationParams, interface{}) middleware.Responder
+}
+
+// NewV2DryRunInstallation creates a new http.Handler for the v2 dry run installation operation
+func NewV2DryRunInstallation(ctx *middleware.Context, handler V2DryRunInstallationHandler) *V2DryRunInstallation {
+	return &V2DryRunInstallation{Context: ctx, Handler: handler}
+}
+
+/* V2DryRunInstallation swagger:route POST /v2/clusters/{cluster_id}/actions/dry-run installer v2DryRunInstallation
+
+Generates the install-config, the operator manifests and the ignition files of the cluster as the installation
+would, in a scratch working directory, and returns the generated artifacts together with the errors of every
+generation stage. The cluster, its hosts and its stored files are not modified.
+
+
+*/
+type V2DryRunInstallation struct {
+	Context *middleware.Context
+	Handler V2DryRunInstallationHandler
+}
+
+func (o *V2DryRunInstallation) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DryRunInstallationParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
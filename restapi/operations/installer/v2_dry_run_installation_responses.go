@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DryRunInstallationOKCode is the HTTP code returned for type V2DryRunInstallationOK
+const V2DryRunInstallationOKCode int = 200
+
+/*V2DryRunInstallationOK Success.
+
+swagger:response v2DryRunInstallationOK
+*/
+type V2DryRunInstallationOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DryRunResult `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallationOK creates V2DryRunInstallationOK with default headers values
+func NewV2DryRunInstallationOK() *V2DryRunInstallationOK {
+
+	return &V2DryRunInstallationOK{}
+}
+
+// WithPayload adds the payload to the v2 dry run installation o k response
+func (o *V2DryRunInstallationOK) WithPayload(payload *models.DryRunResult) *V2DryRunInstallationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run installation o k response
+func (o *V2DryRunInstallationOK) SetPayload(payload *models.DryRunResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallationUnauthorizedCode is the HTTP code returned for type V2DryRunInstallationUnauthorized
+const V2DryRunInstallationUnauthorizedCode int = 401
+
+/*V2DryRunInstallationUnauthorized Unauthorized.
+
+swagger:response v2DryRunInstallationUnauthorized
+*/
+type V2DryRunInstallationUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallationUnauthorized creates V2DryRunInstallationUnauthorized with default headers values
+func NewV2DryRunInstallationUnauthorized() *V2DryRunInstallationUnauthorized {
+
+	return &V2DryRunInstallationUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 dry run installation unauthorized response
+func (o *V2DryRunInstallationUnauthorized) WithPayload(payload *models.InfraError) *V2DryRunInstallationUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run installation unauthorized response
+func (o *V2DryRunInstallationUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallationUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallationForbiddenCode is the HTTP code returned for type V2DryRunInstallationForbidden
+const V2DryRunInstallationForbiddenCode int = 403
+
+/*V2DryRunInstallationForbidden Forbidden.
+
+swagger:response v2DryRunInstallationForbidden
+*/
+type V2DryRunInstallationForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallationForbidden creates V2DryRunInstallationForbidden with default headers values
+func NewV2DryRunInstallationForbidden() *V2DryRunInstallationForbidden {
+
+	return &V2DryRunInstallationForbidden{}
+}
+
+// WithPayload adds the payload to the v2 dry run installation forbidden response
+func (o *V2DryRunInstallationForbidden) WithPayload(payload *models.InfraError) *V2DryRunInstallationForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run installation forbidden response
+func (o *V2DryRunInstallationForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallationForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallationNotFoundCode is the HTTP code returned for type V2DryRunInstallationNotFound
+const V2DryRunInstallationNotFoundCode int = 404
+
+/*V2DryRunInstallationNotFound Error.
+
+swagger:response v2DryRunInstallationNotFound
+*/
+type V2DryRunInstallationNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallationNotFound creates V2DryRunInstallationNotFound with default headers values
+func NewV2DryRunInstallationNotFound() *V2DryRunInstallationNotFound {
+
+	return &V2DryRunInstallationNotFound{}
+}
+
+// WithPayload adds the payload to the v2 dry run installation not found response
+func (o *V2DryRunInstallationNotFound) WithPayload(payload *models.Error) *V2DryRunInstallationNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run installation not found response
+func (o *V2DryRunInstallationNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallationNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallationMethodNotAllowedCode is the HTTP code returned for type V2DryRunInstallationMethodNotAllowed
+const V2DryRunInstallationMethodNotAllowedCode int = 405
+
+/*V2DryRunInstallationMethodNotAllowed Method Not Allowed.
+
+swagger:response v2DryRunInstallationMethodNotAllowed
+*/
+type V2DryRunInstallationMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallationMethodNotAllowed creates V2DryRunInstallationMethodNotAllowed with default headers values
+func NewV2DryRunInstallationMethodNotAllowed() *V2DryRunInstallationMethodNotAllowed {
+
+	return &V2DryRunInstallationMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 dry run installation method not allowed response
+func (o *V2DryRunInstallationMethodNotAllowed) WithPayload(payload *models.Error) *V2DryRunInstallationMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run installation method not allowed response
+func (o *V2DryRunInstallationMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallationMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallationConflictCode is the HTTP code returned for type V2DryRunInstallationConflict
+const V2DryRunInstallationConflictCode int = 409
+
+/*V2DryRunInstallationConflict Error.
+
+swagger:response v2DryRunInstallationConflict
+*/
+type V2DryRunInstallationConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallationConflict creates V2DryRunInstallationConflict with default headers values
+func NewV2DryRunInstallationConflict() *V2DryRunInstallationConflict {
+
+	return &V2DryRunInstallationConflict{}
+}
+
+// WithPayload adds the payload to the v2 dry run installation conflict response
+func (o *V2DryRunInstallationConflict) WithPayload(payload *models.Error) *V2DryRunInstallationConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run installation conflict response
+func (o *V2DryRunInstallationConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallationConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DryRunInstallationInternalServerErrorCode is the HTTP code returned for type V2DryRunInstallationInternalServerError
+const V2DryRunInstallationInternalServerErrorCode int = 500
+
+/*V2DryRunInstallationInternalServerError Error.
+
+swagger:response v2DryRunInstallationInternalServerError
+*/
+type V2DryRunInstallationInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DryRunInstallationInternalServerError creates V2DryRunInstallationInternalServerError with default headers values
+func NewV2DryRunInstallationInternalServerError() *V2DryRunInstallationInternalServerError {
+
+	return &V2DryRunInstallationInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 dry run installation internal server error response
+func (o *V2DryRunInstallationInternalServerError) WithPayload(payload *models.Error) *V2DryRunInstallationInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 dry run installation internal server error response
+func (o *V2DryRunInstallationInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DryRunInstallationInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
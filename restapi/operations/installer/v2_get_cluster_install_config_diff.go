@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetClusterInstallConfigDiffHandlerFunc turns a function with the right signature into a v2 get cluster install config diff handler
+type V2GetClusterInstallConfigDiffHandlerFunc func(V2GetClusterInstallConfigDiffParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetClusterInstallConfigDiffHandlerFunc) Handle(params V2GetClusterInstallConfigDiffParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetClusterInstallConfigDiffHandler interface for that can handle valid v2 get cluster install config diff params
+type V2GetClusterInstallConfigDiffHandler interface {
+	Handle(V2GetClusterInstallConfigDiffParams, interface{}) middleware.Responder
+}
+
+// NewV2GetClusterInstallConfigDiff creates a new http.Handler for the v2 get cluster install config diff operation
+func NewV2GetClusterInstallConfigDiff(ctx *middleware.Context, handler V2GetClusterInstallConfigDiffHandler) *V2GetClusterInstallConfigDiff {
+	return &V2GetClusterInstallConfigDiff{Context: ctx, Handler: handler}
+}
+
+/* V2GetClusterInstallConfigDiff swagger:route GET /v2/clusters/{cluster_id}/install-config/diff installer v2GetClusterInstallConfigDiff
+
+Get a unified diff between the install config YAML rendered with two versions of the install config overrides.
+
+
+*/
+type V2GetClusterInstallConfigDiff struct {
+	Context *middleware.Context
+	Handler V2GetClusterInstallConfigDiffHandler
+}
+
+func (o *V2GetClusterInstallConfigDiff) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetClusterInstallConfigDiffParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2GetClusterInstallConfigDiffParams creates a new V2GetClusterInstallConfigDiffParams object
+// with the default values initialized.
+func NewV2GetClusterInstallConfigDiffParams() V2GetClusterInstallConfigDiffParams {
+
+	var (
+		// initialize parameters with default values
+
+		fromDefault = int64(0)
+	)
+
+	return V2GetClusterInstallConfigDiffParams{
+		From: &fromDefault,
+	}
+}
+
+// V2GetClusterInstallConfigDiffParams contains all the bound params for the v2 get cluster install config diff operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2GetClusterInstallConfigDiff
+type V2GetClusterInstallConfigDiffParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster whose install config is being compared.
+	  Required: true
+	  In: path
+	*/
+	ClusterID strfmt.UUID
+	/*The version of the install config overrides to compare from. Version 0 is the install config without overrides.
+	  Minimum: 0
+	  In: query
+	  Default: 0
+	*/
+	From *int64
+	/*The version of the install config overrides to compare to. Defaults to the current overrides.
+	  Minimum: 0
+	  In: query
+	*/
+	To *int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2GetClusterInstallConfigDiffParams() beforehand.
+func (o *V2GetClusterInstallConfigDiffParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
+	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFrom, qhkFrom, _ := qs.GetOK("from")
+	if err := o.bindFrom(qFrom, qhkFrom, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTo, qhkTo, _ := qs.GetOK("to")
+	if err := o.bindTo(qTo, qhkTo, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from path.
+func (o *V2GetClusterInstallConfigDiffParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *V2GetClusterInstallConfigDiffParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "path", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindFrom binds and validates parameter From from query.
+func (o *V2GetClusterInstallConfigDiffParams) bindFrom(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2GetClusterInstallConfigDiffParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("from", "query", "int64", raw)
+	}
+	o.From = &value
+
+	if err := o.validateFrom(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFrom carries on validations for parameter From
+func (o *V2GetClusterInstallConfigDiffParams) validateFrom(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("from", "query", *o.From, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindTo binds and validates parameter To from query.
+func (o *V2GetClusterInstallConfigDiffParams) bindTo(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("to", "query", "int64", raw)
+	}
+	o.To = &value
+
+	if err := o.validateTo(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTo carries on validations for parameter To
+func (o *V2GetClusterInstallConfigDiffParams) validateTo(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("to", "query", *o.To, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterInstallConfigDiffOKCode is the HTTP code returned for type V2GetClusterInstallConfigDiffOK
+const V2GetClusterInstallConfigDiffOKCode int = 200
+
+/*V2GetClusterInstallConfigDiffOK Success.
+
+swagger:response v2GetClusterInstallConfigDiffOK
+*/
+type V2GetClusterInstallConfigDiffOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallConfigDiffOK creates V2GetClusterInstallConfigDiffOK with default headers values
+func NewV2GetClusterInstallConfigDiffOK() *V2GetClusterInstallConfigDiffOK {
+
+	return &V2GetClusterInstallConfigDiffOK{}
+}
+
+// WithPayload adds the payload to the v2 get cluster install config diff o k response
+func (o *V2GetClusterInstallConfigDiffOK) WithPayload(payload string) *V2GetClusterInstallConfigDiffOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster install config diff o k response
+func (o *V2GetClusterInstallConfigDiffOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallConfigDiffOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2GetClusterInstallConfigDiffUnauthorizedCode is the HTTP code returned for type V2GetClusterInstallConfigDiffUnauthorized
+const V2GetClusterInstallConfigDiffUnauthorizedCode int = 401
+
+/*V2GetClusterInstallConfigDiffUnauthorized Unauthorized.
+
+swagger:response v2GetClusterInstallConfigDiffUnauthorized
+*/
+type V2GetClusterInstallConfigDiffUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallConfigDiffUnauthorized creates V2GetClusterInstallConfigDiffUnauthorized with default headers values
+func NewV2GetClusterInstallConfigDiffUnauthorized() *V2GetClusterInstallConfigDiffUnauthorized {
+
+	return &V2GetClusterInstallConfigDiffUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get cluster install config diff unauthorized response
+func (o *V2GetClusterInstallConfigDiffUnauthorized) WithPayload(payload *models.InfraError) *V2GetClusterInstallConfigDiffUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster install config diff unauthorized response
+func (o *V2GetClusterInstallConfigDiffUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallConfigDiffUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallConfigDiffForbiddenCode is the HTTP code returned for type V2GetClusterInstallConfigDiffForbidden
+const V2GetClusterInstallConfigDiffForbiddenCode int = 403
+
+/*V2GetClusterInstallConfigDiffForbidden Forbidden.
+
+swagger:response v2GetClusterInstallConfigDiffForbidden
+*/
+type V2GetClusterInstallConfigDiffForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallConfigDiffForbidden creates V2GetClusterInstallConfigDiffForbidden with default headers values
+func NewV2GetClusterInstallConfigDiffForbidden() *V2GetClusterInstallConfigDiffForbidden {
+
+	return &V2GetClusterInstallConfigDiffForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get cluster install config diff forbidden response
+func (o *V2GetClusterInstallConfigDiffForbidden) WithPayload(payload *models.InfraError) *V2GetClusterInstallConfigDiffForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster install config diff forbidden response
+func (o *V2GetClusterInstallConfigDiffForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallConfigDiffForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallConfigDiffNotFoundCode is the HTTP code returned for type V2GetClusterInstallConfigDiffNotFound
+const V2GetClusterInstallConfigDiffNotFoundCode int = 404
+
+/*V2GetClusterInstallConfigDiffNotFound Error.
+
+swagger:response v2GetClusterInstallConfigDiffNotFound
+*/
+type V2GetClusterInstallConfigDiffNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallConfigDiffNotFound creates V2GetClusterInstallConfigDiffNotFound with default headers values
+func NewV2GetClusterInstallConfigDiffNotFound() *V2GetClusterInstallConfigDiffNotFound {
+
+	return &V2GetClusterInstallConfigDiffNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get cluster install config diff not found response
+func (o *V2GetClusterInstallConfigDiffNotFound) WithPayload(payload *models.Error) *V2GetClusterInstallConfigDiffNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster install config diff not found response
+func (o *V2GetClusterInstallConfigDiffNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallConfigDiffNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallConfigDiffMethodNotAllowedCode is the HTTP code returned for type V2GetClusterInstallConfigDiffMethodNotAllowed
+const V2GetClusterInstallConfigDiffMethodNotAllowedCode int = 405
+
+/*V2GetClusterInstallConfigDiffMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetClusterInstallConfigDiffMethodNotAllowed
+*/
+type V2GetClusterInstallConfigDiffMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallConfigDiffMethodNotAllowed creates V2GetClusterInstallConfigDiffMethodNotAllowed with default headers values
+func NewV2GetClusterInstallConfigDiffMethodNotAllowed() *V2GetClusterInstallConfigDiffMethodNotAllowed {
+
+	return &V2GetClusterInstallConfigDiffMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get cluster install config diff method not allowed response
+func (o *V2GetClusterInstallConfigDiffMethodNotAllowed) WithPayload(payload *models.Error) *V2GetClusterInstallConfigDiffMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster install config diff method not allowed response
+func (o *V2GetClusterInstallConfigDiffMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallConfigDiffMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallConfigDiffInternalServerErrorCode is the HTTP code returned for type V2GetClusterInstallConfigDiffInternalServerError
+const V2GetClusterInstallConfigDiffInternalServerErrorCode int = 500
+
+/*V2GetClusterInstallConfigDiffInternalServerError Error.
+
+swagger:response v2GetClusterInstallConfigDiffInternalServerError
+*/
+type V2GetClusterInstallConfigDiffInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallConfigDiffInternalServerError creates V2GetClusterInstallConfigDiffInternalServerError with default headers values
+func NewV2GetClusterInstallConfigDiffInternalServerError() *V2GetClusterInstallConfigDiffInternalServerError {
+
+	return &V2GetClusterInstallConfigDiffInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get cluster install config diff internal server error response
+func (o *V2GetClusterInstallConfigDiffInternalServerError) WithPayload(payload *models.Error) *V2GetClusterInstallConfigDiffInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster install config diff internal server error response
+func (o *V2GetClusterInstallConfigDiffInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallConfigDiffInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
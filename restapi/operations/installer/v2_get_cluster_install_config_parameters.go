@@ -9,8 +9,10 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -36,6 +38,13 @@ type V2GetClusterInstallConfigParams struct {
 	  In: path
 	*/
 	ClusterID strfmt.UUID
+	/*Render the install config with the overrides of this version of the install config overrides history
+	instead of the current ones. Version 0 renders the install config without overrides.
+
+	  Minimum: 0
+	  In: query
+	*/
+	Version *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -47,10 +56,17 @@ func (o *V2GetClusterInstallConfigParams) BindRequest(r *http.Request, route *mi
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qVersion, qhkVersion, _ := qs.GetOK("version")
+	if err := o.bindVersion(qVersion, qhkVersion, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -89,3 +105,40 @@ func (o *V2GetClusterInstallConfigParams) validateClusterID(formats strfmt.Regis
 	}
 	return nil
 }
+
+// bindVersion binds and validates parameter Version from query.
+func (o *V2GetClusterInstallConfigParams) bindVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("version", "query", "int64", raw)
+	}
+	o.Version = &value
+
+	if err := o.validateVersion(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateVersion carries on validations for parameter Version
+func (o *V2GetClusterInstallConfigParams) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("version", "query", *o.Version, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -12,12 +12,15 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // V2GetClusterInstallConfigURL generates an URL for the v2 get cluster install config operation
 type V2GetClusterInstallConfigURL struct {
 	ClusterID strfmt.UUID
 
+	Version *int64
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -57,6 +60,18 @@ func (o *V2GetClusterInstallConfigURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var versionQ string
+	if o.Version != nil {
+		versionQ = swag.FormatInt64(*o.Version)
+	}
+	if versionQ != "" {
+		qs.Set("version", versionQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetClusterInstallationTimelineHandlerFunc turns a function with the right signature into a v2 get cluster installation timeline handler
+type V2GetClusterInstallationTimelineHandlerFunc func(V2GetClusterInstallationTimelineParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetClusterInstallationTimelineHandlerFunc) Handle(params V2GetClusterInstallationTimelineParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetClusterInstallationTimelineHandler interface for that can handle valid v2 get cluster installation timeline params
+type V2GetClusterInstallationTimelineHandler interface {
+	Handle(V2GetClusterInstallationTimelineParams, interface{}) middleware.Responder
+}
+
+// NewV2GetClusterInstallationTimeline creates a new http.Handler for the v2 get cluster installation timeline operation
+func NewV2GetClusterInstallationTimeline(ctx *middleware.Context, handler V2GetClusterInstallationTimelineHandler) *V2GetClusterInstallationTimeline {
+	return &V2GetClusterInstallationTimeline{Context: ctx, Handler: handler}
+}
+
+/* V2GetClusterInstallationTimeline swagger:route GET /v2/clusters/{cluster_id}/installation-timeline installer v2GetClusterInstallationTimeline
+
+Get the timeline of the cluster installation, built from the installation stages that each host went through.
+
+
+*/
+type V2GetClusterInstallationTimeline struct {
+	Context *middleware.Context
+	Handler V2GetClusterInstallationTimelineHandler
+}
+
+func (o *V2GetClusterInstallationTimeline) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetClusterInstallationTimelineParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
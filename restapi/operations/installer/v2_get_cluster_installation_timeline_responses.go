@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterInstallationTimelineOKCode is the HTTP code returned for type V2GetClusterInstallationTimelineOK
+const V2GetClusterInstallationTimelineOKCode int = 200
+
+/*V2GetClusterInstallationTimelineOK Success.
+
+swagger:response v2GetClusterInstallationTimelineOK
+*/
+type V2GetClusterInstallationTimelineOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InstallationTimeline `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallationTimelineOK creates V2GetClusterInstallationTimelineOK with default headers values
+func NewV2GetClusterInstallationTimelineOK() *V2GetClusterInstallationTimelineOK {
+
+	return &V2GetClusterInstallationTimelineOK{}
+}
+
+// WithPayload adds the payload to the v2 get cluster installation timeline o k response
+func (o *V2GetClusterInstallationTimelineOK) WithPayload(payload *models.InstallationTimeline) *V2GetClusterInstallationTimelineOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster installation timeline o k response
+func (o *V2GetClusterInstallationTimelineOK) SetPayload(payload *models.InstallationTimeline) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallationTimelineOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallationTimelineUnauthorizedCode is the HTTP code returned for type V2GetClusterInstallationTimelineUnauthorized
+const V2GetClusterInstallationTimelineUnauthorizedCode int = 401
+
+/*V2GetClusterInstallationTimelineUnauthorized Unauthorized.
+
+swagger:response v2GetClusterInstallationTimelineUnauthorized
+*/
+type V2GetClusterInstallationTimelineUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallationTimelineUnauthorized creates V2GetClusterInstallationTimelineUnauthorized with default headers values
+func NewV2GetClusterInstallationTimelineUnauthorized() *V2GetClusterInstallationTimelineUnauthorized {
+
+	return &V2GetClusterInstallationTimelineUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get cluster installation timeline unauthorized response
+func (o *V2GetClusterInstallationTimelineUnauthorized) WithPayload(payload *models.InfraError) *V2GetClusterInstallationTimelineUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster installation timeline unauthorized response
+func (o *V2GetClusterInstallationTimelineUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallationTimelineUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallationTimelineForbiddenCode is the HTTP code returned for type V2GetClusterInstallationTimelineForbidden
+const V2GetClusterInstallationTimelineForbiddenCode int = 403
+
+/*V2GetClusterInstallationTimelineForbidden Forbidden.
+
+swagger:response v2GetClusterInstallationTimelineForbidden
+*/
+type V2GetClusterInstallationTimelineForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallationTimelineForbidden creates V2GetClusterInstallationTimelineForbidden with default headers values
+func NewV2GetClusterInstallationTimelineForbidden() *V2GetClusterInstallationTimelineForbidden {
+
+	return &V2GetClusterInstallationTimelineForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get cluster installation timeline forbidden response
+func (o *V2GetClusterInstallationTimelineForbidden) WithPayload(payload *models.InfraError) *V2GetClusterInstallationTimelineForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster installation timeline forbidden response
+func (o *V2GetClusterInstallationTimelineForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallationTimelineForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallationTimelineNotFoundCode is the HTTP code returned for type V2GetClusterInstallationTimelineNotFound
+const V2GetClusterInstallationTimelineNotFoundCode int = 404
+
+/*V2GetClusterInstallationTimelineNotFound Error.
+
+swagger:response v2GetClusterInstallationTimelineNotFound
+*/
+type V2GetClusterInstallationTimelineNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallationTimelineNotFound creates V2GetClusterInstallationTimelineNotFound with default headers values
+func NewV2GetClusterInstallationTimelineNotFound() *V2GetClusterInstallationTimelineNotFound {
+
+	return &V2GetClusterInstallationTimelineNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get cluster installation timeline not found response
+func (o *V2GetClusterInstallationTimelineNotFound) WithPayload(payload *models.Error) *V2GetClusterInstallationTimelineNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster installation timeline not found response
+func (o *V2GetClusterInstallationTimelineNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallationTimelineNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallationTimelineMethodNotAllowedCode is the HTTP code returned for type V2GetClusterInstallationTimelineMethodNotAllowed
+const V2GetClusterInstallationTimelineMethodNotAllowedCode int = 405
+
+/*V2GetClusterInstallationTimelineMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetClusterInstallationTimelineMethodNotAllowed
+*/
+type V2GetClusterInstallationTimelineMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallationTimelineMethodNotAllowed creates V2GetClusterInstallationTimelineMethodNotAllowed with default headers values
+func NewV2GetClusterInstallationTimelineMethodNotAllowed() *V2GetClusterInstallationTimelineMethodNotAllowed {
+
+	return &V2GetClusterInstallationTimelineMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get cluster installation timeline method not allowed response
+func (o *V2GetClusterInstallationTimelineMethodNotAllowed) WithPayload(payload *models.Error) *V2GetClusterInstallationTimelineMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster installation timeline method not allowed response
+func (o *V2GetClusterInstallationTimelineMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallationTimelineMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterInstallationTimelineInternalServerErrorCode is the HTTP code returned for type V2GetClusterInstallationTimelineInternalServerError
+const V2GetClusterInstallationTimelineInternalServerErrorCode int = 500
+
+/*V2GetClusterInstallationTimelineInternalServerError Error.
+
+swagger:response v2GetClusterInstallationTimelineInternalServerError
+*/
+type V2GetClusterInstallationTimelineInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterInstallationTimelineInternalServerError creates V2GetClusterInstallationTimelineInternalServerError with default headers values
+func NewV2GetClusterInstallationTimelineInternalServerError() *V2GetClusterInstallationTimelineInternalServerError {
+
+	return &V2GetClusterInstallationTimelineInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get cluster installation timeline internal server error response
+func (o *V2GetClusterInstallationTimelineInternalServerError) WithPayload(payload *models.Error) *V2GetClusterInstallationTimelineInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster installation timeline internal server error response
+func (o *V2GetClusterInstallationTimelineInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterInstallationTimelineInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -21,6 +21,10 @@ const V2GetClusterOKCode int = 200
 swagger:response v2GetClusterOK
 */
 type V2GetClusterOK struct {
+	/*The resource version of the cluster, to be sent in the If-Match header of its updates.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewV2GetClusterOK() *V2GetClusterOK {
 	return &V2GetClusterOK{}
 }
 
+// WithETag adds the eTag to the v2 get cluster o k response
+func (o *V2GetClusterOK) WithETag(eTag string) *V2GetClusterOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the v2 get cluster o k response
+func (o *V2GetClusterOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the v2 get cluster o k response
 func (o *V2GetClusterOK) WithPayload(payload *models.Cluster) *V2GetClusterOK {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *V2GetClusterOK) SetPayload(payload *models.Cluster) {
 // WriteResponse to the client
 func (o *V2GetClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetHostDiagnosticHandlerFunc turns a function with the right signature into a v2 get host diagnostic handler
+type V2GetHostDiagnosticHandlerFunc func(V2GetHostDiagnosticParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetHostDiagnosticHandlerFunc) Handle(params V2GetHostDiagnosticParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetHostDiagnosticHandler interface for that can handle valid v2 get host diagnostic params
+type V2GetHostDiagnosticHandler interface {
+	Handle(V2GetHostDiagnosticParams, interface{}) middleware.Responder
+}
+
+// NewV2GetHostDiagnostic creates a new http.Handler for the v2 get host diagnostic operation
+func NewV2GetHostDiagnostic(ctx *middleware.Context, handler V2GetHostDiagnosticHandler) *V2GetHostDiagnostic {
+	return &V2GetHostDiagnostic{Context: ctx, Handler: handler}
+}
+
+/* V2GetHostDiagnostic swagger:route GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics/{diagnostic_id} installer v2GetHostDiagnostic
+
+Retrieves a diagnostic action of the host, including its output once the agent uploaded it.
+
+*/
+type V2GetHostDiagnostic struct {
+	Context *middleware.Context
+	Handler V2GetHostDiagnosticHandler
+}
+
+func (o *V2GetHostDiagnostic) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetHostDiagnosticParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
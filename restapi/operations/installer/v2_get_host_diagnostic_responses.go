@@ -0,0 +1,234 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetHostDiagnosticOKCode is the HTTP code returned for type V2GetHostDiagnosticOK
+const V2GetHostDiagnosticOKCode int = 200
+
+/*V2GetHostDiagnosticOK Success.
+
+swagger:response v2GetHostDiagnosticOK
+*/
+type V2GetHostDiagnosticOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HostDiagnostic `json:"body,omitempty"`
+}
+
+// NewV2GetHostDiagnosticOK creates V2GetHostDiagnosticOK with default headers values
+func NewV2GetHostDiagnosticOK() *V2GetHostDiagnosticOK {
+
+	return &V2GetHostDiagnosticOK{}
+}
+
+// WithPayload adds the payload to the v2 get host diagnostic o k response
+func (o *V2GetHostDiagnosticOK) WithPayload(payload *models.HostDiagnostic) *V2GetHostDiagnosticOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host diagnostic o k response
+func (o *V2GetHostDiagnosticOK) SetPayload(payload *models.HostDiagnostic) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostDiagnosticOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostDiagnosticUnauthorizedCode is the HTTP code returned for type V2GetHostDiagnosticUnauthorized
+const V2GetHostDiagnosticUnauthorizedCode int = 401
+
+/*V2GetHostDiagnosticUnauthorized Unauthorized.
+
+swagger:response v2GetHostDiagnosticUnauthorized
+*/
+type V2GetHostDiagnosticUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetHostDiagnosticUnauthorized creates V2GetHostDiagnosticUnauthorized with default headers values
+func NewV2GetHostDiagnosticUnauthorized() *V2GetHostDiagnosticUnauthorized {
+
+	return &V2GetHostDiagnosticUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get host diagnostic unauthorized response
+func (o *V2GetHostDiagnosticUnauthorized) WithPayload(payload *models.InfraError) *V2GetHostDiagnosticUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host diagnostic unauthorized response
+func (o *V2GetHostDiagnosticUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostDiagnosticUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostDiagnosticForbiddenCode is the HTTP code returned for type V2GetHostDiagnosticForbidden
+const V2GetHostDiagnosticForbiddenCode int = 403
+
+/*V2GetHostDiagnosticForbidden Forbidden.
+
+swagger:response v2GetHostDiagnosticForbidden
+*/
+type V2GetHostDiagnosticForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetHostDiagnosticForbidden creates V2GetHostDiagnosticForbidden with default headers values
+func NewV2GetHostDiagnosticForbidden() *V2GetHostDiagnosticForbidden {
+
+	return &V2GetHostDiagnosticForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get host diagnostic forbidden response
+func (o *V2GetHostDiagnosticForbidden) WithPayload(payload *models.InfraError) *V2GetHostDiagnosticForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host diagnostic forbidden response
+func (o *V2GetHostDiagnosticForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostDiagnosticForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostDiagnosticNotFoundCode is the HTTP code returned for type V2GetHostDiagnosticNotFound
+const V2GetHostDiagnosticNotFoundCode int = 404
+
+/*V2GetHostDiagnosticNotFound Error.
+
+swagger:response v2GetHostDiagnosticNotFound
+*/
+type V2GetHostDiagnosticNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetHostDiagnosticNotFound creates V2GetHostDiagnosticNotFound with default headers values
+func NewV2GetHostDiagnosticNotFound() *V2GetHostDiagnosticNotFound {
+
+	return &V2GetHostDiagnosticNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get host diagnostic not found response
+func (o *V2GetHostDiagnosticNotFound) WithPayload(payload *models.Error) *V2GetHostDiagnosticNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host diagnostic not found response
+func (o *V2GetHostDiagnosticNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostDiagnosticNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetHostDiagnosticInternalServerErrorCode is the HTTP code returned for type V2GetHostDiagnosticInternalServerError
+const V2GetHostDiagnosticInternalServerErrorCode int = 500
+
+/*V2GetHostDiagnosticInternalServerError Error.
+
+swagger:response v2GetHostDiagnosticInternalServerError
+*/
+type V2GetHostDiagnosticInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetHostDiagnosticInternalServerError creates V2GetHostDiagnosticInternalServerError with default headers values
+func NewV2GetHostDiagnosticInternalServerError() *V2GetHostDiagnosticInternalServerError {
+
+	return &V2GetHostDiagnosticInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get host diagnostic internal server error response
+func (o *V2GetHostDiagnosticInternalServerError) WithPayload(payload *models.Error) *V2GetHostDiagnosticInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get host diagnostic internal server error response
+func (o *V2GetHostDiagnosticInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetHostDiagnosticInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
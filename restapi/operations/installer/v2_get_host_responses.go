@@ -21,6 +21,10 @@ const V2GetHostOKCode int = 200
 swagger:response v2GetHostOK
 */
 type V2GetHostOK struct {
+	/*The resource version of the host, to be sent in the If-Match header of its updates.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewV2GetHostOK() *V2GetHostOK {
 	return &V2GetHostOK{}
 }
 
+// WithETag adds the eTag to the v2 get host o k response
+func (o *V2GetHostOK) WithETag(eTag string) *V2GetHostOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the v2 get host o k response
+func (o *V2GetHostOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the v2 get host o k response
 func (o *V2GetHostOK) WithPayload(payload *models.Host) *V2GetHostOK {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *V2GetHostOK) SetPayload(payload *models.Host) {
 // WriteResponse to the client
 func (o *V2GetHostOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -51,6 +51,17 @@ type V2GetNextStepsParams struct {
 	  In: query
 	*/
 	Timestamp *int64
+	/*Long-poll mode. The number of seconds the service may hold the request open, waiting for the state
+	of the host to change or for a new command to be queued for it, before it returns the next operations.
+	When omitted or 0 the next operations are returned immediately. The request is not held
+	when the host changed since it last checked in, nor when the service is unable to
+	watch the host for changes.
+
+	  Maximum: 120
+	  Minimum: 0
+	  In: query
+	*/
+	WaitTimeout *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -82,6 +93,11 @@ func (o *V2GetNextStepsParams) BindRequest(r *http.Request, route *middleware.Ma
 	if err := o.bindTimestamp(qTimestamp, qhkTimestamp, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qWaitTimeout, qhkWaitTimeout, _ := qs.GetOK("wait_timeout")
+	if err := o.bindWaitTimeout(qWaitTimeout, qhkWaitTimeout, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -193,3 +209,44 @@ func (o *V2GetNextStepsParams) bindTimestamp(rawData []string, hasKey bool, form
 
 	return nil
 }
+
+// bindWaitTimeout binds and validates parameter WaitTimeout from query.
+func (o *V2GetNextStepsParams) bindWaitTimeout(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("wait_timeout", "query", "int64", raw)
+	}
+	o.WaitTimeout = &value
+
+	if err := o.validateWaitTimeout(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateWaitTimeout carries on validations for parameter WaitTimeout
+func (o *V2GetNextStepsParams) validateWaitTimeout(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("wait_timeout", "query", *o.WaitTimeout, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("wait_timeout", "query", *o.WaitTimeout, 120, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -20,7 +20,8 @@ type V2GetNextStepsURL struct {
 	HostID     strfmt.UUID
 	InfraEnvID strfmt.UUID
 
-	Timestamp *int64
+	Timestamp   *int64
+	WaitTimeout *int64
 
 	_basePath string
 	// avoid unkeyed usage
@@ -78,6 +79,14 @@ func (o *V2GetNextStepsURL) Build() (*url.URL, error) {
 		qs.Set("timestamp", timestampQ)
 	}
 
+	var waitTimeoutQ string
+	if o.WaitTimeout != nil {
+		waitTimeoutQ = swag.FormatInt64(*o.WaitTimeout)
+	}
+	if waitTimeoutQ != "" {
+		qs.Set("wait_timeout", waitTimeoutQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
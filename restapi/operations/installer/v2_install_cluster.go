@@ -31,7 +31,9 @@ func NewV2InstallCluster(ctx *middleware.Context, handler V2InstallClusterHandle
 
 /* V2InstallCluster swagger:route POST /v2/clusters/{cluster_id}/actions/install installer v2InstallCluster
 
-Installs the OpenShift cluster.
+Installs the OpenShift cluster. When an install schedule is given and its start time is in the future,
+the installation is scheduled instead and starts automatically once the cluster is ready within the schedule's window.
+
 
 */
 type V2InstallCluster struct {
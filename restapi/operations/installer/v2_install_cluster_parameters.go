@@ -6,12 +6,16 @@ package installer
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
 )
 
 // NewV2InstallClusterParams creates a new V2InstallClusterParams object
@@ -36,6 +40,10 @@ type V2InstallClusterParams struct {
 	  In: path
 	*/
 	ClusterID strfmt.UUID
+	/*The window in which the installation is allowed to start.
+	  In: body
+	*/
+	InstallSchedule *models.InstallSchedule
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -51,6 +59,28 @@ func (o *V2InstallClusterParams) BindRequest(r *http.Request, route *middleware.
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.InstallSchedule
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			res = append(res, errors.NewParseError("installSchedule", "body", "", err))
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(context.Background())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.InstallSchedule = &body
+			}
+		}
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
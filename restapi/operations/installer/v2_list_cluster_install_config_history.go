@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListClusterInstallConfigHistoryHandlerFunc turns a function with the right signature into a v2 list cluster install config history handler
+type V2ListClusterInstallConfigHistoryHandlerFunc func(V2ListClusterInstallConfigHistoryParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListClusterInstallConfigHistoryHandlerFunc) Handle(params V2ListClusterInstallConfigHistoryParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListClusterInstallConfigHistoryHandler interface for that can handle valid v2 list cluster install config history params
+type V2ListClusterInstallConfigHistoryHandler interface {
+	Handle(V2ListClusterInstallConfigHistoryParams, interface{}) middleware.Responder
+}
+
+// NewV2ListClusterInstallConfigHistory creates a new http.Handler for the v2 list cluster install config history operation
+func NewV2ListClusterInstallConfigHistory(ctx *middleware.Context, handler V2ListClusterInstallConfigHistoryHandler) *V2ListClusterInstallConfigHistory {
+	return &V2ListClusterInstallConfigHistory{Context: ctx, Handler: handler}
+}
+
+/* V2ListClusterInstallConfigHistory swagger:route GET /v2/clusters/{cluster_id}/install-config/history installer v2ListClusterInstallConfigHistory
+
+Lists the versions of the install config overrides of the cluster, oldest first.
+
+*/
+type V2ListClusterInstallConfigHistory struct {
+	Context *middleware.Context
+	Handler V2ListClusterInstallConfigHistoryHandler
+}
+
+func (o *V2ListClusterInstallConfigHistory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListClusterInstallConfigHistoryParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
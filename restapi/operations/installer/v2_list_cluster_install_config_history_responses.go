@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterInstallConfigHistoryOKCode is the HTTP code returned for type V2ListClusterInstallConfigHistoryOK
+const V2ListClusterInstallConfigHistoryOKCode int = 200
+
+/*V2ListClusterInstallConfigHistoryOK Success.
+
+swagger:response v2ListClusterInstallConfigHistoryOK
+*/
+type V2ListClusterInstallConfigHistoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.InstallConfigOverrideVersionList `json:"body,omitempty"`
+}
+
+// NewV2ListClusterInstallConfigHistoryOK creates V2ListClusterInstallConfigHistoryOK with default headers values
+func NewV2ListClusterInstallConfigHistoryOK() *V2ListClusterInstallConfigHistoryOK {
+
+	return &V2ListClusterInstallConfigHistoryOK{}
+}
+
+// WithPayload adds the payload to the v2 list cluster install config history o k response
+func (o *V2ListClusterInstallConfigHistoryOK) WithPayload(payload models.InstallConfigOverrideVersionList) *V2ListClusterInstallConfigHistoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster install config history o k response
+func (o *V2ListClusterInstallConfigHistoryOK) SetPayload(payload models.InstallConfigOverrideVersionList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterInstallConfigHistoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.InstallConfigOverrideVersionList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListClusterInstallConfigHistoryUnauthorizedCode is the HTTP code returned for type V2ListClusterInstallConfigHistoryUnauthorized
+const V2ListClusterInstallConfigHistoryUnauthorizedCode int = 401
+
+/*V2ListClusterInstallConfigHistoryUnauthorized Unauthorized.
+
+swagger:response v2ListClusterInstallConfigHistoryUnauthorized
+*/
+type V2ListClusterInstallConfigHistoryUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterInstallConfigHistoryUnauthorized creates V2ListClusterInstallConfigHistoryUnauthorized with default headers values
+func NewV2ListClusterInstallConfigHistoryUnauthorized() *V2ListClusterInstallConfigHistoryUnauthorized {
+
+	return &V2ListClusterInstallConfigHistoryUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list cluster install config history unauthorized response
+func (o *V2ListClusterInstallConfigHistoryUnauthorized) WithPayload(payload *models.InfraError) *V2ListClusterInstallConfigHistoryUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster install config history unauthorized response
+func (o *V2ListClusterInstallConfigHistoryUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterInstallConfigHistoryUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterInstallConfigHistoryForbiddenCode is the HTTP code returned for type V2ListClusterInstallConfigHistoryForbidden
+const V2ListClusterInstallConfigHistoryForbiddenCode int = 403
+
+/*V2ListClusterInstallConfigHistoryForbidden Forbidden.
+
+swagger:response v2ListClusterInstallConfigHistoryForbidden
+*/
+type V2ListClusterInstallConfigHistoryForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterInstallConfigHistoryForbidden creates V2ListClusterInstallConfigHistoryForbidden with default headers values
+func NewV2ListClusterInstallConfigHistoryForbidden() *V2ListClusterInstallConfigHistoryForbidden {
+
+	return &V2ListClusterInstallConfigHistoryForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list cluster install config history forbidden response
+func (o *V2ListClusterInstallConfigHistoryForbidden) WithPayload(payload *models.InfraError) *V2ListClusterInstallConfigHistoryForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster install config history forbidden response
+func (o *V2ListClusterInstallConfigHistoryForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterInstallConfigHistoryForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterInstallConfigHistoryNotFoundCode is the HTTP code returned for type V2ListClusterInstallConfigHistoryNotFound
+const V2ListClusterInstallConfigHistoryNotFoundCode int = 404
+
+/*V2ListClusterInstallConfigHistoryNotFound Error.
+
+swagger:response v2ListClusterInstallConfigHistoryNotFound
+*/
+type V2ListClusterInstallConfigHistoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterInstallConfigHistoryNotFound creates V2ListClusterInstallConfigHistoryNotFound with default headers values
+func NewV2ListClusterInstallConfigHistoryNotFound() *V2ListClusterInstallConfigHistoryNotFound {
+
+	return &V2ListClusterInstallConfigHistoryNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list cluster install config history not found response
+func (o *V2ListClusterInstallConfigHistoryNotFound) WithPayload(payload *models.Error) *V2ListClusterInstallConfigHistoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster install config history not found response
+func (o *V2ListClusterInstallConfigHistoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterInstallConfigHistoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterInstallConfigHistoryMethodNotAllowedCode is the HTTP code returned for type V2ListClusterInstallConfigHistoryMethodNotAllowed
+const V2ListClusterInstallConfigHistoryMethodNotAllowedCode int = 405
+
+/*V2ListClusterInstallConfigHistoryMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ListClusterInstallConfigHistoryMethodNotAllowed
+*/
+type V2ListClusterInstallConfigHistoryMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterInstallConfigHistoryMethodNotAllowed creates V2ListClusterInstallConfigHistoryMethodNotAllowed with default headers values
+func NewV2ListClusterInstallConfigHistoryMethodNotAllowed() *V2ListClusterInstallConfigHistoryMethodNotAllowed {
+
+	return &V2ListClusterInstallConfigHistoryMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 list cluster install config history method not allowed response
+func (o *V2ListClusterInstallConfigHistoryMethodNotAllowed) WithPayload(payload *models.Error) *V2ListClusterInstallConfigHistoryMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster install config history method not allowed response
+func (o *V2ListClusterInstallConfigHistoryMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterInstallConfigHistoryMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterInstallConfigHistoryInternalServerErrorCode is the HTTP code returned for type V2ListClusterInstallConfigHistoryInternalServerError
+const V2ListClusterInstallConfigHistoryInternalServerErrorCode int = 500
+
+/*V2ListClusterInstallConfigHistoryInternalServerError Error.
+
+swagger:response v2ListClusterInstallConfigHistoryInternalServerError
+*/
+type V2ListClusterInstallConfigHistoryInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterInstallConfigHistoryInternalServerError creates V2ListClusterInstallConfigHistoryInternalServerError with default headers values
+func NewV2ListClusterInstallConfigHistoryInternalServerError() *V2ListClusterInstallConfigHistoryInternalServerError {
+
+	return &V2ListClusterInstallConfigHistoryInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list cluster install config history internal server error response
+func (o *V2ListClusterInstallConfigHistoryInternalServerError) WithPayload(payload *models.Error) *V2ListClusterInstallConfigHistoryInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster install config history internal server error response
+func (o *V2ListClusterInstallConfigHistoryInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterInstallConfigHistoryInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
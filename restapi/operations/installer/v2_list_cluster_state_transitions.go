@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListClusterStateTransitionsHandlerFunc turns a function with the right signature into a v2 list cluster state transitions handler
+type V2ListClusterStateTransitionsHandlerFunc func(V2ListClusterStateTransitionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListClusterStateTransitionsHandlerFunc) Handle(params V2ListClusterStateTransitionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListClusterStateTransitionsHandler interface for that can handle valid v2 list cluster state transitions params
+type V2ListClusterStateTransitionsHandler interface {
+	Handle(V2ListClusterStateTransitionsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListClusterStateTransitions creates a new http.Handler for the v2 list cluster state transitions operation
+func NewV2ListClusterStateTransitions(ctx *middleware.Context, handler V2ListClusterStateTransitionsHandler) *V2ListClusterStateTransitions {
+	return &V2ListClusterStateTransitions{Context: ctx, Handler: handler}
+}
+
+/* V2ListClusterStateTransitions swagger:route GET /v2/clusters/{cluster_id}/state-transitions installer v2ListClusterStateTransitions
+
+Lists the status transitions of the cluster, oldest first.
+
+*/
+type V2ListClusterStateTransitions struct {
+	Context *middleware.Context
+	Handler V2ListClusterStateTransitionsHandler
+}
+
+func (o *V2ListClusterStateTransitions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListClusterStateTransitionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,281 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterStateTransitionsOKCode is the HTTP code returned for type V2ListClusterStateTransitionsOK
+const V2ListClusterStateTransitionsOKCode int = 200
+
+/*V2ListClusterStateTransitionsOK Success.
+
+swagger:response v2ListClusterStateTransitionsOK
+*/
+type V2ListClusterStateTransitionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.StateTransitionList `json:"body,omitempty"`
+}
+
+// NewV2ListClusterStateTransitionsOK creates V2ListClusterStateTransitionsOK with default headers values
+func NewV2ListClusterStateTransitionsOK() *V2ListClusterStateTransitionsOK {
+
+	return &V2ListClusterStateTransitionsOK{}
+}
+
+// WithPayload adds the payload to the v2 list cluster state transitions o k response
+func (o *V2ListClusterStateTransitionsOK) WithPayload(payload models.StateTransitionList) *V2ListClusterStateTransitionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster state transitions o k response
+func (o *V2ListClusterStateTransitionsOK) SetPayload(payload models.StateTransitionList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterStateTransitionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.StateTransitionList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListClusterStateTransitionsUnauthorizedCode is the HTTP code returned for type V2ListClusterStateTransitionsUnauthorized
+const V2ListClusterStateTransitionsUnauthorizedCode int = 401
+
+/*V2ListClusterStateTransitionsUnauthorized Unauthorized.
+
+swagger:response v2ListClusterStateTransitionsUnauthorized
+*/
+type V2ListClusterStateTransitionsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterStateTransitionsUnauthorized creates V2ListClusterStateTransitionsUnauthorized with default headers values
+func NewV2ListClusterStateTransitionsUnauthorized() *V2ListClusterStateTransitionsUnauthorized {
+
+	return &V2ListClusterStateTransitionsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list cluster state transitions unauthorized response
+func (o *V2ListClusterStateTransitionsUnauthorized) WithPayload(payload *models.InfraError) *V2ListClusterStateTransitionsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster state transitions unauthorized response
+func (o *V2ListClusterStateTransitionsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterStateTransitionsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterStateTransitionsForbiddenCode is the HTTP code returned for type V2ListClusterStateTransitionsForbidden
+const V2ListClusterStateTransitionsForbiddenCode int = 403
+
+/*V2ListClusterStateTransitionsForbidden Forbidden.
+
+swagger:response v2ListClusterStateTransitionsForbidden
+*/
+type V2ListClusterStateTransitionsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterStateTransitionsForbidden creates V2ListClusterStateTransitionsForbidden with default headers values
+func NewV2ListClusterStateTransitionsForbidden() *V2ListClusterStateTransitionsForbidden {
+
+	return &V2ListClusterStateTransitionsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list cluster state transitions forbidden response
+func (o *V2ListClusterStateTransitionsForbidden) WithPayload(payload *models.InfraError) *V2ListClusterStateTransitionsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster state transitions forbidden response
+func (o *V2ListClusterStateTransitionsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterStateTransitionsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterStateTransitionsNotFoundCode is the HTTP code returned for type V2ListClusterStateTransitionsNotFound
+const V2ListClusterStateTransitionsNotFoundCode int = 404
+
+/*V2ListClusterStateTransitionsNotFound Error.
+
+swagger:response v2ListClusterStateTransitionsNotFound
+*/
+type V2ListClusterStateTransitionsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterStateTransitionsNotFound creates V2ListClusterStateTransitionsNotFound with default headers values
+func NewV2ListClusterStateTransitionsNotFound() *V2ListClusterStateTransitionsNotFound {
+
+	return &V2ListClusterStateTransitionsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list cluster state transitions not found response
+func (o *V2ListClusterStateTransitionsNotFound) WithPayload(payload *models.Error) *V2ListClusterStateTransitionsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster state transitions not found response
+func (o *V2ListClusterStateTransitionsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterStateTransitionsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterStateTransitionsMethodNotAllowedCode is the HTTP code returned for type V2ListClusterStateTransitionsMethodNotAllowed
+const V2ListClusterStateTransitionsMethodNotAllowedCode int = 405
+
+/*V2ListClusterStateTransitionsMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ListClusterStateTransitionsMethodNotAllowed
+*/
+type V2ListClusterStateTransitionsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterStateTransitionsMethodNotAllowed creates V2ListClusterStateTransitionsMethodNotAllowed with default headers values
+func NewV2ListClusterStateTransitionsMethodNotAllowed() *V2ListClusterStateTransitionsMethodNotAllowed {
+
+	return &V2ListClusterStateTransitionsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 list cluster state transitions method not allowed response
+func (o *V2ListClusterStateTransitionsMethodNotAllowed) WithPayload(payload *models.Error) *V2ListClusterStateTransitionsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster state transitions method not allowed response
+func (o *V2ListClusterStateTransitionsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterStateTransitionsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterStateTransitionsInternalServerErrorCode is the HTTP code returned for type V2ListClusterStateTransitionsInternalServerError
+const V2ListClusterStateTransitionsInternalServerErrorCode int = 500
+
+/*V2ListClusterStateTransitionsInternalServerError Error.
+
+swagger:response v2ListClusterStateTransitionsInternalServerError
+*/
+type V2ListClusterStateTransitionsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterStateTransitionsInternalServerError creates V2ListClusterStateTransitionsInternalServerError with default headers values
+func NewV2ListClusterStateTransitionsInternalServerError() *V2ListClusterStateTransitionsInternalServerError {
+
+	return &V2ListClusterStateTransitionsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list cluster state transitions internal server error response
+func (o *V2ListClusterStateTransitionsInternalServerError) WithPayload(payload *models.Error) *V2ListClusterStateTransitionsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster state transitions internal server error response
+func (o *V2ListClusterStateTransitionsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterStateTransitionsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListDeletedClustersHandlerFunc turns a function with the right signature into a v2 list deleted clusters handler
+type V2ListDeletedClustersHandlerFunc func(V2ListDeletedClustersParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListDeletedClustersHandlerFunc) Handle(params V2ListDeletedClustersParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListDeletedClustersHandler interface for that can handle valid v2 list deleted clusters params
+type V2ListDeletedClustersHandler interface {
+	Handle(V2ListDeletedClustersParams, interface{}) middleware.Responder
+}
+
+// NewV2ListDeletedClusters creates a new http.Handler for the v2 list deleted clusters operation
+func NewV2ListDeletedClusters(ctx *middleware.Context, handler V2ListDeletedClustersHandler) *V2ListDeletedClusters {
+	return &V2ListDeletedClusters{Context: ctx, Handler: handler}
+}
+
+/* V2ListDeletedClusters swagger:route GET /v2/deleted-clusters installer v2ListDeletedClusters
+
+Lists the deregistered clusters that can still be restored, before they are permanently deleted.
+
+
+*/
+type V2ListDeletedClusters struct {
+	Context *middleware.Context
+	Handler V2ListDeletedClustersHandler
+}
+
+func (o *V2ListDeletedClusters) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListDeletedClustersParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
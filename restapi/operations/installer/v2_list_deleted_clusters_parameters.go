@@ -0,0 +1,46 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// NewV2ListDeletedClustersParams creates a new V2ListDeletedClustersParams object
+//
+// There are no default values defined in the spec.
+func NewV2ListDeletedClustersParams() V2ListDeletedClustersParams {
+
+	return V2ListDeletedClustersParams{}
+}
+
+// V2ListDeletedClustersParams contains all the bound params for the v2 list deleted clusters operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2ListDeletedClusters
+type V2ListDeletedClustersParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ListDeletedClustersParams() beforehand.
+func (o *V2ListDeletedClustersParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListDeletedClustersOKCode is the HTTP code returned for type V2ListDeletedClustersOK
+const V2ListDeletedClustersOKCode int = 200
+
+/*V2ListDeletedClustersOK Success.
+
+swagger:response v2ListDeletedClustersOK
+*/
+type V2ListDeletedClustersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.DeletedClusterList `json:"body,omitempty"`
+}
+
+// NewV2ListDeletedClustersOK creates V2ListDeletedClustersOK with default headers values
+func NewV2ListDeletedClustersOK() *V2ListDeletedClustersOK {
+
+	return &V2ListDeletedClustersOK{}
+}
+
+// WithPayload adds the payload to the v2 list deleted clusters o k response
+func (o *V2ListDeletedClustersOK) WithPayload(payload models.DeletedClusterList) *V2ListDeletedClustersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list deleted clusters o k response
+func (o *V2ListDeletedClustersOK) SetPayload(payload models.DeletedClusterList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListDeletedClustersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.DeletedClusterList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListDeletedClustersUnauthorizedCode is the HTTP code returned for type V2ListDeletedClustersUnauthorized
+const V2ListDeletedClustersUnauthorizedCode int = 401
+
+/*V2ListDeletedClustersUnauthorized Unauthorized.
+
+swagger:response v2ListDeletedClustersUnauthorized
+*/
+type V2ListDeletedClustersUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListDeletedClustersUnauthorized creates V2ListDeletedClustersUnauthorized with default headers values
+func NewV2ListDeletedClustersUnauthorized() *V2ListDeletedClustersUnauthorized {
+
+	return &V2ListDeletedClustersUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list deleted clusters unauthorized response
+func (o *V2ListDeletedClustersUnauthorized) WithPayload(payload *models.InfraError) *V2ListDeletedClustersUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list deleted clusters unauthorized response
+func (o *V2ListDeletedClustersUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListDeletedClustersUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListDeletedClustersForbiddenCode is the HTTP code returned for type V2ListDeletedClustersForbidden
+const V2ListDeletedClustersForbiddenCode int = 403
+
+/*V2ListDeletedClustersForbidden Forbidden.
+
+swagger:response v2ListDeletedClustersForbidden
+*/
+type V2ListDeletedClustersForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListDeletedClustersForbidden creates V2ListDeletedClustersForbidden with default headers values
+func NewV2ListDeletedClustersForbidden() *V2ListDeletedClustersForbidden {
+
+	return &V2ListDeletedClustersForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list deleted clusters forbidden response
+func (o *V2ListDeletedClustersForbidden) WithPayload(payload *models.InfraError) *V2ListDeletedClustersForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list deleted clusters forbidden response
+func (o *V2ListDeletedClustersForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListDeletedClustersForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListDeletedClustersMethodNotAllowedCode is the HTTP code returned for type V2ListDeletedClustersMethodNotAllowed
+const V2ListDeletedClustersMethodNotAllowedCode int = 405
+
+/*V2ListDeletedClustersMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ListDeletedClustersMethodNotAllowed
+*/
+type V2ListDeletedClustersMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListDeletedClustersMethodNotAllowed creates V2ListDeletedClustersMethodNotAllowed with default headers values
+func NewV2ListDeletedClustersMethodNotAllowed() *V2ListDeletedClustersMethodNotAllowed {
+
+	return &V2ListDeletedClustersMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 list deleted clusters method not allowed response
+func (o *V2ListDeletedClustersMethodNotAllowed) WithPayload(payload *models.Error) *V2ListDeletedClustersMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list deleted clusters method not allowed response
+func (o *V2ListDeletedClustersMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListDeletedClustersMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListDeletedClustersInternalServerErrorCode is the HTTP code returned for type V2ListDeletedClustersInternalServerError
+const V2ListDeletedClustersInternalServerErrorCode int = 500
+
+/*V2ListDeletedClustersInternalServerError Error.
+
+swagger:response v2ListDeletedClustersInternalServerError
+*/
+type V2ListDeletedClustersInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListDeletedClustersInternalServerError creates V2ListDeletedClustersInternalServerError with default headers values
+func NewV2ListDeletedClustersInternalServerError() *V2ListDeletedClustersInternalServerError {
+
+	return &V2ListDeletedClustersInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list deleted clusters internal server error response
+func (o *V2ListDeletedClustersInternalServerError) WithPayload(payload *models.Error) *V2ListDeletedClustersInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list deleted clusters internal server error response
+func (o *V2ListDeletedClustersInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListDeletedClustersInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListHostDiagnosticsHandlerFunc turns a function with the right signature into a v2 list host diagnostics handler
+type V2ListHostDiagnosticsHandlerFunc func(V2ListHostDiagnosticsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListHostDiagnosticsHandlerFunc) Handle(params V2ListHostDiagnosticsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListHostDiagnosticsHandler interface for that can handle valid v2 list host diagnostics params
+type V2ListHostDiagnosticsHandler interface {
+	Handle(V2ListHostDiagnosticsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListHostDiagnostics creates a new http.Handler for the v2 list host diagnostics operation
+func NewV2ListHostDiagnostics(ctx *middleware.Context, handler V2ListHostDiagnosticsHandler) *V2ListHostDiagnostics {
+	return &V2ListHostDiagnostics{Context: ctx, Handler: handler}
+}
+
+/* V2ListHostDiagnostics swagger:route GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics installer v2ListHostDiagnostics
+
+Lists the diagnostic actions that were requested for the host.
+
+*/
+type V2ListHostDiagnostics struct {
+	Context *middleware.Context
+	Handler V2ListHostDiagnosticsHandler
+}
+
+func (o *V2ListHostDiagnostics) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListHostDiagnosticsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,237 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostDiagnosticsOKCode is the HTTP code returned for type V2ListHostDiagnosticsOK
+const V2ListHostDiagnosticsOKCode int = 200
+
+/*V2ListHostDiagnosticsOK Success.
+
+swagger:response v2ListHostDiagnosticsOK
+*/
+type V2ListHostDiagnosticsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.HostDiagnosticList `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiagnosticsOK creates V2ListHostDiagnosticsOK with default headers values
+func NewV2ListHostDiagnosticsOK() *V2ListHostDiagnosticsOK {
+
+	return &V2ListHostDiagnosticsOK{}
+}
+
+// WithPayload adds the payload to the v2 list host diagnostics o k response
+func (o *V2ListHostDiagnosticsOK) WithPayload(payload models.HostDiagnosticList) *V2ListHostDiagnosticsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host diagnostics o k response
+func (o *V2ListHostDiagnosticsOK) SetPayload(payload models.HostDiagnosticList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiagnosticsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.HostDiagnosticList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListHostDiagnosticsUnauthorizedCode is the HTTP code returned for type V2ListHostDiagnosticsUnauthorized
+const V2ListHostDiagnosticsUnauthorizedCode int = 401
+
+/*V2ListHostDiagnosticsUnauthorized Unauthorized.
+
+swagger:response v2ListHostDiagnosticsUnauthorized
+*/
+type V2ListHostDiagnosticsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiagnosticsUnauthorized creates V2ListHostDiagnosticsUnauthorized with default headers values
+func NewV2ListHostDiagnosticsUnauthorized() *V2ListHostDiagnosticsUnauthorized {
+
+	return &V2ListHostDiagnosticsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list host diagnostics unauthorized response
+func (o *V2ListHostDiagnosticsUnauthorized) WithPayload(payload *models.InfraError) *V2ListHostDiagnosticsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host diagnostics unauthorized response
+func (o *V2ListHostDiagnosticsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiagnosticsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostDiagnosticsForbiddenCode is the HTTP code returned for type V2ListHostDiagnosticsForbidden
+const V2ListHostDiagnosticsForbiddenCode int = 403
+
+/*V2ListHostDiagnosticsForbidden Forbidden.
+
+swagger:response v2ListHostDiagnosticsForbidden
+*/
+type V2ListHostDiagnosticsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiagnosticsForbidden creates V2ListHostDiagnosticsForbidden with default headers values
+func NewV2ListHostDiagnosticsForbidden() *V2ListHostDiagnosticsForbidden {
+
+	return &V2ListHostDiagnosticsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list host diagnostics forbidden response
+func (o *V2ListHostDiagnosticsForbidden) WithPayload(payload *models.InfraError) *V2ListHostDiagnosticsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host diagnostics forbidden response
+func (o *V2ListHostDiagnosticsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiagnosticsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostDiagnosticsNotFoundCode is the HTTP code returned for type V2ListHostDiagnosticsNotFound
+const V2ListHostDiagnosticsNotFoundCode int = 404
+
+/*V2ListHostDiagnosticsNotFound Error.
+
+swagger:response v2ListHostDiagnosticsNotFound
+*/
+type V2ListHostDiagnosticsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiagnosticsNotFound creates V2ListHostDiagnosticsNotFound with default headers values
+func NewV2ListHostDiagnosticsNotFound() *V2ListHostDiagnosticsNotFound {
+
+	return &V2ListHostDiagnosticsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list host diagnostics not found response
+func (o *V2ListHostDiagnosticsNotFound) WithPayload(payload *models.Error) *V2ListHostDiagnosticsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host diagnostics not found response
+func (o *V2ListHostDiagnosticsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiagnosticsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostDiagnosticsInternalServerErrorCode is the HTTP code returned for type V2ListHostDiagnosticsInternalServerError
+const V2ListHostDiagnosticsInternalServerErrorCode int = 500
+
+/*V2ListHostDiagnosticsInternalServerError Error.
+
+swagger:response v2ListHostDiagnosticsInternalServerError
+*/
+type V2ListHostDiagnosticsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiagnosticsInternalServerError creates V2ListHostDiagnosticsInternalServerError with default headers values
+func NewV2ListHostDiagnosticsInternalServerError() *V2ListHostDiagnosticsInternalServerError {
+
+	return &V2ListHostDiagnosticsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list host diagnostics internal server error response
+func (o *V2ListHostDiagnosticsInternalServerError) WithPayload(payload *models.Error) *V2ListHostDiagnosticsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host diagnostics internal server error response
+func (o *V2ListHostDiagnosticsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiagnosticsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
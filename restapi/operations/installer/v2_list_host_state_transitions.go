@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListHostStateTransitionsHandlerFunc turns a function with the right signature into a v2 list host state transitions handler
+type V2ListHostStateTransitionsHandlerFunc func(V2ListHostStateTransitionsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListHostStateTransitionsHandlerFunc) Handle(params V2ListHostStateTransitionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListHostStateTransitionsHandler interface for that can handle valid v2 list host state transitions params
+type V2ListHostStateTransitionsHandler interface {
+	Handle(V2ListHostStateTransitionsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListHostStateTransitions creates a new http.Handler for the v2 list host state transitions operation
+func NewV2ListHostStateTransitions(ctx *middleware.Context, handler V2ListHostStateTransitionsHandler) *V2ListHostStateTransitions {
+	return &V2ListHostStateTransitions{Context: ctx, Handler: handler}
+}
+
+/* V2ListHostStateTransitions swagger:route GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/state-transitions installer v2ListHostStateTransitions
+
+Lists the status transitions of the host, oldest first.
+
+*/
+type V2ListHostStateTransitions struct {
+	Context *middleware.Context
+	Handler V2ListHostStateTransitionsHandler
+}
+
+func (o *V2ListHostStateTransitions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListHostStateTransitionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,237 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostStateTransitionsOKCode is the HTTP code returned for type V2ListHostStateTransitionsOK
+const V2ListHostStateTransitionsOKCode int = 200
+
+/*V2ListHostStateTransitionsOK Success.
+
+swagger:response v2ListHostStateTransitionsOK
+*/
+type V2ListHostStateTransitionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.StateTransitionList `json:"body,omitempty"`
+}
+
+// NewV2ListHostStateTransitionsOK creates V2ListHostStateTransitionsOK with default headers values
+func NewV2ListHostStateTransitionsOK() *V2ListHostStateTransitionsOK {
+
+	return &V2ListHostStateTransitionsOK{}
+}
+
+// WithPayload adds the payload to the v2 list host state transitions o k response
+func (o *V2ListHostStateTransitionsOK) WithPayload(payload models.StateTransitionList) *V2ListHostStateTransitionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host state transitions o k response
+func (o *V2ListHostStateTransitionsOK) SetPayload(payload models.StateTransitionList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostStateTransitionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.StateTransitionList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListHostStateTransitionsUnauthorizedCode is the HTTP code returned for type V2ListHostStateTransitionsUnauthorized
+const V2ListHostStateTransitionsUnauthorizedCode int = 401
+
+/*V2ListHostStateTransitionsUnauthorized Unauthorized.
+
+swagger:response v2ListHostStateTransitionsUnauthorized
+*/
+type V2ListHostStateTransitionsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostStateTransitionsUnauthorized creates V2ListHostStateTransitionsUnauthorized with default headers values
+func NewV2ListHostStateTransitionsUnauthorized() *V2ListHostStateTransitionsUnauthorized {
+
+	return &V2ListHostStateTransitionsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list host state transitions unauthorized response
+func (o *V2ListHostStateTransitionsUnauthorized) WithPayload(payload *models.InfraError) *V2ListHostStateTransitionsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host state transitions unauthorized response
+func (o *V2ListHostStateTransitionsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostStateTransitionsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostStateTransitionsForbiddenCode is the HTTP code returned for type V2ListHostStateTransitionsForbidden
+const V2ListHostStateTransitionsForbiddenCode int = 403
+
+/*V2ListHostStateTransitionsForbidden Forbidden.
+
+swagger:response v2ListHostStateTransitionsForbidden
+*/
+type V2ListHostStateTransitionsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostStateTransitionsForbidden creates V2ListHostStateTransitionsForbidden with default headers values
+func NewV2ListHostStateTransitionsForbidden() *V2ListHostStateTransitionsForbidden {
+
+	return &V2ListHostStateTransitionsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list host state transitions forbidden response
+func (o *V2ListHostStateTransitionsForbidden) WithPayload(payload *models.InfraError) *V2ListHostStateTransitionsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host state transitions forbidden response
+func (o *V2ListHostStateTransitionsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostStateTransitionsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostStateTransitionsNotFoundCode is the HTTP code returned for type V2ListHostStateTransitionsNotFound
+const V2ListHostStateTransitionsNotFoundCode int = 404
+
+/*V2ListHostStateTransitionsNotFound Error.
+
+swagger:response v2ListHostStateTransitionsNotFound
+*/
+type V2ListHostStateTransitionsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostStateTransitionsNotFound creates V2ListHostStateTransitionsNotFound with default headers values
+func NewV2ListHostStateTransitionsNotFound() *V2ListHostStateTransitionsNotFound {
+
+	return &V2ListHostStateTransitionsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list host state transitions not found response
+func (o *V2ListHostStateTransitionsNotFound) WithPayload(payload *models.Error) *V2ListHostStateTransitionsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host state transitions not found response
+func (o *V2ListHostStateTransitionsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostStateTransitionsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostStateTransitionsInternalServerErrorCode is the HTTP code returned for type V2ListHostStateTransitionsInternalServerError
+const V2ListHostStateTransitionsInternalServerErrorCode int = 500
+
+/*V2ListHostStateTransitionsInternalServerError Error.
+
+swagger:response v2ListHostStateTransitionsInternalServerError
+*/
+type V2ListHostStateTransitionsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostStateTransitionsInternalServerError creates V2ListHostStateTransitionsInternalServerError with default headers values
+func NewV2ListHostStateTransitionsInternalServerError() *V2ListHostStateTransitionsInternalServerError {
+
+	return &V2ListHostStateTransitionsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list host state transitions internal server error response
+func (o *V2ListHostStateTransitionsInternalServerError) WithPayload(payload *models.Error) *V2ListHostStateTransitionsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host state transitions internal server error response
+func (o *V2ListHostStateTransitionsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostStateTransitionsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListHostValidationStatsHandlerFunc turns a function with the right signature into a v2 list host validation stats handler
+type V2ListHostValidationStatsHandlerFunc func(V2ListHostValidationStatsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListHostValidationStatsHandlerFunc) Handle(params V2ListHostValidationStatsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListHostValidationStatsHandler interface for that can handle valid v2 list host validation stats params
+type V2ListHostValidationStatsHandler interface {
+	Handle(V2ListHostValidationStatsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListHostValidationStats creates a new http.Handler for the v2 list host validation stats operation
+func NewV2ListHostValidationStats(ctx *middleware.Context, handler V2ListHostValidationStatsHandler) *V2ListHostValidationStats {
+	return &V2ListHostValidationStats{Context: ctx, Handler: handler}
+}
+
+/* V2ListHostValidationStats swagger:route GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/validation-stats installer v2ListHostValidationStats
+
+Lists the statistics of the validations of the host: how long they took to succeed after the host was
+discovered, and how often they changed between success and failure.
+
+
+*/
+type V2ListHostValidationStats struct {
+	Context *middleware.Context
+	Handler V2ListHostValidationStatsHandler
+}
+
+func (o *V2ListHostValidationStats) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListHostValidationStatsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,237 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostValidationStatsOKCode is the HTTP code returned for type V2ListHostValidationStatsOK
+const V2ListHostValidationStatsOKCode int = 200
+
+/*V2ListHostValidationStatsOK Success.
+
+swagger:response v2ListHostValidationStatsOK
+*/
+type V2ListHostValidationStatsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.HostValidationStatsList `json:"body,omitempty"`
+}
+
+// NewV2ListHostValidationStatsOK creates V2ListHostValidationStatsOK with default headers values
+func NewV2ListHostValidationStatsOK() *V2ListHostValidationStatsOK {
+
+	return &V2ListHostValidationStatsOK{}
+}
+
+// WithPayload adds the payload to the v2 list host validation stats o k response
+func (o *V2ListHostValidationStatsOK) WithPayload(payload models.HostValidationStatsList) *V2ListHostValidationStatsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host validation stats o k response
+func (o *V2ListHostValidationStatsOK) SetPayload(payload models.HostValidationStatsList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostValidationStatsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.HostValidationStatsList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListHostValidationStatsUnauthorizedCode is the HTTP code returned for type V2ListHostValidationStatsUnauthorized
+const V2ListHostValidationStatsUnauthorizedCode int = 401
+
+/*V2ListHostValidationStatsUnauthorized Unauthorized.
+
+swagger:response v2ListHostValidationStatsUnauthorized
+*/
+type V2ListHostValidationStatsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostValidationStatsUnauthorized creates V2ListHostValidationStatsUnauthorized with default headers values
+func NewV2ListHostValidationStatsUnauthorized() *V2ListHostValidationStatsUnauthorized {
+
+	return &V2ListHostValidationStatsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list host validation stats unauthorized response
+func (o *V2ListHostValidationStatsUnauthorized) WithPayload(payload *models.InfraError) *V2ListHostValidationStatsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host validation stats unauthorized response
+func (o *V2ListHostValidationStatsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostValidationStatsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostValidationStatsForbiddenCode is the HTTP code returned for type V2ListHostValidationStatsForbidden
+const V2ListHostValidationStatsForbiddenCode int = 403
+
+/*V2ListHostValidationStatsForbidden Forbidden.
+
+swagger:response v2ListHostValidationStatsForbidden
+*/
+type V2ListHostValidationStatsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostValidationStatsForbidden creates V2ListHostValidationStatsForbidden with default headers values
+func NewV2ListHostValidationStatsForbidden() *V2ListHostValidationStatsForbidden {
+
+	return &V2ListHostValidationStatsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list host validation stats forbidden response
+func (o *V2ListHostValidationStatsForbidden) WithPayload(payload *models.InfraError) *V2ListHostValidationStatsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host validation stats forbidden response
+func (o *V2ListHostValidationStatsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostValidationStatsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostValidationStatsNotFoundCode is the HTTP code returned for type V2ListHostValidationStatsNotFound
+const V2ListHostValidationStatsNotFoundCode int = 404
+
+/*V2ListHostValidationStatsNotFound Error.
+
+swagger:response v2ListHostValidationStatsNotFound
+*/
+type V2ListHostValidationStatsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostValidationStatsNotFound creates V2ListHostValidationStatsNotFound with default headers values
+func NewV2ListHostValidationStatsNotFound() *V2ListHostValidationStatsNotFound {
+
+	return &V2ListHostValidationStatsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list host validation stats not found response
+func (o *V2ListHostValidationStatsNotFound) WithPayload(payload *models.Error) *V2ListHostValidationStatsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host validation stats not found response
+func (o *V2ListHostValidationStatsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostValidationStatsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostValidationStatsInternalServerErrorCode is the HTTP code returned for type V2ListHostValidationStatsInternalServerError
+const V2ListHostValidationStatsInternalServerErrorCode int = 500
+
+/*V2ListHostValidationStatsInternalServerError Error.
+
+swagger:response v2ListHostValidationStatsInternalServerError
+*/
+type V2ListHostValidationStatsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostValidationStatsInternalServerError creates V2ListHostValidationStatsInternalServerError with default headers values
+func NewV2ListHostValidationStatsInternalServerError() *V2ListHostValidationStatsInternalServerError {
+
+	return &V2ListHostValidationStatsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list host validation stats internal server error response
+func (o *V2ListHostValidationStatsInternalServerError) WithPayload(payload *models.Error) *V2ListHostValidationStatsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host validation stats internal server error response
+func (o *V2ListHostValidationStatsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostValidationStatsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
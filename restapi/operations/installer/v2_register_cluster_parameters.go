@@ -13,6 +13,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
 
 	"github.com/openshift/assisted-service/models"
@@ -35,6 +36,13 @@ type V2RegisterClusterParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*A cluster template to create the cluster from. The properties describing the new cluster take precedence
+	over the ones of the template, and the manifests and install config overrides of the template are added
+	to the cluster.
+
+	  In: query
+	*/
+	FromTemplate *strfmt.UUID
 	/*The properties describing the new cluster.
 	  Required: true
 	  In: body
@@ -51,6 +59,13 @@ func (o *V2RegisterClusterParams) BindRequest(r *http.Request, route *middleware
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qFromTemplate, qhkFromTemplate, _ := qs.GetOK("from_template")
+	if err := o.bindFromTemplate(qFromTemplate, qhkFromTemplate, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.ClusterCreateParams
@@ -83,3 +98,40 @@ func (o *V2RegisterClusterParams) BindRequest(r *http.Request, route *middleware
 	}
 	return nil
 }
+
+// bindFromTemplate binds and validates parameter FromTemplate from query.
+func (o *V2RegisterClusterParams) bindFromTemplate(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("from_template", "query", "strfmt.UUID", raw)
+	}
+	o.FromTemplate = (value.(*strfmt.UUID))
+
+	if err := o.validateFromTemplate(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFromTemplate carries on validations for parameter FromTemplate
+func (o *V2RegisterClusterParams) validateFromTemplate(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("from_template", "query", "uuid", o.FromTemplate.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
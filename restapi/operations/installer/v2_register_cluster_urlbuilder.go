@@ -9,11 +9,17 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
 )
 
 // V2RegisterClusterURL generates an URL for the v2 register cluster operation
 type V2RegisterClusterURL struct {
+	FromTemplate *strfmt.UUID
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -43,6 +49,18 @@ func (o *V2RegisterClusterURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var fromTemplateQ string
+	if o.FromTemplate != nil {
+		fromTemplateQ = o.FromTemplate.String()
+	}
+	if fromTemplateQ != "" {
+		qs.Set("from_template", fromTemplateQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ReinstallFailedHostsHandlerFunc turns a function with the right signature into a v2 reinstall failed hosts handler
+type V2ReinstallFailedHostsHandlerFunc func(V2ReinstallFailedHostsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ReinstallFailedHostsHandlerFunc) Handle(params V2ReinstallFailedHostsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ReinstallFailedHostsHandler interface for that can handle valid v2 reinstall failed hosts params
+type V2ReinstallFailedHostsHandler interface {
+	Handle(V2ReinstallFailedHostsParams, interface{}) middleware.Responder
+}
+
+// NewV2ReinstallFailedHosts creates a new http.Handler for the v2 reinstall failed hosts operation
+func NewV2ReinstallFailedHosts(ctx *middleware.Context, handler V2ReinstallFailedHostsHandler) *V2ReinstallFailedHosts {
+	return &V2ReinstallFailedHosts{Context: ctx, Handler: handler}
+}
+
+/* V2ReinstallFailedHosts swagger:route POST /v2/clusters/{cluster_id}/actions/reinstall-failed-hosts installer v2ReinstallFailedHosts
+
+Resets only the failed or cancelled workers of an installing or installed cluster, without touching
+its other hosts. The hosts become day-2 hosts of the cluster: once they are rebooted into the discovery
+image and register again, they are installed with v2InstallHost and join the cluster through the
+add-hosts flow, using a freshly generated day-2 ignition.
+
+
+*/
+type V2ReinstallFailedHosts struct {
+	Context *middleware.Context
+	Handler V2ReinstallFailedHostsHandler
+}
+
+func (o *V2ReinstallFailedHosts) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ReinstallFailedHostsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
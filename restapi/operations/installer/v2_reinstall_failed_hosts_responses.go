@@ -0,0 +1,322 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ReinstallFailedHostsAcceptedCode is the HTTP code returned for type V2ReinstallFailedHostsAccepted
+const V2ReinstallFailedHostsAcceptedCode int = 202
+
+/*V2ReinstallFailedHostsAccepted Success.
+
+swagger:response v2ReinstallFailedHostsAccepted
+*/
+type V2ReinstallFailedHostsAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Cluster `json:"body,omitempty"`
+}
+
+// NewV2ReinstallFailedHostsAccepted creates V2ReinstallFailedHostsAccepted with default headers values
+func NewV2ReinstallFailedHostsAccepted() *V2ReinstallFailedHostsAccepted {
+
+	return &V2ReinstallFailedHostsAccepted{}
+}
+
+// WithPayload adds the payload to the v2 reinstall failed hosts accepted response
+func (o *V2ReinstallFailedHostsAccepted) WithPayload(payload *models.Cluster) *V2ReinstallFailedHostsAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 reinstall failed hosts accepted response
+func (o *V2ReinstallFailedHostsAccepted) SetPayload(payload *models.Cluster) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ReinstallFailedHostsAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ReinstallFailedHostsUnauthorizedCode is the HTTP code returned for type V2ReinstallFailedHostsUnauthorized
+const V2ReinstallFailedHostsUnauthorizedCode int = 401
+
+/*V2ReinstallFailedHostsUnauthorized Unauthorized.
+
+swagger:response v2ReinstallFailedHostsUnauthorized
+*/
+type V2ReinstallFailedHostsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ReinstallFailedHostsUnauthorized creates V2ReinstallFailedHostsUnauthorized with default headers values
+func NewV2ReinstallFailedHostsUnauthorized() *V2ReinstallFailedHostsUnauthorized {
+
+	return &V2ReinstallFailedHostsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 reinstall failed hosts unauthorized response
+func (o *V2ReinstallFailedHostsUnauthorized) WithPayload(payload *models.InfraError) *V2ReinstallFailedHostsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 reinstall failed hosts unauthorized response
+func (o *V2ReinstallFailedHostsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ReinstallFailedHostsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ReinstallFailedHostsForbiddenCode is the HTTP code returned for type V2ReinstallFailedHostsForbidden
+const V2ReinstallFailedHostsForbiddenCode int = 403
+
+/*V2ReinstallFailedHostsForbidden Forbidden.
+
+swagger:response v2ReinstallFailedHostsForbidden
+*/
+type V2ReinstallFailedHostsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ReinstallFailedHostsForbidden creates V2ReinstallFailedHostsForbidden with default headers values
+func NewV2ReinstallFailedHostsForbidden() *V2ReinstallFailedHostsForbidden {
+
+	return &V2ReinstallFailedHostsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 reinstall failed hosts forbidden response
+func (o *V2ReinstallFailedHostsForbidden) WithPayload(payload *models.InfraError) *V2ReinstallFailedHostsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 reinstall failed hosts forbidden response
+func (o *V2ReinstallFailedHostsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ReinstallFailedHostsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ReinstallFailedHostsNotFoundCode is the HTTP code returned for type V2ReinstallFailedHostsNotFound
+const V2ReinstallFailedHostsNotFoundCode int = 404
+
+/*V2ReinstallFailedHostsNotFound Error.
+
+swagger:response v2ReinstallFailedHostsNotFound
+*/
+type V2ReinstallFailedHostsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ReinstallFailedHostsNotFound creates V2ReinstallFailedHostsNotFound with default headers values
+func NewV2ReinstallFailedHostsNotFound() *V2ReinstallFailedHostsNotFound {
+
+	return &V2ReinstallFailedHostsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 reinstall failed hosts not found response
+func (o *V2ReinstallFailedHostsNotFound) WithPayload(payload *models.Error) *V2ReinstallFailedHostsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 reinstall failed hosts not found response
+func (o *V2ReinstallFailedHostsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ReinstallFailedHostsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ReinstallFailedHostsMethodNotAllowedCode is the HTTP code returned for type V2ReinstallFailedHostsMethodNotAllowed
+const V2ReinstallFailedHostsMethodNotAllowedCode int = 405
+
+/*V2ReinstallFailedHostsMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ReinstallFailedHostsMethodNotAllowed
+*/
+type V2ReinstallFailedHostsMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ReinstallFailedHostsMethodNotAllowed creates V2ReinstallFailedHostsMethodNotAllowed with default headers values
+func NewV2ReinstallFailedHostsMethodNotAllowed() *V2ReinstallFailedHostsMethodNotAllowed {
+
+	return &V2ReinstallFailedHostsMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 reinstall failed hosts method not allowed response
+func (o *V2ReinstallFailedHostsMethodNotAllowed) WithPayload(payload *models.Error) *V2ReinstallFailedHostsMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 reinstall failed hosts method not allowed response
+func (o *V2ReinstallFailedHostsMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ReinstallFailedHostsMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ReinstallFailedHostsConflictCode is the HTTP code returned for type V2ReinstallFailedHostsConflict
+const V2ReinstallFailedHostsConflictCode int = 409
+
+/*V2ReinstallFailedHostsConflict Error.
+
+swagger:response v2ReinstallFailedHostsConflict
+*/
+type V2ReinstallFailedHostsConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ReinstallFailedHostsConflict creates V2ReinstallFailedHostsConflict with default headers values
+func NewV2ReinstallFailedHostsConflict() *V2ReinstallFailedHostsConflict {
+
+	return &V2ReinstallFailedHostsConflict{}
+}
+
+// WithPayload adds the payload to the v2 reinstall failed hosts conflict response
+func (o *V2ReinstallFailedHostsConflict) WithPayload(payload *models.Error) *V2ReinstallFailedHostsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 reinstall failed hosts conflict response
+func (o *V2ReinstallFailedHostsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ReinstallFailedHostsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ReinstallFailedHostsInternalServerErrorCode is the HTTP code returned for type V2ReinstallFailedHostsInternalServerError
+const V2ReinstallFailedHostsInternalServerErrorCode int = 500
+
+/*V2ReinstallFailedHostsInternalServerError Error.
+
+swagger:response v2ReinstallFailedHostsInternalServerError
+*/
+type V2ReinstallFailedHostsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ReinstallFailedHostsInternalServerError creates V2ReinstallFailedHostsInternalServerError with default headers values
+func NewV2ReinstallFailedHostsInternalServerError() *V2ReinstallFailedHostsInternalServerError {
+
+	return &V2ReinstallFailedHostsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 reinstall failed hosts internal server error response
+func (o *V2ReinstallFailedHostsInternalServerError) WithPayload(payload *models.Error) *V2ReinstallFailedHostsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 reinstall failed hosts internal server error response
+func (o *V2ReinstallFailedHostsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ReinstallFailedHostsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2RestoreDeletedClusterHandlerFunc turns a function with the right signature into a v2 restore deleted cluster handler
+type V2RestoreDeletedClusterHandlerFunc func(V2RestoreDeletedClusterParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2RestoreDeletedClusterHandlerFunc) Handle(params V2RestoreDeletedClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2RestoreDeletedClusterHandler interface for that can handle valid v2 restore deleted cluster params
+type V2RestoreDeletedClusterHandler interface {
+	Handle(V2RestoreDeletedClusterParams, interface{}) middleware.Responder
+}
+
+// NewV2RestoreDeletedCluster creates a new http.Handler for the v2 restore deleted cluster operation
+func NewV2RestoreDeletedCluster(ctx *middleware.Context, handler V2RestoreDeletedClusterHandler) *V2RestoreDeletedCluster {
+	return &V2RestoreDeletedCluster{Context: ctx, Handler: handler}
+}
+
+/* V2RestoreDeletedCluster swagger:route POST /v2/deleted-clusters/{cluster_id}/actions/restore installer v2RestoreDeletedCluster
+
+Restores a deregistered cluster, together with the hosts that were deregistered with it, before it is
+permanently deleted.
+
+
+*/
+type V2RestoreDeletedCluster struct {
+	Context *middleware.Context
+	Handler V2RestoreDeletedClusterHandler
+}
+
+func (o *V2RestoreDeletedCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2RestoreDeletedClusterParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,366 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2RestoreDeletedClusterCreatedCode is the HTTP code returned for type V2RestoreDeletedClusterCreated
+const V2RestoreDeletedClusterCreatedCode int = 201
+
+/*V2RestoreDeletedClusterCreated Success.
+
+swagger:response v2RestoreDeletedClusterCreated
+*/
+type V2RestoreDeletedClusterCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Cluster `json:"body,omitempty"`
+}
+
+// NewV2RestoreDeletedClusterCreated creates V2RestoreDeletedClusterCreated with default headers values
+func NewV2RestoreDeletedClusterCreated() *V2RestoreDeletedClusterCreated {
+
+	return &V2RestoreDeletedClusterCreated{}
+}
+
+// WithPayload adds the payload to the v2 restore deleted cluster created response
+func (o *V2RestoreDeletedClusterCreated) WithPayload(payload *models.Cluster) *V2RestoreDeletedClusterCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 restore deleted cluster created response
+func (o *V2RestoreDeletedClusterCreated) SetPayload(payload *models.Cluster) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RestoreDeletedClusterCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RestoreDeletedClusterBadRequestCode is the HTTP code returned for type V2RestoreDeletedClusterBadRequest
+const V2RestoreDeletedClusterBadRequestCode int = 400
+
+/*V2RestoreDeletedClusterBadRequest Error.
+
+swagger:response v2RestoreDeletedClusterBadRequest
+*/
+type V2RestoreDeletedClusterBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RestoreDeletedClusterBadRequest creates V2RestoreDeletedClusterBadRequest with default headers values
+func NewV2RestoreDeletedClusterBadRequest() *V2RestoreDeletedClusterBadRequest {
+
+	return &V2RestoreDeletedClusterBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 restore deleted cluster bad request response
+func (o *V2RestoreDeletedClusterBadRequest) WithPayload(payload *models.Error) *V2RestoreDeletedClusterBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 restore deleted cluster bad request response
+func (o *V2RestoreDeletedClusterBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RestoreDeletedClusterBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RestoreDeletedClusterUnauthorizedCode is the HTTP code returned for type V2RestoreDeletedClusterUnauthorized
+const V2RestoreDeletedClusterUnauthorizedCode int = 401
+
+/*V2RestoreDeletedClusterUnauthorized Unauthorized.
+
+swagger:response v2RestoreDeletedClusterUnauthorized
+*/
+type V2RestoreDeletedClusterUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RestoreDeletedClusterUnauthorized creates V2RestoreDeletedClusterUnauthorized with default headers values
+func NewV2RestoreDeletedClusterUnauthorized() *V2RestoreDeletedClusterUnauthorized {
+
+	return &V2RestoreDeletedClusterUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 restore deleted cluster unauthorized response
+func (o *V2RestoreDeletedClusterUnauthorized) WithPayload(payload *models.InfraError) *V2RestoreDeletedClusterUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 restore deleted cluster unauthorized response
+func (o *V2RestoreDeletedClusterUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RestoreDeletedClusterUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RestoreDeletedClusterForbiddenCode is the HTTP code returned for type V2RestoreDeletedClusterForbidden
+const V2RestoreDeletedClusterForbiddenCode int = 403
+
+/*V2RestoreDeletedClusterForbidden Forbidden.
+
+swagger:response v2RestoreDeletedClusterForbidden
+*/
+type V2RestoreDeletedClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2RestoreDeletedClusterForbidden creates V2RestoreDeletedClusterForbidden with default headers values
+func NewV2RestoreDeletedClusterForbidden() *V2RestoreDeletedClusterForbidden {
+
+	return &V2RestoreDeletedClusterForbidden{}
+}
+
+// WithPayload adds the payload to the v2 restore deleted cluster forbidden response
+func (o *V2RestoreDeletedClusterForbidden) WithPayload(payload *models.InfraError) *V2RestoreDeletedClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 restore deleted cluster forbidden response
+func (o *V2RestoreDeletedClusterForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RestoreDeletedClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RestoreDeletedClusterNotFoundCode is the HTTP code returned for type V2RestoreDeletedClusterNotFound
+const V2RestoreDeletedClusterNotFoundCode int = 404
+
+/*V2RestoreDeletedClusterNotFound Error.
+
+swagger:response v2RestoreDeletedClusterNotFound
+*/
+type V2RestoreDeletedClusterNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RestoreDeletedClusterNotFound creates V2RestoreDeletedClusterNotFound with default headers values
+func NewV2RestoreDeletedClusterNotFound() *V2RestoreDeletedClusterNotFound {
+
+	return &V2RestoreDeletedClusterNotFound{}
+}
+
+// WithPayload adds the payload to the v2 restore deleted cluster not found response
+func (o *V2RestoreDeletedClusterNotFound) WithPayload(payload *models.Error) *V2RestoreDeletedClusterNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 restore deleted cluster not found response
+func (o *V2RestoreDeletedClusterNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RestoreDeletedClusterNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RestoreDeletedClusterMethodNotAllowedCode is the HTTP code returned for type V2RestoreDeletedClusterMethodNotAllowed
+const V2RestoreDeletedClusterMethodNotAllowedCode int = 405
+
+/*V2RestoreDeletedClusterMethodNotAllowed Method Not Allowed.
+
+swagger:response v2RestoreDeletedClusterMethodNotAllowed
+*/
+type V2RestoreDeletedClusterMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RestoreDeletedClusterMethodNotAllowed creates V2RestoreDeletedClusterMethodNotAllowed with default headers values
+func NewV2RestoreDeletedClusterMethodNotAllowed() *V2RestoreDeletedClusterMethodNotAllowed {
+
+	return &V2RestoreDeletedClusterMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 restore deleted cluster method not allowed response
+func (o *V2RestoreDeletedClusterMethodNotAllowed) WithPayload(payload *models.Error) *V2RestoreDeletedClusterMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 restore deleted cluster method not allowed response
+func (o *V2RestoreDeletedClusterMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RestoreDeletedClusterMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RestoreDeletedClusterConflictCode is the HTTP code returned for type V2RestoreDeletedClusterConflict
+const V2RestoreDeletedClusterConflictCode int = 409
+
+/*V2RestoreDeletedClusterConflict Error.
+
+swagger:response v2RestoreDeletedClusterConflict
+*/
+type V2RestoreDeletedClusterConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RestoreDeletedClusterConflict creates V2RestoreDeletedClusterConflict with default headers values
+func NewV2RestoreDeletedClusterConflict() *V2RestoreDeletedClusterConflict {
+
+	return &V2RestoreDeletedClusterConflict{}
+}
+
+// WithPayload adds the payload to the v2 restore deleted cluster conflict response
+func (o *V2RestoreDeletedClusterConflict) WithPayload(payload *models.Error) *V2RestoreDeletedClusterConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 restore deleted cluster conflict response
+func (o *V2RestoreDeletedClusterConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RestoreDeletedClusterConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2RestoreDeletedClusterInternalServerErrorCode is the HTTP code returned for type V2RestoreDeletedClusterInternalServerError
+const V2RestoreDeletedClusterInternalServerErrorCode int = 500
+
+/*V2RestoreDeletedClusterInternalServerError Error.
+
+swagger:response v2RestoreDeletedClusterInternalServerError
+*/
+type V2RestoreDeletedClusterInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2RestoreDeletedClusterInternalServerError creates V2RestoreDeletedClusterInternalServerError with default headers values
+func NewV2RestoreDeletedClusterInternalServerError() *V2RestoreDeletedClusterInternalServerError {
+
+	return &V2RestoreDeletedClusterInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 restore deleted cluster internal server error response
+func (o *V2RestoreDeletedClusterInternalServerError) WithPayload(payload *models.Error) *V2RestoreDeletedClusterInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 restore deleted cluster internal server error response
+func (o *V2RestoreDeletedClusterInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2RestoreDeletedClusterInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -36,6 +36,10 @@ type V2UpdateClusterParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Update the cluster only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.
+	  In: header
+	*/
+	IfMatch *string
 	/*The properties to update.
 	  Required: true
 	  In: body
@@ -57,6 +61,10 @@ func (o *V2UpdateClusterParams) BindRequest(r *http.Request, route *middleware.M
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.V2ClusterUpdateParams
@@ -95,6 +103,23 @@ func (o *V2UpdateClusterParams) BindRequest(r *http.Request, route *middleware.M
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *V2UpdateClusterParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindClusterID binds and validates parameter ClusterID from path.
 func (o *V2UpdateClusterParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
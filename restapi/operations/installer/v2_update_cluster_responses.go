@@ -21,6 +21,10 @@ const V2UpdateClusterCreatedCode int = 201
 swagger:response v2UpdateClusterCreated
 */
 type V2UpdateClusterCreated struct {
+	/*The resource version of the cluster, to be sent in the If-Match header of its updates.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewV2UpdateClusterCreated() *V2UpdateClusterCreated {
 	return &V2UpdateClusterCreated{}
 }
 
+// WithETag adds the eTag to the v2 update cluster created response
+func (o *V2UpdateClusterCreated) WithETag(eTag string) *V2UpdateClusterCreated {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the v2 update cluster created response
+func (o *V2UpdateClusterCreated) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the v2 update cluster created response
 func (o *V2UpdateClusterCreated) WithPayload(payload *models.Cluster) *V2UpdateClusterCreated {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *V2UpdateClusterCreated) SetPayload(payload *models.Cluster) {
 // WriteResponse to the client
 func (o *V2UpdateClusterCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(201)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -321,6 +343,50 @@ func (o *V2UpdateClusterConflict) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// V2UpdateClusterPreconditionFailedCode is the HTTP code returned for type V2UpdateClusterPreconditionFailed
+const V2UpdateClusterPreconditionFailedCode int = 412
+
+/*V2UpdateClusterPreconditionFailed Precondition Failed.
+
+swagger:response v2UpdateClusterPreconditionFailed
+*/
+type V2UpdateClusterPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterPreconditionFailed creates V2UpdateClusterPreconditionFailed with default headers values
+func NewV2UpdateClusterPreconditionFailed() *V2UpdateClusterPreconditionFailed {
+
+	return &V2UpdateClusterPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the v2 update cluster precondition failed response
+func (o *V2UpdateClusterPreconditionFailed) WithPayload(payload *models.Error) *V2UpdateClusterPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster precondition failed response
+func (o *V2UpdateClusterPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // V2UpdateClusterInternalServerErrorCode is the HTTP code returned for type V2UpdateClusterInternalServerError
 const V2UpdateClusterInternalServerErrorCode int = 500
 
@@ -36,6 +36,10 @@ type V2UpdateHostParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Update the host only if its ETag matches one of the given ETags, to avoid overwriting concurrent updates.
+	  In: header
+	*/
+	IfMatch *string
 	/*The properties to update.
 	  Required: true
 	  In: body
@@ -62,6 +66,10 @@ func (o *V2UpdateHostParams) BindRequest(r *http.Request, route *middleware.Matc
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.HostUpdateParams
@@ -105,6 +113,23 @@ func (o *V2UpdateHostParams) BindRequest(r *http.Request, route *middleware.Matc
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *V2UpdateHostParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindHostID binds and validates parameter HostID from path.
 func (o *V2UpdateHostParams) bindHostID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
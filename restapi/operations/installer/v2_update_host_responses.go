@@ -21,6 +21,10 @@ const V2UpdateHostCreatedCode int = 201
 swagger:response v2UpdateHostCreated
 */
 type V2UpdateHostCreated struct {
+	/*The resource version of the host, to be sent in the If-Match header of its updates.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewV2UpdateHostCreated() *V2UpdateHostCreated {
 	return &V2UpdateHostCreated{}
 }
 
+// WithETag adds the eTag to the v2 update host created response
+func (o *V2UpdateHostCreated) WithETag(eTag string) *V2UpdateHostCreated {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the v2 update host created response
+func (o *V2UpdateHostCreated) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the v2 update host created response
 func (o *V2UpdateHostCreated) WithPayload(payload *models.Host) *V2UpdateHostCreated {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *V2UpdateHostCreated) SetPayload(payload *models.Host) {
 // WriteResponse to the client
 func (o *V2UpdateHostCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(201)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -321,6 +343,50 @@ func (o *V2UpdateHostConflict) WriteResponse(rw http.ResponseWriter, producer ru
 	}
 }
 
+// V2UpdateHostPreconditionFailedCode is the HTTP code returned for type V2UpdateHostPreconditionFailed
+const V2UpdateHostPreconditionFailedCode int = 412
+
+/*V2UpdateHostPreconditionFailed Precondition Failed.
+
+swagger:response v2UpdateHostPreconditionFailed
+*/
+type V2UpdateHostPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateHostPreconditionFailed creates V2UpdateHostPreconditionFailed with default headers values
+func NewV2UpdateHostPreconditionFailed() *V2UpdateHostPreconditionFailed {
+
+	return &V2UpdateHostPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the v2 update host precondition failed response
+func (o *V2UpdateHostPreconditionFailed) WithPayload(payload *models.Error) *V2UpdateHostPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update host precondition failed response
+func (o *V2UpdateHostPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateHostPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // V2UpdateHostInternalServerErrorCode is the HTTP code returned for type V2UpdateHostInternalServerError
 const V2UpdateHostInternalServerErrorCode int = 500
 